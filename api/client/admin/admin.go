@@ -6,6 +6,10 @@ import (
 
 // Admin provides access to Powergate admin APIs.
 type Admin struct {
+	Backups     *Backups
+	Deals       *Deals
+	Logs        *Logs
+	Miners      *Miners
 	StorageJobs *StorageJobs
 	Users       *Users
 	Wallet      *Wallet
@@ -14,6 +18,10 @@ type Admin struct {
 // NewAdmin creates a new admin API.
 func NewAdmin(client adminPb.AdminServiceClient) *Admin {
 	return &Admin{
+		Backups:     &Backups{client: client},
+		Deals:       &Deals{client: client},
+		Logs:        &Logs{client: client},
+		Miners:      &Miners{client: client},
 		StorageJobs: &StorageJobs{client: client},
 		Users:       &Users{client: client},
 		Wallet:      &Wallet{client: client},
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"context"
+	"io"
+
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+)
+
+// Backups provides access to Powergate backup admin APIs.
+type Backups struct {
+	client adminPb.AdminServiceClient
+}
+
+// Create writes an archive of a consistent snapshot of the Powergate
+// datastore to w. If userID isn't empty, only that user data is included.
+func (b *Backups) Create(ctx context.Context, w io.Writer, userID string) error {
+	stream, err := b.client.Backup(ctx, &adminPb.BackupRequest{UserId: userID})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(res.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
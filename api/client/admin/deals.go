@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+	"io"
+
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
+)
+
+// Deals provides access to Powergate deals admin APIs.
+type Deals struct {
+	client adminPb.AdminServiceClient
+}
+
+// Export writes the deal records of all users configured by config to w,
+// as CSV or Parquet. If userID isn't empty, only that user records are
+// included.
+func (d *Deals) Export(ctx context.Context, w io.Writer, userID string, config *userPb.DealRecordsExportConfig) error {
+	stream, err := d.client.ExportDealRecords(ctx, &adminPb.ExportDealRecordsRequest{UserId: userID, Config: config})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(res.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
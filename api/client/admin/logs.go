@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+)
+
+// Logs provides access to Powergate job logs admin APIs.
+type Logs struct {
+	client adminPb.AdminServiceClient
+}
+
+// Purge deletes job logs older than olderThan. If cid isn't empty, only
+// logs of cid are deleted.
+func (l *Logs) Purge(ctx context.Context, cid string, olderThan time.Duration) (*adminPb.PurgeLogsResponse, error) {
+	req := &adminPb.PurgeLogsRequest{
+		Cid:       cid,
+		OlderThan: int64(olderThan.Seconds()),
+	}
+	return l.client.PurgeLogs(ctx, req)
+}
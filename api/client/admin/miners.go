@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"context"
+
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+)
+
+// Miners provides access to Powergate miners admin APIs.
+type Miners struct {
+	client adminPb.AdminServiceClient
+}
+
+// SetCountryOverride pins the country of a miner, overriding the
+// one resolved from its IP addresses.
+func (m *Miners) SetCountryOverride(ctx context.Context, minerAddr, country string) (*adminPb.SetCountryOverrideResponse, error) {
+	req := &adminPb.SetCountryOverrideRequest{
+		MinerAddress: minerAddr,
+		Country:      country,
+	}
+	return m.client.SetCountryOverride(ctx, req)
+}
+
+// RemoveCountryOverride removes the pinned country of a miner.
+func (m *Miners) RemoveCountryOverride(ctx context.Context, minerAddr string) (*adminPb.RemoveCountryOverrideResponse, error) {
+	req := &adminPb.RemoveCountryOverrideRequest{
+		MinerAddress: minerAddr,
+	}
+	return m.client.RemoveCountryOverride(ctx, req)
+}
+
+// CountryOverrides lists all pinned miner countries.
+func (m *Miners) CountryOverrides(ctx context.Context) (*adminPb.CountryOverridesResponse, error) {
+	return m.client.CountryOverrides(ctx, &adminPb.CountryOverridesRequest{})
+}
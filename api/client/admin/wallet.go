@@ -3,8 +3,10 @@ package admin
 import (
 	"context"
 	"math/big"
+	"time"
 
 	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 )
 
 // Wallet provides access to Powergate wallet admin APIs.
@@ -34,3 +36,56 @@ func (w *Wallet) SendFil(ctx context.Context, from, to string, amount *big.Int)
 	}
 	return w.client.SendFil(ctx, req)
 }
+
+// SetSpendPolicy sets the spend policy of an address of a user.
+func (w *Wallet) SetSpendPolicy(ctx context.Context, userID, address string, policy *userPb.SpendPolicy) (*adminPb.SetSpendPolicyResponse, error) {
+	req := &adminPb.SetSpendPolicyRequest{
+		UserId:      userID,
+		Address:     address,
+		SpendPolicy: policy,
+	}
+	return w.client.SetSpendPolicy(ctx, req)
+}
+
+// SendRequests lists the transfers waiting for approval. If userID is empty,
+// requests of all users are returned.
+func (w *Wallet) SendRequests(ctx context.Context, userID string) (*adminPb.SendRequestsResponse, error) {
+	return w.client.SendRequests(ctx, &adminPb.SendRequestsRequest{UserId: userID})
+}
+
+// ApproveSendRequest approves and sends a transfer waiting for approval.
+func (w *Wallet) ApproveSendRequest(ctx context.Context, userID, id string) (*adminPb.ApproveSendRequestResponse, error) {
+	return w.client.ApproveSendRequest(ctx, &adminPb.ApproveSendRequestRequest{UserId: userID, Id: id})
+}
+
+// RejectSendRequest rejects a transfer waiting for approval.
+func (w *Wallet) RejectSendRequest(ctx context.Context, userID, id string) (*adminPb.RejectSendRequestResponse, error) {
+	return w.client.RejectSendRequest(ctx, &adminPb.RejectSendRequestRequest{UserId: userID, Id: id})
+}
+
+// ExportUserKeys exports the private keys of a user addresses encrypted with passphrase.
+func (w *Wallet) ExportUserKeys(ctx context.Context, userID, passphrase string) (*adminPb.ExportUserKeysResponse, error) {
+	return w.client.ExportUserKeys(ctx, &adminPb.ExportUserKeysRequest{UserId: userID, Passphrase: passphrase})
+}
+
+// ImportUserKey imports a key exported with ExportUserKeys and adds its address to a user.
+func (w *Wallet) ImportUserKey(ctx context.Context, userID, name string, key []byte, passphrase string, makeDefault bool) (*adminPb.ImportUserKeyResponse, error) {
+	req := &adminPb.ImportUserKeyRequest{
+		UserId:      userID,
+		Name:        name,
+		Key:         key,
+		Passphrase:  passphrase,
+		MakeDefault: makeDefault,
+	}
+	return w.client.ImportUserKey(ctx, req)
+}
+
+// FundsStatus returns the last known funds status of user addresses.
+func (w *Wallet) FundsStatus(ctx context.Context) (*adminPb.FundsStatusResponse, error) {
+	return w.client.FundsStatus(ctx, &adminPb.FundsStatusRequest{})
+}
+
+// FundsEvents returns the funds events of user addresses since a time.
+func (w *Wallet) FundsEvents(ctx context.Context, since time.Time) (*adminPb.FundsEventsResponse, error) {
+	return w.client.FundsEvents(ctx, &adminPb.FundsEventsRequest{Since: since.Unix()})
+}
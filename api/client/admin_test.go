@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/api/client/admin"
 	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/backup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -74,3 +76,32 @@ func setupAdmin(t *testing.T, adminAuthToken string) (*admin.Admin, func()) {
 		serverDone()
 	}
 }
+
+func TestBackup(t *testing.T) {
+	a, done := setupAdmin(t, "")
+	defer done()
+
+	resp, err := a.Users.Create(ctx)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = a.Backups.Create(ctx, &buf, "")
+	require.NoError(t, err)
+	manifest, err := backup.ReadManifest(&buf)
+	require.NoError(t, err)
+	require.NotZero(t, manifest.Total)
+	require.NotEmpty(t, manifest.Prefixes)
+
+	buf.Reset()
+	err = a.Backups.Create(ctx, &buf, resp.User.Id)
+	require.NoError(t, err)
+	manifest, err = backup.ReadManifest(&buf)
+	require.NoError(t, err)
+	require.Equal(t, resp.User.Id, manifest.UserID.String())
+	require.NotZero(t, manifest.Total)
+
+	err = a.Backups.Create(ctx, &buf, "unknown")
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+}
@@ -1,20 +1,16 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
-	"strings"
+	"time"
 
 	files "github.com/ipfs/go-ipfs-files"
-	httpapi "github.com/ipfs/go-ipfs-http-client"
-	"github.com/ipfs/interface-go-ipfs-core/options"
-	ipfspath "github.com/ipfs/interface-go-ipfs-core/path"
-	"github.com/multiformats/go-multiaddr"
 	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
-	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/ffs/dirtar"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -43,6 +39,105 @@ func WithHistory(enabled bool) WatchLogsOption {
 	}
 }
 
+// WithMinLogLevel filters only log messages with at least level.
+func WithMinLogLevel(level userPb.LogLevel) WatchLogsOption {
+	return func(r *userPb.WatchLogsRequest) {
+		r.MinLevel = level
+	}
+}
+
+// WithLogText filters only log messages containing text in the message
+// or fields, ignoring case.
+func WithLogText(text string) WatchLogsOption {
+	return func(r *userPb.WatchLogsRequest) {
+		r.Text = text
+	}
+}
+
+// LogsOption is a function that changes a LogsRequest.
+type LogsOption func(r *userPb.LogsRequest)
+
+// WithLogsJobID filters only log messages of the Job with id jobID. If
+// no cid is provided to Logs, the logs of the Job's cid are returned.
+func WithLogsJobID(jobID string) LogsOption {
+	return func(r *userPb.LogsRequest) {
+		r.JobId = jobID
+	}
+}
+
+// WithLogsMinLevel filters only log messages with at least level.
+func WithLogsMinLevel(level userPb.LogLevel) LogsOption {
+	return func(r *userPb.LogsRequest) {
+		r.MinLevel = level
+	}
+}
+
+// WithLogsTimeRange filters only log messages created since from, and
+// before to. Zero values don't limit the range.
+func WithLogsTimeRange(from, to time.Time) LogsOption {
+	return func(r *userPb.LogsRequest) {
+		if !from.IsZero() {
+			r.From = from.Unix()
+		}
+		if !to.IsZero() {
+			r.To = to.Unix()
+		}
+	}
+}
+
+// WithLogsText filters only log messages containing text in the message
+// or fields, ignoring case.
+func WithLogsText(text string) LogsOption {
+	return func(r *userPb.LogsRequest) {
+		r.Text = text
+	}
+}
+
+// GetOption is a function that changes a GetRequest.
+type GetOption func(r *userPb.GetRequest)
+
+// WithGetPath gets the data of a path within the DAG of the Cid, e.g: a
+// file of a folder.
+func WithGetPath(path string) GetOption {
+	return func(r *userPb.GetRequest) {
+		r.Path = path
+	}
+}
+
+// GetCAROption is a function that changes a GetCARRequest.
+type GetCAROption func(r *userPb.GetCARRequest)
+
+// WithCARSelector includes only the part of the DAG selected by a
+// dag-json encoded IPLD selector in the CAR file.
+func WithCARSelector(selector string) GetCAROption {
+	return func(r *userPb.GetCARRequest) {
+		r.Selector = selector
+	}
+}
+
+// WithCARVersion indicates the version of the CAR format, 1 or 2. By
+// default, CARv1 files are returned.
+func WithCARVersion(version int) GetCAROption {
+	return func(r *userPb.GetCARRequest) {
+		r.CarVersion = uint32(version)
+	}
+}
+
+// WithCARFromCold retrieves the DAG from the miners of the Cid Filecoin
+// deals instead of from the Hot Storage.
+func WithCARFromCold(fromCold bool) GetCAROption {
+	return func(r *userPb.GetCARRequest) {
+		r.FromCold = fromCold
+	}
+}
+
+// WithLogsLimit limits the result to the most recent limit messages.
+func WithLogsLimit(limit int) LogsOption {
+	return func(r *userPb.LogsRequest) {
+		r.Limit = int64(limit)
+	}
+}
+
 // WatchLogsEvent represents an event for watching cid logs.
 type WatchLogsEvent struct {
 	Res *userPb.WatchLogsResponse
@@ -51,6 +146,49 @@ type WatchLogsEvent struct {
 
 // Stage allows to temporarily stage data in the Hot Storage in preparation for pushing a cid storage config.
 func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse, error) {
+	return d.stage(ctx, data, false, false)
+}
+
+// StageTar allows to temporarily stage a tar archive as a directory in the Hot Storage in preparation
+// for pushing a cid storage config.
+func (d *Data) StageTar(ctx context.Context, data io.Reader) (*userPb.StageResponse, error) {
+	return d.stage(ctx, data, true, false)
+}
+
+// StageCAR allows to temporarily import the blocks of a CAR file in the Hot Storage in preparation
+// for pushing a cid storage config. The roots of the CAR file are returned, and the data keeps its cids.
+func (d *Data) StageCAR(ctx context.Context, data io.Reader) (*userPb.StageResponse, error) {
+	return d.stage(ctx, data, false, true)
+}
+
+// StageFolder allows to temporarily stage a folder in the Hot Storage in preparation for pushing a cid storage config.
+func (d *Data) StageFolder(ctx context.Context, folderPath string) (string, error) {
+	stat, err := os.Lstat(folderPath)
+	if err != nil {
+		return "", err
+	}
+	ff, err := files.NewSerialFile(folderPath, false, stat)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = ff.Close() }()
+	dir := files.ToDir(ff)
+	if dir == nil {
+		return "", fmt.Errorf("%s isn't a folder", folderPath)
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		_ = writer.CloseWithError(dirtar.Write(writer, dir))
+	}()
+	res, err := d.StageTar(ctx, reader)
+	_ = reader.Close()
+	if err != nil {
+		return "", err
+	}
+	return res.Cid, nil
+}
+
+func (d *Data) stage(ctx context.Context, data io.Reader, tar, car bool) (*userPb.StageResponse, error) {
 	stream, err := d.client.Stage(ctx)
 	if err != nil {
 		return nil, err
@@ -62,7 +200,7 @@ func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse
 		if err != nil && err != io.EOF {
 			return nil, err
 		}
-		sendErr := stream.Send(&userPb.StageRequest{Chunk: buffer[:bytesRead]})
+		sendErr := stream.Send(&userPb.StageRequest{Chunk: buffer[:bytesRead], Tar: tar, Car: car})
 		if sendErr != nil {
 			if sendErr == io.EOF {
 				var noOp interface{}
@@ -77,47 +215,82 @@ func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse
 	return stream.CloseAndRecv()
 }
 
-// StageFolder allows to temporarily stage a folder in the Hot Storage in preparation for pushing a cid storage config.
-func (d *Data) StageFolder(ctx context.Context, ipfsRevProxyAddr string, folderPath string) (string, error) {
-	ffsToken := ctx.Value(AuthKey).(string)
+// ReplaceData pushes a StorageConfig for c2 equal to that of c1, and removes c1. This operation
+// is more efficient than manually removing and adding in two separate operations.
+func (d *Data) ReplaceData(ctx context.Context, cid1, cid2 string) (*userPb.ReplaceDataResponse, error) {
+	return d.client.ReplaceData(ctx, &userPb.ReplaceDataRequest{Cid1: cid1, Cid2: cid2})
+}
 
-	ipfs, err := newDecoratedIPFSAPI(ipfsRevProxyAddr, ffsToken)
-	if err != nil {
-		return "", fmt.Errorf("creating IPFS HTTP client: %s", err)
-	}
+// Get returns an io.Reader for reading a stored Cid from the Hot Storage.
+// Folders are read as tar archives.
+func (d *Data) Get(ctx context.Context, cid string, opts ...GetOption) (io.Reader, error) {
+	r, _, err := d.get(ctx, cid, opts...)
+	return r, err
+}
 
-	stat, err := os.Lstat(folderPath)
+// GetFolder retrieves to outputDir a Cid which corresponds to a folder.
+func (d *Data) GetFolder(ctx context.Context, cid, outputDir string, opts ...GetOption) error {
+	r, isDir, err := d.get(ctx, cid, opts...)
 	if err != nil {
-		return "", err
+		return err
 	}
-	ff, err := files.NewSerialFile(folderPath, false, stat)
-	if err != nil {
-		return "", err
+	if !isDir {
+		return fmt.Errorf("cid %s isn't a folder", cid)
 	}
-	defer func() { _ = ff.Close() }()
-	opts := []options.UnixfsAddOption{
-		options.Unixfs.CidVersion(1),
-		options.Unixfs.Pin(false),
+	if err := dirtar.Extract(r, outputDir); err != nil {
+		return fmt.Errorf("saving folder to output folder: %s", err)
+	}
+	return nil
+}
+
+// get returns an io.Reader for reading a stored Cid, and true if it's a
+// folder read as a tar archive.
+func (d *Data) get(ctx context.Context, cid string, opts ...GetOption) (io.Reader, bool, error) {
+	req := &userPb.GetRequest{Cid: cid}
+	for _, opt := range opts {
+		opt(req)
 	}
-	pth, err := ipfs.Unixfs().Add(context.Background(), files.ToDir(ff), opts...)
+	stream, err := d.client.Get(ctx, req)
 	if err != nil {
-		return "", err
+		return nil, false, err
 	}
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return bytes.NewReader(nil), false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		res := first
+		for {
+			_, err = writer.Write(res.GetChunk())
+			if err != nil {
+				_ = writer.CloseWithError(err)
+				break
+			}
+			res, err = stream.Recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				break
+			} else if err != nil {
+				_ = writer.CloseWithError(err)
+				break
+			}
+		}
+	}()
 
-	return pth.Cid().String(), nil
-}
-
-// ReplaceData pushes a StorageConfig for c2 equal to that of c1, and removes c1. This operation
-// is more efficient than manually removing and adding in two separate operations.
-func (d *Data) ReplaceData(ctx context.Context, cid1, cid2 string) (*userPb.ReplaceDataResponse, error) {
-	return d.client.ReplaceData(ctx, &userPb.ReplaceDataRequest{Cid1: cid1, Cid2: cid2})
+	return reader, first.Directory, nil
 }
 
-// Get returns an io.Reader for reading a stored Cid from the Hot Storage.
-func (d *Data) Get(ctx context.Context, cid string) (io.Reader, error) {
-	stream, err := d.client.Get(ctx, &userPb.GetRequest{
-		Cid: cid,
-	})
+// GetCAR returns an io.Reader for reading a stored Cid DAG as a CAR file.
+// By default, the complete DAG is read from the Hot Storage.
+func (d *Data) GetCAR(ctx context.Context, cid string, opts ...GetCAROption) (io.Reader, error) {
+	req := &userPb.GetCARRequest{Cid: cid}
+	for _, opt := range opts {
+		opt(req)
+	}
+	stream, err := d.client.GetCAR(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -143,28 +316,6 @@ func (d *Data) Get(ctx context.Context, cid string) (io.Reader, error) {
 	return reader, nil
 }
 
-// GetFolder retrieves to outputDir a Cid which corresponds to a folder.
-func (d *Data) GetFolder(ctx context.Context, ipfsRevProxyAddr, cid, outputDir string) error {
-	token := ctx.Value(AuthKey).(string)
-	ipfs, err := newDecoratedIPFSAPI(ipfsRevProxyAddr, token)
-	if err != nil {
-		return fmt.Errorf("creating decorated IPFS client: %s", err)
-	}
-	ci, err := util.CidFromString(cid)
-	if err != nil {
-		return fmt.Errorf("decoding cid: %s", err)
-	}
-	n, err := ipfs.Unixfs().Get(ctx, ipfspath.IpfsPath(ci))
-	if err != nil {
-		return fmt.Errorf("getting folder DAG from IPFS: %s", err)
-	}
-	err = files.WriteTo(n, outputDir)
-	if err != nil {
-		return fmt.Errorf("saving folder DAG to output folder: %s", err)
-	}
-	return nil
-}
-
 // WatchLogs pushes human-friendly messages about Cid executions. The method is blocking
 // and will continue to send messages until the context is canceled. The provided channel
 // is owned by the method and must not be closed.
@@ -195,52 +346,18 @@ func (d *Data) WatchLogs(ctx context.Context, ch chan<- WatchLogsEvent, cid stri
 	return nil
 }
 
-// CidInfo returns information about cids stored by the user.
-func (d *Data) CidInfo(ctx context.Context, cids ...string) (*userPb.CidInfoResponse, error) {
-	return d.client.CidInfo(ctx, &userPb.CidInfoRequest{Cids: cids})
-}
-
-func newDecoratedIPFSAPI(proxyAddr, ffsToken string) (*httpapi.HttpApi, error) {
-	ipport := strings.Split(proxyAddr, ":")
-	if len(ipport) != 2 {
-		return nil, fmt.Errorf("ipfs addr is invalid")
-	}
-	cm, err := multiaddr.NewComponent("dns4", ipport[0])
-	if err != nil {
-		return nil, err
-	}
-	cp, err := multiaddr.NewComponent("tcp", ipport[1])
-	if err != nil {
-		return nil, err
-	}
-	useHTTPS := ipport[1] == "443"
-	ipfsMaddr := cm.Encapsulate(cp)
-	customClient := http.DefaultClient
-	customClient.Transport = newFFSHeaderDecorator(ffsToken, useHTTPS)
-	ipfs, err := httpapi.NewApiWithClient(ipfsMaddr, customClient)
-	if err != nil {
-		return nil, err
-	}
-	return ipfs, nil
-}
-
-type ffsHeaderDecorator struct {
-	ffsToken string
-	useHTTPS bool
-}
-
-func newFFSHeaderDecorator(ffsToken string, useHTTPS bool) *ffsHeaderDecorator {
-	return &ffsHeaderDecorator{
-		ffsToken: ffsToken,
-		useHTTPS: useHTTPS,
+// Logs returns history messages about Cid executions matching the
+// filters of opts. cid can be empty if a Job is provided with
+// WithLogsJobID.
+func (d *Data) Logs(ctx context.Context, cid string, opts ...LogsOption) (*userPb.LogsResponse, error) {
+	r := &userPb.LogsRequest{Cid: cid}
+	for _, opt := range opts {
+		opt(r)
 	}
+	return d.client.Logs(ctx, r)
 }
 
-func (fhd ffsHeaderDecorator) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header["x-ipfs-ffs-auth"] = []string{fhd.ffsToken}
-	if fhd.useHTTPS {
-		req.URL.Scheme = "https"
-	}
-
-	return http.DefaultTransport.RoundTrip(req)
+// CidInfo returns information about cids stored by the user.
+func (d *Data) CidInfo(ctx context.Context, cids ...string) (*userPb.CidInfoResponse, error) {
+	return d.client.CidInfo(ctx, &userPb.CidInfoRequest{Cids: cids})
 }
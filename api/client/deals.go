@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
 	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 )
@@ -54,6 +56,78 @@ func WithAscending(ascending bool) DealRecordsOption {
 	}
 }
 
+// WithMiners limits the results to deals with the provided miners.
+func WithMiners(miners ...string) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.Miners = miners
+	}
+}
+
+// WithPieceCids limits the results to deals for the provided piece cids.
+// Ignored for ListRetrievalDealRecords.
+func WithPieceCids(cids ...string) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.PieceCids = cids
+	}
+}
+
+// WithStateIDs limits the results to deals in the provided storage deal
+// states. Ignored for ListRetrievalDealRecords.
+func WithStateIDs(ids ...uint64) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.StateIds = ids
+	}
+}
+
+// WithTimeRange limits the results to records with a timestamp in [from, to).
+// Zero values don't limit the range.
+func WithTimeRange(from, to time.Time) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.FromTime, c.ToTime = 0, 0
+		if !from.IsZero() {
+			c.FromTime = from.Unix()
+		}
+		if !to.IsZero() {
+			c.ToTime = to.Unix()
+		}
+	}
+}
+
+// WithPriceRange limits the results to deals with a price in [min, max].
+// The price is the price per epoch of storage deals, and the total price
+// of retrieval deals. Zero values don't limit the range.
+func WithPriceRange(min, max uint64) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.MinPrice = min
+		c.MaxPrice = max
+	}
+}
+
+// WithLimit limits the number of results. The next_cursor of the response
+// can be used with WithCursor to get the next page. Default is 1000.
+func WithLimit(limit int) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.Limit = int64(limit)
+	}
+}
+
+// WithCursor specifies the next_cursor returned with the previous page of
+// results, to continue listing from there.
+func WithCursor(cursor string) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.Cursor = cursor
+	}
+}
+
+// WithIncludeSummary specifies whether or not to include a summary of all
+// the records matching the filters, ignoring the cursor and limit. Default
+// is false.
+func WithIncludeSummary(includeSummary bool) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.IncludeSummary = includeSummary
+	}
+}
+
 // StorageDealRecords returns a list of storage deals for the user according to the provided options.
 func (d *Deals) StorageDealRecords(ctx context.Context, opts ...DealRecordsOption) (*userPb.StorageDealRecordsResponse, error) {
 	conf := &userPb.DealRecordsConfig{}
@@ -71,3 +145,24 @@ func (d *Deals) RetrievalDealRecords(ctx context.Context, opts ...DealRecordsOpt
 	}
 	return d.client.RetrievalDealRecords(ctx, &userPb.RetrievalDealRecordsRequest{Config: conf})
 }
+
+// Export writes the deal records configured by config to w, as CSV or
+// Parquet.
+func (d *Deals) Export(ctx context.Context, w io.Writer, config *userPb.DealRecordsExportConfig) error {
+	stream, err := d.client.ExportDealRecords(ctx, &userPb.ExportDealRecordsRequest{Config: config})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(res.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
@@ -29,6 +29,37 @@ func WithAddressType(addressType string) NewAddressOption {
 	}
 }
 
+// TransactionsOption is a function that changes a TransactionsRequest.
+type TransactionsOption func(r *userPb.TransactionsRequest)
+
+// WithTxAddress limits the results to transactions of a single managed address.
+func WithTxAddress(address string) TransactionsOption {
+	return func(r *userPb.TransactionsRequest) {
+		r.Address = address
+	}
+}
+
+// WithTxAscending specifies to sort the results in ascending order. Default is descending order.
+func WithTxAscending(ascending bool) TransactionsOption {
+	return func(r *userPb.TransactionsRequest) {
+		r.Ascending = ascending
+	}
+}
+
+// WithTxOffset skips the first offset transactions of the results.
+func WithTxOffset(offset int64) TransactionsOption {
+	return func(r *userPb.TransactionsRequest) {
+		r.Offset = offset
+	}
+}
+
+// WithTxLimit limits the results to at most limit transactions.
+func WithTxLimit(limit int64) TransactionsOption {
+	return func(r *userPb.TransactionsRequest) {
+		r.Limit = limit
+	}
+}
+
 // Balance gets a filecoin wallet's balance.
 func (w *Wallet) Balance(ctx context.Context, address string) (*userPb.BalanceResponse, error) {
 	return w.client.Balance(ctx, &userPb.BalanceRequest{Address: address})
@@ -58,6 +89,54 @@ func (w *Wallet) SendFil(ctx context.Context, from string, to string, amount *bi
 	return w.client.SendFil(ctx, req)
 }
 
+// Transactions returns the recorded transactions of the user addresses.
+func (w *Wallet) Transactions(ctx context.Context, options ...TransactionsOption) (*userPb.TransactionsResponse, error) {
+	r := &userPb.TransactionsRequest{}
+	for _, opt := range options {
+		opt(r)
+	}
+	return w.client.Transactions(ctx, r)
+}
+
+// NewMultisigAddress creates a new multisig address managed by the user, with the provided
+// signers and approval threshold. At least one signer must be an address managed by the user,
+// which funds the multisig with amount.
+func (w *Wallet) NewMultisigAddress(ctx context.Context, name string, signers []string, threshold uint64, amount *big.Int, makeDefault bool) (*userPb.NewMultisigAddressResponse, error) {
+	r := &userPb.NewMultisigAddressRequest{
+		Name:        name,
+		Signers:     signers,
+		Threshold:   threshold,
+		Amount:      amount.String(),
+		MakeDefault: makeDefault,
+	}
+	return w.client.NewMultisigAddress(ctx, r)
+}
+
+// MultisigPending returns the transactions of a multisig address waiting for approvals.
+func (w *Wallet) MultisigPending(ctx context.Context, address string) (*userPb.MultisigPendingResponse, error) {
+	return w.client.MultisigPending(ctx, &userPb.MultisigPendingRequest{Address: address})
+}
+
+// MultisigPropose proposes a transfer from a multisig address to any other address.
+func (w *Wallet) MultisigPropose(ctx context.Context, address string, to string, amount *big.Int) (*userPb.MultisigProposeResponse, error) {
+	r := &userPb.MultisigProposeRequest{Address: address, To: to, Amount: amount.String()}
+	return w.client.MultisigPropose(ctx, r)
+}
+
+// MultisigProposeEscrowTopUp proposes a deposit from a multisig address into the storage market
+// escrow used by deals made with the multisig address.
+func (w *Wallet) MultisigProposeEscrowTopUp(ctx context.Context, address string, amount *big.Int) (*userPb.MultisigProposeEscrowTopUpResponse, error) {
+	r := &userPb.MultisigProposeEscrowTopUpRequest{Address: address, Amount: amount.String()}
+	return w.client.MultisigProposeEscrowTopUp(ctx, r)
+}
+
+// MultisigApprove approves a pending transaction of a multisig address with a signer address
+// managed by the user.
+func (w *Wallet) MultisigApprove(ctx context.Context, address string, approver string, txID int64) (*userPb.MultisigApproveResponse, error) {
+	r := &userPb.MultisigApproveRequest{Address: address, Approver: approver, TransactionId: txID}
+	return w.client.MultisigApprove(ctx, r)
+}
+
 // SignMessage signs a message with a user wallet address.
 func (w *Wallet) SignMessage(ctx context.Context, address string, message []byte) (*userPb.SignMessageResponse, error) {
 	r := &userPb.SignMessageRequest{Address: address, Message: message}
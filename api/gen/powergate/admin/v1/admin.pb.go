@@ -26,6 +26,61 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type FundsEventKind int32
+
+const (
+	FundsEventKind_FUNDS_EVENT_KIND_UNSPECIFIED          FundsEventKind = 0
+	FundsEventKind_FUNDS_EVENT_KIND_LOW_FUNDS            FundsEventKind = 1
+	FundsEventKind_FUNDS_EVENT_KIND_FUNDS_RECOVERED      FundsEventKind = 2
+	FundsEventKind_FUNDS_EVENT_KIND_TOP_UP               FundsEventKind = 3
+	FundsEventKind_FUNDS_EVENT_KIND_TOP_UP_LIMIT_REACHED FundsEventKind = 4
+)
+
+// Enum value maps for FundsEventKind.
+var (
+	FundsEventKind_name = map[int32]string{
+		0: "FUNDS_EVENT_KIND_UNSPECIFIED",
+		1: "FUNDS_EVENT_KIND_LOW_FUNDS",
+		2: "FUNDS_EVENT_KIND_FUNDS_RECOVERED",
+		3: "FUNDS_EVENT_KIND_TOP_UP",
+		4: "FUNDS_EVENT_KIND_TOP_UP_LIMIT_REACHED",
+	}
+	FundsEventKind_value = map[string]int32{
+		"FUNDS_EVENT_KIND_UNSPECIFIED":          0,
+		"FUNDS_EVENT_KIND_LOW_FUNDS":            1,
+		"FUNDS_EVENT_KIND_FUNDS_RECOVERED":      2,
+		"FUNDS_EVENT_KIND_TOP_UP":               3,
+		"FUNDS_EVENT_KIND_TOP_UP_LIMIT_REACHED": 4,
+	}
+)
+
+func (x FundsEventKind) Enum() *FundsEventKind {
+	p := new(FundsEventKind)
+	*p = x
+	return p
+}
+
+func (x FundsEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FundsEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_admin_v1_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (FundsEventKind) Type() protoreflect.EnumType {
+	return &file_powergate_admin_v1_admin_proto_enumTypes[0]
+}
+
+func (x FundsEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FundsEventKind.Descriptor instead.
+func (FundsEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
 // Wallet
 type NewAddressRequest struct {
 	state         protoimpl.MessageState
@@ -273,6 +328,8 @@ type SendFilResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	MessageCid string `protobuf:"bytes,1,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
 }
 
 func (x *SendFilResponse) Reset() {
@@ -307,17 +364,25 @@ func (*SendFilResponse) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{5}
 }
 
-type User struct {
+func (x *SendFilResponse) GetMessageCid() string {
+	if x != nil {
+		return x.MessageCid
+	}
+	return ""
+}
+
+type SetSpendPolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	UserId      string          `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address     string          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	SpendPolicy *v1.SpendPolicy `protobuf:"bytes,3,opt,name=spend_policy,json=spendPolicy,proto3" json:"spend_policy,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *SetSpendPolicyRequest) Reset() {
+	*x = SetSpendPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -325,13 +390,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *SetSpendPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*SetSpendPolicyRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *SetSpendPolicyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,33 +408,40 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetSpendPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSpendPolicyRequest) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *User) GetId() string {
+func (x *SetSpendPolicyRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *User) GetToken() string {
+func (x *SetSpendPolicyRequest) GetAddress() string {
 	if x != nil {
-		return x.Token
+		return x.Address
 	}
 	return ""
 }
 
-type CreateUserRequest struct {
+func (x *SetSpendPolicyRequest) GetSpendPolicy() *v1.SpendPolicy {
+	if x != nil {
+		return x.SpendPolicy
+	}
+	return nil
+}
+
+type SetSpendPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *SetSpendPolicyResponse) Reset() {
+	*x = SetSpendPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -377,13 +449,13 @@ func (x *CreateUserRequest) Reset() {
 	}
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *SetSpendPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*SetSpendPolicyResponse) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *SetSpendPolicyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,21 +467,26 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetSpendPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetSpendPolicyResponse) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{7}
 }
 
-type CreateUserResponse struct {
+type SendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From      string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount    string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
+func (x *SendRequest) Reset() {
+	*x = SendRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,13 +494,13 @@ func (x *CreateUserResponse) Reset() {
 	}
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *SendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*SendRequest) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *SendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -435,26 +512,63 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
+func (*SendRequest) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateUserResponse) GetUser() *User {
+func (x *SendRequest) GetId() string {
 	if x != nil {
-		return x.User
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UsersRequest struct {
+func (x *SendRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SendRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SendRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SendRequest) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SendRequestsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *UsersRequest) Reset() {
-	*x = UsersRequest{}
+func (x *SendRequestsRequest) Reset() {
+	*x = SendRequestsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,13 +576,13 @@ func (x *UsersRequest) Reset() {
 	}
 }
 
-func (x *UsersRequest) String() string {
+func (x *SendRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsersRequest) ProtoMessage() {}
+func (*SendRequestsRequest) ProtoMessage() {}
 
-func (x *UsersRequest) ProtoReflect() protoreflect.Message {
+func (x *SendRequestsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,21 +594,28 @@ func (x *UsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsersRequest.ProtoReflect.Descriptor instead.
-func (*UsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*SendRequestsRequest) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{9}
 }
 
-type UsersResponse struct {
+func (x *SendRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SendRequestsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	SendRequests []*SendRequest `protobuf:"bytes,1,rep,name=send_requests,json=sendRequests,proto3" json:"send_requests,omitempty"`
 }
 
-func (x *UsersResponse) Reset() {
-	*x = UsersResponse{}
+func (x *SendRequestsResponse) Reset() {
+	*x = SendRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,13 +623,13 @@ func (x *UsersResponse) Reset() {
 	}
 }
 
-func (x *UsersResponse) String() string {
+func (x *SendRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsersResponse) ProtoMessage() {}
+func (*SendRequestsResponse) ProtoMessage() {}
 
-func (x *UsersResponse) ProtoReflect() protoreflect.Message {
+func (x *SendRequestsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -520,29 +641,29 @@ func (x *UsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsersResponse.ProtoReflect.Descriptor instead.
-func (*UsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendRequestsResponse.ProtoReflect.Descriptor instead.
+func (*SendRequestsResponse) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UsersResponse) GetUsers() []*User {
+func (x *SendRequestsResponse) GetSendRequests() []*SendRequest {
 	if x != nil {
-		return x.Users
+		return x.SendRequests
 	}
 	return nil
 }
 
-type QueuedStorageJobsRequest struct {
+type ApproveSendRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *QueuedStorageJobsRequest) Reset() {
-	*x = QueuedStorageJobsRequest{}
+func (x *ApproveSendRequestRequest) Reset() {
+	*x = ApproveSendRequestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,13 +671,13 @@ func (x *QueuedStorageJobsRequest) Reset() {
 	}
 }
 
-func (x *QueuedStorageJobsRequest) String() string {
+func (x *ApproveSendRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueuedStorageJobsRequest) ProtoMessage() {}
+func (*ApproveSendRequestRequest) ProtoMessage() {}
 
-func (x *QueuedStorageJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *ApproveSendRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -568,35 +689,35 @@ func (x *QueuedStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueuedStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*QueuedStorageJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveSendRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSendRequestRequest) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueuedStorageJobsRequest) GetUserId() string {
+func (x *ApproveSendRequestRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *QueuedStorageJobsRequest) GetCids() []string {
+func (x *ApproveSendRequestRequest) GetId() string {
 	if x != nil {
-		return x.Cids
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type QueuedStorageJobsResponse struct {
+type ApproveSendRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+	MessageCid string `protobuf:"bytes,1,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
 }
 
-func (x *QueuedStorageJobsResponse) Reset() {
-	*x = QueuedStorageJobsResponse{}
+func (x *ApproveSendRequestResponse) Reset() {
+	*x = ApproveSendRequestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,13 +725,13 @@ func (x *QueuedStorageJobsResponse) Reset() {
 	}
 }
 
-func (x *QueuedStorageJobsResponse) String() string {
+func (x *ApproveSendRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueuedStorageJobsResponse) ProtoMessage() {}
+func (*ApproveSendRequestResponse) ProtoMessage() {}
 
-func (x *QueuedStorageJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *ApproveSendRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,29 +743,29 @@ func (x *QueuedStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueuedStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*QueuedStorageJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveSendRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveSendRequestResponse) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueuedStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+func (x *ApproveSendRequestResponse) GetMessageCid() string {
 	if x != nil {
-		return x.StorageJobs
+		return x.MessageCid
 	}
-	return nil
+	return ""
 }
 
-type ExecutingStorageJobsRequest struct {
+type RejectSendRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ExecutingStorageJobsRequest) Reset() {
-	*x = ExecutingStorageJobsRequest{}
+func (x *RejectSendRequestRequest) Reset() {
+	*x = RejectSendRequestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -652,13 +773,13 @@ func (x *ExecutingStorageJobsRequest) Reset() {
 	}
 }
 
-func (x *ExecutingStorageJobsRequest) String() string {
+func (x *RejectSendRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutingStorageJobsRequest) ProtoMessage() {}
+func (*RejectSendRequestRequest) ProtoMessage() {}
 
-func (x *ExecutingStorageJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *RejectSendRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,35 +791,33 @@ func (x *ExecutingStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutingStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*ExecutingStorageJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectSendRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectSendRequestRequest) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ExecutingStorageJobsRequest) GetUserId() string {
+func (x *RejectSendRequestRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ExecutingStorageJobsRequest) GetCids() []string {
+func (x *RejectSendRequestRequest) GetId() string {
 	if x != nil {
-		return x.Cids
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ExecutingStorageJobsResponse struct {
+type RejectSendRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
 }
 
-func (x *ExecutingStorageJobsResponse) Reset() {
-	*x = ExecutingStorageJobsResponse{}
+func (x *RejectSendRequestResponse) Reset() {
+	*x = RejectSendRequestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,13 +825,13 @@ func (x *ExecutingStorageJobsResponse) Reset() {
 	}
 }
 
-func (x *ExecutingStorageJobsResponse) String() string {
+func (x *RejectSendRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutingStorageJobsResponse) ProtoMessage() {}
+func (*RejectSendRequestResponse) ProtoMessage() {}
 
-func (x *ExecutingStorageJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *RejectSendRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,29 +843,24 @@ func (x *ExecutingStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutingStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*ExecutingStorageJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectSendRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectSendRequestResponse) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ExecutingStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
-	if x != nil {
-		return x.StorageJobs
-	}
-	return nil
-}
-
-type LatestFinalStorageJobsRequest struct {
+type ExportedKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Key     []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *LatestFinalStorageJobsRequest) Reset() {
-	*x = LatestFinalStorageJobsRequest{}
+func (x *ExportedKey) Reset() {
+	*x = ExportedKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -754,13 +868,13 @@ func (x *LatestFinalStorageJobsRequest) Reset() {
 	}
 }
 
-func (x *LatestFinalStorageJobsRequest) String() string {
+func (x *ExportedKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestFinalStorageJobsRequest) ProtoMessage() {}
+func (*ExportedKey) ProtoMessage() {}
 
-func (x *LatestFinalStorageJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportedKey) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -772,35 +886,50 @@ func (x *LatestFinalStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestFinalStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*LatestFinalStorageJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportedKey.ProtoReflect.Descriptor instead.
+func (*ExportedKey) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LatestFinalStorageJobsRequest) GetUserId() string {
+func (x *ExportedKey) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LatestFinalStorageJobsRequest) GetCids() []string {
+func (x *ExportedKey) GetAddress() string {
 	if x != nil {
-		return x.Cids
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExportedKey) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExportedKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
 	}
 	return nil
 }
 
-type LatestFinalStorageJobsResponse struct {
+type ExportUserKeysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 }
 
-func (x *LatestFinalStorageJobsResponse) Reset() {
-	*x = LatestFinalStorageJobsResponse{}
+func (x *ExportUserKeysRequest) Reset() {
+	*x = ExportUserKeysRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,13 +937,13 @@ func (x *LatestFinalStorageJobsResponse) Reset() {
 	}
 }
 
-func (x *LatestFinalStorageJobsResponse) String() string {
+func (x *ExportUserKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestFinalStorageJobsResponse) ProtoMessage() {}
+func (*ExportUserKeysRequest) ProtoMessage() {}
 
-func (x *LatestFinalStorageJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportUserKeysRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,29 +955,35 @@ func (x *LatestFinalStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestFinalStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*LatestFinalStorageJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportUserKeysRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserKeysRequest) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *LatestFinalStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+func (x *ExportUserKeysRequest) GetUserId() string {
 	if x != nil {
-		return x.StorageJobs
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type LatestSuccessfulStorageJobsRequest struct {
+func (x *ExportUserKeysRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type ExportUserKeysResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	Keys []*ExportedKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *LatestSuccessfulStorageJobsRequest) Reset() {
-	*x = LatestSuccessfulStorageJobsRequest{}
+func (x *ExportUserKeysResponse) Reset() {
+	*x = ExportUserKeysResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,13 +991,13 @@ func (x *LatestSuccessfulStorageJobsRequest) Reset() {
 	}
 }
 
-func (x *LatestSuccessfulStorageJobsRequest) String() string {
+func (x *ExportUserKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestSuccessfulStorageJobsRequest) ProtoMessage() {}
+func (*ExportUserKeysResponse) ProtoMessage() {}
 
-func (x *LatestSuccessfulStorageJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportUserKeysResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,35 +1009,32 @@ func (x *LatestSuccessfulStorageJobsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestSuccessfulStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*LatestSuccessfulStorageJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportUserKeysResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserKeysResponse) Descriptor() ([]byte, []int) {
 	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *LatestSuccessfulStorageJobsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *LatestSuccessfulStorageJobsRequest) GetCids() []string {
+func (x *ExportUserKeysResponse) GetKeys() []*ExportedKey {
 	if x != nil {
-		return x.Cids
+		return x.Keys
 	}
 	return nil
 }
 
-type LatestSuccessfulStorageJobsResponse struct {
+type ImportUserKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key         []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Passphrase  string `protobuf:"bytes,4,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	MakeDefault bool   `protobuf:"varint,5,opt,name=make_default,json=makeDefault,proto3" json:"make_default,omitempty"`
 }
 
-func (x *LatestSuccessfulStorageJobsResponse) Reset() {
-	*x = LatestSuccessfulStorageJobsResponse{}
+func (x *ImportUserKeyRequest) Reset() {
+	*x = ImportUserKeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_admin_v1_admin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,13 +1042,13 @@ func (x *LatestSuccessfulStorageJobsResponse) Reset() {
 	}
 }
 
-func (x *LatestSuccessfulStorageJobsResponse) String() string {
+func (x *ImportUserKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestSuccessfulStorageJobsResponse) ProtoMessage() {}
+func (*ImportUserKeyRequest) ProtoMessage() {}
 
-func (x *LatestSuccessfulStorageJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportUserKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_admin_v1_admin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -928,44 +1060,1659 @@ func (x *LatestSuccessfulStorageJobsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestSuccessfulStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*LatestSuccessfulStorageJobsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ImportUserKeyRequest.ProtoReflect.Descriptor instead.
+func (*ImportUserKeyRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportUserKeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportUserKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportUserKeyRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ImportUserKeyRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *ImportUserKeyRequest) GetMakeDefault() bool {
+	if x != nil {
+		return x.MakeDefault
+	}
+	return false
+}
+
+type ImportUserKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ImportUserKeyResponse) Reset() {
+	*x = ImportUserKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUserKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserKeyResponse) ProtoMessage() {}
+
+func (x *ImportUserKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserKeyResponse.ProtoReflect.Descriptor instead.
+func (*ImportUserKeyResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportUserKeyResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AddrFundsStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance         string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	EscrowAvailable string `protobuf:"bytes,3,opt,name=escrow_available,json=escrowAvailable,proto3" json:"escrow_available,omitempty"`
+	EscrowLocked    string `protobuf:"bytes,4,opt,name=escrow_locked,json=escrowLocked,proto3" json:"escrow_locked,omitempty"`
+	Low             bool   `protobuf:"varint,5,opt,name=low,proto3" json:"low,omitempty"`
+	LastTopUp       int64  `protobuf:"varint,6,opt,name=last_top_up,json=lastTopUp,proto3" json:"last_top_up,omitempty"`
+	LastChecked     int64  `protobuf:"varint,7,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
+}
+
+func (x *AddrFundsStatus) Reset() {
+	*x = AddrFundsStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrFundsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrFundsStatus) ProtoMessage() {}
+
+func (x *AddrFundsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrFundsStatus.ProtoReflect.Descriptor instead.
+func (*AddrFundsStatus) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddrFundsStatus) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddrFundsStatus) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AddrFundsStatus) GetEscrowAvailable() string {
+	if x != nil {
+		return x.EscrowAvailable
+	}
+	return ""
+}
+
+func (x *AddrFundsStatus) GetEscrowLocked() string {
+	if x != nil {
+		return x.EscrowLocked
+	}
+	return ""
+}
+
+func (x *AddrFundsStatus) GetLow() bool {
+	if x != nil {
+		return x.Low
+	}
+	return false
+}
+
+func (x *AddrFundsStatus) GetLastTopUp() int64 {
+	if x != nil {
+		return x.LastTopUp
+	}
+	return 0
+}
+
+func (x *AddrFundsStatus) GetLastChecked() int64 {
+	if x != nil {
+		return x.LastChecked
+	}
+	return 0
+}
+
+type FundsEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Kind       FundsEventKind `protobuf:"varint,2,opt,name=kind,proto3,enum=powergate.admin.v1.FundsEventKind" json:"kind,omitempty"`
+	Funds      string         `protobuf:"bytes,3,opt,name=funds,proto3" json:"funds,omitempty"`
+	Amount     string         `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MessageCid string         `protobuf:"bytes,5,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
+	Time       int64          `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *FundsEvent) Reset() {
+	*x = FundsEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundsEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundsEvent) ProtoMessage() {}
+
+func (x *FundsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundsEvent.ProtoReflect.Descriptor instead.
+func (*FundsEvent) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FundsEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FundsEvent) GetKind() FundsEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FundsEventKind_FUNDS_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *FundsEvent) GetFunds() string {
+	if x != nil {
+		return x.Funds
+	}
+	return ""
+}
+
+func (x *FundsEvent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FundsEvent) GetMessageCid() string {
+	if x != nil {
+		return x.MessageCid
+	}
+	return ""
+}
+
+func (x *FundsEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type FundsStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FundsStatusRequest) Reset() {
+	*x = FundsStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundsStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundsStatusRequest) ProtoMessage() {}
+
+func (x *FundsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundsStatusRequest.ProtoReflect.Descriptor instead.
+func (*FundsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+type FundsStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses []*AddrFundsStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *FundsStatusResponse) Reset() {
+	*x = FundsStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundsStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundsStatusResponse) ProtoMessage() {}
+
+func (x *FundsStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundsStatusResponse.ProtoReflect.Descriptor instead.
+func (*FundsStatusResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FundsStatusResponse) GetStatuses() []*AddrFundsStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type FundsEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Since int64 `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *FundsEventsRequest) Reset() {
+	*x = FundsEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundsEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundsEventsRequest) ProtoMessage() {}
+
+func (x *FundsEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundsEventsRequest.ProtoReflect.Descriptor instead.
+func (*FundsEventsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FundsEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type FundsEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*FundsEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *FundsEventsResponse) Reset() {
+	*x = FundsEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundsEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundsEventsResponse) ProtoMessage() {}
+
+func (x *FundsEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundsEventsResponse.ProtoReflect.Descriptor instead.
+func (*FundsEventsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FundsEventsResponse) GetEvents() []*FundsEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UsersRequest) Reset() {
+	*x = UsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersRequest) ProtoMessage() {}
+
+func (x *UsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersRequest.ProtoReflect.Descriptor instead.
+func (*UsersRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+type UsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *UsersResponse) Reset() {
+	*x = UsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersResponse) ProtoMessage() {}
+
+func (x *UsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersResponse.ProtoReflect.Descriptor instead.
+func (*UsersResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type QueuedStorageJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+}
+
+func (x *QueuedStorageJobsRequest) Reset() {
+	*x = QueuedStorageJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedStorageJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedStorageJobsRequest) ProtoMessage() {}
+
+func (x *QueuedStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*QueuedStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueuedStorageJobsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueuedStorageJobsRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type QueuedStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+}
+
+func (x *QueuedStorageJobsResponse) Reset() {
+	*x = QueuedStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedStorageJobsResponse) ProtoMessage() {}
+
+func (x *QueuedStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*QueuedStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueuedStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.StorageJobs
+	}
+	return nil
+}
+
+type ExecutingStorageJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+}
+
+func (x *ExecutingStorageJobsRequest) Reset() {
+	*x = ExecutingStorageJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutingStorageJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutingStorageJobsRequest) ProtoMessage() {}
+
+func (x *ExecutingStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutingStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*ExecutingStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExecutingStorageJobsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExecutingStorageJobsRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type ExecutingStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+}
+
+func (x *ExecutingStorageJobsResponse) Reset() {
+	*x = ExecutingStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutingStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutingStorageJobsResponse) ProtoMessage() {}
+
+func (x *ExecutingStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutingStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*ExecutingStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExecutingStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.StorageJobs
+	}
+	return nil
+}
+
+type LatestFinalStorageJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+}
+
+func (x *LatestFinalStorageJobsRequest) Reset() {
+	*x = LatestFinalStorageJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestFinalStorageJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestFinalStorageJobsRequest) ProtoMessage() {}
+
+func (x *LatestFinalStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestFinalStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*LatestFinalStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LatestFinalStorageJobsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LatestFinalStorageJobsRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type LatestFinalStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+}
+
+func (x *LatestFinalStorageJobsResponse) Reset() {
+	*x = LatestFinalStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestFinalStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestFinalStorageJobsResponse) ProtoMessage() {}
+
+func (x *LatestFinalStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestFinalStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*LatestFinalStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LatestFinalStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.StorageJobs
+	}
+	return nil
+}
+
+type LatestSuccessfulStorageJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+}
+
+func (x *LatestSuccessfulStorageJobsRequest) Reset() {
+	*x = LatestSuccessfulStorageJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestSuccessfulStorageJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestSuccessfulStorageJobsRequest) ProtoMessage() {}
+
+func (x *LatestSuccessfulStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestSuccessfulStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*LatestSuccessfulStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LatestSuccessfulStorageJobsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LatestSuccessfulStorageJobsRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type LatestSuccessfulStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageJobs []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+}
+
+func (x *LatestSuccessfulStorageJobsResponse) Reset() {
+	*x = LatestSuccessfulStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestSuccessfulStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestSuccessfulStorageJobsResponse) ProtoMessage() {}
+
+func (x *LatestSuccessfulStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestSuccessfulStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*LatestSuccessfulStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LatestSuccessfulStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.StorageJobs
+	}
+	return nil
+}
+
+type StorageJobsSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+}
+
+func (x *StorageJobsSummaryRequest) Reset() {
+	*x = StorageJobsSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageJobsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageJobsSummaryRequest) ProtoMessage() {}
+
+func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageJobsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*StorageJobsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StorageJobsSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StorageJobsSummaryRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type StorageJobsSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobCounts                   *v1.JobCounts    `protobuf:"bytes,1,opt,name=job_counts,json=jobCounts,proto3" json:"job_counts,omitempty"`
+	QueuedStorageJobs           []*v1.StorageJob `protobuf:"bytes,2,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
+	ExecutingStorageJobs        []*v1.StorageJob `protobuf:"bytes,3,rep,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
+	LatestFinalStorageJobs      []*v1.StorageJob `protobuf:"bytes,4,rep,name=latest_final_storage_jobs,json=latestFinalStorageJobs,proto3" json:"latest_final_storage_jobs,omitempty"`
+	LatestSuccessfulStorageJobs []*v1.StorageJob `protobuf:"bytes,5,rep,name=latest_successful_storage_jobs,json=latestSuccessfulStorageJobs,proto3" json:"latest_successful_storage_jobs,omitempty"`
+}
+
+func (x *StorageJobsSummaryResponse) Reset() {
+	*x = StorageJobsSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageJobsSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageJobsSummaryResponse) ProtoMessage() {}
+
+func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageJobsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*StorageJobsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StorageJobsSummaryResponse) GetJobCounts() *v1.JobCounts {
+	if x != nil {
+		return x.JobCounts
+	}
+	return nil
+}
+
+func (x *StorageJobsSummaryResponse) GetQueuedStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.QueuedStorageJobs
+	}
+	return nil
+}
+
+func (x *StorageJobsSummaryResponse) GetExecutingStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.ExecutingStorageJobs
+	}
+	return nil
+}
+
+func (x *StorageJobsSummaryResponse) GetLatestFinalStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.LatestFinalStorageJobs
+	}
+	return nil
+}
+
+func (x *StorageJobsSummaryResponse) GetLatestSuccessfulStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.LatestSuccessfulStorageJobs
+	}
+	return nil
+}
+
+type CountryOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinerAddress string `protobuf:"bytes,1,opt,name=miner_address,json=minerAddress,proto3" json:"miner_address,omitempty"`
+	Country      string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+}
+
+func (x *CountryOverride) Reset() {
+	*x = CountryOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountryOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryOverride) ProtoMessage() {}
+
+func (x *CountryOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryOverride.ProtoReflect.Descriptor instead.
+func (*CountryOverride) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CountryOverride) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+func (x *CountryOverride) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type SetCountryOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinerAddress string `protobuf:"bytes,1,opt,name=miner_address,json=minerAddress,proto3" json:"miner_address,omitempty"`
+	Country      string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+}
+
+func (x *SetCountryOverrideRequest) Reset() {
+	*x = SetCountryOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCountryOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCountryOverrideRequest) ProtoMessage() {}
+
+func (x *SetCountryOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCountryOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetCountryOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetCountryOverrideRequest) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+func (x *SetCountryOverrideRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type SetCountryOverrideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCountryOverrideResponse) Reset() {
+	*x = SetCountryOverrideResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCountryOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCountryOverrideResponse) ProtoMessage() {}
+
+func (x *SetCountryOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCountryOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetCountryOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{43}
+}
+
+type RemoveCountryOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinerAddress string `protobuf:"bytes,1,opt,name=miner_address,json=minerAddress,proto3" json:"miner_address,omitempty"`
+}
+
+func (x *RemoveCountryOverrideRequest) Reset() {
+	*x = RemoveCountryOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCountryOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCountryOverrideRequest) ProtoMessage() {}
+
+func (x *RemoveCountryOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCountryOverrideRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCountryOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RemoveCountryOverrideRequest) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+type RemoveCountryOverrideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveCountryOverrideResponse) Reset() {
+	*x = RemoveCountryOverrideResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCountryOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCountryOverrideResponse) ProtoMessage() {}
+
+func (x *RemoveCountryOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCountryOverrideResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCountryOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{45}
+}
+
+type CountryOverridesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CountryOverridesRequest) Reset() {
+	*x = CountryOverridesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountryOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryOverridesRequest) ProtoMessage() {}
+
+func (x *CountryOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryOverridesRequest.ProtoReflect.Descriptor instead.
+func (*CountryOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{46}
+}
+
+type CountryOverridesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryOverrides []*CountryOverride `protobuf:"bytes,1,rep,name=country_overrides,json=countryOverrides,proto3" json:"country_overrides,omitempty"`
+}
+
+func (x *CountryOverridesResponse) Reset() {
+	*x = CountryOverridesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountryOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryOverridesResponse) ProtoMessage() {}
+
+func (x *CountryOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryOverridesResponse.ProtoReflect.Descriptor instead.
+func (*CountryOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CountryOverridesResponse) GetCountryOverrides() []*CountryOverride {
+	if x != nil {
+		return x.CountryOverrides
+	}
+	return nil
+}
+
+type BackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRequest) ProtoMessage() {}
+
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BackupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *BackupResponse) Reset() {
+	*x = BackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupResponse) ProtoMessage() {}
+
+func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *LatestSuccessfulStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+func (x *BackupResponse) GetChunk() []byte {
 	if x != nil {
-		return x.StorageJobs
+		return x.Chunk
 	}
 	return nil
 }
 
-type StorageJobsSummaryRequest struct {
+type ExportDealRecordsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cids   []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	UserId string                      `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Config *v1.DealRecordsExportConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *StorageJobsSummaryRequest) Reset() {
-	*x = StorageJobsSummaryRequest{}
+func (x *ExportDealRecordsRequest) Reset() {
+	*x = ExportDealRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobsSummaryRequest) String() string {
+func (x *ExportDealRecordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobsSummaryRequest) ProtoMessage() {}
+func (*ExportDealRecordsRequest) ProtoMessage() {}
 
-func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
+func (x *ExportDealRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -976,54 +2723,50 @@ func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*StorageJobsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ExportDealRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ExportDealRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *StorageJobsSummaryRequest) GetUserId() string {
+func (x *ExportDealRecordsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StorageJobsSummaryRequest) GetCids() []string {
+func (x *ExportDealRecordsRequest) GetConfig() *v1.DealRecordsExportConfig {
 	if x != nil {
-		return x.Cids
+		return x.Config
 	}
 	return nil
 }
 
-type StorageJobsSummaryResponse struct {
+type ExportDealRecordsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobCounts                   *v1.JobCounts    `protobuf:"bytes,1,opt,name=job_counts,json=jobCounts,proto3" json:"job_counts,omitempty"`
-	QueuedStorageJobs           []*v1.StorageJob `protobuf:"bytes,2,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
-	ExecutingStorageJobs        []*v1.StorageJob `protobuf:"bytes,3,rep,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
-	LatestFinalStorageJobs      []*v1.StorageJob `protobuf:"bytes,4,rep,name=latest_final_storage_jobs,json=latestFinalStorageJobs,proto3" json:"latest_final_storage_jobs,omitempty"`
-	LatestSuccessfulStorageJobs []*v1.StorageJob `protobuf:"bytes,5,rep,name=latest_successful_storage_jobs,json=latestSuccessfulStorageJobs,proto3" json:"latest_successful_storage_jobs,omitempty"`
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *StorageJobsSummaryResponse) Reset() {
-	*x = StorageJobsSummaryResponse{}
+func (x *ExportDealRecordsResponse) Reset() {
+	*x = ExportDealRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobsSummaryResponse) String() string {
+func (x *ExportDealRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobsSummaryResponse) ProtoMessage() {}
+func (*ExportDealRecordsResponse) ProtoMessage() {}
 
-func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+func (x *ExportDealRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,44 +2777,118 @@ func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*StorageJobsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ExportDealRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ExportDealRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *StorageJobsSummaryResponse) GetJobCounts() *v1.JobCounts {
+func (x *ExportDealRecordsResponse) GetChunk() []byte {
 	if x != nil {
-		return x.JobCounts
+		return x.Chunk
 	}
 	return nil
 }
 
-func (x *StorageJobsSummaryResponse) GetQueuedStorageJobs() []*v1.StorageJob {
-	if x != nil {
-		return x.QueuedStorageJobs
+type PurgeLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid       string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	OlderThan int64  `protobuf:"varint,2,opt,name=older_than,json=olderThan,proto3" json:"older_than,omitempty"`
+}
+
+func (x *PurgeLogsRequest) Reset() {
+	*x = PurgeLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *StorageJobsSummaryResponse) GetExecutingStorageJobs() []*v1.StorageJob {
+func (x *PurgeLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeLogsRequest) ProtoMessage() {}
+
+func (x *PurgeLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeLogsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeLogsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PurgeLogsRequest) GetCid() string {
 	if x != nil {
-		return x.ExecutingStorageJobs
+		return x.Cid
 	}
-	return nil
+	return ""
 }
 
-func (x *StorageJobsSummaryResponse) GetLatestFinalStorageJobs() []*v1.StorageJob {
+func (x *PurgeLogsRequest) GetOlderThan() int64 {
 	if x != nil {
-		return x.LatestFinalStorageJobs
+		return x.OlderThan
 	}
-	return nil
+	return 0
 }
 
-func (x *StorageJobsSummaryResponse) GetLatestSuccessfulStorageJobs() []*v1.StorageJob {
+type PurgeLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *PurgeLogsResponse) Reset() {
+	*x = PurgeLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeLogsResponse) ProtoMessage() {}
+
+func (x *PurgeLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeLogsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeLogsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PurgeLogsResponse) GetDeleted() int64 {
 	if x != nil {
-		return x.LatestSuccessfulStorageJobs
+		return x.Deleted
 	}
-	return nil
+	return 0
 }
 
 var File_powergate_admin_v1_admin_proto protoreflect.FileDescriptor
@@ -1098,8 +2915,124 @@ var file_powergate_admin_v1_admin_proto_rawDesc = []byte{
 	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x11, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2c, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x32, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x41, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x01,
+	0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x2e, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x5c, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0x44, 0x0a, 0x19, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x43, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x18, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x50, 0x0a, 0x15, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x16, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64,
+	0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6c,
+	0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x75,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x6f, 0x70,
+	0x55, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x56, 0x0a, 0x13, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x75, 0x6e, 0x64, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x2c, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
@@ -1189,7 +3122,70 @@ var file_powergate_admin_v1_admin_proto_rawDesc = []byte{
 	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
 	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x1b, 0x6c, 0x61, 0x74, 0x65, 0x73,
 	0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x32, 0xcd, 0x08, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x50, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x5a, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x43, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52,
+	0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x22, 0x28, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x0e, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x22, 0x77, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x31, 0x0a, 0x19,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22,
+	0x43, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74,
+	0x68, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x54, 0x68, 0x61, 0x6e, 0x22, 0x2d, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x2a, 0xc0, 0x01, 0x0a, 0x0e, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x55, 0x4e, 0x44,
+	0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x4f, 0x57,
+	0x5f, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x46, 0x55, 0x4e, 0x44,
+	0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x55, 0x4e,
+	0x44, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x50, 0x10, 0x03, 0x12, 0x29, 0x0a, 0x25, 0x46,
+	0x55, 0x4e, 0x44, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x54, 0x4f, 0x50, 0x5f, 0x55, 0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x43, 0x48, 0x45, 0x44, 0x10, 0x04, 0x32, 0xae, 0x14, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
 	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64,
@@ -1207,63 +3203,157 @@ var file_powergate_admin_v1_admin_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
 	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x72, 0x0a, 0x11, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x28, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
 	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2c, 0x2e, 0x70,
+	0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x46, 0x75, 0x6e, 0x64,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x11, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2c,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70,
 	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x14, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x73, 0x12, 0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x16, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a,
+	0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x16, 0x4c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x90,
+	0x01, 0x0a, 0x1b, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x36,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
 	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x90, 0x01, 0x0a,
-	0x1b, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
-	0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x36, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
-	0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x75, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x75, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x2d,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x7e, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6f, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x06, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x09, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x74, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1278,69 +3368,142 @@ func file_powergate_admin_v1_admin_proto_rawDescGZIP() []byte {
 	return file_powergate_admin_v1_admin_proto_rawDescData
 }
 
-var file_powergate_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_powergate_admin_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_powergate_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_powergate_admin_v1_admin_proto_goTypes = []interface{}{
-	(*NewAddressRequest)(nil),                   // 0: powergate.admin.v1.NewAddressRequest
-	(*NewAddressResponse)(nil),                  // 1: powergate.admin.v1.NewAddressResponse
-	(*AddressesRequest)(nil),                    // 2: powergate.admin.v1.AddressesRequest
-	(*AddressesResponse)(nil),                   // 3: powergate.admin.v1.AddressesResponse
-	(*SendFilRequest)(nil),                      // 4: powergate.admin.v1.SendFilRequest
-	(*SendFilResponse)(nil),                     // 5: powergate.admin.v1.SendFilResponse
-	(*User)(nil),                                // 6: powergate.admin.v1.User
-	(*CreateUserRequest)(nil),                   // 7: powergate.admin.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),                  // 8: powergate.admin.v1.CreateUserResponse
-	(*UsersRequest)(nil),                        // 9: powergate.admin.v1.UsersRequest
-	(*UsersResponse)(nil),                       // 10: powergate.admin.v1.UsersResponse
-	(*QueuedStorageJobsRequest)(nil),            // 11: powergate.admin.v1.QueuedStorageJobsRequest
-	(*QueuedStorageJobsResponse)(nil),           // 12: powergate.admin.v1.QueuedStorageJobsResponse
-	(*ExecutingStorageJobsRequest)(nil),         // 13: powergate.admin.v1.ExecutingStorageJobsRequest
-	(*ExecutingStorageJobsResponse)(nil),        // 14: powergate.admin.v1.ExecutingStorageJobsResponse
-	(*LatestFinalStorageJobsRequest)(nil),       // 15: powergate.admin.v1.LatestFinalStorageJobsRequest
-	(*LatestFinalStorageJobsResponse)(nil),      // 16: powergate.admin.v1.LatestFinalStorageJobsResponse
-	(*LatestSuccessfulStorageJobsRequest)(nil),  // 17: powergate.admin.v1.LatestSuccessfulStorageJobsRequest
-	(*LatestSuccessfulStorageJobsResponse)(nil), // 18: powergate.admin.v1.LatestSuccessfulStorageJobsResponse
-	(*StorageJobsSummaryRequest)(nil),           // 19: powergate.admin.v1.StorageJobsSummaryRequest
-	(*StorageJobsSummaryResponse)(nil),          // 20: powergate.admin.v1.StorageJobsSummaryResponse
-	(*v1.StorageJob)(nil),                       // 21: powergate.user.v1.StorageJob
-	(*v1.JobCounts)(nil),                        // 22: powergate.user.v1.JobCounts
+	(FundsEventKind)(0),                         // 0: powergate.admin.v1.FundsEventKind
+	(*NewAddressRequest)(nil),                   // 1: powergate.admin.v1.NewAddressRequest
+	(*NewAddressResponse)(nil),                  // 2: powergate.admin.v1.NewAddressResponse
+	(*AddressesRequest)(nil),                    // 3: powergate.admin.v1.AddressesRequest
+	(*AddressesResponse)(nil),                   // 4: powergate.admin.v1.AddressesResponse
+	(*SendFilRequest)(nil),                      // 5: powergate.admin.v1.SendFilRequest
+	(*SendFilResponse)(nil),                     // 6: powergate.admin.v1.SendFilResponse
+	(*SetSpendPolicyRequest)(nil),               // 7: powergate.admin.v1.SetSpendPolicyRequest
+	(*SetSpendPolicyResponse)(nil),              // 8: powergate.admin.v1.SetSpendPolicyResponse
+	(*SendRequest)(nil),                         // 9: powergate.admin.v1.SendRequest
+	(*SendRequestsRequest)(nil),                 // 10: powergate.admin.v1.SendRequestsRequest
+	(*SendRequestsResponse)(nil),                // 11: powergate.admin.v1.SendRequestsResponse
+	(*ApproveSendRequestRequest)(nil),           // 12: powergate.admin.v1.ApproveSendRequestRequest
+	(*ApproveSendRequestResponse)(nil),          // 13: powergate.admin.v1.ApproveSendRequestResponse
+	(*RejectSendRequestRequest)(nil),            // 14: powergate.admin.v1.RejectSendRequestRequest
+	(*RejectSendRequestResponse)(nil),           // 15: powergate.admin.v1.RejectSendRequestResponse
+	(*ExportedKey)(nil),                         // 16: powergate.admin.v1.ExportedKey
+	(*ExportUserKeysRequest)(nil),               // 17: powergate.admin.v1.ExportUserKeysRequest
+	(*ExportUserKeysResponse)(nil),              // 18: powergate.admin.v1.ExportUserKeysResponse
+	(*ImportUserKeyRequest)(nil),                // 19: powergate.admin.v1.ImportUserKeyRequest
+	(*ImportUserKeyResponse)(nil),               // 20: powergate.admin.v1.ImportUserKeyResponse
+	(*AddrFundsStatus)(nil),                     // 21: powergate.admin.v1.AddrFundsStatus
+	(*FundsEvent)(nil),                          // 22: powergate.admin.v1.FundsEvent
+	(*FundsStatusRequest)(nil),                  // 23: powergate.admin.v1.FundsStatusRequest
+	(*FundsStatusResponse)(nil),                 // 24: powergate.admin.v1.FundsStatusResponse
+	(*FundsEventsRequest)(nil),                  // 25: powergate.admin.v1.FundsEventsRequest
+	(*FundsEventsResponse)(nil),                 // 26: powergate.admin.v1.FundsEventsResponse
+	(*User)(nil),                                // 27: powergate.admin.v1.User
+	(*CreateUserRequest)(nil),                   // 28: powergate.admin.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                  // 29: powergate.admin.v1.CreateUserResponse
+	(*UsersRequest)(nil),                        // 30: powergate.admin.v1.UsersRequest
+	(*UsersResponse)(nil),                       // 31: powergate.admin.v1.UsersResponse
+	(*QueuedStorageJobsRequest)(nil),            // 32: powergate.admin.v1.QueuedStorageJobsRequest
+	(*QueuedStorageJobsResponse)(nil),           // 33: powergate.admin.v1.QueuedStorageJobsResponse
+	(*ExecutingStorageJobsRequest)(nil),         // 34: powergate.admin.v1.ExecutingStorageJobsRequest
+	(*ExecutingStorageJobsResponse)(nil),        // 35: powergate.admin.v1.ExecutingStorageJobsResponse
+	(*LatestFinalStorageJobsRequest)(nil),       // 36: powergate.admin.v1.LatestFinalStorageJobsRequest
+	(*LatestFinalStorageJobsResponse)(nil),      // 37: powergate.admin.v1.LatestFinalStorageJobsResponse
+	(*LatestSuccessfulStorageJobsRequest)(nil),  // 38: powergate.admin.v1.LatestSuccessfulStorageJobsRequest
+	(*LatestSuccessfulStorageJobsResponse)(nil), // 39: powergate.admin.v1.LatestSuccessfulStorageJobsResponse
+	(*StorageJobsSummaryRequest)(nil),           // 40: powergate.admin.v1.StorageJobsSummaryRequest
+	(*StorageJobsSummaryResponse)(nil),          // 41: powergate.admin.v1.StorageJobsSummaryResponse
+	(*CountryOverride)(nil),                     // 42: powergate.admin.v1.CountryOverride
+	(*SetCountryOverrideRequest)(nil),           // 43: powergate.admin.v1.SetCountryOverrideRequest
+	(*SetCountryOverrideResponse)(nil),          // 44: powergate.admin.v1.SetCountryOverrideResponse
+	(*RemoveCountryOverrideRequest)(nil),        // 45: powergate.admin.v1.RemoveCountryOverrideRequest
+	(*RemoveCountryOverrideResponse)(nil),       // 46: powergate.admin.v1.RemoveCountryOverrideResponse
+	(*CountryOverridesRequest)(nil),             // 47: powergate.admin.v1.CountryOverridesRequest
+	(*CountryOverridesResponse)(nil),            // 48: powergate.admin.v1.CountryOverridesResponse
+	(*BackupRequest)(nil),                       // 49: powergate.admin.v1.BackupRequest
+	(*BackupResponse)(nil),                      // 50: powergate.admin.v1.BackupResponse
+	(*ExportDealRecordsRequest)(nil),            // 51: powergate.admin.v1.ExportDealRecordsRequest
+	(*ExportDealRecordsResponse)(nil),           // 52: powergate.admin.v1.ExportDealRecordsResponse
+	(*PurgeLogsRequest)(nil),                    // 53: powergate.admin.v1.PurgeLogsRequest
+	(*PurgeLogsResponse)(nil),                   // 54: powergate.admin.v1.PurgeLogsResponse
+	(*v1.SpendPolicy)(nil),                      // 55: powergate.user.v1.SpendPolicy
+	(*v1.StorageJob)(nil),                       // 56: powergate.user.v1.StorageJob
+	(*v1.JobCounts)(nil),                        // 57: powergate.user.v1.JobCounts
+	(*v1.DealRecordsExportConfig)(nil),          // 58: powergate.user.v1.DealRecordsExportConfig
 }
 var file_powergate_admin_v1_admin_proto_depIdxs = []int32{
-	6,  // 0: powergate.admin.v1.CreateUserResponse.user:type_name -> powergate.admin.v1.User
-	6,  // 1: powergate.admin.v1.UsersResponse.users:type_name -> powergate.admin.v1.User
-	21, // 2: powergate.admin.v1.QueuedStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	21, // 3: powergate.admin.v1.ExecutingStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	21, // 4: powergate.admin.v1.LatestFinalStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	21, // 5: powergate.admin.v1.LatestSuccessfulStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	22, // 6: powergate.admin.v1.StorageJobsSummaryResponse.job_counts:type_name -> powergate.user.v1.JobCounts
-	21, // 7: powergate.admin.v1.StorageJobsSummaryResponse.queued_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	21, // 8: powergate.admin.v1.StorageJobsSummaryResponse.executing_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	21, // 9: powergate.admin.v1.StorageJobsSummaryResponse.latest_final_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	21, // 10: powergate.admin.v1.StorageJobsSummaryResponse.latest_successful_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	0,  // 11: powergate.admin.v1.AdminService.NewAddress:input_type -> powergate.admin.v1.NewAddressRequest
-	2,  // 12: powergate.admin.v1.AdminService.Addresses:input_type -> powergate.admin.v1.AddressesRequest
-	4,  // 13: powergate.admin.v1.AdminService.SendFil:input_type -> powergate.admin.v1.SendFilRequest
-	7,  // 14: powergate.admin.v1.AdminService.CreateUser:input_type -> powergate.admin.v1.CreateUserRequest
-	9,  // 15: powergate.admin.v1.AdminService.Users:input_type -> powergate.admin.v1.UsersRequest
-	11, // 16: powergate.admin.v1.AdminService.QueuedStorageJobs:input_type -> powergate.admin.v1.QueuedStorageJobsRequest
-	13, // 17: powergate.admin.v1.AdminService.ExecutingStorageJobs:input_type -> powergate.admin.v1.ExecutingStorageJobsRequest
-	15, // 18: powergate.admin.v1.AdminService.LatestFinalStorageJobs:input_type -> powergate.admin.v1.LatestFinalStorageJobsRequest
-	17, // 19: powergate.admin.v1.AdminService.LatestSuccessfulStorageJobs:input_type -> powergate.admin.v1.LatestSuccessfulStorageJobsRequest
-	19, // 20: powergate.admin.v1.AdminService.StorageJobsSummary:input_type -> powergate.admin.v1.StorageJobsSummaryRequest
-	1,  // 21: powergate.admin.v1.AdminService.NewAddress:output_type -> powergate.admin.v1.NewAddressResponse
-	3,  // 22: powergate.admin.v1.AdminService.Addresses:output_type -> powergate.admin.v1.AddressesResponse
-	5,  // 23: powergate.admin.v1.AdminService.SendFil:output_type -> powergate.admin.v1.SendFilResponse
-	8,  // 24: powergate.admin.v1.AdminService.CreateUser:output_type -> powergate.admin.v1.CreateUserResponse
-	10, // 25: powergate.admin.v1.AdminService.Users:output_type -> powergate.admin.v1.UsersResponse
-	12, // 26: powergate.admin.v1.AdminService.QueuedStorageJobs:output_type -> powergate.admin.v1.QueuedStorageJobsResponse
-	14, // 27: powergate.admin.v1.AdminService.ExecutingStorageJobs:output_type -> powergate.admin.v1.ExecutingStorageJobsResponse
-	16, // 28: powergate.admin.v1.AdminService.LatestFinalStorageJobs:output_type -> powergate.admin.v1.LatestFinalStorageJobsResponse
-	18, // 29: powergate.admin.v1.AdminService.LatestSuccessfulStorageJobs:output_type -> powergate.admin.v1.LatestSuccessfulStorageJobsResponse
-	20, // 30: powergate.admin.v1.AdminService.StorageJobsSummary:output_type -> powergate.admin.v1.StorageJobsSummaryResponse
-	21, // [21:31] is the sub-list for method output_type
-	11, // [11:21] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	55, // 0: powergate.admin.v1.SetSpendPolicyRequest.spend_policy:type_name -> powergate.user.v1.SpendPolicy
+	9,  // 1: powergate.admin.v1.SendRequestsResponse.send_requests:type_name -> powergate.admin.v1.SendRequest
+	16, // 2: powergate.admin.v1.ExportUserKeysResponse.keys:type_name -> powergate.admin.v1.ExportedKey
+	0,  // 3: powergate.admin.v1.FundsEvent.kind:type_name -> powergate.admin.v1.FundsEventKind
+	21, // 4: powergate.admin.v1.FundsStatusResponse.statuses:type_name -> powergate.admin.v1.AddrFundsStatus
+	22, // 5: powergate.admin.v1.FundsEventsResponse.events:type_name -> powergate.admin.v1.FundsEvent
+	27, // 6: powergate.admin.v1.CreateUserResponse.user:type_name -> powergate.admin.v1.User
+	27, // 7: powergate.admin.v1.UsersResponse.users:type_name -> powergate.admin.v1.User
+	56, // 8: powergate.admin.v1.QueuedStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	56, // 9: powergate.admin.v1.ExecutingStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	56, // 10: powergate.admin.v1.LatestFinalStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	56, // 11: powergate.admin.v1.LatestSuccessfulStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	57, // 12: powergate.admin.v1.StorageJobsSummaryResponse.job_counts:type_name -> powergate.user.v1.JobCounts
+	56, // 13: powergate.admin.v1.StorageJobsSummaryResponse.queued_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	56, // 14: powergate.admin.v1.StorageJobsSummaryResponse.executing_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	56, // 15: powergate.admin.v1.StorageJobsSummaryResponse.latest_final_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	56, // 16: powergate.admin.v1.StorageJobsSummaryResponse.latest_successful_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	42, // 17: powergate.admin.v1.CountryOverridesResponse.country_overrides:type_name -> powergate.admin.v1.CountryOverride
+	58, // 18: powergate.admin.v1.ExportDealRecordsRequest.config:type_name -> powergate.user.v1.DealRecordsExportConfig
+	1,  // 19: powergate.admin.v1.AdminService.NewAddress:input_type -> powergate.admin.v1.NewAddressRequest
+	3,  // 20: powergate.admin.v1.AdminService.Addresses:input_type -> powergate.admin.v1.AddressesRequest
+	5,  // 21: powergate.admin.v1.AdminService.SendFil:input_type -> powergate.admin.v1.SendFilRequest
+	7,  // 22: powergate.admin.v1.AdminService.SetSpendPolicy:input_type -> powergate.admin.v1.SetSpendPolicyRequest
+	10, // 23: powergate.admin.v1.AdminService.SendRequests:input_type -> powergate.admin.v1.SendRequestsRequest
+	12, // 24: powergate.admin.v1.AdminService.ApproveSendRequest:input_type -> powergate.admin.v1.ApproveSendRequestRequest
+	14, // 25: powergate.admin.v1.AdminService.RejectSendRequest:input_type -> powergate.admin.v1.RejectSendRequestRequest
+	17, // 26: powergate.admin.v1.AdminService.ExportUserKeys:input_type -> powergate.admin.v1.ExportUserKeysRequest
+	19, // 27: powergate.admin.v1.AdminService.ImportUserKey:input_type -> powergate.admin.v1.ImportUserKeyRequest
+	23, // 28: powergate.admin.v1.AdminService.FundsStatus:input_type -> powergate.admin.v1.FundsStatusRequest
+	25, // 29: powergate.admin.v1.AdminService.FundsEvents:input_type -> powergate.admin.v1.FundsEventsRequest
+	28, // 30: powergate.admin.v1.AdminService.CreateUser:input_type -> powergate.admin.v1.CreateUserRequest
+	30, // 31: powergate.admin.v1.AdminService.Users:input_type -> powergate.admin.v1.UsersRequest
+	32, // 32: powergate.admin.v1.AdminService.QueuedStorageJobs:input_type -> powergate.admin.v1.QueuedStorageJobsRequest
+	34, // 33: powergate.admin.v1.AdminService.ExecutingStorageJobs:input_type -> powergate.admin.v1.ExecutingStorageJobsRequest
+	36, // 34: powergate.admin.v1.AdminService.LatestFinalStorageJobs:input_type -> powergate.admin.v1.LatestFinalStorageJobsRequest
+	38, // 35: powergate.admin.v1.AdminService.LatestSuccessfulStorageJobs:input_type -> powergate.admin.v1.LatestSuccessfulStorageJobsRequest
+	40, // 36: powergate.admin.v1.AdminService.StorageJobsSummary:input_type -> powergate.admin.v1.StorageJobsSummaryRequest
+	43, // 37: powergate.admin.v1.AdminService.SetCountryOverride:input_type -> powergate.admin.v1.SetCountryOverrideRequest
+	45, // 38: powergate.admin.v1.AdminService.RemoveCountryOverride:input_type -> powergate.admin.v1.RemoveCountryOverrideRequest
+	47, // 39: powergate.admin.v1.AdminService.CountryOverrides:input_type -> powergate.admin.v1.CountryOverridesRequest
+	49, // 40: powergate.admin.v1.AdminService.Backup:input_type -> powergate.admin.v1.BackupRequest
+	53, // 41: powergate.admin.v1.AdminService.PurgeLogs:input_type -> powergate.admin.v1.PurgeLogsRequest
+	51, // 42: powergate.admin.v1.AdminService.ExportDealRecords:input_type -> powergate.admin.v1.ExportDealRecordsRequest
+	2,  // 43: powergate.admin.v1.AdminService.NewAddress:output_type -> powergate.admin.v1.NewAddressResponse
+	4,  // 44: powergate.admin.v1.AdminService.Addresses:output_type -> powergate.admin.v1.AddressesResponse
+	6,  // 45: powergate.admin.v1.AdminService.SendFil:output_type -> powergate.admin.v1.SendFilResponse
+	8,  // 46: powergate.admin.v1.AdminService.SetSpendPolicy:output_type -> powergate.admin.v1.SetSpendPolicyResponse
+	11, // 47: powergate.admin.v1.AdminService.SendRequests:output_type -> powergate.admin.v1.SendRequestsResponse
+	13, // 48: powergate.admin.v1.AdminService.ApproveSendRequest:output_type -> powergate.admin.v1.ApproveSendRequestResponse
+	15, // 49: powergate.admin.v1.AdminService.RejectSendRequest:output_type -> powergate.admin.v1.RejectSendRequestResponse
+	18, // 50: powergate.admin.v1.AdminService.ExportUserKeys:output_type -> powergate.admin.v1.ExportUserKeysResponse
+	20, // 51: powergate.admin.v1.AdminService.ImportUserKey:output_type -> powergate.admin.v1.ImportUserKeyResponse
+	24, // 52: powergate.admin.v1.AdminService.FundsStatus:output_type -> powergate.admin.v1.FundsStatusResponse
+	26, // 53: powergate.admin.v1.AdminService.FundsEvents:output_type -> powergate.admin.v1.FundsEventsResponse
+	29, // 54: powergate.admin.v1.AdminService.CreateUser:output_type -> powergate.admin.v1.CreateUserResponse
+	31, // 55: powergate.admin.v1.AdminService.Users:output_type -> powergate.admin.v1.UsersResponse
+	33, // 56: powergate.admin.v1.AdminService.QueuedStorageJobs:output_type -> powergate.admin.v1.QueuedStorageJobsResponse
+	35, // 57: powergate.admin.v1.AdminService.ExecutingStorageJobs:output_type -> powergate.admin.v1.ExecutingStorageJobsResponse
+	37, // 58: powergate.admin.v1.AdminService.LatestFinalStorageJobs:output_type -> powergate.admin.v1.LatestFinalStorageJobsResponse
+	39, // 59: powergate.admin.v1.AdminService.LatestSuccessfulStorageJobs:output_type -> powergate.admin.v1.LatestSuccessfulStorageJobsResponse
+	41, // 60: powergate.admin.v1.AdminService.StorageJobsSummary:output_type -> powergate.admin.v1.StorageJobsSummaryResponse
+	44, // 61: powergate.admin.v1.AdminService.SetCountryOverride:output_type -> powergate.admin.v1.SetCountryOverrideResponse
+	46, // 62: powergate.admin.v1.AdminService.RemoveCountryOverride:output_type -> powergate.admin.v1.RemoveCountryOverrideResponse
+	48, // 63: powergate.admin.v1.AdminService.CountryOverrides:output_type -> powergate.admin.v1.CountryOverridesResponse
+	50, // 64: powergate.admin.v1.AdminService.Backup:output_type -> powergate.admin.v1.BackupResponse
+	54, // 65: powergate.admin.v1.AdminService.PurgeLogs:output_type -> powergate.admin.v1.PurgeLogsResponse
+	52, // 66: powergate.admin.v1.AdminService.ExportDealRecords:output_type -> powergate.admin.v1.ExportDealRecordsResponse
+	43, // [43:67] is the sub-list for method output_type
+	19, // [19:43] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_powergate_admin_v1_admin_proto_init() }
@@ -1361,8 +3524,140 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAddressResponse); i {
+		file_powergate_admin_v1_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFilRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFilResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSendRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSendRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1373,8 +3668,8 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressesRequest); i {
+		file_powergate_admin_v1_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectSendRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1385,8 +3680,8 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressesResponse); i {
+		file_powergate_admin_v1_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectSendRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1397,8 +3692,8 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFilRequest); i {
+		file_powergate_admin_v1_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportedKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1409,8 +3704,8 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFilResponse); i {
+		file_powergate_admin_v1_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1421,7 +3716,115 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUserKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUserKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrFundsStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundsEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundsStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundsStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundsEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundsEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*User); i {
 			case 0:
 				return &v.state
@@ -1433,7 +3836,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserRequest); i {
 			case 0:
 				return &v.state
@@ -1445,7 +3848,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserResponse); i {
 			case 0:
 				return &v.state
@@ -1457,7 +3860,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UsersRequest); i {
 			case 0:
 				return &v.state
@@ -1469,7 +3872,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UsersResponse); i {
 			case 0:
 				return &v.state
@@ -1481,7 +3884,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueuedStorageJobsRequest); i {
 			case 0:
 				return &v.state
@@ -1493,7 +3896,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueuedStorageJobsResponse); i {
 			case 0:
 				return &v.state
@@ -1505,7 +3908,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecutingStorageJobsRequest); i {
 			case 0:
 				return &v.state
@@ -1517,7 +3920,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecutingStorageJobsResponse); i {
 			case 0:
 				return &v.state
@@ -1529,7 +3932,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LatestFinalStorageJobsRequest); i {
 			case 0:
 				return &v.state
@@ -1541,7 +3944,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LatestFinalStorageJobsResponse); i {
 			case 0:
 				return &v.state
@@ -1553,7 +3956,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LatestSuccessfulStorageJobsRequest); i {
 			case 0:
 				return &v.state
@@ -1565,7 +3968,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LatestSuccessfulStorageJobsResponse); i {
 			case 0:
 				return &v.state
@@ -1577,7 +3980,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StorageJobsSummaryRequest); i {
 			case 0:
 				return &v.state
@@ -1589,7 +3992,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_admin_v1_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_admin_v1_admin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StorageJobsSummaryResponse); i {
 			case 0:
 				return &v.state
@@ -1601,19 +4004,176 @@ func file_powergate_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
+		file_powergate_admin_v1_admin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountryOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCountryOverrideRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCountryOverrideResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCountryOverrideRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCountryOverrideResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountryOverridesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountryOverridesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDealRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDealRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_powergate_admin_v1_admin_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      1,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_powergate_admin_v1_admin_proto_goTypes,
 		DependencyIndexes: file_powergate_admin_v1_admin_proto_depIdxs,
+		EnumInfos:         file_powergate_admin_v1_admin_proto_enumTypes,
 		MessageInfos:      file_powergate_admin_v1_admin_proto_msgTypes,
 	}.Build()
 	File_powergate_admin_v1_admin_proto = out.File
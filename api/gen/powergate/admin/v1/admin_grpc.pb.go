@@ -21,6 +21,14 @@ type AdminServiceClient interface {
 	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
 	Addresses(ctx context.Context, in *AddressesRequest, opts ...grpc.CallOption) (*AddressesResponse, error)
 	SendFil(ctx context.Context, in *SendFilRequest, opts ...grpc.CallOption) (*SendFilResponse, error)
+	SetSpendPolicy(ctx context.Context, in *SetSpendPolicyRequest, opts ...grpc.CallOption) (*SetSpendPolicyResponse, error)
+	SendRequests(ctx context.Context, in *SendRequestsRequest, opts ...grpc.CallOption) (*SendRequestsResponse, error)
+	ApproveSendRequest(ctx context.Context, in *ApproveSendRequestRequest, opts ...grpc.CallOption) (*ApproveSendRequestResponse, error)
+	RejectSendRequest(ctx context.Context, in *RejectSendRequestRequest, opts ...grpc.CallOption) (*RejectSendRequestResponse, error)
+	ExportUserKeys(ctx context.Context, in *ExportUserKeysRequest, opts ...grpc.CallOption) (*ExportUserKeysResponse, error)
+	ImportUserKey(ctx context.Context, in *ImportUserKeyRequest, opts ...grpc.CallOption) (*ImportUserKeyResponse, error)
+	FundsStatus(ctx context.Context, in *FundsStatusRequest, opts ...grpc.CallOption) (*FundsStatusResponse, error)
+	FundsEvents(ctx context.Context, in *FundsEventsRequest, opts ...grpc.CallOption) (*FundsEventsResponse, error)
 	// Users
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	Users(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*UsersResponse, error)
@@ -30,6 +38,16 @@ type AdminServiceClient interface {
 	LatestFinalStorageJobs(ctx context.Context, in *LatestFinalStorageJobsRequest, opts ...grpc.CallOption) (*LatestFinalStorageJobsResponse, error)
 	LatestSuccessfulStorageJobs(ctx context.Context, in *LatestSuccessfulStorageJobsRequest, opts ...grpc.CallOption) (*LatestSuccessfulStorageJobsResponse, error)
 	StorageJobsSummary(ctx context.Context, in *StorageJobsSummaryRequest, opts ...grpc.CallOption) (*StorageJobsSummaryResponse, error)
+	// Miners
+	SetCountryOverride(ctx context.Context, in *SetCountryOverrideRequest, opts ...grpc.CallOption) (*SetCountryOverrideResponse, error)
+	RemoveCountryOverride(ctx context.Context, in *RemoveCountryOverrideRequest, opts ...grpc.CallOption) (*RemoveCountryOverrideResponse, error)
+	CountryOverrides(ctx context.Context, in *CountryOverridesRequest, opts ...grpc.CallOption) (*CountryOverridesResponse, error)
+	// Backups
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (AdminService_BackupClient, error)
+	// Logs
+	PurgeLogs(ctx context.Context, in *PurgeLogsRequest, opts ...grpc.CallOption) (*PurgeLogsResponse, error)
+	// Deals
+	ExportDealRecords(ctx context.Context, in *ExportDealRecordsRequest, opts ...grpc.CallOption) (AdminService_ExportDealRecordsClient, error)
 }
 
 type adminServiceClient struct {
@@ -67,6 +85,78 @@ func (c *adminServiceClient) SendFil(ctx context.Context, in *SendFilRequest, op
 	return out, nil
 }
 
+func (c *adminServiceClient) SetSpendPolicy(ctx context.Context, in *SetSpendPolicyRequest, opts ...grpc.CallOption) (*SetSpendPolicyResponse, error) {
+	out := new(SetSpendPolicyResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/SetSpendPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SendRequests(ctx context.Context, in *SendRequestsRequest, opts ...grpc.CallOption) (*SendRequestsResponse, error) {
+	out := new(SendRequestsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/SendRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApproveSendRequest(ctx context.Context, in *ApproveSendRequestRequest, opts ...grpc.CallOption) (*ApproveSendRequestResponse, error) {
+	out := new(ApproveSendRequestResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/ApproveSendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RejectSendRequest(ctx context.Context, in *RejectSendRequestRequest, opts ...grpc.CallOption) (*RejectSendRequestResponse, error) {
+	out := new(RejectSendRequestResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/RejectSendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportUserKeys(ctx context.Context, in *ExportUserKeysRequest, opts ...grpc.CallOption) (*ExportUserKeysResponse, error) {
+	out := new(ExportUserKeysResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/ExportUserKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportUserKey(ctx context.Context, in *ImportUserKeyRequest, opts ...grpc.CallOption) (*ImportUserKeyResponse, error) {
+	out := new(ImportUserKeyResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/ImportUserKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) FundsStatus(ctx context.Context, in *FundsStatusRequest, opts ...grpc.CallOption) (*FundsStatusResponse, error) {
+	out := new(FundsStatusResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/FundsStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) FundsEvents(ctx context.Context, in *FundsEventsRequest, opts ...grpc.CallOption) (*FundsEventsResponse, error) {
+	out := new(FundsEventsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/FundsEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
 	out := new(CreateUserResponse)
 	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/CreateUser", in, out, opts...)
@@ -130,6 +220,106 @@ func (c *adminServiceClient) StorageJobsSummary(ctx context.Context, in *Storage
 	return out, nil
 }
 
+func (c *adminServiceClient) SetCountryOverride(ctx context.Context, in *SetCountryOverrideRequest, opts ...grpc.CallOption) (*SetCountryOverrideResponse, error) {
+	out := new(SetCountryOverrideResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/SetCountryOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveCountryOverride(ctx context.Context, in *RemoveCountryOverrideRequest, opts ...grpc.CallOption) (*RemoveCountryOverrideResponse, error) {
+	out := new(RemoveCountryOverrideResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/RemoveCountryOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CountryOverrides(ctx context.Context, in *CountryOverridesRequest, opts ...grpc.CallOption) (*CountryOverridesResponse, error) {
+	out := new(CountryOverridesResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/CountryOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (AdminService_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/powergate.admin.v1.AdminService/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_BackupClient interface {
+	Recv() (*BackupResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceBackupClient) Recv() (*BackupResponse, error) {
+	m := new(BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) PurgeLogs(ctx context.Context, in *PurgeLogsRequest, opts ...grpc.CallOption) (*PurgeLogsResponse, error) {
+	out := new(PurgeLogsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/PurgeLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportDealRecords(ctx context.Context, in *ExportDealRecordsRequest, opts ...grpc.CallOption) (AdminService_ExportDealRecordsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[1], "/powergate.admin.v1.AdminService/ExportDealRecords", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceExportDealRecordsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_ExportDealRecordsClient interface {
+	Recv() (*ExportDealRecordsResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceExportDealRecordsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceExportDealRecordsClient) Recv() (*ExportDealRecordsResponse, error) {
+	m := new(ExportDealRecordsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -138,6 +328,14 @@ type AdminServiceServer interface {
 	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
 	Addresses(context.Context, *AddressesRequest) (*AddressesResponse, error)
 	SendFil(context.Context, *SendFilRequest) (*SendFilResponse, error)
+	SetSpendPolicy(context.Context, *SetSpendPolicyRequest) (*SetSpendPolicyResponse, error)
+	SendRequests(context.Context, *SendRequestsRequest) (*SendRequestsResponse, error)
+	ApproveSendRequest(context.Context, *ApproveSendRequestRequest) (*ApproveSendRequestResponse, error)
+	RejectSendRequest(context.Context, *RejectSendRequestRequest) (*RejectSendRequestResponse, error)
+	ExportUserKeys(context.Context, *ExportUserKeysRequest) (*ExportUserKeysResponse, error)
+	ImportUserKey(context.Context, *ImportUserKeyRequest) (*ImportUserKeyResponse, error)
+	FundsStatus(context.Context, *FundsStatusRequest) (*FundsStatusResponse, error)
+	FundsEvents(context.Context, *FundsEventsRequest) (*FundsEventsResponse, error)
 	// Users
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	Users(context.Context, *UsersRequest) (*UsersResponse, error)
@@ -147,6 +345,16 @@ type AdminServiceServer interface {
 	LatestFinalStorageJobs(context.Context, *LatestFinalStorageJobsRequest) (*LatestFinalStorageJobsResponse, error)
 	LatestSuccessfulStorageJobs(context.Context, *LatestSuccessfulStorageJobsRequest) (*LatestSuccessfulStorageJobsResponse, error)
 	StorageJobsSummary(context.Context, *StorageJobsSummaryRequest) (*StorageJobsSummaryResponse, error)
+	// Miners
+	SetCountryOverride(context.Context, *SetCountryOverrideRequest) (*SetCountryOverrideResponse, error)
+	RemoveCountryOverride(context.Context, *RemoveCountryOverrideRequest) (*RemoveCountryOverrideResponse, error)
+	CountryOverrides(context.Context, *CountryOverridesRequest) (*CountryOverridesResponse, error)
+	// Backups
+	Backup(*BackupRequest, AdminService_BackupServer) error
+	// Logs
+	PurgeLogs(context.Context, *PurgeLogsRequest) (*PurgeLogsResponse, error)
+	// Deals
+	ExportDealRecords(*ExportDealRecordsRequest, AdminService_ExportDealRecordsServer) error
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -163,6 +371,30 @@ func (UnimplementedAdminServiceServer) Addresses(context.Context, *AddressesRequ
 func (UnimplementedAdminServiceServer) SendFil(context.Context, *SendFilRequest) (*SendFilResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendFil not implemented")
 }
+func (UnimplementedAdminServiceServer) SetSpendPolicy(context.Context, *SetSpendPolicyRequest) (*SetSpendPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendPolicy not implemented")
+}
+func (UnimplementedAdminServiceServer) SendRequests(context.Context, *SendRequestsRequest) (*SendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRequests not implemented")
+}
+func (UnimplementedAdminServiceServer) ApproveSendRequest(context.Context, *ApproveSendRequestRequest) (*ApproveSendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSendRequest not implemented")
+}
+func (UnimplementedAdminServiceServer) RejectSendRequest(context.Context, *RejectSendRequestRequest) (*RejectSendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSendRequest not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportUserKeys(context.Context, *ExportUserKeysRequest) (*ExportUserKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) ImportUserKey(context.Context, *ImportUserKeyRequest) (*ImportUserKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUserKey not implemented")
+}
+func (UnimplementedAdminServiceServer) FundsStatus(context.Context, *FundsStatusRequest) (*FundsStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundsStatus not implemented")
+}
+func (UnimplementedAdminServiceServer) FundsEvents(context.Context, *FundsEventsRequest) (*FundsEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundsEvents not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -184,6 +416,24 @@ func (UnimplementedAdminServiceServer) LatestSuccessfulStorageJobs(context.Conte
 func (UnimplementedAdminServiceServer) StorageJobsSummary(context.Context, *StorageJobsSummaryRequest) (*StorageJobsSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageJobsSummary not implemented")
 }
+func (UnimplementedAdminServiceServer) SetCountryOverride(context.Context, *SetCountryOverrideRequest) (*SetCountryOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCountryOverride not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveCountryOverride(context.Context, *RemoveCountryOverrideRequest) (*RemoveCountryOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCountryOverride not implemented")
+}
+func (UnimplementedAdminServiceServer) CountryOverrides(context.Context, *CountryOverridesRequest) (*CountryOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountryOverrides not implemented")
+}
+func (UnimplementedAdminServiceServer) Backup(*BackupRequest, AdminService_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedAdminServiceServer) PurgeLogs(context.Context, *PurgeLogsRequest) (*PurgeLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeLogs not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportDealRecords(*ExportDealRecordsRequest, AdminService_ExportDealRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDealRecords not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -251,6 +501,150 @@ func _AdminService_SendFil_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SetSpendPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpendPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetSpendPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/SetSpendPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetSpendPolicy(ctx, req.(*SetSpendPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/SendRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SendRequests(ctx, req.(*SendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApproveSendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApproveSendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/ApproveSendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApproveSendRequest(ctx, req.(*ApproveSendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RejectSendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RejectSendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/RejectSendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RejectSendRequest(ctx, req.(*RejectSendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportUserKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportUserKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/ExportUserKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportUserKeys(ctx, req.(*ExportUserKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ImportUserKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUserKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportUserKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/ImportUserKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportUserKey(ctx, req.(*ImportUserKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_FundsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).FundsStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/FundsStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).FundsStatus(ctx, req.(*FundsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_FundsEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundsEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).FundsEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/FundsEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).FundsEvents(ctx, req.(*FundsEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -377,6 +771,120 @@ func _AdminService_StorageJobsSummary_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SetCountryOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCountryOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetCountryOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/SetCountryOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetCountryOverride(ctx, req.(*SetCountryOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveCountryOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCountryOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveCountryOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/RemoveCountryOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveCountryOverride(ctx, req.(*RemoveCountryOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CountryOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountryOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CountryOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/CountryOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CountryOverrides(ctx, req.(*CountryOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).Backup(m, &adminServiceBackupServer{stream})
+}
+
+type AdminService_BackupServer interface {
+	Send(*BackupResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceBackupServer) Send(m *BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_PurgeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/PurgeLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeLogs(ctx, req.(*PurgeLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportDealRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDealRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).ExportDealRecords(m, &adminServiceExportDealRecordsServer{stream})
+}
+
+type AdminService_ExportDealRecordsServer interface {
+	Send(*ExportDealRecordsResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceExportDealRecordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceExportDealRecordsServer) Send(m *ExportDealRecordsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "powergate.admin.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -393,6 +901,38 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendFil",
 			Handler:    _AdminService_SendFil_Handler,
 		},
+		{
+			MethodName: "SetSpendPolicy",
+			Handler:    _AdminService_SetSpendPolicy_Handler,
+		},
+		{
+			MethodName: "SendRequests",
+			Handler:    _AdminService_SendRequests_Handler,
+		},
+		{
+			MethodName: "ApproveSendRequest",
+			Handler:    _AdminService_ApproveSendRequest_Handler,
+		},
+		{
+			MethodName: "RejectSendRequest",
+			Handler:    _AdminService_RejectSendRequest_Handler,
+		},
+		{
+			MethodName: "ExportUserKeys",
+			Handler:    _AdminService_ExportUserKeys_Handler,
+		},
+		{
+			MethodName: "ImportUserKey",
+			Handler:    _AdminService_ImportUserKey_Handler,
+		},
+		{
+			MethodName: "FundsStatus",
+			Handler:    _AdminService_FundsStatus_Handler,
+		},
+		{
+			MethodName: "FundsEvents",
+			Handler:    _AdminService_FundsEvents_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _AdminService_CreateUser_Handler,
@@ -421,7 +961,34 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StorageJobsSummary",
 			Handler:    _AdminService_StorageJobsSummary_Handler,
 		},
+		{
+			MethodName: "SetCountryOverride",
+			Handler:    _AdminService_SetCountryOverride_Handler,
+		},
+		{
+			MethodName: "RemoveCountryOverride",
+			Handler:    _AdminService_RemoveCountryOverride_Handler,
+		},
+		{
+			MethodName: "CountryOverrides",
+			Handler:    _AdminService_CountryOverrides_Handler,
+		},
+		{
+			MethodName: "PurgeLogs",
+			Handler:    _AdminService_PurgeLogs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Backup",
+			Handler:       _AdminService_Backup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportDealRecords",
+			Handler:       _AdminService_ExportDealRecords_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "powergate/admin/v1/admin.proto",
 }
@@ -25,6 +25,165 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type TransactionKind int32
+
+const (
+	TransactionKind_TRANSACTION_KIND_UNSPECIFIED       TransactionKind = 0
+	TransactionKind_TRANSACTION_KIND_TRANSFER          TransactionKind = 1
+	TransactionKind_TRANSACTION_KIND_ESCROW_DEPOSIT    TransactionKind = 2
+	TransactionKind_TRANSACTION_KIND_ESCROW_WITHDRAWAL TransactionKind = 3
+)
+
+// Enum value maps for TransactionKind.
+var (
+	TransactionKind_name = map[int32]string{
+		0: "TRANSACTION_KIND_UNSPECIFIED",
+		1: "TRANSACTION_KIND_TRANSFER",
+		2: "TRANSACTION_KIND_ESCROW_DEPOSIT",
+		3: "TRANSACTION_KIND_ESCROW_WITHDRAWAL",
+	}
+	TransactionKind_value = map[string]int32{
+		"TRANSACTION_KIND_UNSPECIFIED":       0,
+		"TRANSACTION_KIND_TRANSFER":          1,
+		"TRANSACTION_KIND_ESCROW_DEPOSIT":    2,
+		"TRANSACTION_KIND_ESCROW_WITHDRAWAL": 3,
+	}
+)
+
+func (x TransactionKind) Enum() *TransactionKind {
+	p := new(TransactionKind)
+	*p = x
+	return p
+}
+
+func (x TransactionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_user_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionKind) Type() protoreflect.EnumType {
+	return &file_powergate_user_v1_user_proto_enumTypes[0]
+}
+
+func (x TransactionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionKind.Descriptor instead.
+func (TransactionKind) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
+type TransactionStatus int32
+
+const (
+	TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED TransactionStatus = 0
+	TransactionStatus_TRANSACTION_STATUS_PENDING     TransactionStatus = 1
+	TransactionStatus_TRANSACTION_STATUS_CONFIRMED   TransactionStatus = 2
+	TransactionStatus_TRANSACTION_STATUS_FAILED      TransactionStatus = 3
+	TransactionStatus_TRANSACTION_STATUS_DROPPED     TransactionStatus = 4
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0: "TRANSACTION_STATUS_UNSPECIFIED",
+		1: "TRANSACTION_STATUS_PENDING",
+		2: "TRANSACTION_STATUS_CONFIRMED",
+		3: "TRANSACTION_STATUS_FAILED",
+		4: "TRANSACTION_STATUS_DROPPED",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNSPECIFIED": 0,
+		"TRANSACTION_STATUS_PENDING":     1,
+		"TRANSACTION_STATUS_CONFIRMED":   2,
+		"TRANSACTION_STATUS_FAILED":      3,
+		"TRANSACTION_STATUS_DROPPED":     4,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_user_v1_user_proto_enumTypes[1].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_powergate_user_v1_user_proto_enumTypes[1]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+type DealState int32
+
+const (
+	DealState_DEAL_STATE_UNSPECIFIED DealState = 0
+	DealState_DEAL_STATE_ACTIVE      DealState = 1
+	DealState_DEAL_STATE_SLASHED     DealState = 2
+	DealState_DEAL_STATE_EXPIRED     DealState = 3
+)
+
+// Enum value maps for DealState.
+var (
+	DealState_name = map[int32]string{
+		0: "DEAL_STATE_UNSPECIFIED",
+		1: "DEAL_STATE_ACTIVE",
+		2: "DEAL_STATE_SLASHED",
+		3: "DEAL_STATE_EXPIRED",
+	}
+	DealState_value = map[string]int32{
+		"DEAL_STATE_UNSPECIFIED": 0,
+		"DEAL_STATE_ACTIVE":      1,
+		"DEAL_STATE_SLASHED":     2,
+		"DEAL_STATE_EXPIRED":     3,
+	}
+)
+
+func (x DealState) Enum() *DealState {
+	p := new(DealState)
+	*p = x
+	return p
+}
+
+func (x DealState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DealState) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_user_v1_user_proto_enumTypes[2].Descriptor()
+}
+
+func (DealState) Type() protoreflect.EnumType {
+	return &file_powergate_user_v1_user_proto_enumTypes[2]
+}
+
+func (x DealState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DealState.Descriptor instead.
+func (DealState) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{2}
+}
+
 type JobStatus int32
 
 const (
@@ -67,11 +226,11 @@ func (x JobStatus) String() string {
 }
 
 func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_powergate_user_v1_user_proto_enumTypes[0].Descriptor()
+	return file_powergate_user_v1_user_proto_enumTypes[3].Descriptor()
 }
 
 func (JobStatus) Type() protoreflect.EnumType {
-	return &file_powergate_user_v1_user_proto_enumTypes[0]
+	return &file_powergate_user_v1_user_proto_enumTypes[3]
 }
 
 func (x JobStatus) Number() protoreflect.EnumNumber {
@@ -80,7 +239,215 @@ func (x JobStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobStatus.Descriptor instead.
 func (JobStatus) EnumDescriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{0}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{3}
+}
+
+type LogLevel int32
+
+const (
+	LogLevel_LOG_LEVEL_UNSPECIFIED LogLevel = 0
+	LogLevel_LOG_LEVEL_DEBUG       LogLevel = 1
+	LogLevel_LOG_LEVEL_INFO        LogLevel = 2
+	LogLevel_LOG_LEVEL_WARN        LogLevel = 3
+	LogLevel_LOG_LEVEL_ERROR       LogLevel = 4
+)
+
+// Enum value maps for LogLevel.
+var (
+	LogLevel_name = map[int32]string{
+		0: "LOG_LEVEL_UNSPECIFIED",
+		1: "LOG_LEVEL_DEBUG",
+		2: "LOG_LEVEL_INFO",
+		3: "LOG_LEVEL_WARN",
+		4: "LOG_LEVEL_ERROR",
+	}
+	LogLevel_value = map[string]int32{
+		"LOG_LEVEL_UNSPECIFIED": 0,
+		"LOG_LEVEL_DEBUG":       1,
+		"LOG_LEVEL_INFO":        2,
+		"LOG_LEVEL_WARN":        3,
+		"LOG_LEVEL_ERROR":       4,
+	}
+)
+
+func (x LogLevel) Enum() *LogLevel {
+	p := new(LogLevel)
+	*p = x
+	return p
+}
+
+func (x LogLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_user_v1_user_proto_enumTypes[4].Descriptor()
+}
+
+func (LogLevel) Type() protoreflect.EnumType {
+	return &file_powergate_user_v1_user_proto_enumTypes[4]
+}
+
+func (x LogLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogLevel.Descriptor instead.
+func (LogLevel) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+type DealRecordsKind int32
+
+const (
+	DealRecordsKind_DEAL_RECORDS_KIND_UNSPECIFIED DealRecordsKind = 0
+	DealRecordsKind_DEAL_RECORDS_KIND_STORAGE     DealRecordsKind = 1
+	DealRecordsKind_DEAL_RECORDS_KIND_RETRIEVAL   DealRecordsKind = 2
+)
+
+// Enum value maps for DealRecordsKind.
+var (
+	DealRecordsKind_name = map[int32]string{
+		0: "DEAL_RECORDS_KIND_UNSPECIFIED",
+		1: "DEAL_RECORDS_KIND_STORAGE",
+		2: "DEAL_RECORDS_KIND_RETRIEVAL",
+	}
+	DealRecordsKind_value = map[string]int32{
+		"DEAL_RECORDS_KIND_UNSPECIFIED": 0,
+		"DEAL_RECORDS_KIND_STORAGE":     1,
+		"DEAL_RECORDS_KIND_RETRIEVAL":   2,
+	}
+)
+
+func (x DealRecordsKind) Enum() *DealRecordsKind {
+	p := new(DealRecordsKind)
+	*p = x
+	return p
+}
+
+func (x DealRecordsKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DealRecordsKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_user_v1_user_proto_enumTypes[5].Descriptor()
+}
+
+func (DealRecordsKind) Type() protoreflect.EnumType {
+	return &file_powergate_user_v1_user_proto_enumTypes[5]
+}
+
+func (x DealRecordsKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DealRecordsKind.Descriptor instead.
+func (DealRecordsKind) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_PARQUET     ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_PARQUET":     2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_user_v1_user_proto_enumTypes[6].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_powergate_user_v1_user_proto_enumTypes[6]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+type RetrievalDealStatus int32
+
+const (
+	RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_UNSPECIFIED RetrievalDealStatus = 0
+	RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_SUCCEEDED   RetrievalDealStatus = 1
+	RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_IN_PROGRESS RetrievalDealStatus = 2
+	RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_FAILED      RetrievalDealStatus = 3
+	RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_CANCELED    RetrievalDealStatus = 4
+)
+
+// Enum value maps for RetrievalDealStatus.
+var (
+	RetrievalDealStatus_name = map[int32]string{
+		0: "RETRIEVAL_DEAL_STATUS_UNSPECIFIED",
+		1: "RETRIEVAL_DEAL_STATUS_SUCCEEDED",
+		2: "RETRIEVAL_DEAL_STATUS_IN_PROGRESS",
+		3: "RETRIEVAL_DEAL_STATUS_FAILED",
+		4: "RETRIEVAL_DEAL_STATUS_CANCELED",
+	}
+	RetrievalDealStatus_value = map[string]int32{
+		"RETRIEVAL_DEAL_STATUS_UNSPECIFIED": 0,
+		"RETRIEVAL_DEAL_STATUS_SUCCEEDED":   1,
+		"RETRIEVAL_DEAL_STATUS_IN_PROGRESS": 2,
+		"RETRIEVAL_DEAL_STATUS_FAILED":      3,
+		"RETRIEVAL_DEAL_STATUS_CANCELED":    4,
+	}
+)
+
+func (x RetrievalDealStatus) Enum() *RetrievalDealStatus {
+	p := new(RetrievalDealStatus)
+	*p = x
+	return p
+}
+
+func (x RetrievalDealStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetrievalDealStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_powergate_user_v1_user_proto_enumTypes[7].Descriptor()
+}
+
+func (RetrievalDealStatus) Type() protoreflect.EnumType {
+	return &file_powergate_user_v1_user_proto_enumTypes[7]
+}
+
+func (x RetrievalDealStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetrievalDealStatus.Descriptor instead.
+func (RetrievalDealStatus) EnumDescriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 type BuildInfoRequest struct {
@@ -469,6 +836,8 @@ type StageRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Tar   bool   `protobuf:"varint,2,opt,name=tar,proto3" json:"tar,omitempty"`
+	Car   bool   `protobuf:"varint,3,opt,name=car,proto3" json:"car,omitempty"`
 }
 
 func (x *StageRequest) Reset() {
@@ -510,12 +879,27 @@ func (x *StageRequest) GetChunk() []byte {
 	return nil
 }
 
+func (x *StageRequest) GetTar() bool {
+	if x != nil {
+		return x.Tar
+	}
+	return false
+}
+
+func (x *StageRequest) GetCar() bool {
+	if x != nil {
+		return x.Car
+	}
+	return false
+}
+
 type StageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Cid   string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Roots []string `protobuf:"bytes,2,rep,name=roots,proto3" json:"roots,omitempty"`
 }
 
 func (x *StageResponse) Reset() {
@@ -557,6 +941,13 @@ func (x *StageResponse) GetCid() string {
 	return ""
 }
 
+func (x *StageResponse) GetRoots() []string {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
 type ApplyStorageConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -790,7 +1181,8 @@ type GetRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Cid  string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
 func (x *GetRequest) Reset() {
@@ -832,12 +1224,20 @@ func (x *GetRequest) GetCid() string {
 	return ""
 }
 
+func (x *GetRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type GetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Chunk     []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Directory bool   `protobuf:"varint,2,opt,name=directory,proto3" json:"directory,omitempty"`
 }
 
 func (x *GetResponse) Reset() {
@@ -879,16 +1279,26 @@ func (x *GetResponse) GetChunk() []byte {
 	return nil
 }
 
-type RemoveRequest struct {
+func (x *GetResponse) GetDirectory() bool {
+	if x != nil {
+		return x.Directory
+	}
+	return false
+}
+
+type GetCARRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Cid        string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Selector   string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	FromCold   bool   `protobuf:"varint,3,opt,name=from_cold,json=fromCold,proto3" json:"from_cold,omitempty"`
+	CarVersion uint32 `protobuf:"varint,4,opt,name=car_version,json=carVersion,proto3" json:"car_version,omitempty"`
 }
 
-func (x *RemoveRequest) Reset() {
-	*x = RemoveRequest{}
+func (x *GetCARRequest) Reset() {
+	*x = GetCARRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_user_v1_user_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +1306,13 @@ func (x *RemoveRequest) Reset() {
 	}
 }
 
-func (x *RemoveRequest) String() string {
+func (x *GetCARRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRequest) ProtoMessage() {}
+func (*GetCARRequest) ProtoMessage() {}
 
-func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCARRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_user_v1_user_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,26 +1324,49 @@ func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCARRequest.ProtoReflect.Descriptor instead.
+func (*GetCARRequest) Descriptor() ([]byte, []int) {
 	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RemoveRequest) GetCid() string {
+func (x *GetCARRequest) GetCid() string {
 	if x != nil {
 		return x.Cid
 	}
 	return ""
 }
 
-type RemoveResponse struct {
+func (x *GetCARRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *GetCARRequest) GetFromCold() bool {
+	if x != nil {
+		return x.FromCold
+	}
+	return false
+}
+
+func (x *GetCARRequest) GetCarVersion() uint32 {
+	if x != nil {
+		return x.CarVersion
+	}
+	return 0
+}
+
+type GetCARResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *RemoveResponse) Reset() {
-	*x = RemoveResponse{}
+func (x *GetCARResponse) Reset() {
+	*x = GetCARResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_user_v1_user_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -941,13 +1374,13 @@ func (x *RemoveResponse) Reset() {
 	}
 }
 
-func (x *RemoveResponse) String() string {
+func (x *GetCARResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveResponse) ProtoMessage() {}
+func (*GetCARResponse) ProtoMessage() {}
 
-func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCARResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_powergate_user_v1_user_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,23 +1392,28 @@ func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
-func (*RemoveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCARResponse.ProtoReflect.Descriptor instead.
+func (*GetCARResponse) Descriptor() ([]byte, []int) {
 	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
-type WatchLogsRequest struct {
+func (x *GetCARResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type RemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid     string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	JobId   string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	History bool   `protobuf:"varint,3,opt,name=history,proto3" json:"history,omitempty"`
+	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
 }
 
-func (x *WatchLogsRequest) Reset() {
-	*x = WatchLogsRequest{}
+func (x *RemoveRequest) Reset() {
+	*x = RemoveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_powergate_user_v1_user_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -983,6 +1421,95 @@ func (x *WatchLogsRequest) Reset() {
 	}
 }
 
+func (x *RemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRequest) ProtoMessage() {}
+
+func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+type RemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveResponse) Reset() {
+	*x = RemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveResponse) ProtoMessage() {}
+
+func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+type WatchLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid      string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	JobId    string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	History  bool     `protobuf:"varint,3,opt,name=history,proto3" json:"history,omitempty"`
+	MinLevel LogLevel `protobuf:"varint,4,opt,name=min_level,json=minLevel,proto3,enum=powergate.user.v1.LogLevel" json:"min_level,omitempty"`
+	Text     string   `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WatchLogsRequest) Reset() {
+	*x = WatchLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
 func (x *WatchLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
@@ -990,7 +1517,7 @@ func (x *WatchLogsRequest) String() string {
 func (*WatchLogsRequest) ProtoMessage() {}
 
 func (x *WatchLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[18]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1530,7 @@ func (x *WatchLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchLogsRequest.ProtoReflect.Descriptor instead.
 func (*WatchLogsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{18}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *WatchLogsRequest) GetCid() string {
@@ -1027,6 +1554,20 @@ func (x *WatchLogsRequest) GetHistory() bool {
 	return false
 }
 
+func (x *WatchLogsRequest) GetMinLevel() LogLevel {
+	if x != nil {
+		return x.MinLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *WatchLogsRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 type WatchLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1038,7 +1579,7 @@ type WatchLogsResponse struct {
 func (x *WatchLogsResponse) Reset() {
 	*x = WatchLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[19]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1051,7 +1592,7 @@ func (x *WatchLogsResponse) String() string {
 func (*WatchLogsResponse) ProtoMessage() {}
 
 func (x *WatchLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[19]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1605,7 @@ func (x *WatchLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchLogsResponse.ProtoReflect.Descriptor instead.
 func (*WatchLogsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{19}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WatchLogsResponse) GetLogEntry() *LogEntry {
@@ -1074,6 +1615,148 @@ func (x *WatchLogsResponse) GetLogEntry() *LogEntry {
 	return nil
 }
 
+type LogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid      string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	JobId    string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	MinLevel LogLevel `protobuf:"varint,3,opt,name=min_level,json=minLevel,proto3,enum=powergate.user.v1.LogLevel" json:"min_level,omitempty"`
+	From     int64    `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	To       int64    `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`
+	Text     string   `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	Limit    int64    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *LogsRequest) Reset() {
+	*x = LogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsRequest) ProtoMessage() {}
+
+func (x *LogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsRequest.ProtoReflect.Descriptor instead.
+func (*LogsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LogsRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *LogsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *LogsRequest) GetMinLevel() LogLevel {
+	if x != nil {
+		return x.MinLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *LogsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *LogsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *LogsRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LogsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogEntries []*LogEntry `protobuf:"bytes,1,rep,name=log_entries,json=logEntries,proto3" json:"log_entries,omitempty"`
+}
+
+func (x *LogsResponse) Reset() {
+	*x = LogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsResponse) ProtoMessage() {}
+
+func (x *LogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsResponse.ProtoReflect.Descriptor instead.
+func (*LogsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LogsResponse) GetLogEntries() []*LogEntry {
+	if x != nil {
+		return x.LogEntries
+	}
+	return nil
+}
+
 type CidInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1085,7 +1768,7 @@ type CidInfoRequest struct {
 func (x *CidInfoRequest) Reset() {
 	*x = CidInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[20]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1098,7 +1781,7 @@ func (x *CidInfoRequest) String() string {
 func (*CidInfoRequest) ProtoMessage() {}
 
 func (x *CidInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[20]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1794,7 @@ func (x *CidInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CidInfoRequest.ProtoReflect.Descriptor instead.
 func (*CidInfoRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{20}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CidInfoRequest) GetCids() []string {
@@ -1132,7 +1815,7 @@ type CidInfoResponse struct {
 func (x *CidInfoResponse) Reset() {
 	*x = CidInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[21]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1145,7 +1828,7 @@ func (x *CidInfoResponse) String() string {
 func (*CidInfoResponse) ProtoMessage() {}
 
 func (x *CidInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[21]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1841,7 @@ func (x *CidInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CidInfoResponse.ProtoReflect.Descriptor instead.
 func (*CidInfoResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{21}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CidInfoResponse) GetCidInfos() []*CidInfo {
@@ -1179,7 +1862,7 @@ type BalanceRequest struct {
 func (x *BalanceRequest) Reset() {
 	*x = BalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[22]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1192,7 +1875,7 @@ func (x *BalanceRequest) String() string {
 func (*BalanceRequest) ProtoMessage() {}
 
 func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[22]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,7 +1888,7 @@ func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
 func (*BalanceRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{22}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *BalanceRequest) GetAddress() string {
@@ -1226,7 +1909,7 @@ type BalanceResponse struct {
 func (x *BalanceResponse) Reset() {
 	*x = BalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[23]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1239,7 +1922,7 @@ func (x *BalanceResponse) String() string {
 func (*BalanceResponse) ProtoMessage() {}
 
 func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[23]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1935,7 @@ func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
 func (*BalanceResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{23}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *BalanceResponse) GetBalance() string {
@@ -1275,7 +1958,7 @@ type NewAddressRequest struct {
 func (x *NewAddressRequest) Reset() {
 	*x = NewAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[24]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1288,7 +1971,7 @@ func (x *NewAddressRequest) String() string {
 func (*NewAddressRequest) ProtoMessage() {}
 
 func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[24]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,7 +1984,7 @@ func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAddressRequest.ProtoReflect.Descriptor instead.
 func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{24}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *NewAddressRequest) GetName() string {
@@ -1336,7 +2019,7 @@ type NewAddressResponse struct {
 func (x *NewAddressResponse) Reset() {
 	*x = NewAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[25]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1349,7 +2032,7 @@ func (x *NewAddressResponse) String() string {
 func (*NewAddressResponse) ProtoMessage() {}
 
 func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[25]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +2045,7 @@ func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAddressResponse.ProtoReflect.Descriptor instead.
 func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{25}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *NewAddressResponse) GetAddress() string {
@@ -1381,7 +2064,7 @@ type AddressesRequest struct {
 func (x *AddressesRequest) Reset() {
 	*x = AddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[26]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1394,7 +2077,7 @@ func (x *AddressesRequest) String() string {
 func (*AddressesRequest) ProtoMessage() {}
 
 func (x *AddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[26]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,7 +2090,7 @@ func (x *AddressesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressesRequest.ProtoReflect.Descriptor instead.
 func (*AddressesRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{26}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{30}
 }
 
 type AddressesResponse struct {
@@ -1421,7 +2104,7 @@ type AddressesResponse struct {
 func (x *AddressesResponse) Reset() {
 	*x = AddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[27]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1434,7 +2117,7 @@ func (x *AddressesResponse) String() string {
 func (*AddressesResponse) ProtoMessage() {}
 
 func (x *AddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[27]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,7 +2130,7 @@ func (x *AddressesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressesResponse.ProtoReflect.Descriptor instead.
 func (*AddressesResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{27}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AddressesResponse) GetAddresses() []*AddrInfo {
@@ -1470,7 +2153,7 @@ type SendFilRequest struct {
 func (x *SendFilRequest) Reset() {
 	*x = SendFilRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[28]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1483,7 +2166,7 @@ func (x *SendFilRequest) String() string {
 func (*SendFilRequest) ProtoMessage() {}
 
 func (x *SendFilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[28]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,7 +2179,7 @@ func (x *SendFilRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendFilRequest.ProtoReflect.Descriptor instead.
 func (*SendFilRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{28}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SendFilRequest) GetFrom() string {
@@ -1524,12 +2207,15 @@ type SendFilResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	MessageCid    string `protobuf:"bytes,1,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
+	SendRequestId string `protobuf:"bytes,2,opt,name=send_request_id,json=sendRequestId,proto3" json:"send_request_id,omitempty"`
 }
 
 func (x *SendFilResponse) Reset() {
 	*x = SendFilResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[29]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1542,7 +2228,7 @@ func (x *SendFilResponse) String() string {
 func (*SendFilResponse) ProtoMessage() {}
 
 func (x *SendFilResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[29]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1555,7 +2241,21 @@ func (x *SendFilResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendFilResponse.ProtoReflect.Descriptor instead.
 func (*SendFilResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{29}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SendFilResponse) GetMessageCid() string {
+	if x != nil {
+		return x.MessageCid
+	}
+	return ""
+}
+
+func (x *SendFilResponse) GetSendRequestId() string {
+	if x != nil {
+		return x.SendRequestId
+	}
+	return ""
 }
 
 type SignMessageRequest struct {
@@ -1570,7 +2270,7 @@ type SignMessageRequest struct {
 func (x *SignMessageRequest) Reset() {
 	*x = SignMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[30]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1583,7 +2283,7 @@ func (x *SignMessageRequest) String() string {
 func (*SignMessageRequest) ProtoMessage() {}
 
 func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[30]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,7 +2296,7 @@ func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignMessageRequest.ProtoReflect.Descriptor instead.
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{30}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SignMessageRequest) GetAddress() string {
@@ -1624,7 +2324,7 @@ type SignMessageResponse struct {
 func (x *SignMessageResponse) Reset() {
 	*x = SignMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[31]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1637,7 +2337,7 @@ func (x *SignMessageResponse) String() string {
 func (*SignMessageResponse) ProtoMessage() {}
 
 func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[31]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +2350,7 @@ func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignMessageResponse.ProtoReflect.Descriptor instead.
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{31}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SignMessageResponse) GetSignature() []byte {
@@ -1673,7 +2373,7 @@ type VerifyMessageRequest struct {
 func (x *VerifyMessageRequest) Reset() {
 	*x = VerifyMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[32]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +2386,7 @@ func (x *VerifyMessageRequest) String() string {
 func (*VerifyMessageRequest) ProtoMessage() {}
 
 func (x *VerifyMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[32]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +2399,7 @@ func (x *VerifyMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyMessageRequest.ProtoReflect.Descriptor instead.
 func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{32}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *VerifyMessageRequest) GetAddress() string {
@@ -1734,7 +2434,7 @@ type VerifyMessageResponse struct {
 func (x *VerifyMessageResponse) Reset() {
 	*x = VerifyMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[33]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1747,7 +2447,7 @@ func (x *VerifyMessageResponse) String() string {
 func (*VerifyMessageResponse) ProtoMessage() {}
 
 func (x *VerifyMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[33]
+	mi := &file_powergate_user_v1_user_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,7 +2460,7 @@ func (x *VerifyMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyMessageResponse.ProtoReflect.Descriptor instead.
 func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{33}
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *VerifyMessageResponse) GetOk() bool {
@@ -1770,31 +2470,34 @@ func (x *VerifyMessageResponse) GetOk() bool {
 	return false
 }
 
-type CancelStorageJobRequest struct {
+type TransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Ascending bool   `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Offset    int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CancelStorageJobRequest) Reset() {
-	*x = CancelStorageJobRequest{}
+func (x *TransactionsRequest) Reset() {
+	*x = TransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[34]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelStorageJobRequest) String() string {
+func (x *TransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelStorageJobRequest) ProtoMessage() {}
+func (*TransactionsRequest) ProtoMessage() {}
 
-func (x *CancelStorageJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[34]
+func (x *TransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1805,41 +2508,64 @@ func (x *CancelStorageJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelStorageJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelStorageJobRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use TransactionsRequest.ProtoReflect.Descriptor instead.
+func (*TransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CancelStorageJobRequest) GetJobId() string {
+func (x *TransactionsRequest) GetAddress() string {
 	if x != nil {
-		return x.JobId
+		return x.Address
 	}
 	return ""
 }
 
-type CancelStorageJobResponse struct {
+func (x *TransactionsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *TransactionsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TransactionsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *CancelStorageJobResponse) Reset() {
-	*x = CancelStorageJobResponse{}
+func (x *TransactionsResponse) Reset() {
+	*x = TransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[35]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelStorageJobResponse) String() string {
+func (x *TransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelStorageJobResponse) ProtoMessage() {}
+func (*TransactionsResponse) ProtoMessage() {}
 
-func (x *CancelStorageJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[35]
+func (x *TransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,36 +2576,47 @@ func (x *CancelStorageJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelStorageJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelStorageJobResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use TransactionsResponse.ProtoReflect.Descriptor instead.
+func (*TransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{39}
 }
 
-type StorageJobRequest struct {
+func (x *TransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type NewMultisigAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signers     []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold   uint64   `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Amount      string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MakeDefault bool     `protobuf:"varint,5,opt,name=make_default,json=makeDefault,proto3" json:"make_default,omitempty"`
 }
 
-func (x *StorageJobRequest) Reset() {
-	*x = StorageJobRequest{}
+func (x *NewMultisigAddressRequest) Reset() {
+	*x = NewMultisigAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[36]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobRequest) String() string {
+func (x *NewMultisigAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobRequest) ProtoMessage() {}
+func (*NewMultisigAddressRequest) ProtoMessage() {}
 
-func (x *StorageJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[36]
+func (x *NewMultisigAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,43 +2627,71 @@ func (x *StorageJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobRequest.ProtoReflect.Descriptor instead.
-func (*StorageJobRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use NewMultisigAddressRequest.ProtoReflect.Descriptor instead.
+func (*NewMultisigAddressRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *StorageJobRequest) GetJobId() string {
+func (x *NewMultisigAddressRequest) GetName() string {
 	if x != nil {
-		return x.JobId
+		return x.Name
 	}
 	return ""
 }
 
-type StorageJobResponse struct {
+func (x *NewMultisigAddressRequest) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *NewMultisigAddressRequest) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *NewMultisigAddressRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *NewMultisigAddressRequest) GetMakeDefault() bool {
+	if x != nil {
+		return x.MakeDefault
+	}
+	return false
+}
+
+type NewMultisigAddressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJob *StorageJob `protobuf:"bytes,1,opt,name=storage_job,json=storageJob,proto3" json:"storage_job,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *StorageJobResponse) Reset() {
-	*x = StorageJobResponse{}
+func (x *NewMultisigAddressResponse) Reset() {
+	*x = NewMultisigAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[37]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobResponse) String() string {
+func (x *NewMultisigAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobResponse) ProtoMessage() {}
+func (*NewMultisigAddressResponse) ProtoMessage() {}
 
-func (x *StorageJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[37]
+func (x *NewMultisigAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,43 +2702,43 @@ func (x *StorageJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobResponse.ProtoReflect.Descriptor instead.
-func (*StorageJobResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use NewMultisigAddressResponse.ProtoReflect.Descriptor instead.
+func (*NewMultisigAddressResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *StorageJobResponse) GetStorageJob() *StorageJob {
+func (x *NewMultisigAddressResponse) GetAddress() string {
 	if x != nil {
-		return x.StorageJob
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type StorageConfigForJobRequest struct {
+type MultisigPendingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *StorageConfigForJobRequest) Reset() {
-	*x = StorageConfigForJobRequest{}
+func (x *MultisigPendingRequest) Reset() {
+	*x = MultisigPendingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[38]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageConfigForJobRequest) String() string {
+func (x *MultisigPendingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageConfigForJobRequest) ProtoMessage() {}
+func (*MultisigPendingRequest) ProtoMessage() {}
 
-func (x *StorageConfigForJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[38]
+func (x *MultisigPendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1984,43 +2749,43 @@ func (x *StorageConfigForJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageConfigForJobRequest.ProtoReflect.Descriptor instead.
-func (*StorageConfigForJobRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use MultisigPendingRequest.ProtoReflect.Descriptor instead.
+func (*MultisigPendingRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *StorageConfigForJobRequest) GetJobId() string {
+func (x *MultisigPendingRequest) GetAddress() string {
 	if x != nil {
-		return x.JobId
+		return x.Address
 	}
 	return ""
 }
 
-type StorageConfigForJobResponse struct {
+type MultisigPendingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageConfig *StorageConfig `protobuf:"bytes,1,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
+	Transactions []*MultisigTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *StorageConfigForJobResponse) Reset() {
-	*x = StorageConfigForJobResponse{}
+func (x *MultisigPendingResponse) Reset() {
+	*x = MultisigPendingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[39]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageConfigForJobResponse) String() string {
+func (x *MultisigPendingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageConfigForJobResponse) ProtoMessage() {}
+func (*MultisigPendingResponse) ProtoMessage() {}
 
-func (x *StorageConfigForJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[39]
+func (x *MultisigPendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,43 +2796,45 @@ func (x *StorageConfigForJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageConfigForJobResponse.ProtoReflect.Descriptor instead.
-func (*StorageConfigForJobResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use MultisigPendingResponse.ProtoReflect.Descriptor instead.
+func (*MultisigPendingResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *StorageConfigForJobResponse) GetStorageConfig() *StorageConfig {
+func (x *MultisigPendingResponse) GetTransactions() []*MultisigTransaction {
 	if x != nil {
-		return x.StorageConfig
+		return x.Transactions
 	}
 	return nil
 }
 
-type QueuedStorageJobsRequest struct {
+type MultisigProposeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	To      string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *QueuedStorageJobsRequest) Reset() {
-	*x = QueuedStorageJobsRequest{}
+func (x *MultisigProposeRequest) Reset() {
+	*x = MultisigProposeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[40]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueuedStorageJobsRequest) String() string {
+func (x *MultisigProposeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueuedStorageJobsRequest) ProtoMessage() {}
+func (*MultisigProposeRequest) ProtoMessage() {}
 
-func (x *QueuedStorageJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[40]
+func (x *MultisigProposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,43 +2845,57 @@ func (x *QueuedStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueuedStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*QueuedStorageJobsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use MultisigProposeRequest.ProtoReflect.Descriptor instead.
+func (*MultisigProposeRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *QueuedStorageJobsRequest) GetCids() []string {
+func (x *MultisigProposeRequest) GetAddress() string {
 	if x != nil {
-		return x.Cids
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type QueuedStorageJobsResponse struct {
+func (x *MultisigProposeRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *MultisigProposeRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MultisigProposeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+	MessageCid string `protobuf:"bytes,1,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
 }
 
-func (x *QueuedStorageJobsResponse) Reset() {
-	*x = QueuedStorageJobsResponse{}
+func (x *MultisigProposeResponse) Reset() {
+	*x = MultisigProposeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[41]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueuedStorageJobsResponse) String() string {
+func (x *MultisigProposeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueuedStorageJobsResponse) ProtoMessage() {}
+func (*MultisigProposeResponse) ProtoMessage() {}
 
-func (x *QueuedStorageJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[41]
+func (x *MultisigProposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2125,43 +2906,44 @@ func (x *QueuedStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueuedStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*QueuedStorageJobsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use MultisigProposeResponse.ProtoReflect.Descriptor instead.
+func (*MultisigProposeResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *QueuedStorageJobsResponse) GetStorageJobs() []*StorageJob {
+func (x *MultisigProposeResponse) GetMessageCid() string {
 	if x != nil {
-		return x.StorageJobs
+		return x.MessageCid
 	}
-	return nil
+	return ""
 }
 
-type ExecutingStorageJobsRequest struct {
+type MultisigProposeEscrowTopUpRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *ExecutingStorageJobsRequest) Reset() {
-	*x = ExecutingStorageJobsRequest{}
+func (x *MultisigProposeEscrowTopUpRequest) Reset() {
+	*x = MultisigProposeEscrowTopUpRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[42]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecutingStorageJobsRequest) String() string {
+func (x *MultisigProposeEscrowTopUpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutingStorageJobsRequest) ProtoMessage() {}
+func (*MultisigProposeEscrowTopUpRequest) ProtoMessage() {}
 
-func (x *ExecutingStorageJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[42]
+func (x *MultisigProposeEscrowTopUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,43 +2954,50 @@ func (x *ExecutingStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutingStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*ExecutingStorageJobsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use MultisigProposeEscrowTopUpRequest.ProtoReflect.Descriptor instead.
+func (*MultisigProposeEscrowTopUpRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ExecutingStorageJobsRequest) GetCids() []string {
+func (x *MultisigProposeEscrowTopUpRequest) GetAddress() string {
 	if x != nil {
-		return x.Cids
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type ExecutingStorageJobsResponse struct {
+func (x *MultisigProposeEscrowTopUpRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MultisigProposeEscrowTopUpResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+	MessageCid string `protobuf:"bytes,1,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
 }
 
-func (x *ExecutingStorageJobsResponse) Reset() {
-	*x = ExecutingStorageJobsResponse{}
+func (x *MultisigProposeEscrowTopUpResponse) Reset() {
+	*x = MultisigProposeEscrowTopUpResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[43]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecutingStorageJobsResponse) String() string {
+func (x *MultisigProposeEscrowTopUpResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutingStorageJobsResponse) ProtoMessage() {}
+func (*MultisigProposeEscrowTopUpResponse) ProtoMessage() {}
 
-func (x *ExecutingStorageJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[43]
+func (x *MultisigProposeEscrowTopUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2219,43 +3008,45 @@ func (x *ExecutingStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutingStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*ExecutingStorageJobsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use MultisigProposeEscrowTopUpResponse.ProtoReflect.Descriptor instead.
+func (*MultisigProposeEscrowTopUpResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ExecutingStorageJobsResponse) GetStorageJobs() []*StorageJob {
+func (x *MultisigProposeEscrowTopUpResponse) GetMessageCid() string {
 	if x != nil {
-		return x.StorageJobs
+		return x.MessageCid
 	}
-	return nil
+	return ""
 }
 
-type LatestFinalStorageJobsRequest struct {
+type MultisigApproveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Approver      string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	TransactionId int64  `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func (x *LatestFinalStorageJobsRequest) Reset() {
-	*x = LatestFinalStorageJobsRequest{}
+func (x *MultisigApproveRequest) Reset() {
+	*x = MultisigApproveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[44]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LatestFinalStorageJobsRequest) String() string {
+func (x *MultisigApproveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestFinalStorageJobsRequest) ProtoMessage() {}
+func (*MultisigApproveRequest) ProtoMessage() {}
 
-func (x *LatestFinalStorageJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[44]
+func (x *MultisigApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,43 +3057,57 @@ func (x *LatestFinalStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestFinalStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*LatestFinalStorageJobsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use MultisigApproveRequest.ProtoReflect.Descriptor instead.
+func (*MultisigApproveRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *LatestFinalStorageJobsRequest) GetCids() []string {
+func (x *MultisigApproveRequest) GetAddress() string {
 	if x != nil {
-		return x.Cids
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type LatestFinalStorageJobsResponse struct {
+func (x *MultisigApproveRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *MultisigApproveRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type MultisigApproveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+	MessageCid string `protobuf:"bytes,1,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
 }
 
-func (x *LatestFinalStorageJobsResponse) Reset() {
-	*x = LatestFinalStorageJobsResponse{}
+func (x *MultisigApproveResponse) Reset() {
+	*x = MultisigApproveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[45]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LatestFinalStorageJobsResponse) String() string {
+func (x *MultisigApproveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestFinalStorageJobsResponse) ProtoMessage() {}
+func (*MultisigApproveResponse) ProtoMessage() {}
 
-func (x *LatestFinalStorageJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[45]
+func (x *MultisigApproveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2313,43 +3118,43 @@ func (x *LatestFinalStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestFinalStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*LatestFinalStorageJobsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use MultisigApproveResponse.ProtoReflect.Descriptor instead.
+func (*MultisigApproveResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *LatestFinalStorageJobsResponse) GetStorageJobs() []*StorageJob {
+func (x *MultisigApproveResponse) GetMessageCid() string {
 	if x != nil {
-		return x.StorageJobs
+		return x.MessageCid
 	}
-	return nil
+	return ""
 }
 
-type LatestSuccessfulStorageJobsRequest struct {
+type CancelStorageJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *LatestSuccessfulStorageJobsRequest) Reset() {
-	*x = LatestSuccessfulStorageJobsRequest{}
+func (x *CancelStorageJobRequest) Reset() {
+	*x = CancelStorageJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[46]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LatestSuccessfulStorageJobsRequest) String() string {
+func (x *CancelStorageJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestSuccessfulStorageJobsRequest) ProtoMessage() {}
+func (*CancelStorageJobRequest) ProtoMessage() {}
 
-func (x *LatestSuccessfulStorageJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[46]
+func (x *CancelStorageJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2360,43 +3165,41 @@ func (x *LatestSuccessfulStorageJobsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestSuccessfulStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*LatestSuccessfulStorageJobsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use CancelStorageJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelStorageJobRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *LatestSuccessfulStorageJobsRequest) GetCids() []string {
+func (x *CancelStorageJobRequest) GetJobId() string {
 	if x != nil {
-		return x.Cids
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type LatestSuccessfulStorageJobsResponse struct {
+type CancelStorageJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
 }
 
-func (x *LatestSuccessfulStorageJobsResponse) Reset() {
-	*x = LatestSuccessfulStorageJobsResponse{}
+func (x *CancelStorageJobResponse) Reset() {
+	*x = CancelStorageJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[47]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LatestSuccessfulStorageJobsResponse) String() string {
+func (x *CancelStorageJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestSuccessfulStorageJobsResponse) ProtoMessage() {}
+func (*CancelStorageJobResponse) ProtoMessage() {}
 
-func (x *LatestSuccessfulStorageJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[47]
+func (x *CancelStorageJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2407,43 +3210,36 @@ func (x *LatestSuccessfulStorageJobsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestSuccessfulStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*LatestSuccessfulStorageJobsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *LatestSuccessfulStorageJobsResponse) GetStorageJobs() []*StorageJob {
-	if x != nil {
-		return x.StorageJobs
-	}
-	return nil
+// Deprecated: Use CancelStorageJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelStorageJobResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{51}
 }
 
-type StorageJobsSummaryRequest struct {
+type StorageJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *StorageJobsSummaryRequest) Reset() {
-	*x = StorageJobsSummaryRequest{}
+func (x *StorageJobRequest) Reset() {
+	*x = StorageJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[48]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobsSummaryRequest) String() string {
+func (x *StorageJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobsSummaryRequest) ProtoMessage() {}
+func (*StorageJobRequest) ProtoMessage() {}
 
-func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[48]
+func (x *StorageJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,47 +3250,43 @@ func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*StorageJobsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use StorageJobRequest.ProtoReflect.Descriptor instead.
+func (*StorageJobRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *StorageJobsSummaryRequest) GetCids() []string {
+func (x *StorageJobRequest) GetJobId() string {
 	if x != nil {
-		return x.Cids
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type StorageJobsSummaryResponse struct {
+type StorageJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobCounts                   *JobCounts    `protobuf:"bytes,1,opt,name=job_counts,json=jobCounts,proto3" json:"job_counts,omitempty"`
-	QueuedStorageJobs           []*StorageJob `protobuf:"bytes,2,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
-	ExecutingStorageJobs        []*StorageJob `protobuf:"bytes,3,rep,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
-	LatestFinalStorageJobs      []*StorageJob `protobuf:"bytes,4,rep,name=latest_final_storage_jobs,json=latestFinalStorageJobs,proto3" json:"latest_final_storage_jobs,omitempty"`
-	LatestSuccessfulStorageJobs []*StorageJob `protobuf:"bytes,5,rep,name=latest_successful_storage_jobs,json=latestSuccessfulStorageJobs,proto3" json:"latest_successful_storage_jobs,omitempty"`
+	StorageJob *StorageJob `protobuf:"bytes,1,opt,name=storage_job,json=storageJob,proto3" json:"storage_job,omitempty"`
 }
 
-func (x *StorageJobsSummaryResponse) Reset() {
-	*x = StorageJobsSummaryResponse{}
+func (x *StorageJobResponse) Reset() {
+	*x = StorageJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[49]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobsSummaryResponse) String() string {
+func (x *StorageJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobsSummaryResponse) ProtoMessage() {}
+func (*StorageJobResponse) ProtoMessage() {}
 
-func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[49]
+func (x *StorageJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2505,71 +3297,43 @@ func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*StorageJobsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *StorageJobsSummaryResponse) GetJobCounts() *JobCounts {
-	if x != nil {
-		return x.JobCounts
-	}
-	return nil
-}
-
-func (x *StorageJobsSummaryResponse) GetQueuedStorageJobs() []*StorageJob {
-	if x != nil {
-		return x.QueuedStorageJobs
-	}
-	return nil
-}
-
-func (x *StorageJobsSummaryResponse) GetExecutingStorageJobs() []*StorageJob {
-	if x != nil {
-		return x.ExecutingStorageJobs
-	}
-	return nil
-}
-
-func (x *StorageJobsSummaryResponse) GetLatestFinalStorageJobs() []*StorageJob {
-	if x != nil {
-		return x.LatestFinalStorageJobs
-	}
-	return nil
+// Deprecated: Use StorageJobResponse.ProtoReflect.Descriptor instead.
+func (*StorageJobResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *StorageJobsSummaryResponse) GetLatestSuccessfulStorageJobs() []*StorageJob {
+func (x *StorageJobResponse) GetStorageJob() *StorageJob {
 	if x != nil {
-		return x.LatestSuccessfulStorageJobs
+		return x.StorageJob
 	}
 	return nil
 }
 
-type WatchStorageJobsRequest struct {
+type StorageConfigForJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobIds []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *WatchStorageJobsRequest) Reset() {
-	*x = WatchStorageJobsRequest{}
+func (x *StorageConfigForJobRequest) Reset() {
+	*x = StorageConfigForJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[50]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WatchStorageJobsRequest) String() string {
+func (x *StorageConfigForJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchStorageJobsRequest) ProtoMessage() {}
+func (*StorageConfigForJobRequest) ProtoMessage() {}
 
-func (x *WatchStorageJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[50]
+func (x *StorageConfigForJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2580,43 +3344,43 @@ func (x *WatchStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*WatchStorageJobsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use StorageConfigForJobRequest.ProtoReflect.Descriptor instead.
+func (*StorageConfigForJobRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *WatchStorageJobsRequest) GetJobIds() []string {
+func (x *StorageConfigForJobRequest) GetJobId() string {
 	if x != nil {
-		return x.JobIds
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type WatchStorageJobsResponse struct {
+type StorageConfigForJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJob *StorageJob `protobuf:"bytes,1,opt,name=storage_job,json=storageJob,proto3" json:"storage_job,omitempty"`
+	StorageConfig *StorageConfig `protobuf:"bytes,1,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
 }
 
-func (x *WatchStorageJobsResponse) Reset() {
-	*x = WatchStorageJobsResponse{}
+func (x *StorageConfigForJobResponse) Reset() {
+	*x = StorageConfigForJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[51]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WatchStorageJobsResponse) String() string {
+func (x *StorageConfigForJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchStorageJobsResponse) ProtoMessage() {}
+func (*StorageConfigForJobResponse) ProtoMessage() {}
 
-func (x *WatchStorageJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[51]
+func (x *StorageConfigForJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2627,43 +3391,43 @@ func (x *WatchStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*WatchStorageJobsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use StorageConfigForJobResponse.ProtoReflect.Descriptor instead.
+func (*StorageConfigForJobResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *WatchStorageJobsResponse) GetStorageJob() *StorageJob {
+func (x *StorageConfigForJobResponse) GetStorageConfig() *StorageConfig {
 	if x != nil {
-		return x.StorageJob
+		return x.StorageConfig
 	}
 	return nil
 }
 
-type StorageDealRecordsRequest struct {
+type QueuedStorageJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *DealRecordsConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
 }
 
-func (x *StorageDealRecordsRequest) Reset() {
-	*x = StorageDealRecordsRequest{}
+func (x *QueuedStorageJobsRequest) Reset() {
+	*x = QueuedStorageJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[52]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageDealRecordsRequest) String() string {
+func (x *QueuedStorageJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageDealRecordsRequest) ProtoMessage() {}
+func (*QueuedStorageJobsRequest) ProtoMessage() {}
 
-func (x *StorageDealRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[52]
+func (x *QueuedStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,43 +3438,43 @@ func (x *StorageDealRecordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageDealRecordsRequest.ProtoReflect.Descriptor instead.
-func (*StorageDealRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use QueuedStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*QueuedStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *StorageDealRecordsRequest) GetConfig() *DealRecordsConfig {
+func (x *QueuedStorageJobsRequest) GetCids() []string {
 	if x != nil {
-		return x.Config
+		return x.Cids
 	}
 	return nil
 }
 
-type StorageDealRecordsResponse struct {
+type QueuedStorageJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Records []*StorageDealRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
 }
 
-func (x *StorageDealRecordsResponse) Reset() {
-	*x = StorageDealRecordsResponse{}
+func (x *QueuedStorageJobsResponse) Reset() {
+	*x = QueuedStorageJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[53]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageDealRecordsResponse) String() string {
+func (x *QueuedStorageJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageDealRecordsResponse) ProtoMessage() {}
+func (*QueuedStorageJobsResponse) ProtoMessage() {}
 
-func (x *StorageDealRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[53]
+func (x *QueuedStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2721,43 +3485,43 @@ func (x *StorageDealRecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageDealRecordsResponse.ProtoReflect.Descriptor instead.
-func (*StorageDealRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use QueuedStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*QueuedStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *StorageDealRecordsResponse) GetRecords() []*StorageDealRecord {
+func (x *QueuedStorageJobsResponse) GetStorageJobs() []*StorageJob {
 	if x != nil {
-		return x.Records
+		return x.StorageJobs
 	}
 	return nil
 }
 
-type RetrievalDealRecordsRequest struct {
+type ExecutingStorageJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *DealRecordsConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
 }
 
-func (x *RetrievalDealRecordsRequest) Reset() {
-	*x = RetrievalDealRecordsRequest{}
+func (x *ExecutingStorageJobsRequest) Reset() {
+	*x = ExecutingStorageJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[54]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RetrievalDealRecordsRequest) String() string {
+func (x *ExecutingStorageJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrievalDealRecordsRequest) ProtoMessage() {}
+func (*ExecutingStorageJobsRequest) ProtoMessage() {}
 
-func (x *RetrievalDealRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[54]
+func (x *ExecutingStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2768,43 +3532,43 @@ func (x *RetrievalDealRecordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrievalDealRecordsRequest.ProtoReflect.Descriptor instead.
-func (*RetrievalDealRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ExecutingStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*ExecutingStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *RetrievalDealRecordsRequest) GetConfig() *DealRecordsConfig {
+func (x *ExecutingStorageJobsRequest) GetCids() []string {
 	if x != nil {
-		return x.Config
+		return x.Cids
 	}
 	return nil
 }
 
-type RetrievalDealRecordsResponse struct {
+type ExecutingStorageJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Records []*RetrievalDealRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
 }
 
-func (x *RetrievalDealRecordsResponse) Reset() {
-	*x = RetrievalDealRecordsResponse{}
+func (x *ExecutingStorageJobsResponse) Reset() {
+	*x = ExecutingStorageJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[55]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RetrievalDealRecordsResponse) String() string {
+func (x *ExecutingStorageJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrievalDealRecordsResponse) ProtoMessage() {}
+func (*ExecutingStorageJobsResponse) ProtoMessage() {}
 
-func (x *RetrievalDealRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[55]
+func (x *ExecutingStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2815,46 +3579,43 @@ func (x *RetrievalDealRecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrievalDealRecordsResponse.ProtoReflect.Descriptor instead.
-func (*RetrievalDealRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ExecutingStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*ExecutingStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RetrievalDealRecordsResponse) GetRecords() []*RetrievalDealRecord {
+func (x *ExecutingStorageJobsResponse) GetStorageJobs() []*StorageJob {
 	if x != nil {
-		return x.Records
+		return x.StorageJobs
 	}
 	return nil
 }
 
-type JobCounts struct {
+type LatestFinalStorageJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queued           int32 `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
-	Executing        int32 `protobuf:"varint,2,opt,name=executing,proto3" json:"executing,omitempty"`
-	LatestFinal      int32 `protobuf:"varint,3,opt,name=latest_final,json=latestFinal,proto3" json:"latest_final,omitempty"`
-	LatestSuccessful int32 `protobuf:"varint,4,opt,name=latest_successful,json=latestSuccessful,proto3" json:"latest_successful,omitempty"`
+	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
 }
 
-func (x *JobCounts) Reset() {
-	*x = JobCounts{}
+func (x *LatestFinalStorageJobsRequest) Reset() {
+	*x = LatestFinalStorageJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[56]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobCounts) String() string {
+func (x *LatestFinalStorageJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobCounts) ProtoMessage() {}
+func (*LatestFinalStorageJobsRequest) ProtoMessage() {}
 
-func (x *JobCounts) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[56]
+func (x *LatestFinalStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2865,67 +3626,90 @@ func (x *JobCounts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobCounts.ProtoReflect.Descriptor instead.
-func (*JobCounts) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use LatestFinalStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*LatestFinalStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *JobCounts) GetQueued() int32 {
+func (x *LatestFinalStorageJobsRequest) GetCids() []string {
 	if x != nil {
-		return x.Queued
+		return x.Cids
 	}
-	return 0
+	return nil
 }
 
-func (x *JobCounts) GetExecuting() int32 {
-	if x != nil {
-		return x.Executing
+type LatestFinalStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+}
+
+func (x *LatestFinalStorageJobsResponse) Reset() {
+	*x = LatestFinalStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *JobCounts) GetLatestFinal() int32 {
-	if x != nil {
-		return x.LatestFinal
+func (x *LatestFinalStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestFinalStorageJobsResponse) ProtoMessage() {}
+
+func (x *LatestFinalStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *JobCounts) GetLatestSuccessful() int32 {
+// Deprecated: Use LatestFinalStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*LatestFinalStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LatestFinalStorageJobsResponse) GetStorageJobs() []*StorageJob {
 	if x != nil {
-		return x.LatestSuccessful
+		return x.StorageJobs
 	}
-	return 0
+	return nil
 }
 
-type AddrInfo struct {
+type LatestSuccessfulStorageJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Balance string `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
 }
 
-func (x *AddrInfo) Reset() {
-	*x = AddrInfo{}
+func (x *LatestSuccessfulStorageJobsRequest) Reset() {
+	*x = LatestSuccessfulStorageJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[57]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrInfo) String() string {
+func (x *LatestSuccessfulStorageJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrInfo) ProtoMessage() {}
+func (*LatestSuccessfulStorageJobsRequest) ProtoMessage() {}
 
-func (x *AddrInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[57]
+func (x *LatestSuccessfulStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2936,64 +3720,90 @@ func (x *AddrInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrInfo.ProtoReflect.Descriptor instead.
-func (*AddrInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use LatestSuccessfulStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*LatestSuccessfulStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *AddrInfo) GetName() string {
+func (x *LatestSuccessfulStorageJobsRequest) GetCids() []string {
 	if x != nil {
-		return x.Name
+		return x.Cids
 	}
-	return ""
+	return nil
 }
 
-func (x *AddrInfo) GetAddress() string {
-	if x != nil {
-		return x.Address
+type LatestSuccessfulStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageJobs []*StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+}
+
+func (x *LatestSuccessfulStorageJobsResponse) Reset() {
+	*x = LatestSuccessfulStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AddrInfo) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *LatestSuccessfulStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestSuccessfulStorageJobsResponse) ProtoMessage() {}
+
+func (x *LatestSuccessfulStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AddrInfo) GetBalance() string {
+// Deprecated: Use LatestSuccessfulStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*LatestSuccessfulStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LatestSuccessfulStorageJobsResponse) GetStorageJobs() []*StorageJob {
 	if x != nil {
-		return x.Balance
+		return x.StorageJobs
 	}
-	return ""
+	return nil
 }
 
-type IpfsConfig struct {
+type StorageJobsSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AddTimeout int64 `protobuf:"varint,1,opt,name=add_timeout,json=addTimeout,proto3" json:"add_timeout,omitempty"`
+	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
 }
 
-func (x *IpfsConfig) Reset() {
-	*x = IpfsConfig{}
+func (x *StorageJobsSummaryRequest) Reset() {
+	*x = StorageJobsSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[58]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IpfsConfig) String() string {
+func (x *StorageJobsSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IpfsConfig) ProtoMessage() {}
+func (*StorageJobsSummaryRequest) ProtoMessage() {}
 
-func (x *IpfsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[58]
+func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3004,46 +3814,47 @@ func (x *IpfsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IpfsConfig.ProtoReflect.Descriptor instead.
-func (*IpfsConfig) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use StorageJobsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*StorageJobsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *IpfsConfig) GetAddTimeout() int64 {
+func (x *StorageJobsSummaryRequest) GetCids() []string {
 	if x != nil {
-		return x.AddTimeout
+		return x.Cids
 	}
-	return 0
+	return nil
 }
 
-type HotConfig struct {
+type StorageJobsSummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled          bool        `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	AllowUnfreeze    bool        `protobuf:"varint,2,opt,name=allow_unfreeze,json=allowUnfreeze,proto3" json:"allow_unfreeze,omitempty"`
-	UnfreezeMaxPrice uint64      `protobuf:"varint,3,opt,name=unfreeze_max_price,json=unfreezeMaxPrice,proto3" json:"unfreeze_max_price,omitempty"`
-	Ipfs             *IpfsConfig `protobuf:"bytes,4,opt,name=ipfs,proto3" json:"ipfs,omitempty"`
+	JobCounts                   *JobCounts    `protobuf:"bytes,1,opt,name=job_counts,json=jobCounts,proto3" json:"job_counts,omitempty"`
+	QueuedStorageJobs           []*StorageJob `protobuf:"bytes,2,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
+	ExecutingStorageJobs        []*StorageJob `protobuf:"bytes,3,rep,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
+	LatestFinalStorageJobs      []*StorageJob `protobuf:"bytes,4,rep,name=latest_final_storage_jobs,json=latestFinalStorageJobs,proto3" json:"latest_final_storage_jobs,omitempty"`
+	LatestSuccessfulStorageJobs []*StorageJob `protobuf:"bytes,5,rep,name=latest_successful_storage_jobs,json=latestSuccessfulStorageJobs,proto3" json:"latest_successful_storage_jobs,omitempty"`
 }
 
-func (x *HotConfig) Reset() {
-	*x = HotConfig{}
+func (x *StorageJobsSummaryResponse) Reset() {
+	*x = StorageJobsSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[59]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HotConfig) String() string {
+func (x *StorageJobsSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HotConfig) ProtoMessage() {}
+func (*StorageJobsSummaryResponse) ProtoMessage() {}
 
-func (x *HotConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[59]
+func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3054,65 +3865,71 @@ func (x *HotConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HotConfig.ProtoReflect.Descriptor instead.
-func (*HotConfig) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use StorageJobsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*StorageJobsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *HotConfig) GetEnabled() bool {
+func (x *StorageJobsSummaryResponse) GetJobCounts() *JobCounts {
 	if x != nil {
-		return x.Enabled
+		return x.JobCounts
 	}
-	return false
+	return nil
 }
 
-func (x *HotConfig) GetAllowUnfreeze() bool {
+func (x *StorageJobsSummaryResponse) GetQueuedStorageJobs() []*StorageJob {
 	if x != nil {
-		return x.AllowUnfreeze
+		return x.QueuedStorageJobs
 	}
-	return false
+	return nil
 }
 
-func (x *HotConfig) GetUnfreezeMaxPrice() uint64 {
+func (x *StorageJobsSummaryResponse) GetExecutingStorageJobs() []*StorageJob {
 	if x != nil {
-		return x.UnfreezeMaxPrice
+		return x.ExecutingStorageJobs
 	}
-	return 0
+	return nil
 }
 
-func (x *HotConfig) GetIpfs() *IpfsConfig {
+func (x *StorageJobsSummaryResponse) GetLatestFinalStorageJobs() []*StorageJob {
 	if x != nil {
-		return x.Ipfs
+		return x.LatestFinalStorageJobs
 	}
 	return nil
 }
 
-type FilRenew struct {
+func (x *StorageJobsSummaryResponse) GetLatestSuccessfulStorageJobs() []*StorageJob {
+	if x != nil {
+		return x.LatestSuccessfulStorageJobs
+	}
+	return nil
+}
+
+type WatchStorageJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled   bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Threshold int64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	JobIds []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
 }
 
-func (x *FilRenew) Reset() {
-	*x = FilRenew{}
+func (x *WatchStorageJobsRequest) Reset() {
+	*x = WatchStorageJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[60]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilRenew) String() string {
+func (x *WatchStorageJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilRenew) ProtoMessage() {}
+func (*WatchStorageJobsRequest) ProtoMessage() {}
 
-func (x *FilRenew) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[60]
+func (x *WatchStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,59 +3940,43 @@ func (x *FilRenew) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilRenew.ProtoReflect.Descriptor instead.
-func (*FilRenew) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *FilRenew) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+// Deprecated: Use WatchStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*WatchStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *FilRenew) GetThreshold() int64 {
+func (x *WatchStorageJobsRequest) GetJobIds() []string {
 	if x != nil {
-		return x.Threshold
+		return x.JobIds
 	}
-	return 0
+	return nil
 }
 
-type FilConfig struct {
+type WatchStorageJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicationFactor int64     `protobuf:"varint,1,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
-	DealMinDuration   int64     `protobuf:"varint,2,opt,name=deal_min_duration,json=dealMinDuration,proto3" json:"deal_min_duration,omitempty"`
-	ExcludedMiners    []string  `protobuf:"bytes,3,rep,name=excluded_miners,json=excludedMiners,proto3" json:"excluded_miners,omitempty"`
-	TrustedMiners     []string  `protobuf:"bytes,4,rep,name=trusted_miners,json=trustedMiners,proto3" json:"trusted_miners,omitempty"`
-	CountryCodes      []string  `protobuf:"bytes,5,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
-	Renew             *FilRenew `protobuf:"bytes,6,opt,name=renew,proto3" json:"renew,omitempty"`
-	Address           string    `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	MaxPrice          uint64    `protobuf:"varint,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
-	FastRetrieval     bool      `protobuf:"varint,9,opt,name=fast_retrieval,json=fastRetrieval,proto3" json:"fast_retrieval,omitempty"`
-	DealStartOffset   int64     `protobuf:"varint,10,opt,name=deal_start_offset,json=dealStartOffset,proto3" json:"deal_start_offset,omitempty"`
+	StorageJob *StorageJob `protobuf:"bytes,1,opt,name=storage_job,json=storageJob,proto3" json:"storage_job,omitempty"`
 }
 
-func (x *FilConfig) Reset() {
-	*x = FilConfig{}
+func (x *WatchStorageJobsResponse) Reset() {
+	*x = WatchStorageJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[61]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilConfig) String() string {
+func (x *WatchStorageJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilConfig) ProtoMessage() {}
+func (*WatchStorageJobsResponse) ProtoMessage() {}
 
-func (x *FilConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[61]
+func (x *WatchStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3186,107 +3987,153 @@ func (x *FilConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilConfig.ProtoReflect.Descriptor instead.
-func (*FilConfig) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use WatchStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*WatchStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *FilConfig) GetReplicationFactor() int64 {
+func (x *WatchStorageJobsResponse) GetStorageJob() *StorageJob {
 	if x != nil {
-		return x.ReplicationFactor
+		return x.StorageJob
 	}
-	return 0
+	return nil
 }
 
-func (x *FilConfig) GetDealMinDuration() int64 {
-	if x != nil {
-		return x.DealMinDuration
-	}
-	return 0
-}
+type StorageDealRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *FilConfig) GetExcludedMiners() []string {
-	if x != nil {
-		return x.ExcludedMiners
-	}
-	return nil
+	Config *DealRecordsConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *FilConfig) GetTrustedMiners() []string {
-	if x != nil {
-		return x.TrustedMiners
+func (x *StorageDealRecordsRequest) Reset() {
+	*x = StorageDealRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *FilConfig) GetCountryCodes() []string {
-	if x != nil {
-		return x.CountryCodes
-	}
-	return nil
+func (x *StorageDealRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FilConfig) GetRenew() *FilRenew {
-	if x != nil {
-		return x.Renew
-	}
-	return nil
-}
+func (*StorageDealRecordsRequest) ProtoMessage() {}
 
-func (x *FilConfig) GetAddress() string {
+func (x *StorageDealRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageDealRecordsRequest.ProtoReflect.Descriptor instead.
+func (*StorageDealRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StorageDealRecordsRequest) GetConfig() *DealRecordsConfig {
 	if x != nil {
-		return x.Address
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-func (x *FilConfig) GetMaxPrice() uint64 {
+type StorageDealRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records    []*StorageDealRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextCursor string               `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Summary    *DealRecordsSummary  `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *StorageDealRecordsResponse) Reset() {
+	*x = StorageDealRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageDealRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageDealRecordsResponse) ProtoMessage() {}
+
+func (x *StorageDealRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageDealRecordsResponse.ProtoReflect.Descriptor instead.
+func (*StorageDealRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StorageDealRecordsResponse) GetRecords() []*StorageDealRecord {
 	if x != nil {
-		return x.MaxPrice
+		return x.Records
 	}
-	return 0
+	return nil
 }
 
-func (x *FilConfig) GetFastRetrieval() bool {
+func (x *StorageDealRecordsResponse) GetNextCursor() string {
 	if x != nil {
-		return x.FastRetrieval
+		return x.NextCursor
 	}
-	return false
+	return ""
 }
 
-func (x *FilConfig) GetDealStartOffset() int64 {
+func (x *StorageDealRecordsResponse) GetSummary() *DealRecordsSummary {
 	if x != nil {
-		return x.DealStartOffset
+		return x.Summary
 	}
-	return 0
+	return nil
 }
 
-type ColdConfig struct {
+type RetrievalDealRecordsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled  bool       `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Filecoin *FilConfig `protobuf:"bytes,2,opt,name=filecoin,proto3" json:"filecoin,omitempty"`
+	Config *DealRecordsConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *ColdConfig) Reset() {
-	*x = ColdConfig{}
+func (x *RetrievalDealRecordsRequest) Reset() {
+	*x = RetrievalDealRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[62]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ColdConfig) String() string {
+func (x *RetrievalDealRecordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColdConfig) ProtoMessage() {}
+func (*RetrievalDealRecordsRequest) ProtoMessage() {}
 
-func (x *ColdConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[62]
+func (x *RetrievalDealRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3297,52 +4144,45 @@ func (x *ColdConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColdConfig.ProtoReflect.Descriptor instead.
-func (*ColdConfig) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *ColdConfig) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+// Deprecated: Use RetrievalDealRecordsRequest.ProtoReflect.Descriptor instead.
+func (*RetrievalDealRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ColdConfig) GetFilecoin() *FilConfig {
+func (x *RetrievalDealRecordsRequest) GetConfig() *DealRecordsConfig {
 	if x != nil {
-		return x.Filecoin
+		return x.Config
 	}
 	return nil
 }
 
-type StorageConfig struct {
+type RetrievalDealRecordsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hot        *HotConfig  `protobuf:"bytes,1,opt,name=hot,proto3" json:"hot,omitempty"`
-	Cold       *ColdConfig `protobuf:"bytes,2,opt,name=cold,proto3" json:"cold,omitempty"`
-	Repairable bool        `protobuf:"varint,3,opt,name=repairable,proto3" json:"repairable,omitempty"`
+	Records    []*RetrievalDealRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextCursor string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Summary    *DealRecordsSummary    `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
 }
 
-func (x *StorageConfig) Reset() {
-	*x = StorageConfig{}
+func (x *RetrievalDealRecordsResponse) Reset() {
+	*x = RetrievalDealRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[63]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageConfig) String() string {
+func (x *RetrievalDealRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageConfig) ProtoMessage() {}
+func (*RetrievalDealRecordsResponse) ProtoMessage() {}
 
-func (x *StorageConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[63]
+func (x *RetrievalDealRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3353,57 +4193,57 @@ func (x *StorageConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
-func (*StorageConfig) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use RetrievalDealRecordsResponse.ProtoReflect.Descriptor instead.
+func (*RetrievalDealRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *StorageConfig) GetHot() *HotConfig {
+func (x *RetrievalDealRecordsResponse) GetRecords() []*RetrievalDealRecord {
 	if x != nil {
-		return x.Hot
+		return x.Records
 	}
 	return nil
 }
 
-func (x *StorageConfig) GetCold() *ColdConfig {
+func (x *RetrievalDealRecordsResponse) GetNextCursor() string {
 	if x != nil {
-		return x.Cold
+		return x.NextCursor
 	}
-	return nil
+	return ""
 }
 
-func (x *StorageConfig) GetRepairable() bool {
+func (x *RetrievalDealRecordsResponse) GetSummary() *DealRecordsSummary {
 	if x != nil {
-		return x.Repairable
+		return x.Summary
 	}
-	return false
+	return nil
 }
 
-type IpfsHotInfo struct {
+type ExportDealRecordsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created int64 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Config *DealRecordsExportConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *IpfsHotInfo) Reset() {
-	*x = IpfsHotInfo{}
+func (x *ExportDealRecordsRequest) Reset() {
+	*x = ExportDealRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[64]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IpfsHotInfo) String() string {
+func (x *ExportDealRecordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IpfsHotInfo) ProtoMessage() {}
+func (*ExportDealRecordsRequest) ProtoMessage() {}
 
-func (x *IpfsHotInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[64]
+func (x *ExportDealRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,45 +4254,43 @@ func (x *IpfsHotInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IpfsHotInfo.ProtoReflect.Descriptor instead.
-func (*IpfsHotInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ExportDealRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ExportDealRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *IpfsHotInfo) GetCreated() int64 {
+func (x *ExportDealRecordsRequest) GetConfig() *DealRecordsExportConfig {
 	if x != nil {
-		return x.Created
+		return x.Config
 	}
-	return 0
+	return nil
 }
 
-type HotInfo struct {
+type ExportDealRecordsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Size    int64        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Ipfs    *IpfsHotInfo `protobuf:"bytes,3,opt,name=ipfs,proto3" json:"ipfs,omitempty"`
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *HotInfo) Reset() {
-	*x = HotInfo{}
+func (x *ExportDealRecordsResponse) Reset() {
+	*x = ExportDealRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[65]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HotInfo) String() string {
+func (x *ExportDealRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HotInfo) ProtoMessage() {}
+func (*ExportDealRecordsResponse) ProtoMessage() {}
 
-func (x *HotInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[65]
+func (x *ExportDealRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3463,64 +4301,46 @@ func (x *HotInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HotInfo.ProtoReflect.Descriptor instead.
-func (*HotInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *HotInfo) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *HotInfo) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+// Deprecated: Use ExportDealRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ExportDealRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *HotInfo) GetIpfs() *IpfsHotInfo {
+func (x *ExportDealRecordsResponse) GetChunk() []byte {
 	if x != nil {
-		return x.Ipfs
+		return x.Chunk
 	}
 	return nil
 }
 
-type FilStorage struct {
+type JobCounts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProposalCid     string `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
-	Renewed         bool   `protobuf:"varint,2,opt,name=renewed,proto3" json:"renewed,omitempty"`
-	Duration        int64  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	ActivationEpoch int64  `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	StartEpoch      uint64 `protobuf:"varint,5,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
-	Miner           string `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
-	EpochPrice      uint64 `protobuf:"varint,7,opt,name=epoch_price,json=epochPrice,proto3" json:"epoch_price,omitempty"`
-	PieceCid        string `protobuf:"bytes,8,opt,name=piece_cid,json=pieceCid,proto3" json:"piece_cid,omitempty"`
+	Queued           int32 `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	Executing        int32 `protobuf:"varint,2,opt,name=executing,proto3" json:"executing,omitempty"`
+	LatestFinal      int32 `protobuf:"varint,3,opt,name=latest_final,json=latestFinal,proto3" json:"latest_final,omitempty"`
+	LatestSuccessful int32 `protobuf:"varint,4,opt,name=latest_successful,json=latestSuccessful,proto3" json:"latest_successful,omitempty"`
 }
 
-func (x *FilStorage) Reset() {
-	*x = FilStorage{}
+func (x *JobCounts) Reset() {
+	*x = JobCounts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[66]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilStorage) String() string {
+func (x *JobCounts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilStorage) ProtoMessage() {}
+func (*JobCounts) ProtoMessage() {}
 
-func (x *FilStorage) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[66]
+func (x *JobCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3531,94 +4351,69 @@ func (x *FilStorage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilStorage.ProtoReflect.Descriptor instead.
-func (*FilStorage) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *FilStorage) GetProposalCid() string {
-	if x != nil {
-		return x.ProposalCid
-	}
-	return ""
+// Deprecated: Use JobCounts.ProtoReflect.Descriptor instead.
+func (*JobCounts) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *FilStorage) GetRenewed() bool {
+func (x *JobCounts) GetQueued() int32 {
 	if x != nil {
-		return x.Renewed
+		return x.Queued
 	}
-	return false
+	return 0
 }
 
-func (x *FilStorage) GetDuration() int64 {
+func (x *JobCounts) GetExecuting() int32 {
 	if x != nil {
-		return x.Duration
+		return x.Executing
 	}
 	return 0
 }
 
-func (x *FilStorage) GetActivationEpoch() int64 {
+func (x *JobCounts) GetLatestFinal() int32 {
 	if x != nil {
-		return x.ActivationEpoch
+		return x.LatestFinal
 	}
 	return 0
 }
 
-func (x *FilStorage) GetStartEpoch() uint64 {
+func (x *JobCounts) GetLatestSuccessful() int32 {
 	if x != nil {
-		return x.StartEpoch
+		return x.LatestSuccessful
 	}
 	return 0
 }
 
-func (x *FilStorage) GetMiner() string {
-	if x != nil {
-		return x.Miner
-	}
-	return ""
-}
-
-func (x *FilStorage) GetEpochPrice() uint64 {
-	if x != nil {
-		return x.EpochPrice
-	}
-	return 0
-}
-
-func (x *FilStorage) GetPieceCid() string {
-	if x != nil {
-		return x.PieceCid
-	}
-	return ""
-}
-
-type FilInfo struct {
+type AddrInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DataCid   string        `protobuf:"bytes,1,opt,name=data_cid,json=dataCid,proto3" json:"data_cid,omitempty"`
-	Size      uint64        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Proposals []*FilStorage `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address     string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type        string        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Balance     string        `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	SpendPolicy *SpendPolicy  `protobuf:"bytes,5,opt,name=spend_policy,json=spendPolicy,proto3" json:"spend_policy,omitempty"`
+	Multisig    *MultisigInfo `protobuf:"bytes,6,opt,name=multisig,proto3" json:"multisig,omitempty"`
 }
 
-func (x *FilInfo) Reset() {
-	*x = FilInfo{}
+func (x *AddrInfo) Reset() {
+	*x = AddrInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[67]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilInfo) String() string {
+func (x *AddrInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilInfo) ProtoMessage() {}
+func (*AddrInfo) ProtoMessage() {}
 
-func (x *FilInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[67]
+func (x *AddrInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3629,58 +4424,80 @@ func (x *FilInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilInfo.ProtoReflect.Descriptor instead.
-func (*FilInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use AddrInfo.ProtoReflect.Descriptor instead.
+func (*AddrInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *FilInfo) GetDataCid() string {
+func (x *AddrInfo) GetName() string {
 	if x != nil {
-		return x.DataCid
+		return x.Name
 	}
 	return ""
 }
 
-func (x *FilInfo) GetSize() uint64 {
+func (x *AddrInfo) GetAddress() string {
 	if x != nil {
-		return x.Size
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *FilInfo) GetProposals() []*FilStorage {
+func (x *AddrInfo) GetType() string {
 	if x != nil {
-		return x.Proposals
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddrInfo) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AddrInfo) GetSpendPolicy() *SpendPolicy {
+	if x != nil {
+		return x.SpendPolicy
 	}
 	return nil
 }
 
-type ColdInfo struct {
+func (x *AddrInfo) GetMultisig() *MultisigInfo {
+	if x != nil {
+		return x.Multisig
+	}
+	return nil
+}
+
+type MultisigInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled  bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Filecoin *FilInfo `protobuf:"bytes,2,opt,name=filecoin,proto3" json:"filecoin,omitempty"`
+	Creator   string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Signers   []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint64   `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (x *ColdInfo) Reset() {
-	*x = ColdInfo{}
+func (x *MultisigInfo) Reset() {
+	*x = MultisigInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[68]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ColdInfo) String() string {
+func (x *MultisigInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColdInfo) ProtoMessage() {}
+func (*MultisigInfo) ProtoMessage() {}
 
-func (x *ColdInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[68]
+func (x *MultisigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,54 +4508,61 @@ func (x *ColdInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColdInfo.ProtoReflect.Descriptor instead.
-func (*ColdInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use MultisigInfo.ProtoReflect.Descriptor instead.
+func (*MultisigInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ColdInfo) GetEnabled() bool {
+func (x *MultisigInfo) GetCreator() string {
 	if x != nil {
-		return x.Enabled
+		return x.Creator
 	}
-	return false
+	return ""
 }
 
-func (x *ColdInfo) GetFilecoin() *FilInfo {
+func (x *MultisigInfo) GetSigners() []string {
 	if x != nil {
-		return x.Filecoin
+		return x.Signers
 	}
 	return nil
 }
 
-type StorageInfo struct {
+func (x *MultisigInfo) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type MultisigTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId   string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Cid     string    `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	Created int64     `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
-	Hot     *HotInfo  `protobuf:"bytes,4,opt,name=hot,proto3" json:"hot,omitempty"`
-	Cold    *ColdInfo `protobuf:"bytes,5,opt,name=cold,proto3" json:"cold,omitempty"`
+	Id       int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	To       string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount   string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method   uint64   `protobuf:"varint,4,opt,name=method,proto3" json:"method,omitempty"`
+	Approved []string `protobuf:"bytes,5,rep,name=approved,proto3" json:"approved,omitempty"`
 }
 
-func (x *StorageInfo) Reset() {
-	*x = StorageInfo{}
+func (x *MultisigTransaction) Reset() {
+	*x = MultisigTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[69]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageInfo) String() string {
+func (x *MultisigTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageInfo) ProtoMessage() {}
+func (*MultisigTransaction) ProtoMessage() {}
 
-func (x *StorageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[69]
+func (x *MultisigTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3749,77 +4573,74 @@ func (x *StorageInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageInfo.ProtoReflect.Descriptor instead.
-func (*StorageInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use MultisigTransaction.ProtoReflect.Descriptor instead.
+func (*MultisigTransaction) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *StorageInfo) GetJobId() string {
+func (x *MultisigTransaction) GetId() int64 {
 	if x != nil {
-		return x.JobId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *StorageInfo) GetCid() string {
+func (x *MultisigTransaction) GetTo() string {
 	if x != nil {
-		return x.Cid
+		return x.To
 	}
 	return ""
 }
 
-func (x *StorageInfo) GetCreated() int64 {
+func (x *MultisigTransaction) GetAmount() string {
 	if x != nil {
-		return x.Created
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-func (x *StorageInfo) GetHot() *HotInfo {
+func (x *MultisigTransaction) GetMethod() uint64 {
 	if x != nil {
-		return x.Hot
+		return x.Method
 	}
-	return nil
+	return 0
 }
 
-func (x *StorageInfo) GetCold() *ColdInfo {
+func (x *MultisigTransaction) GetApproved() []string {
 	if x != nil {
-		return x.Cold
+		return x.Approved
 	}
 	return nil
 }
 
-type CidInfo struct {
+type SpendPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid                        string         `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	LatestPushedStorageConfig  *StorageConfig `protobuf:"bytes,2,opt,name=latest_pushed_storage_config,json=latestPushedStorageConfig,proto3" json:"latest_pushed_storage_config,omitempty"`
-	CurrentStorageInfo         *StorageInfo   `protobuf:"bytes,3,opt,name=current_storage_info,json=currentStorageInfo,proto3" json:"current_storage_info,omitempty"`
-	QueuedStorageJobs          []*StorageJob  `protobuf:"bytes,4,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
-	ExecutingStorageJob        *StorageJob    `protobuf:"bytes,5,opt,name=executing_storage_job,json=executingStorageJob,proto3" json:"executing_storage_job,omitempty"`
-	LatestFinalStorageJob      *StorageJob    `protobuf:"bytes,6,opt,name=latest_final_storage_job,json=latestFinalStorageJob,proto3" json:"latest_final_storage_job,omitempty"`
-	LatestSuccessfulStorageJob *StorageJob    `protobuf:"bytes,7,opt,name=latest_successful_storage_job,json=latestSuccessfulStorageJob,proto3" json:"latest_successful_storage_job,omitempty"`
+	MaxPerTx            string   `protobuf:"bytes,1,opt,name=max_per_tx,json=maxPerTx,proto3" json:"max_per_tx,omitempty"`
+	MaxPerDay           string   `protobuf:"bytes,2,opt,name=max_per_day,json=maxPerDay,proto3" json:"max_per_day,omitempty"`
+	AllowedDestinations []string `protobuf:"bytes,3,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	ApprovalThreshold   string   `protobuf:"bytes,4,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
 }
 
-func (x *CidInfo) Reset() {
-	*x = CidInfo{}
+func (x *SpendPolicy) Reset() {
+	*x = SpendPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[70]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CidInfo) String() string {
+func (x *SpendPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CidInfo) ProtoMessage() {}
+func (*SpendPolicy) ProtoMessage() {}
 
-func (x *CidInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[70]
+func (x *SpendPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3830,96 +4651,72 @@ func (x *CidInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CidInfo.ProtoReflect.Descriptor instead.
-func (*CidInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use SpendPolicy.ProtoReflect.Descriptor instead.
+func (*SpendPolicy) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *CidInfo) GetCid() string {
+func (x *SpendPolicy) GetMaxPerTx() string {
 	if x != nil {
-		return x.Cid
+		return x.MaxPerTx
 	}
 	return ""
 }
 
-func (x *CidInfo) GetLatestPushedStorageConfig() *StorageConfig {
-	if x != nil {
-		return x.LatestPushedStorageConfig
-	}
-	return nil
-}
-
-func (x *CidInfo) GetCurrentStorageInfo() *StorageInfo {
-	if x != nil {
-		return x.CurrentStorageInfo
-	}
-	return nil
-}
-
-func (x *CidInfo) GetQueuedStorageJobs() []*StorageJob {
-	if x != nil {
-		return x.QueuedStorageJobs
-	}
-	return nil
-}
-
-func (x *CidInfo) GetExecutingStorageJob() *StorageJob {
+func (x *SpendPolicy) GetMaxPerDay() string {
 	if x != nil {
-		return x.ExecutingStorageJob
+		return x.MaxPerDay
 	}
-	return nil
+	return ""
 }
 
-func (x *CidInfo) GetLatestFinalStorageJob() *StorageJob {
+func (x *SpendPolicy) GetAllowedDestinations() []string {
 	if x != nil {
-		return x.LatestFinalStorageJob
+		return x.AllowedDestinations
 	}
 	return nil
 }
 
-func (x *CidInfo) GetLatestSuccessfulStorageJob() *StorageJob {
+func (x *SpendPolicy) GetApprovalThreshold() string {
 	if x != nil {
-		return x.LatestSuccessfulStorageJob
+		return x.ApprovalThreshold
 	}
-	return nil
+	return ""
 }
 
-type DealInfo struct {
+type Transaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProposalCid     string `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
-	StateId         uint64 `protobuf:"varint,2,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
-	StateName       string `protobuf:"bytes,3,opt,name=state_name,json=stateName,proto3" json:"state_name,omitempty"`
-	Miner           string `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
-	PieceCid        string `protobuf:"bytes,5,opt,name=piece_cid,json=pieceCid,proto3" json:"piece_cid,omitempty"`
-	Size            uint64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
-	PricePerEpoch   uint64 `protobuf:"varint,7,opt,name=price_per_epoch,json=pricePerEpoch,proto3" json:"price_per_epoch,omitempty"`
-	StartEpoch      uint64 `protobuf:"varint,8,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
-	Duration        uint64 `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
-	DealId          uint64 `protobuf:"varint,10,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
-	ActivationEpoch int64  `protobuf:"varint,11,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	Message         string `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+	MessageCid string            `protobuf:"bytes,1,opt,name=message_cid,json=messageCid,proto3" json:"message_cid,omitempty"`
+	From       string            `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         string            `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount     string            `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Kind       TransactionKind   `protobuf:"varint,5,opt,name=kind,proto3,enum=powergate.user.v1.TransactionKind" json:"kind,omitempty"`
+	Status     TransactionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=powergate.user.v1.TransactionStatus" json:"status,omitempty"`
+	ExitCode   int64             `protobuf:"varint,7,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Height     int64             `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Time       int64             `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *DealInfo) Reset() {
-	*x = DealInfo{}
+func (x *Transaction) Reset() {
+	*x = Transaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[71]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DealInfo) String() string {
+func (x *Transaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DealInfo) ProtoMessage() {}
+func (*Transaction) ProtoMessage() {}
 
-func (x *DealInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[71]
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3930,127 +4727,99 @@ func (x *DealInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DealInfo.ProtoReflect.Descriptor instead.
-func (*DealInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *DealInfo) GetProposalCid() string {
+func (x *Transaction) GetMessageCid() string {
 	if x != nil {
-		return x.ProposalCid
+		return x.MessageCid
 	}
 	return ""
 }
 
-func (x *DealInfo) GetStateId() uint64 {
-	if x != nil {
-		return x.StateId
-	}
-	return 0
-}
-
-func (x *DealInfo) GetStateName() string {
+func (x *Transaction) GetFrom() string {
 	if x != nil {
-		return x.StateName
+		return x.From
 	}
 	return ""
 }
 
-func (x *DealInfo) GetMiner() string {
+func (x *Transaction) GetTo() string {
 	if x != nil {
-		return x.Miner
+		return x.To
 	}
 	return ""
 }
 
-func (x *DealInfo) GetPieceCid() string {
+func (x *Transaction) GetAmount() string {
 	if x != nil {
-		return x.PieceCid
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *DealInfo) GetSize() uint64 {
+func (x *Transaction) GetKind() TransactionKind {
 	if x != nil {
-		return x.Size
+		return x.Kind
 	}
-	return 0
+	return TransactionKind_TRANSACTION_KIND_UNSPECIFIED
 }
 
-func (x *DealInfo) GetPricePerEpoch() uint64 {
+func (x *Transaction) GetStatus() TransactionStatus {
 	if x != nil {
-		return x.PricePerEpoch
+		return x.Status
 	}
-	return 0
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
 }
 
-func (x *DealInfo) GetStartEpoch() uint64 {
+func (x *Transaction) GetExitCode() int64 {
 	if x != nil {
-		return x.StartEpoch
+		return x.ExitCode
 	}
 	return 0
 }
 
-func (x *DealInfo) GetDuration() uint64 {
+func (x *Transaction) GetHeight() int64 {
 	if x != nil {
-		return x.Duration
+		return x.Height
 	}
 	return 0
 }
 
-func (x *DealInfo) GetDealId() uint64 {
+func (x *Transaction) GetTime() int64 {
 	if x != nil {
-		return x.DealId
+		return x.Time
 	}
 	return 0
 }
 
-func (x *DealInfo) GetActivationEpoch() int64 {
-	if x != nil {
-		return x.ActivationEpoch
-	}
-	return 0
-}
-
-func (x *DealInfo) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type StorageJob struct {
+type IpfsConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApiId      string       `protobuf:"bytes,2,opt,name=api_id,json=apiId,proto3" json:"api_id,omitempty"`
-	Cid        string       `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	Status     JobStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=powergate.user.v1.JobStatus" json:"status,omitempty"`
-	ErrorCause string       `protobuf:"bytes,5,opt,name=error_cause,json=errorCause,proto3" json:"error_cause,omitempty"`
-	DealInfo   []*DealInfo  `protobuf:"bytes,6,rep,name=deal_info,json=dealInfo,proto3" json:"deal_info,omitempty"`
-	DealErrors []*DealError `protobuf:"bytes,7,rep,name=deal_errors,json=dealErrors,proto3" json:"deal_errors,omitempty"`
-	CreatedAt  int64        `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AddTimeout int64 `protobuf:"varint,1,opt,name=add_timeout,json=addTimeout,proto3" json:"add_timeout,omitempty"`
 }
 
-func (x *StorageJob) Reset() {
-	*x = StorageJob{}
+func (x *IpfsConfig) Reset() {
+	*x = IpfsConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[72]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJob) String() string {
+func (x *IpfsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJob) ProtoMessage() {}
+func (*IpfsConfig) ProtoMessage() {}
 
-func (x *StorageJob) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[72]
+func (x *IpfsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4061,94 +4830,115 @@ func (x *StorageJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJob.ProtoReflect.Descriptor instead.
-func (*StorageJob) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use IpfsConfig.ProtoReflect.Descriptor instead.
+func (*IpfsConfig) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *StorageJob) GetId() string {
+func (x *IpfsConfig) GetAddTimeout() int64 {
 	if x != nil {
-		return x.Id
+		return x.AddTimeout
 	}
-	return ""
+	return 0
 }
 
-func (x *StorageJob) GetApiId() string {
-	if x != nil {
-		return x.ApiId
-	}
-	return ""
+type HotConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled          bool        `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AllowUnfreeze    bool        `protobuf:"varint,2,opt,name=allow_unfreeze,json=allowUnfreeze,proto3" json:"allow_unfreeze,omitempty"`
+	UnfreezeMaxPrice uint64      `protobuf:"varint,3,opt,name=unfreeze_max_price,json=unfreezeMaxPrice,proto3" json:"unfreeze_max_price,omitempty"`
+	Ipfs             *IpfsConfig `protobuf:"bytes,4,opt,name=ipfs,proto3" json:"ipfs,omitempty"`
 }
 
-func (x *StorageJob) GetCid() string {
-	if x != nil {
-		return x.Cid
+func (x *HotConfig) Reset() {
+	*x = HotConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StorageJob) GetStatus() JobStatus {
-	if x != nil {
-		return x.Status
+func (x *HotConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotConfig) ProtoMessage() {}
+
+func (x *HotConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return JobStatus_JOB_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *StorageJob) GetErrorCause() string {
+// Deprecated: Use HotConfig.ProtoReflect.Descriptor instead.
+func (*HotConfig) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *HotConfig) GetEnabled() bool {
 	if x != nil {
-		return x.ErrorCause
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *StorageJob) GetDealInfo() []*DealInfo {
+func (x *HotConfig) GetAllowUnfreeze() bool {
 	if x != nil {
-		return x.DealInfo
+		return x.AllowUnfreeze
 	}
-	return nil
+	return false
 }
 
-func (x *StorageJob) GetDealErrors() []*DealError {
+func (x *HotConfig) GetUnfreezeMaxPrice() uint64 {
 	if x != nil {
-		return x.DealErrors
+		return x.UnfreezeMaxPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *StorageJob) GetCreatedAt() int64 {
+func (x *HotConfig) GetIpfs() *IpfsConfig {
 	if x != nil {
-		return x.CreatedAt
+		return x.Ipfs
 	}
-	return 0
+	return nil
 }
 
-type DealError struct {
+type FilRenew struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProposalCid string `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
-	Miner       string `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
-	Message     string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Enabled   bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Threshold int64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (x *DealError) Reset() {
-	*x = DealError{}
+func (x *FilRenew) Reset() {
+	*x = FilRenew{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[73]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DealError) String() string {
+func (x *FilRenew) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DealError) ProtoMessage() {}
+func (*FilRenew) ProtoMessage() {}
 
-func (x *DealError) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[73]
+func (x *FilRenew) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4159,60 +4949,53 @@ func (x *DealError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DealError.ProtoReflect.Descriptor instead.
-func (*DealError) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{73}
-}
-
-func (x *DealError) GetProposalCid() string {
-	if x != nil {
-		return x.ProposalCid
-	}
-	return ""
+// Deprecated: Use FilRenew.ProtoReflect.Descriptor instead.
+func (*FilRenew) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DealError) GetMiner() string {
+func (x *FilRenew) GetEnabled() bool {
 	if x != nil {
-		return x.Miner
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *DealError) GetMessage() string {
+func (x *FilRenew) GetThreshold() int64 {
 	if x != nil {
-		return x.Message
+		return x.Threshold
 	}
-	return ""
+	return 0
 }
 
-type LogEntry struct {
+type FilEscalation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid     string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	JobId   string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Time    int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
-	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Enabled       bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaxRounds     int64 `protobuf:"varint,2,opt,name=max_rounds,json=maxRounds,proto3" json:"max_rounds,omitempty"`
+	PriceIncrease int64 `protobuf:"varint,3,opt,name=price_increase,json=priceIncrease,proto3" json:"price_increase,omitempty"`
+	Deadline      int64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (x *LogEntry) Reset() {
-	*x = LogEntry{}
+func (x *FilEscalation) Reset() {
+	*x = FilEscalation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[74]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogEntry) String() string {
+func (x *FilEscalation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogEntry) ProtoMessage() {}
+func (*FilEscalation) ProtoMessage() {}
 
-func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[74]
+func (x *FilEscalation) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4223,68 +5006,75 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
-func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use FilEscalation.ProtoReflect.Descriptor instead.
+func (*FilEscalation) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *LogEntry) GetCid() string {
+func (x *FilEscalation) GetEnabled() bool {
 	if x != nil {
-		return x.Cid
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *LogEntry) GetJobId() string {
+func (x *FilEscalation) GetMaxRounds() int64 {
 	if x != nil {
-		return x.JobId
+		return x.MaxRounds
 	}
-	return ""
+	return 0
 }
 
-func (x *LogEntry) GetTime() int64 {
+func (x *FilEscalation) GetPriceIncrease() int64 {
 	if x != nil {
-		return x.Time
+		return x.PriceIncrease
 	}
 	return 0
 }
 
-func (x *LogEntry) GetMessage() string {
+func (x *FilEscalation) GetDeadline() int64 {
 	if x != nil {
-		return x.Message
+		return x.Deadline
 	}
-	return ""
+	return 0
 }
 
-type DealRecordsConfig struct {
+type FilConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromAddrs      []string `protobuf:"bytes,1,rep,name=from_addrs,json=fromAddrs,proto3" json:"from_addrs,omitempty"`
-	DataCids       []string `protobuf:"bytes,2,rep,name=data_cids,json=dataCids,proto3" json:"data_cids,omitempty"`
-	IncludePending bool     `protobuf:"varint,3,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"`
-	IncludeFinal   bool     `protobuf:"varint,4,opt,name=include_final,json=includeFinal,proto3" json:"include_final,omitempty"`
-	Ascending      bool     `protobuf:"varint,5,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	ReplicationFactor int64          `protobuf:"varint,1,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	DealMinDuration   int64          `protobuf:"varint,2,opt,name=deal_min_duration,json=dealMinDuration,proto3" json:"deal_min_duration,omitempty"`
+	ExcludedMiners    []string       `protobuf:"bytes,3,rep,name=excluded_miners,json=excludedMiners,proto3" json:"excluded_miners,omitempty"`
+	TrustedMiners     []string       `protobuf:"bytes,4,rep,name=trusted_miners,json=trustedMiners,proto3" json:"trusted_miners,omitempty"`
+	CountryCodes      []string       `protobuf:"bytes,5,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
+	Renew             *FilRenew      `protobuf:"bytes,6,opt,name=renew,proto3" json:"renew,omitempty"`
+	Address           string         `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	MaxPrice          uint64         `protobuf:"varint,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	FastRetrieval     bool           `protobuf:"varint,9,opt,name=fast_retrieval,json=fastRetrieval,proto3" json:"fast_retrieval,omitempty"`
+	DealStartOffset   int64          `protobuf:"varint,10,opt,name=deal_start_offset,json=dealStartOffset,proto3" json:"deal_start_offset,omitempty"`
+	MaxRetrievalPrice uint64         `protobuf:"varint,11,opt,name=max_retrieval_price,json=maxRetrievalPrice,proto3" json:"max_retrieval_price,omitempty"`
+	Escalation        *FilEscalation `protobuf:"bytes,12,opt,name=escalation,proto3" json:"escalation,omitempty"`
 }
 
-func (x *DealRecordsConfig) Reset() {
-	*x = DealRecordsConfig{}
+func (x *FilConfig) Reset() {
+	*x = FilConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[75]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DealRecordsConfig) String() string {
+func (x *FilConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DealRecordsConfig) ProtoMessage() {}
+func (*FilConfig) ProtoMessage() {}
 
-func (x *DealRecordsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[75]
+func (x *FilConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4295,82 +5085,121 @@ func (x *DealRecordsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DealRecordsConfig.ProtoReflect.Descriptor instead.
-func (*DealRecordsConfig) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use FilConfig.ProtoReflect.Descriptor instead.
+func (*FilConfig) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DealRecordsConfig) GetFromAddrs() []string {
+func (x *FilConfig) GetReplicationFactor() int64 {
 	if x != nil {
-		return x.FromAddrs
+		return x.ReplicationFactor
 	}
-	return nil
+	return 0
 }
 
-func (x *DealRecordsConfig) GetDataCids() []string {
+func (x *FilConfig) GetDealMinDuration() int64 {
 	if x != nil {
-		return x.DataCids
+		return x.DealMinDuration
+	}
+	return 0
+}
+
+func (x *FilConfig) GetExcludedMiners() []string {
+	if x != nil {
+		return x.ExcludedMiners
 	}
 	return nil
 }
 
-func (x *DealRecordsConfig) GetIncludePending() bool {
+func (x *FilConfig) GetTrustedMiners() []string {
 	if x != nil {
-		return x.IncludePending
+		return x.TrustedMiners
 	}
-	return false
+	return nil
 }
 
-func (x *DealRecordsConfig) GetIncludeFinal() bool {
+func (x *FilConfig) GetCountryCodes() []string {
 	if x != nil {
-		return x.IncludeFinal
+		return x.CountryCodes
 	}
-	return false
+	return nil
 }
 
-func (x *DealRecordsConfig) GetAscending() bool {
+func (x *FilConfig) GetRenew() *FilRenew {
 	if x != nil {
-		return x.Ascending
+		return x.Renew
 	}
-	return false
+	return nil
 }
 
-type StorageDealInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FilConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
 
-	ProposalCid     string `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
-	StateId         uint64 `protobuf:"varint,2,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
-	StateName       string `protobuf:"bytes,3,opt,name=state_name,json=stateName,proto3" json:"state_name,omitempty"`
-	Miner           string `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
-	PieceCid        string `protobuf:"bytes,5,opt,name=piece_cid,json=pieceCid,proto3" json:"piece_cid,omitempty"`
-	Size            uint64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
-	PricePerEpoch   uint64 `protobuf:"varint,7,opt,name=price_per_epoch,json=pricePerEpoch,proto3" json:"price_per_epoch,omitempty"`
-	StartEpoch      uint64 `protobuf:"varint,8,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
-	Duration        uint64 `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
-	DealId          uint64 `protobuf:"varint,10,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
-	ActivationEpoch int64  `protobuf:"varint,11,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	Message         string `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+func (x *FilConfig) GetMaxPrice() uint64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
 }
 
-func (x *StorageDealInfo) Reset() {
-	*x = StorageDealInfo{}
+func (x *FilConfig) GetFastRetrieval() bool {
+	if x != nil {
+		return x.FastRetrieval
+	}
+	return false
+}
+
+func (x *FilConfig) GetDealStartOffset() int64 {
+	if x != nil {
+		return x.DealStartOffset
+	}
+	return 0
+}
+
+func (x *FilConfig) GetMaxRetrievalPrice() uint64 {
+	if x != nil {
+		return x.MaxRetrievalPrice
+	}
+	return 0
+}
+
+func (x *FilConfig) GetEscalation() *FilEscalation {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+type ColdConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled  bool       `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Filecoin *FilConfig `protobuf:"bytes,2,opt,name=filecoin,proto3" json:"filecoin,omitempty"`
+}
+
+func (x *ColdConfig) Reset() {
+	*x = ColdConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[76]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageDealInfo) String() string {
+func (x *ColdConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageDealInfo) ProtoMessage() {}
+func (*ColdConfig) ProtoMessage() {}
 
-func (x *StorageDealInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[76]
+func (x *ColdConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4381,124 +5210,113 @@ func (x *StorageDealInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageDealInfo.ProtoReflect.Descriptor instead.
-func (*StorageDealInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ColdConfig.ProtoReflect.Descriptor instead.
+func (*ColdConfig) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *StorageDealInfo) GetProposalCid() string {
+func (x *ColdConfig) GetEnabled() bool {
 	if x != nil {
-		return x.ProposalCid
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *StorageDealInfo) GetStateId() uint64 {
+func (x *ColdConfig) GetFilecoin() *FilConfig {
 	if x != nil {
-		return x.StateId
+		return x.Filecoin
 	}
-	return 0
+	return nil
 }
 
-func (x *StorageDealInfo) GetStateName() string {
-	if x != nil {
-		return x.StateName
-	}
-	return ""
-}
+type StorageConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *StorageDealInfo) GetMiner() string {
-	if x != nil {
-		return x.Miner
-	}
-	return ""
+	Hot        *HotConfig  `protobuf:"bytes,1,opt,name=hot,proto3" json:"hot,omitempty"`
+	Cold       *ColdConfig `protobuf:"bytes,2,opt,name=cold,proto3" json:"cold,omitempty"`
+	Repairable bool        `protobuf:"varint,3,opt,name=repairable,proto3" json:"repairable,omitempty"`
 }
 
-func (x *StorageDealInfo) GetPieceCid() string {
-	if x != nil {
-		return x.PieceCid
+func (x *StorageConfig) Reset() {
+	*x = StorageConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StorageDealInfo) GetSize() uint64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+func (x *StorageConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorageDealInfo) GetPricePerEpoch() uint64 {
-	if x != nil {
-		return x.PricePerEpoch
-	}
-	return 0
-}
+func (*StorageConfig) ProtoMessage() {}
 
-func (x *StorageDealInfo) GetStartEpoch() uint64 {
-	if x != nil {
-		return x.StartEpoch
+func (x *StorageConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StorageDealInfo) GetDuration() uint64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
+func (*StorageConfig) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *StorageDealInfo) GetDealId() uint64 {
+func (x *StorageConfig) GetHot() *HotConfig {
 	if x != nil {
-		return x.DealId
+		return x.Hot
 	}
-	return 0
+	return nil
 }
 
-func (x *StorageDealInfo) GetActivationEpoch() int64 {
+func (x *StorageConfig) GetCold() *ColdConfig {
 	if x != nil {
-		return x.ActivationEpoch
+		return x.Cold
 	}
-	return 0
+	return nil
 }
 
-func (x *StorageDealInfo) GetMessage() string {
+func (x *StorageConfig) GetRepairable() bool {
 	if x != nil {
-		return x.Message
+		return x.Repairable
 	}
-	return ""
+	return false
 }
 
-type StorageDealRecord struct {
+type IpfsHotInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RootCid  string           `protobuf:"bytes,1,opt,name=root_cid,json=rootCid,proto3" json:"root_cid,omitempty"`
-	Address  string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Time     int64            `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
-	Pending  bool             `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
-	DealInfo *StorageDealInfo `protobuf:"bytes,5,opt,name=deal_info,json=dealInfo,proto3" json:"deal_info,omitempty"`
+	Created int64 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
 }
 
-func (x *StorageDealRecord) Reset() {
-	*x = StorageDealRecord{}
+func (x *IpfsHotInfo) Reset() {
+	*x = IpfsHotInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[77]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageDealRecord) String() string {
+func (x *IpfsHotInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageDealRecord) ProtoMessage() {}
+func (*IpfsHotInfo) ProtoMessage() {}
 
-func (x *StorageDealRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[77]
+func (x *IpfsHotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4509,77 +5327,45 @@ func (x *StorageDealRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageDealRecord.ProtoReflect.Descriptor instead.
-func (*StorageDealRecord) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *StorageDealRecord) GetRootCid() string {
-	if x != nil {
-		return x.RootCid
-	}
-	return ""
-}
-
-func (x *StorageDealRecord) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use IpfsHotInfo.ProtoReflect.Descriptor instead.
+func (*IpfsHotInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *StorageDealRecord) GetTime() int64 {
+func (x *IpfsHotInfo) GetCreated() int64 {
 	if x != nil {
-		return x.Time
+		return x.Created
 	}
 	return 0
 }
 
-func (x *StorageDealRecord) GetPending() bool {
-	if x != nil {
-		return x.Pending
-	}
-	return false
-}
-
-func (x *StorageDealRecord) GetDealInfo() *StorageDealInfo {
-	if x != nil {
-		return x.DealInfo
-	}
-	return nil
-}
-
-type RetrievalDealInfo struct {
+type HotInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RootCid                 string `protobuf:"bytes,1,opt,name=root_cid,json=rootCid,proto3" json:"root_cid,omitempty"`
-	Size                    uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	MinPrice                uint64 `protobuf:"varint,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
-	PaymentInterval         uint64 `protobuf:"varint,4,opt,name=payment_interval,json=paymentInterval,proto3" json:"payment_interval,omitempty"`
-	PaymentIntervalIncrease uint64 `protobuf:"varint,5,opt,name=payment_interval_increase,json=paymentIntervalIncrease,proto3" json:"payment_interval_increase,omitempty"`
-	Miner                   string `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
-	MinerPeerId             string `protobuf:"bytes,7,opt,name=miner_peer_id,json=minerPeerId,proto3" json:"miner_peer_id,omitempty"`
+	Enabled bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Size    int64        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Ipfs    *IpfsHotInfo `protobuf:"bytes,3,opt,name=ipfs,proto3" json:"ipfs,omitempty"`
 }
 
-func (x *RetrievalDealInfo) Reset() {
-	*x = RetrievalDealInfo{}
+func (x *HotInfo) Reset() {
+	*x = HotInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[78]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RetrievalDealInfo) String() string {
+func (x *HotInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrievalDealInfo) ProtoMessage() {}
+func (*HotInfo) ProtoMessage() {}
 
-func (x *RetrievalDealInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[78]
+func (x *HotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4590,87 +5376,186 @@ func (x *RetrievalDealInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrievalDealInfo.ProtoReflect.Descriptor instead.
-func (*RetrievalDealInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use HotInfo.ProtoReflect.Descriptor instead.
+func (*HotInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *RetrievalDealInfo) GetRootCid() string {
+func (x *HotInfo) GetEnabled() bool {
 	if x != nil {
-		return x.RootCid
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *RetrievalDealInfo) GetSize() uint64 {
+func (x *HotInfo) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *RetrievalDealInfo) GetMinPrice() uint64 {
+func (x *HotInfo) GetIpfs() *IpfsHotInfo {
 	if x != nil {
-		return x.MinPrice
+		return x.Ipfs
 	}
-	return 0
+	return nil
 }
 
-func (x *RetrievalDealInfo) GetPaymentInterval() uint64 {
+type FilStorage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalCid     string    `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
+	Renewed         bool      `protobuf:"varint,2,opt,name=renewed,proto3" json:"renewed,omitempty"`
+	Duration        int64     `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	ActivationEpoch int64     `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	StartEpoch      uint64    `protobuf:"varint,5,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	Miner           string    `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
+	EpochPrice      uint64    `protobuf:"varint,7,opt,name=epoch_price,json=epochPrice,proto3" json:"epoch_price,omitempty"`
+	PieceCid        string    `protobuf:"bytes,8,opt,name=piece_cid,json=pieceCid,proto3" json:"piece_cid,omitempty"`
+	DealId          uint64    `protobuf:"varint,9,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	State           DealState `protobuf:"varint,10,opt,name=state,proto3,enum=powergate.user.v1.DealState" json:"state,omitempty"`
+	StateReason     string    `protobuf:"bytes,11,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+}
+
+func (x *FilStorage) Reset() {
+	*x = FilStorage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilStorage) ProtoMessage() {}
+
+func (x *FilStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilStorage.ProtoReflect.Descriptor instead.
+func (*FilStorage) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FilStorage) GetProposalCid() string {
 	if x != nil {
-		return x.PaymentInterval
+		return x.ProposalCid
+	}
+	return ""
+}
+
+func (x *FilStorage) GetRenewed() bool {
+	if x != nil {
+		return x.Renewed
+	}
+	return false
+}
+
+func (x *FilStorage) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
 	}
 	return 0
 }
 
-func (x *RetrievalDealInfo) GetPaymentIntervalIncrease() uint64 {
+func (x *FilStorage) GetActivationEpoch() int64 {
 	if x != nil {
-		return x.PaymentIntervalIncrease
+		return x.ActivationEpoch
 	}
 	return 0
 }
 
-func (x *RetrievalDealInfo) GetMiner() string {
+func (x *FilStorage) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *FilStorage) GetMiner() string {
 	if x != nil {
 		return x.Miner
 	}
 	return ""
 }
 
-func (x *RetrievalDealInfo) GetMinerPeerId() string {
+func (x *FilStorage) GetEpochPrice() uint64 {
 	if x != nil {
-		return x.MinerPeerId
+		return x.EpochPrice
+	}
+	return 0
+}
+
+func (x *FilStorage) GetPieceCid() string {
+	if x != nil {
+		return x.PieceCid
 	}
 	return ""
 }
 
-type RetrievalDealRecord struct {
+func (x *FilStorage) GetDealId() uint64 {
+	if x != nil {
+		return x.DealId
+	}
+	return 0
+}
+
+func (x *FilStorage) GetState() DealState {
+	if x != nil {
+		return x.State
+	}
+	return DealState_DEAL_STATE_UNSPECIFIED
+}
+
+func (x *FilStorage) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+type FilInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Time     int64              `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
-	DealInfo *RetrievalDealInfo `protobuf:"bytes,3,opt,name=deal_info,json=dealInfo,proto3" json:"deal_info,omitempty"`
+	DataCid   string        `protobuf:"bytes,1,opt,name=data_cid,json=dataCid,proto3" json:"data_cid,omitempty"`
+	Size      uint64        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Proposals []*FilStorage `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals,omitempty"`
 }
 
-func (x *RetrievalDealRecord) Reset() {
-	*x = RetrievalDealRecord{}
+func (x *FilInfo) Reset() {
+	*x = FilInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_user_v1_user_proto_msgTypes[79]
+		mi := &file_powergate_user_v1_user_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RetrievalDealRecord) String() string {
+func (x *FilInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrievalDealRecord) ProtoMessage() {}
+func (*FilInfo) ProtoMessage() {}
 
-func (x *RetrievalDealRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_user_v1_user_proto_msgTypes[79]
+func (x *FilInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4681,778 +5566,2658 @@ func (x *RetrievalDealRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrievalDealRecord.ProtoReflect.Descriptor instead.
-func (*RetrievalDealRecord) Descriptor() ([]byte, []int) {
-	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use FilInfo.ProtoReflect.Descriptor instead.
+func (*FilInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RetrievalDealRecord) GetAddress() string {
+func (x *FilInfo) GetDataCid() string {
 	if x != nil {
-		return x.Address
+		return x.DataCid
 	}
 	return ""
 }
 
-func (x *RetrievalDealRecord) GetTime() int64 {
+func (x *FilInfo) GetSize() uint64 {
 	if x != nil {
-		return x.Time
+		return x.Size
 	}
 	return 0
 }
 
-func (x *RetrievalDealRecord) GetDealInfo() *RetrievalDealInfo {
+func (x *FilInfo) GetProposals() []*FilStorage {
 	if x != nil {
-		return x.DealInfo
+		return x.Proposals
 	}
 	return nil
 }
 
-var File_powergate_user_v1_user_proto protoreflect.FileDescriptor
+type ColdInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_powergate_user_v1_user_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x22, 0x12, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
-	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69,
-	0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x67, 0x69, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x69, 0x74,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x69,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x74,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x76, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5a, 0x0a, 0x1e, 0x53, 0x65,
-	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x53, 0x74, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22,
-	0x21, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
-	0x69, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x19, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
-	0x69, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
-	0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x68, 0x61, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x33, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x69, 0x64, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x32, 0x22, 0x2c, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x21, 0x0a, 0x0d, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x10, 0x0a,
-	0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x55, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x4d, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c,
-	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x6c,
-	0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6c, 0x6f, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x24, 0x0a, 0x0e, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x0f, 0x43,
-	0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x09, 0x63, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63,
-	0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x22, 0x6d, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
-	0x2e, 0x0a, 0x12, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x12, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33,
-	0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x27, 0x0a,
-	0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x30, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
-	0x22, 0x54, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x22, 0x33, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x1b, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x2e, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x69, 0x64, 0x73, 0x22, 0x5d, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x22, 0x31, 0x0a, 0x1b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x60, 0x0a, 0x1c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x33, 0x0a, 0x1d, 0x4c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x1e,
-	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
-	0x22, 0x38, 0x0a, 0x22, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x67, 0x0a, 0x23, 0x4c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x69, 0x64, 0x73, 0x22, 0xbb, 0x03, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x6a, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x12, 0x4d, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x11, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12,
-	0x53, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x14,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x58, 0x0a, 0x19, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66,
-	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x16, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x62,
-	0x0a, 0x1e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x66, 0x75, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x1b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x22, 0x32, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f,
-	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5c, 0x0a,
-	0x1a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x1b, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x60, 0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x4a,
-	0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21,
-	0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61,
-	0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x22, 0x66,
-	0x0a, 0x08, 0x41, 0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x0a, 0x49, 0x70, 0x66, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x09, 0x48, 0x6f, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x66, 0x72,
-	0x65, 0x65, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x10, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x69, 0x70, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x70, 0x66, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x04, 0x69, 0x70, 0x66, 0x73, 0x22, 0x42, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x6e, 0x65,
-	0x77, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x98, 0x03, 0x0a, 0x09, 0x46, 0x69,
-	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d,
-	0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74,
-	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x52, 0x65,
-	0x6e, 0x65, 0x77, 0x52, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x61, 0x73, 0x74, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08,
-	0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x03, 0x68, 0x6f, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x03, 0x68, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
-	0x65, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x49,
-	0x70, 0x66, 0x73, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x22, 0x6b, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a,
-	0x04, 0x69, 0x70, 0x66, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x70, 0x66, 0x73, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x70, 0x66,
-	0x73, 0x22, 0x85, 0x02, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x43, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x07, 0x46, 0x69, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x43, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
-	0x22, 0x5c, 0x0a, 0x08, 0x43, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0xaf,
-	0x01, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x2c, 0x0a, 0x03, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x68, 0x6f, 0x74, 0x12,
-	0x2f, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x64,
-	0x22, 0xac, 0x04, 0x0a, 0x07, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x61,
-	0x0a, 0x1c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x19, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x75,
-	0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x50, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52,
-	0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x12, 0x51, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
-	0x52, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x56, 0x0a, 0x18, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f,
-	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f,
-	0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x60, 0x0a,
-	0x1d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
-	0x75, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x4a, 0x6f, 0x62, 0x52, 0x1a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x22,
-	0xf1, 0x02, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29,
-	0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xb4, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x75,
-	0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x08, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a, 0x0b,
-	0x64, 0x65, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x0a, 0x64, 0x65, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x09, 0x44, 0x65,
-	0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x08, 0x4c, 0x6f,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x01,
-	0x0a, 0x11, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64,
-	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x43, 0x69, 0x64, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xf8, 0x02, 0x0a, 0x0f,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43,
-	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49,
-	0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x72, 0x6f, 0x6f, 0x74, 0x43, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x3f, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65,
-	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x80, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65,
-	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3a, 0x0a,
-	0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x17, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12,
-	0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x65, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
-	0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x64, 0x65, 0x61,
-	0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x08, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2a, 0xa0, 0x01, 0x0a,
-	0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x4a, 0x4f,
-	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a,
-	0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43,
-	0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17,
-	0x0a, 0x13, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e,
-	0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x05, 0x32,
-	0xfe, 0x16, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x58, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x79, 0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01,
-	0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x73, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5e, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
-	0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x5a, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x12,
-	0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x52,
-	0x0a, 0x07, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x52, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x2e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
-	0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x12, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x07, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5e, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x64, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x4a, 0x6f, 0x62, 0x12, 0x2d, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x11,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
-	0x73, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79,
-	0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x16, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x73, 0x12, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x1b, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x35, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x36, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63,
+	Enabled  bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Filecoin *FilInfo `protobuf:"bytes,2,opt,name=filecoin,proto3" json:"filecoin,omitempty"`
+}
+
+func (x *ColdInfo) Reset() {
+	*x = ColdInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColdInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColdInfo) ProtoMessage() {}
+
+func (x *ColdInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColdInfo.ProtoReflect.Descriptor instead.
+func (*ColdInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ColdInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ColdInfo) GetFilecoin() *FilInfo {
+	if x != nil {
+		return x.Filecoin
+	}
+	return nil
+}
+
+type StorageInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId   string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Cid     string    `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Created int64     `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Hot     *HotInfo  `protobuf:"bytes,4,opt,name=hot,proto3" json:"hot,omitempty"`
+	Cold    *ColdInfo `protobuf:"bytes,5,opt,name=cold,proto3" json:"cold,omitempty"`
+}
+
+func (x *StorageInfo) Reset() {
+	*x = StorageInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageInfo) ProtoMessage() {}
+
+func (x *StorageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageInfo.ProtoReflect.Descriptor instead.
+func (*StorageInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *StorageInfo) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *StorageInfo) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *StorageInfo) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *StorageInfo) GetHot() *HotInfo {
+	if x != nil {
+		return x.Hot
+	}
+	return nil
+}
+
+func (x *StorageInfo) GetCold() *ColdInfo {
+	if x != nil {
+		return x.Cold
+	}
+	return nil
+}
+
+type CidInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid                        string         `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	LatestPushedStorageConfig  *StorageConfig `protobuf:"bytes,2,opt,name=latest_pushed_storage_config,json=latestPushedStorageConfig,proto3" json:"latest_pushed_storage_config,omitempty"`
+	CurrentStorageInfo         *StorageInfo   `protobuf:"bytes,3,opt,name=current_storage_info,json=currentStorageInfo,proto3" json:"current_storage_info,omitempty"`
+	QueuedStorageJobs          []*StorageJob  `protobuf:"bytes,4,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
+	ExecutingStorageJob        *StorageJob    `protobuf:"bytes,5,opt,name=executing_storage_job,json=executingStorageJob,proto3" json:"executing_storage_job,omitempty"`
+	LatestFinalStorageJob      *StorageJob    `protobuf:"bytes,6,opt,name=latest_final_storage_job,json=latestFinalStorageJob,proto3" json:"latest_final_storage_job,omitempty"`
+	LatestSuccessfulStorageJob *StorageJob    `protobuf:"bytes,7,opt,name=latest_successful_storage_job,json=latestSuccessfulStorageJob,proto3" json:"latest_successful_storage_job,omitempty"`
+}
+
+func (x *CidInfo) Reset() {
+	*x = CidInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CidInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CidInfo) ProtoMessage() {}
+
+func (x *CidInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CidInfo.ProtoReflect.Descriptor instead.
+func (*CidInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CidInfo) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *CidInfo) GetLatestPushedStorageConfig() *StorageConfig {
+	if x != nil {
+		return x.LatestPushedStorageConfig
+	}
+	return nil
+}
+
+func (x *CidInfo) GetCurrentStorageInfo() *StorageInfo {
+	if x != nil {
+		return x.CurrentStorageInfo
+	}
+	return nil
+}
+
+func (x *CidInfo) GetQueuedStorageJobs() []*StorageJob {
+	if x != nil {
+		return x.QueuedStorageJobs
+	}
+	return nil
+}
+
+func (x *CidInfo) GetExecutingStorageJob() *StorageJob {
+	if x != nil {
+		return x.ExecutingStorageJob
+	}
+	return nil
+}
+
+func (x *CidInfo) GetLatestFinalStorageJob() *StorageJob {
+	if x != nil {
+		return x.LatestFinalStorageJob
+	}
+	return nil
+}
+
+func (x *CidInfo) GetLatestSuccessfulStorageJob() *StorageJob {
+	if x != nil {
+		return x.LatestSuccessfulStorageJob
+	}
+	return nil
+}
+
+type DealInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalCid     string `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
+	StateId         uint64 `protobuf:"varint,2,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	StateName       string `protobuf:"bytes,3,opt,name=state_name,json=stateName,proto3" json:"state_name,omitempty"`
+	Miner           string `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
+	PieceCid        string `protobuf:"bytes,5,opt,name=piece_cid,json=pieceCid,proto3" json:"piece_cid,omitempty"`
+	Size            uint64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	PricePerEpoch   uint64 `protobuf:"varint,7,opt,name=price_per_epoch,json=pricePerEpoch,proto3" json:"price_per_epoch,omitempty"`
+	StartEpoch      uint64 `protobuf:"varint,8,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	Duration        uint64 `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	DealId          uint64 `protobuf:"varint,10,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	ActivationEpoch int64  `protobuf:"varint,11,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	Message         string `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DealInfo) Reset() {
+	*x = DealInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DealInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealInfo) ProtoMessage() {}
+
+func (x *DealInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealInfo.ProtoReflect.Descriptor instead.
+func (*DealInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DealInfo) GetProposalCid() string {
+	if x != nil {
+		return x.ProposalCid
+	}
+	return ""
+}
+
+func (x *DealInfo) GetStateId() uint64 {
+	if x != nil {
+		return x.StateId
+	}
+	return 0
+}
+
+func (x *DealInfo) GetStateName() string {
+	if x != nil {
+		return x.StateName
+	}
+	return ""
+}
+
+func (x *DealInfo) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+func (x *DealInfo) GetPieceCid() string {
+	if x != nil {
+		return x.PieceCid
+	}
+	return ""
+}
+
+func (x *DealInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DealInfo) GetPricePerEpoch() uint64 {
+	if x != nil {
+		return x.PricePerEpoch
+	}
+	return 0
+}
+
+func (x *DealInfo) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *DealInfo) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *DealInfo) GetDealId() uint64 {
+	if x != nil {
+		return x.DealId
+	}
+	return 0
+}
+
+func (x *DealInfo) GetActivationEpoch() int64 {
+	if x != nil {
+		return x.ActivationEpoch
+	}
+	return 0
+}
+
+func (x *DealInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StorageJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApiId      string       `protobuf:"bytes,2,opt,name=api_id,json=apiId,proto3" json:"api_id,omitempty"`
+	Cid        string       `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Status     JobStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=powergate.user.v1.JobStatus" json:"status,omitempty"`
+	ErrorCause string       `protobuf:"bytes,5,opt,name=error_cause,json=errorCause,proto3" json:"error_cause,omitempty"`
+	DealInfo   []*DealInfo  `protobuf:"bytes,6,rep,name=deal_info,json=dealInfo,proto3" json:"deal_info,omitempty"`
+	DealErrors []*DealError `protobuf:"bytes,7,rep,name=deal_errors,json=dealErrors,proto3" json:"deal_errors,omitempty"`
+	CreatedAt  int64        `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Steps      []*JobStep   `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *StorageJob) Reset() {
+	*x = StorageJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageJob) ProtoMessage() {}
+
+func (x *StorageJob) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageJob.ProtoReflect.Descriptor instead.
+func (*StorageJob) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *StorageJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StorageJob) GetApiId() string {
+	if x != nil {
+		return x.ApiId
+	}
+	return ""
+}
+
+func (x *StorageJob) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *StorageJob) GetStatus() JobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *StorageJob) GetErrorCause() string {
+	if x != nil {
+		return x.ErrorCause
+	}
+	return ""
+}
+
+func (x *StorageJob) GetDealInfo() []*DealInfo {
+	if x != nil {
+		return x.DealInfo
+	}
+	return nil
+}
+
+func (x *StorageJob) GetDealErrors() []*DealError {
+	if x != nil {
+		return x.DealErrors
+	}
+	return nil
+}
+
+func (x *StorageJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *StorageJob) GetSteps() []*JobStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type JobStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Detail     string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	StartedAt  int64  `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt int64  `protobuf:"varint,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Result     string `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	Error      string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *JobStep) Reset() {
+	*x = JobStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStep) ProtoMessage() {}
+
+func (x *JobStep) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStep.ProtoReflect.Descriptor instead.
+func (*JobStep) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *JobStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobStep) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *JobStep) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *JobStep) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *JobStep) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *JobStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DealError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalCid string `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
+	Miner       string `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	Message     string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DealError) Reset() {
+	*x = DealError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DealError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealError) ProtoMessage() {}
+
+func (x *DealError) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealError.ProtoReflect.Descriptor instead.
+func (*DealError) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DealError) GetProposalCid() string {
+	if x != nil {
+		return x.ProposalCid
+	}
+	return ""
+}
+
+func (x *DealError) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+func (x *DealError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid         string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	JobId       string            `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Time        int64             `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Message     string            `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Level       LogLevel          `protobuf:"varint,5,opt,name=level,proto3,enum=powergate.user.v1.LogLevel" json:"level,omitempty"`
+	Event       string            `protobuf:"bytes,6,opt,name=event,proto3" json:"event,omitempty"`
+	Fields      map[string]string `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RetrievalId string            `protobuf:"bytes,8,opt,name=retrieval_id,json=retrievalId,proto3" json:"retrieval_id,omitempty"`
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *LogEntry) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *LogEntry) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *LogEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogEntry) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *LogEntry) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *LogEntry) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *LogEntry) GetRetrievalId() string {
+	if x != nil {
+		return x.RetrievalId
+	}
+	return ""
+}
+
+type DealRecordsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromAddrs      []string `protobuf:"bytes,1,rep,name=from_addrs,json=fromAddrs,proto3" json:"from_addrs,omitempty"`
+	DataCids       []string `protobuf:"bytes,2,rep,name=data_cids,json=dataCids,proto3" json:"data_cids,omitempty"`
+	IncludePending bool     `protobuf:"varint,3,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"`
+	IncludeFinal   bool     `protobuf:"varint,4,opt,name=include_final,json=includeFinal,proto3" json:"include_final,omitempty"`
+	Ascending      bool     `protobuf:"varint,5,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Miners         []string `protobuf:"bytes,6,rep,name=miners,proto3" json:"miners,omitempty"`
+	PieceCids      []string `protobuf:"bytes,7,rep,name=piece_cids,json=pieceCids,proto3" json:"piece_cids,omitempty"`
+	StateIds       []uint64 `protobuf:"varint,8,rep,packed,name=state_ids,json=stateIds,proto3" json:"state_ids,omitempty"`
+	FromTime       int64    `protobuf:"varint,9,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime         int64    `protobuf:"varint,10,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	MinPrice       uint64   `protobuf:"varint,11,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice       uint64   `protobuf:"varint,12,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Limit          int64    `protobuf:"varint,13,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor         string   `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IncludeSummary bool     `protobuf:"varint,15,opt,name=include_summary,json=includeSummary,proto3" json:"include_summary,omitempty"`
+}
+
+func (x *DealRecordsConfig) Reset() {
+	*x = DealRecordsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DealRecordsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealRecordsConfig) ProtoMessage() {}
+
+func (x *DealRecordsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealRecordsConfig.ProtoReflect.Descriptor instead.
+func (*DealRecordsConfig) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DealRecordsConfig) GetFromAddrs() []string {
+	if x != nil {
+		return x.FromAddrs
+	}
+	return nil
+}
+
+func (x *DealRecordsConfig) GetDataCids() []string {
+	if x != nil {
+		return x.DataCids
+	}
+	return nil
+}
+
+func (x *DealRecordsConfig) GetIncludePending() bool {
+	if x != nil {
+		return x.IncludePending
+	}
+	return false
+}
+
+func (x *DealRecordsConfig) GetIncludeFinal() bool {
+	if x != nil {
+		return x.IncludeFinal
+	}
+	return false
+}
+
+func (x *DealRecordsConfig) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *DealRecordsConfig) GetMiners() []string {
+	if x != nil {
+		return x.Miners
+	}
+	return nil
+}
+
+func (x *DealRecordsConfig) GetPieceCids() []string {
+	if x != nil {
+		return x.PieceCids
+	}
+	return nil
+}
+
+func (x *DealRecordsConfig) GetStateIds() []uint64 {
+	if x != nil {
+		return x.StateIds
+	}
+	return nil
+}
+
+func (x *DealRecordsConfig) GetFromTime() int64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *DealRecordsConfig) GetToTime() int64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *DealRecordsConfig) GetMinPrice() uint64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *DealRecordsConfig) GetMaxPrice() uint64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *DealRecordsConfig) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DealRecordsConfig) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *DealRecordsConfig) GetIncludeSummary() bool {
+	if x != nil {
+		return x.IncludeSummary
+	}
+	return false
+}
+
+type DealRecordsSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	TotalAttofil string `protobuf:"bytes,2,opt,name=total_attofil,json=totalAttofil,proto3" json:"total_attofil,omitempty"`
+	TotalBytes   uint64 `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+}
+
+func (x *DealRecordsSummary) Reset() {
+	*x = DealRecordsSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DealRecordsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealRecordsSummary) ProtoMessage() {}
+
+func (x *DealRecordsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealRecordsSummary.ProtoReflect.Descriptor instead.
+func (*DealRecordsSummary) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DealRecordsSummary) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DealRecordsSummary) GetTotalAttofil() string {
+	if x != nil {
+		return x.TotalAttofil
+	}
+	return ""
+}
+
+func (x *DealRecordsSummary) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+type DealRecordsExportConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind           DealRecordsKind `protobuf:"varint,1,opt,name=kind,proto3,enum=powergate.user.v1.DealRecordsKind" json:"kind,omitempty"`
+	Format         ExportFormat    `protobuf:"varint,2,opt,name=format,proto3,enum=powergate.user.v1.ExportFormat" json:"format,omitempty"`
+	FromTime       int64           `protobuf:"varint,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime         int64           `protobuf:"varint,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	IncludePending bool            `protobuf:"varint,5,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"`
+}
+
+func (x *DealRecordsExportConfig) Reset() {
+	*x = DealRecordsExportConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DealRecordsExportConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealRecordsExportConfig) ProtoMessage() {}
+
+func (x *DealRecordsExportConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealRecordsExportConfig.ProtoReflect.Descriptor instead.
+func (*DealRecordsExportConfig) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DealRecordsExportConfig) GetKind() DealRecordsKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DealRecordsKind_DEAL_RECORDS_KIND_UNSPECIFIED
+}
+
+func (x *DealRecordsExportConfig) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *DealRecordsExportConfig) GetFromTime() int64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *DealRecordsExportConfig) GetToTime() int64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *DealRecordsExportConfig) GetIncludePending() bool {
+	if x != nil {
+		return x.IncludePending
+	}
+	return false
+}
+
+type StorageDealInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalCid     string `protobuf:"bytes,1,opt,name=proposal_cid,json=proposalCid,proto3" json:"proposal_cid,omitempty"`
+	StateId         uint64 `protobuf:"varint,2,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	StateName       string `protobuf:"bytes,3,opt,name=state_name,json=stateName,proto3" json:"state_name,omitempty"`
+	Miner           string `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
+	PieceCid        string `protobuf:"bytes,5,opt,name=piece_cid,json=pieceCid,proto3" json:"piece_cid,omitempty"`
+	Size            uint64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	PricePerEpoch   uint64 `protobuf:"varint,7,opt,name=price_per_epoch,json=pricePerEpoch,proto3" json:"price_per_epoch,omitempty"`
+	StartEpoch      uint64 `protobuf:"varint,8,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	Duration        uint64 `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	DealId          uint64 `protobuf:"varint,10,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	ActivationEpoch int64  `protobuf:"varint,11,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	Message         string `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *StorageDealInfo) Reset() {
+	*x = StorageDealInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageDealInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageDealInfo) ProtoMessage() {}
+
+func (x *StorageDealInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageDealInfo.ProtoReflect.Descriptor instead.
+func (*StorageDealInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *StorageDealInfo) GetProposalCid() string {
+	if x != nil {
+		return x.ProposalCid
+	}
+	return ""
+}
+
+func (x *StorageDealInfo) GetStateId() uint64 {
+	if x != nil {
+		return x.StateId
+	}
+	return 0
+}
+
+func (x *StorageDealInfo) GetStateName() string {
+	if x != nil {
+		return x.StateName
+	}
+	return ""
+}
+
+func (x *StorageDealInfo) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+func (x *StorageDealInfo) GetPieceCid() string {
+	if x != nil {
+		return x.PieceCid
+	}
+	return ""
+}
+
+func (x *StorageDealInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *StorageDealInfo) GetPricePerEpoch() uint64 {
+	if x != nil {
+		return x.PricePerEpoch
+	}
+	return 0
+}
+
+func (x *StorageDealInfo) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *StorageDealInfo) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *StorageDealInfo) GetDealId() uint64 {
+	if x != nil {
+		return x.DealId
+	}
+	return 0
+}
+
+func (x *StorageDealInfo) GetActivationEpoch() int64 {
+	if x != nil {
+		return x.ActivationEpoch
+	}
+	return 0
+}
+
+func (x *StorageDealInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StorageDealRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RootCid  string           `protobuf:"bytes,1,opt,name=root_cid,json=rootCid,proto3" json:"root_cid,omitempty"`
+	Address  string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Time     int64            `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Pending  bool             `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	DealInfo *StorageDealInfo `protobuf:"bytes,5,opt,name=deal_info,json=dealInfo,proto3" json:"deal_info,omitempty"`
+	JobId    string           `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *StorageDealRecord) Reset() {
+	*x = StorageDealRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageDealRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageDealRecord) ProtoMessage() {}
+
+func (x *StorageDealRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageDealRecord.ProtoReflect.Descriptor instead.
+func (*StorageDealRecord) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *StorageDealRecord) GetRootCid() string {
+	if x != nil {
+		return x.RootCid
+	}
+	return ""
+}
+
+func (x *StorageDealRecord) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StorageDealRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *StorageDealRecord) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *StorageDealRecord) GetDealInfo() *StorageDealInfo {
+	if x != nil {
+		return x.DealInfo
+	}
+	return nil
+}
+
+func (x *StorageDealRecord) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type RetrievalDealInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RootCid                 string `protobuf:"bytes,1,opt,name=root_cid,json=rootCid,proto3" json:"root_cid,omitempty"`
+	Size                    uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	MinPrice                uint64 `protobuf:"varint,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	PaymentInterval         uint64 `protobuf:"varint,4,opt,name=payment_interval,json=paymentInterval,proto3" json:"payment_interval,omitempty"`
+	PaymentIntervalIncrease uint64 `protobuf:"varint,5,opt,name=payment_interval_increase,json=paymentIntervalIncrease,proto3" json:"payment_interval_increase,omitempty"`
+	Miner                   string `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
+	MinerPeerId             string `protobuf:"bytes,7,opt,name=miner_peer_id,json=minerPeerId,proto3" json:"miner_peer_id,omitempty"`
+}
+
+func (x *RetrievalDealInfo) Reset() {
+	*x = RetrievalDealInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrievalDealInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrievalDealInfo) ProtoMessage() {}
+
+func (x *RetrievalDealInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrievalDealInfo.ProtoReflect.Descriptor instead.
+func (*RetrievalDealInfo) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RetrievalDealInfo) GetRootCid() string {
+	if x != nil {
+		return x.RootCid
+	}
+	return ""
+}
+
+func (x *RetrievalDealInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *RetrievalDealInfo) GetMinPrice() uint64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *RetrievalDealInfo) GetPaymentInterval() uint64 {
+	if x != nil {
+		return x.PaymentInterval
+	}
+	return 0
+}
+
+func (x *RetrievalDealInfo) GetPaymentIntervalIncrease() uint64 {
+	if x != nil {
+		return x.PaymentIntervalIncrease
+	}
+	return 0
+}
+
+func (x *RetrievalDealInfo) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+func (x *RetrievalDealInfo) GetMinerPeerId() string {
+	if x != nil {
+		return x.MinerPeerId
+	}
+	return ""
+}
+
+type RetrievalDealRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address       string              `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Time          int64               `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	DealInfo      *RetrievalDealInfo  `protobuf:"bytes,3,opt,name=deal_info,json=dealInfo,proto3" json:"deal_info,omitempty"`
+	JobId         string              `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	RetrievalId   string              `protobuf:"bytes,5,opt,name=retrieval_id,json=retrievalId,proto3" json:"retrieval_id,omitempty"`
+	Status        RetrievalDealStatus `protobuf:"varint,6,opt,name=status,proto3,enum=powergate.user.v1.RetrievalDealStatus" json:"status,omitempty"`
+	Error         string              `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	BytesReceived uint64              `protobuf:"varint,8,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	FundsSpent    uint64              `protobuf:"varint,9,opt,name=funds_spent,json=fundsSpent,proto3" json:"funds_spent,omitempty"`
+	ElapsedMs     int64               `protobuf:"varint,10,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+}
+
+func (x *RetrievalDealRecord) Reset() {
+	*x = RetrievalDealRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_user_v1_user_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrievalDealRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrievalDealRecord) ProtoMessage() {}
+
+func (x *RetrievalDealRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_user_v1_user_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrievalDealRecord.ProtoReflect.Descriptor instead.
+func (*RetrievalDealRecord) Descriptor() ([]byte, []int) {
+	return file_powergate_user_v1_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RetrievalDealRecord) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RetrievalDealRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *RetrievalDealRecord) GetDealInfo() *RetrievalDealInfo {
+	if x != nil {
+		return x.DealInfo
+	}
+	return nil
+}
+
+func (x *RetrievalDealRecord) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *RetrievalDealRecord) GetRetrievalId() string {
+	if x != nil {
+		return x.RetrievalId
+	}
+	return ""
+}
+
+func (x *RetrievalDealRecord) GetStatus() RetrievalDealStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_UNSPECIFIED
+}
+
+func (x *RetrievalDealRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RetrievalDealRecord) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *RetrievalDealRecord) GetFundsSpent() uint64 {
+	if x != nil {
+		return x.FundsSpent
+	}
+	return 0
+}
+
+func (x *RetrievalDealRecord) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+var File_powergate_user_v1_user_proto protoreflect.FileDescriptor
+
+var file_powergate_user_v1_user_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x22, 0x12, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69,
+	0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x69, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x69, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x69,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x74,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x76, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5a, 0x0a, 0x1e, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0c, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x61,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x63, 0x61, 0x72, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0xdf, 0x01, 0x0a,
+	0x19, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e,
+	0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x61, 0x73,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x33,
+	0x0a, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64,
+	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x31, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64,
+	0x32, 0x22, 0x2c, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22,
+	0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x7b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f,
+	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x21, 0x0a, 0x0d, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x10,
+	0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xa3, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x4d, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x6c,
+	0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xbe, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4c, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0e, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x0f, 0x43, 0x69,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x63, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x69,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x6d, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x61, 0x6b, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x2e,
+	0x0a, 0x12, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x12,
+	0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x5a, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x43, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12,
+	0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x7b,
+	0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5a, 0x0a, 0x14, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x19, 0x4e, 0x65, 0x77, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x6b,
+	0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x0a, 0x1a,
+	0x4e, 0x65, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x32, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x5a, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x17, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x43, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x21, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45,
+	0x0a, 0x22, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x43, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x17,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x22, 0x54, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0a, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x22, 0x33, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x66, 0x0a,
+	0x1b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f,
+	0x72, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2e, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x5d, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x73, 0x22, 0x31, 0x0a, 0x1b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x60, 0x0a, 0x1c, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x33, 0x0a, 0x1d, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x62,
+	0x0a, 0x1e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x73, 0x22, 0x38, 0x0a, 0x22, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x12, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x67, 0x0a, 0x23,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a,
+	0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0xbb, 0x03, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x6a, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x4d, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x11,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x73, 0x12, 0x53, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
 	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
-	0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x6f, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x6d, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72,
+	0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x58, 0x0a, 0x19, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a,
+	0x6f, 0x62, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x16, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x62, 0x0a, 0x1e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x1b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x73, 0x22, 0x32, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x18, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0xbe, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x3f, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x22, 0x5b, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc2, 0x01,
+	0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x22, 0x5e, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x31, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x11,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x22, 0xe6, 0x01, 0x0a, 0x08, 0x41, 0x64,
+	0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x22, 0x60, 0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x50, 0x65, 0x72, 0x54, 0x78, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xa9, 0x02, 0x0a, 0x0b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3c, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x0a, 0x49, 0x70, 0x66, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x09, 0x48, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x31, 0x0a, 0x04, 0x69, 0x70, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x70, 0x66, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x69,
+	0x70, 0x66, 0x73, 0x22, 0x42, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x45,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x8a, 0x04, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64,
+	0x65, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52,
+	0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x61, 0x73, 0x74, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d,
+	0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x40, 0x0a, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x45, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x63, 0x6f, 0x69, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x03, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x03, 0x68, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x49, 0x70, 0x66,
+	0x73, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x6b, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x69,
+	0x70, 0x66, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x70,
+	0x66, 0x73, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x70, 0x66, 0x73, 0x22,
+	0xf5, 0x02, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69,
+	0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x5c,
+	0x0a, 0x08, 0x43, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0xaf, 0x01, 0x0a,
+	0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x2c, 0x0a, 0x03, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x68, 0x6f, 0x74, 0x12, 0x2f, 0x0a,
+	0x04, 0x63, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x64, 0x22, 0xac,
+	0x04, 0x0a, 0x07, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x61, 0x0a, 0x1c,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x19, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x75, 0x73, 0x68,
+	0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x50, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x4d, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x11, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x51, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x13,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x12, 0x56, 0x0a, 0x18, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x60, 0x0a, 0x1d, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x1a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x22, 0xf1, 0x02,
+	0x0a, 0x08, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0xe6, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x08, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x65,
+	0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0a, 0x64,
+	0x65, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x5e, 0x0a, 0x09, 0x44, 0x65, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xc9, 0x02, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x49,
+	0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x03, 0x0a,
+	0x11, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x43, 0x69, 0x64, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x6f,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x70, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x74, 0x6f, 0x66,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x74, 0x74, 0x6f, 0x66, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0xf8, 0x02, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69,
+	0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xce,
+	0x01, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22,
+	0x80, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x19,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x17, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xfd, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
+	0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6d,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64,
+	0x4d, 0x73, 0x2a, 0x9f, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x53, 0x43, 0x52,
+	0x4f, 0x57, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57,
+	0x41, 0x4c, 0x10, 0x03, 0x2a, 0xb8, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x20,
+	0x0a, 0x1c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x1e, 0x0a, 0x1a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0x6e, 0x0a, 0x09, 0x44, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x44, 0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12,
+	0x16, 0x0a, 0x12, 0x44, 0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4c,
+	0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x41, 0x4c, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0xa0, 0x01, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x16, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45,
+	0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f,
+	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x17, 0x0a, 0x13, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f,
+	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x05, 0x2a, 0x77, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19,
+	0x0a, 0x15, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47,
+	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f,
+	0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x57, 0x41, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x2a, 0x74, 0x0a, 0x0f, 0x44,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x21,
+	0x0a, 0x1d, 0x44, 0x45, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x53, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x53, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x53,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x10,
+	0x02, 0x2a, 0x5f, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54,
+	0x10, 0x02, 0x2a, 0xce, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
+	0x44, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45,
+	0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x44,
+	0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45,
+	0x56, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x20, 0x0a,
+	0x1c, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x41, 0x4c, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x22, 0x0a, 0x1e, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x41,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x32, 0xba, 0x1e, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a,
+	0x0e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x28, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x31, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x06, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5e, 0x0a, 0x0b,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x03,
+	0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52,
+	0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x09, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x07, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12,
+	0x4e, 0x65, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a,
+	0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8b, 0x01, 0x0a, 0x1a, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x45, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x12, 0x34, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
 	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x73, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x12, 0x76, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x46, 0x6f, 0x72, 0x4a, 0x6f, 0x62, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
 	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x2e,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2b, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x14, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x73, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x16, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12,
+	0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x1b, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x35, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
 	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
+	0x75, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x10,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6d, 0x0a,
+	0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
 	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x79, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x11,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
 	0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
 	0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
 	0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72,
@@ -5472,196 +8237,268 @@ func file_powergate_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_powergate_user_v1_user_proto_rawDescData
 }
 
-var file_powergate_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_powergate_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_powergate_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_powergate_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 107)
 var file_powergate_user_v1_user_proto_goTypes = []interface{}{
-	(JobStatus)(0),                              // 0: powergate.user.v1.JobStatus
-	(*BuildInfoRequest)(nil),                    // 1: powergate.user.v1.BuildInfoRequest
-	(*BuildInfoResponse)(nil),                   // 2: powergate.user.v1.BuildInfoResponse
-	(*UserIdentifierRequest)(nil),               // 3: powergate.user.v1.UserIdentifierRequest
-	(*UserIdentifierResponse)(nil),              // 4: powergate.user.v1.UserIdentifierResponse
-	(*DefaultStorageConfigRequest)(nil),         // 5: powergate.user.v1.DefaultStorageConfigRequest
-	(*DefaultStorageConfigResponse)(nil),        // 6: powergate.user.v1.DefaultStorageConfigResponse
-	(*SetDefaultStorageConfigRequest)(nil),      // 7: powergate.user.v1.SetDefaultStorageConfigRequest
-	(*SetDefaultStorageConfigResponse)(nil),     // 8: powergate.user.v1.SetDefaultStorageConfigResponse
-	(*StageRequest)(nil),                        // 9: powergate.user.v1.StageRequest
-	(*StageResponse)(nil),                       // 10: powergate.user.v1.StageResponse
-	(*ApplyStorageConfigRequest)(nil),           // 11: powergate.user.v1.ApplyStorageConfigRequest
-	(*ApplyStorageConfigResponse)(nil),          // 12: powergate.user.v1.ApplyStorageConfigResponse
-	(*ReplaceDataRequest)(nil),                  // 13: powergate.user.v1.ReplaceDataRequest
-	(*ReplaceDataResponse)(nil),                 // 14: powergate.user.v1.ReplaceDataResponse
-	(*GetRequest)(nil),                          // 15: powergate.user.v1.GetRequest
-	(*GetResponse)(nil),                         // 16: powergate.user.v1.GetResponse
-	(*RemoveRequest)(nil),                       // 17: powergate.user.v1.RemoveRequest
-	(*RemoveResponse)(nil),                      // 18: powergate.user.v1.RemoveResponse
-	(*WatchLogsRequest)(nil),                    // 19: powergate.user.v1.WatchLogsRequest
-	(*WatchLogsResponse)(nil),                   // 20: powergate.user.v1.WatchLogsResponse
-	(*CidInfoRequest)(nil),                      // 21: powergate.user.v1.CidInfoRequest
-	(*CidInfoResponse)(nil),                     // 22: powergate.user.v1.CidInfoResponse
-	(*BalanceRequest)(nil),                      // 23: powergate.user.v1.BalanceRequest
-	(*BalanceResponse)(nil),                     // 24: powergate.user.v1.BalanceResponse
-	(*NewAddressRequest)(nil),                   // 25: powergate.user.v1.NewAddressRequest
-	(*NewAddressResponse)(nil),                  // 26: powergate.user.v1.NewAddressResponse
-	(*AddressesRequest)(nil),                    // 27: powergate.user.v1.AddressesRequest
-	(*AddressesResponse)(nil),                   // 28: powergate.user.v1.AddressesResponse
-	(*SendFilRequest)(nil),                      // 29: powergate.user.v1.SendFilRequest
-	(*SendFilResponse)(nil),                     // 30: powergate.user.v1.SendFilResponse
-	(*SignMessageRequest)(nil),                  // 31: powergate.user.v1.SignMessageRequest
-	(*SignMessageResponse)(nil),                 // 32: powergate.user.v1.SignMessageResponse
-	(*VerifyMessageRequest)(nil),                // 33: powergate.user.v1.VerifyMessageRequest
-	(*VerifyMessageResponse)(nil),               // 34: powergate.user.v1.VerifyMessageResponse
-	(*CancelStorageJobRequest)(nil),             // 35: powergate.user.v1.CancelStorageJobRequest
-	(*CancelStorageJobResponse)(nil),            // 36: powergate.user.v1.CancelStorageJobResponse
-	(*StorageJobRequest)(nil),                   // 37: powergate.user.v1.StorageJobRequest
-	(*StorageJobResponse)(nil),                  // 38: powergate.user.v1.StorageJobResponse
-	(*StorageConfigForJobRequest)(nil),          // 39: powergate.user.v1.StorageConfigForJobRequest
-	(*StorageConfigForJobResponse)(nil),         // 40: powergate.user.v1.StorageConfigForJobResponse
-	(*QueuedStorageJobsRequest)(nil),            // 41: powergate.user.v1.QueuedStorageJobsRequest
-	(*QueuedStorageJobsResponse)(nil),           // 42: powergate.user.v1.QueuedStorageJobsResponse
-	(*ExecutingStorageJobsRequest)(nil),         // 43: powergate.user.v1.ExecutingStorageJobsRequest
-	(*ExecutingStorageJobsResponse)(nil),        // 44: powergate.user.v1.ExecutingStorageJobsResponse
-	(*LatestFinalStorageJobsRequest)(nil),       // 45: powergate.user.v1.LatestFinalStorageJobsRequest
-	(*LatestFinalStorageJobsResponse)(nil),      // 46: powergate.user.v1.LatestFinalStorageJobsResponse
-	(*LatestSuccessfulStorageJobsRequest)(nil),  // 47: powergate.user.v1.LatestSuccessfulStorageJobsRequest
-	(*LatestSuccessfulStorageJobsResponse)(nil), // 48: powergate.user.v1.LatestSuccessfulStorageJobsResponse
-	(*StorageJobsSummaryRequest)(nil),           // 49: powergate.user.v1.StorageJobsSummaryRequest
-	(*StorageJobsSummaryResponse)(nil),          // 50: powergate.user.v1.StorageJobsSummaryResponse
-	(*WatchStorageJobsRequest)(nil),             // 51: powergate.user.v1.WatchStorageJobsRequest
-	(*WatchStorageJobsResponse)(nil),            // 52: powergate.user.v1.WatchStorageJobsResponse
-	(*StorageDealRecordsRequest)(nil),           // 53: powergate.user.v1.StorageDealRecordsRequest
-	(*StorageDealRecordsResponse)(nil),          // 54: powergate.user.v1.StorageDealRecordsResponse
-	(*RetrievalDealRecordsRequest)(nil),         // 55: powergate.user.v1.RetrievalDealRecordsRequest
-	(*RetrievalDealRecordsResponse)(nil),        // 56: powergate.user.v1.RetrievalDealRecordsResponse
-	(*JobCounts)(nil),                           // 57: powergate.user.v1.JobCounts
-	(*AddrInfo)(nil),                            // 58: powergate.user.v1.AddrInfo
-	(*IpfsConfig)(nil),                          // 59: powergate.user.v1.IpfsConfig
-	(*HotConfig)(nil),                           // 60: powergate.user.v1.HotConfig
-	(*FilRenew)(nil),                            // 61: powergate.user.v1.FilRenew
-	(*FilConfig)(nil),                           // 62: powergate.user.v1.FilConfig
-	(*ColdConfig)(nil),                          // 63: powergate.user.v1.ColdConfig
-	(*StorageConfig)(nil),                       // 64: powergate.user.v1.StorageConfig
-	(*IpfsHotInfo)(nil),                         // 65: powergate.user.v1.IpfsHotInfo
-	(*HotInfo)(nil),                             // 66: powergate.user.v1.HotInfo
-	(*FilStorage)(nil),                          // 67: powergate.user.v1.FilStorage
-	(*FilInfo)(nil),                             // 68: powergate.user.v1.FilInfo
-	(*ColdInfo)(nil),                            // 69: powergate.user.v1.ColdInfo
-	(*StorageInfo)(nil),                         // 70: powergate.user.v1.StorageInfo
-	(*CidInfo)(nil),                             // 71: powergate.user.v1.CidInfo
-	(*DealInfo)(nil),                            // 72: powergate.user.v1.DealInfo
-	(*StorageJob)(nil),                          // 73: powergate.user.v1.StorageJob
-	(*DealError)(nil),                           // 74: powergate.user.v1.DealError
-	(*LogEntry)(nil),                            // 75: powergate.user.v1.LogEntry
-	(*DealRecordsConfig)(nil),                   // 76: powergate.user.v1.DealRecordsConfig
-	(*StorageDealInfo)(nil),                     // 77: powergate.user.v1.StorageDealInfo
-	(*StorageDealRecord)(nil),                   // 78: powergate.user.v1.StorageDealRecord
-	(*RetrievalDealInfo)(nil),                   // 79: powergate.user.v1.RetrievalDealInfo
-	(*RetrievalDealRecord)(nil),                 // 80: powergate.user.v1.RetrievalDealRecord
+	(TransactionKind)(0),                        // 0: powergate.user.v1.TransactionKind
+	(TransactionStatus)(0),                      // 1: powergate.user.v1.TransactionStatus
+	(DealState)(0),                              // 2: powergate.user.v1.DealState
+	(JobStatus)(0),                              // 3: powergate.user.v1.JobStatus
+	(LogLevel)(0),                               // 4: powergate.user.v1.LogLevel
+	(DealRecordsKind)(0),                        // 5: powergate.user.v1.DealRecordsKind
+	(ExportFormat)(0),                           // 6: powergate.user.v1.ExportFormat
+	(RetrievalDealStatus)(0),                    // 7: powergate.user.v1.RetrievalDealStatus
+	(*BuildInfoRequest)(nil),                    // 8: powergate.user.v1.BuildInfoRequest
+	(*BuildInfoResponse)(nil),                   // 9: powergate.user.v1.BuildInfoResponse
+	(*UserIdentifierRequest)(nil),               // 10: powergate.user.v1.UserIdentifierRequest
+	(*UserIdentifierResponse)(nil),              // 11: powergate.user.v1.UserIdentifierResponse
+	(*DefaultStorageConfigRequest)(nil),         // 12: powergate.user.v1.DefaultStorageConfigRequest
+	(*DefaultStorageConfigResponse)(nil),        // 13: powergate.user.v1.DefaultStorageConfigResponse
+	(*SetDefaultStorageConfigRequest)(nil),      // 14: powergate.user.v1.SetDefaultStorageConfigRequest
+	(*SetDefaultStorageConfigResponse)(nil),     // 15: powergate.user.v1.SetDefaultStorageConfigResponse
+	(*StageRequest)(nil),                        // 16: powergate.user.v1.StageRequest
+	(*StageResponse)(nil),                       // 17: powergate.user.v1.StageResponse
+	(*ApplyStorageConfigRequest)(nil),           // 18: powergate.user.v1.ApplyStorageConfigRequest
+	(*ApplyStorageConfigResponse)(nil),          // 19: powergate.user.v1.ApplyStorageConfigResponse
+	(*ReplaceDataRequest)(nil),                  // 20: powergate.user.v1.ReplaceDataRequest
+	(*ReplaceDataResponse)(nil),                 // 21: powergate.user.v1.ReplaceDataResponse
+	(*GetRequest)(nil),                          // 22: powergate.user.v1.GetRequest
+	(*GetResponse)(nil),                         // 23: powergate.user.v1.GetResponse
+	(*GetCARRequest)(nil),                       // 24: powergate.user.v1.GetCARRequest
+	(*GetCARResponse)(nil),                      // 25: powergate.user.v1.GetCARResponse
+	(*RemoveRequest)(nil),                       // 26: powergate.user.v1.RemoveRequest
+	(*RemoveResponse)(nil),                      // 27: powergate.user.v1.RemoveResponse
+	(*WatchLogsRequest)(nil),                    // 28: powergate.user.v1.WatchLogsRequest
+	(*WatchLogsResponse)(nil),                   // 29: powergate.user.v1.WatchLogsResponse
+	(*LogsRequest)(nil),                         // 30: powergate.user.v1.LogsRequest
+	(*LogsResponse)(nil),                        // 31: powergate.user.v1.LogsResponse
+	(*CidInfoRequest)(nil),                      // 32: powergate.user.v1.CidInfoRequest
+	(*CidInfoResponse)(nil),                     // 33: powergate.user.v1.CidInfoResponse
+	(*BalanceRequest)(nil),                      // 34: powergate.user.v1.BalanceRequest
+	(*BalanceResponse)(nil),                     // 35: powergate.user.v1.BalanceResponse
+	(*NewAddressRequest)(nil),                   // 36: powergate.user.v1.NewAddressRequest
+	(*NewAddressResponse)(nil),                  // 37: powergate.user.v1.NewAddressResponse
+	(*AddressesRequest)(nil),                    // 38: powergate.user.v1.AddressesRequest
+	(*AddressesResponse)(nil),                   // 39: powergate.user.v1.AddressesResponse
+	(*SendFilRequest)(nil),                      // 40: powergate.user.v1.SendFilRequest
+	(*SendFilResponse)(nil),                     // 41: powergate.user.v1.SendFilResponse
+	(*SignMessageRequest)(nil),                  // 42: powergate.user.v1.SignMessageRequest
+	(*SignMessageResponse)(nil),                 // 43: powergate.user.v1.SignMessageResponse
+	(*VerifyMessageRequest)(nil),                // 44: powergate.user.v1.VerifyMessageRequest
+	(*VerifyMessageResponse)(nil),               // 45: powergate.user.v1.VerifyMessageResponse
+	(*TransactionsRequest)(nil),                 // 46: powergate.user.v1.TransactionsRequest
+	(*TransactionsResponse)(nil),                // 47: powergate.user.v1.TransactionsResponse
+	(*NewMultisigAddressRequest)(nil),           // 48: powergate.user.v1.NewMultisigAddressRequest
+	(*NewMultisigAddressResponse)(nil),          // 49: powergate.user.v1.NewMultisigAddressResponse
+	(*MultisigPendingRequest)(nil),              // 50: powergate.user.v1.MultisigPendingRequest
+	(*MultisigPendingResponse)(nil),             // 51: powergate.user.v1.MultisigPendingResponse
+	(*MultisigProposeRequest)(nil),              // 52: powergate.user.v1.MultisigProposeRequest
+	(*MultisigProposeResponse)(nil),             // 53: powergate.user.v1.MultisigProposeResponse
+	(*MultisigProposeEscrowTopUpRequest)(nil),   // 54: powergate.user.v1.MultisigProposeEscrowTopUpRequest
+	(*MultisigProposeEscrowTopUpResponse)(nil),  // 55: powergate.user.v1.MultisigProposeEscrowTopUpResponse
+	(*MultisigApproveRequest)(nil),              // 56: powergate.user.v1.MultisigApproveRequest
+	(*MultisigApproveResponse)(nil),             // 57: powergate.user.v1.MultisigApproveResponse
+	(*CancelStorageJobRequest)(nil),             // 58: powergate.user.v1.CancelStorageJobRequest
+	(*CancelStorageJobResponse)(nil),            // 59: powergate.user.v1.CancelStorageJobResponse
+	(*StorageJobRequest)(nil),                   // 60: powergate.user.v1.StorageJobRequest
+	(*StorageJobResponse)(nil),                  // 61: powergate.user.v1.StorageJobResponse
+	(*StorageConfigForJobRequest)(nil),          // 62: powergate.user.v1.StorageConfigForJobRequest
+	(*StorageConfigForJobResponse)(nil),         // 63: powergate.user.v1.StorageConfigForJobResponse
+	(*QueuedStorageJobsRequest)(nil),            // 64: powergate.user.v1.QueuedStorageJobsRequest
+	(*QueuedStorageJobsResponse)(nil),           // 65: powergate.user.v1.QueuedStorageJobsResponse
+	(*ExecutingStorageJobsRequest)(nil),         // 66: powergate.user.v1.ExecutingStorageJobsRequest
+	(*ExecutingStorageJobsResponse)(nil),        // 67: powergate.user.v1.ExecutingStorageJobsResponse
+	(*LatestFinalStorageJobsRequest)(nil),       // 68: powergate.user.v1.LatestFinalStorageJobsRequest
+	(*LatestFinalStorageJobsResponse)(nil),      // 69: powergate.user.v1.LatestFinalStorageJobsResponse
+	(*LatestSuccessfulStorageJobsRequest)(nil),  // 70: powergate.user.v1.LatestSuccessfulStorageJobsRequest
+	(*LatestSuccessfulStorageJobsResponse)(nil), // 71: powergate.user.v1.LatestSuccessfulStorageJobsResponse
+	(*StorageJobsSummaryRequest)(nil),           // 72: powergate.user.v1.StorageJobsSummaryRequest
+	(*StorageJobsSummaryResponse)(nil),          // 73: powergate.user.v1.StorageJobsSummaryResponse
+	(*WatchStorageJobsRequest)(nil),             // 74: powergate.user.v1.WatchStorageJobsRequest
+	(*WatchStorageJobsResponse)(nil),            // 75: powergate.user.v1.WatchStorageJobsResponse
+	(*StorageDealRecordsRequest)(nil),           // 76: powergate.user.v1.StorageDealRecordsRequest
+	(*StorageDealRecordsResponse)(nil),          // 77: powergate.user.v1.StorageDealRecordsResponse
+	(*RetrievalDealRecordsRequest)(nil),         // 78: powergate.user.v1.RetrievalDealRecordsRequest
+	(*RetrievalDealRecordsResponse)(nil),        // 79: powergate.user.v1.RetrievalDealRecordsResponse
+	(*ExportDealRecordsRequest)(nil),            // 80: powergate.user.v1.ExportDealRecordsRequest
+	(*ExportDealRecordsResponse)(nil),           // 81: powergate.user.v1.ExportDealRecordsResponse
+	(*JobCounts)(nil),                           // 82: powergate.user.v1.JobCounts
+	(*AddrInfo)(nil),                            // 83: powergate.user.v1.AddrInfo
+	(*MultisigInfo)(nil),                        // 84: powergate.user.v1.MultisigInfo
+	(*MultisigTransaction)(nil),                 // 85: powergate.user.v1.MultisigTransaction
+	(*SpendPolicy)(nil),                         // 86: powergate.user.v1.SpendPolicy
+	(*Transaction)(nil),                         // 87: powergate.user.v1.Transaction
+	(*IpfsConfig)(nil),                          // 88: powergate.user.v1.IpfsConfig
+	(*HotConfig)(nil),                           // 89: powergate.user.v1.HotConfig
+	(*FilRenew)(nil),                            // 90: powergate.user.v1.FilRenew
+	(*FilEscalation)(nil),                       // 91: powergate.user.v1.FilEscalation
+	(*FilConfig)(nil),                           // 92: powergate.user.v1.FilConfig
+	(*ColdConfig)(nil),                          // 93: powergate.user.v1.ColdConfig
+	(*StorageConfig)(nil),                       // 94: powergate.user.v1.StorageConfig
+	(*IpfsHotInfo)(nil),                         // 95: powergate.user.v1.IpfsHotInfo
+	(*HotInfo)(nil),                             // 96: powergate.user.v1.HotInfo
+	(*FilStorage)(nil),                          // 97: powergate.user.v1.FilStorage
+	(*FilInfo)(nil),                             // 98: powergate.user.v1.FilInfo
+	(*ColdInfo)(nil),                            // 99: powergate.user.v1.ColdInfo
+	(*StorageInfo)(nil),                         // 100: powergate.user.v1.StorageInfo
+	(*CidInfo)(nil),                             // 101: powergate.user.v1.CidInfo
+	(*DealInfo)(nil),                            // 102: powergate.user.v1.DealInfo
+	(*StorageJob)(nil),                          // 103: powergate.user.v1.StorageJob
+	(*JobStep)(nil),                             // 104: powergate.user.v1.JobStep
+	(*DealError)(nil),                           // 105: powergate.user.v1.DealError
+	(*LogEntry)(nil),                            // 106: powergate.user.v1.LogEntry
+	(*DealRecordsConfig)(nil),                   // 107: powergate.user.v1.DealRecordsConfig
+	(*DealRecordsSummary)(nil),                  // 108: powergate.user.v1.DealRecordsSummary
+	(*DealRecordsExportConfig)(nil),             // 109: powergate.user.v1.DealRecordsExportConfig
+	(*StorageDealInfo)(nil),                     // 110: powergate.user.v1.StorageDealInfo
+	(*StorageDealRecord)(nil),                   // 111: powergate.user.v1.StorageDealRecord
+	(*RetrievalDealInfo)(nil),                   // 112: powergate.user.v1.RetrievalDealInfo
+	(*RetrievalDealRecord)(nil),                 // 113: powergate.user.v1.RetrievalDealRecord
+	nil,                                         // 114: powergate.user.v1.LogEntry.FieldsEntry
 }
 var file_powergate_user_v1_user_proto_depIdxs = []int32{
-	64, // 0: powergate.user.v1.DefaultStorageConfigResponse.default_storage_config:type_name -> powergate.user.v1.StorageConfig
-	64, // 1: powergate.user.v1.SetDefaultStorageConfigRequest.config:type_name -> powergate.user.v1.StorageConfig
-	64, // 2: powergate.user.v1.ApplyStorageConfigRequest.config:type_name -> powergate.user.v1.StorageConfig
-	75, // 3: powergate.user.v1.WatchLogsResponse.log_entry:type_name -> powergate.user.v1.LogEntry
-	71, // 4: powergate.user.v1.CidInfoResponse.cid_infos:type_name -> powergate.user.v1.CidInfo
-	58, // 5: powergate.user.v1.AddressesResponse.addresses:type_name -> powergate.user.v1.AddrInfo
-	73, // 6: powergate.user.v1.StorageJobResponse.storage_job:type_name -> powergate.user.v1.StorageJob
-	64, // 7: powergate.user.v1.StorageConfigForJobResponse.storage_config:type_name -> powergate.user.v1.StorageConfig
-	73, // 8: powergate.user.v1.QueuedStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 9: powergate.user.v1.ExecutingStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 10: powergate.user.v1.LatestFinalStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 11: powergate.user.v1.LatestSuccessfulStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	57, // 12: powergate.user.v1.StorageJobsSummaryResponse.job_counts:type_name -> powergate.user.v1.JobCounts
-	73, // 13: powergate.user.v1.StorageJobsSummaryResponse.queued_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 14: powergate.user.v1.StorageJobsSummaryResponse.executing_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 15: powergate.user.v1.StorageJobsSummaryResponse.latest_final_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 16: powergate.user.v1.StorageJobsSummaryResponse.latest_successful_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 17: powergate.user.v1.WatchStorageJobsResponse.storage_job:type_name -> powergate.user.v1.StorageJob
-	76, // 18: powergate.user.v1.StorageDealRecordsRequest.config:type_name -> powergate.user.v1.DealRecordsConfig
-	78, // 19: powergate.user.v1.StorageDealRecordsResponse.records:type_name -> powergate.user.v1.StorageDealRecord
-	76, // 20: powergate.user.v1.RetrievalDealRecordsRequest.config:type_name -> powergate.user.v1.DealRecordsConfig
-	80, // 21: powergate.user.v1.RetrievalDealRecordsResponse.records:type_name -> powergate.user.v1.RetrievalDealRecord
-	59, // 22: powergate.user.v1.HotConfig.ipfs:type_name -> powergate.user.v1.IpfsConfig
-	61, // 23: powergate.user.v1.FilConfig.renew:type_name -> powergate.user.v1.FilRenew
-	62, // 24: powergate.user.v1.ColdConfig.filecoin:type_name -> powergate.user.v1.FilConfig
-	60, // 25: powergate.user.v1.StorageConfig.hot:type_name -> powergate.user.v1.HotConfig
-	63, // 26: powergate.user.v1.StorageConfig.cold:type_name -> powergate.user.v1.ColdConfig
-	65, // 27: powergate.user.v1.HotInfo.ipfs:type_name -> powergate.user.v1.IpfsHotInfo
-	67, // 28: powergate.user.v1.FilInfo.proposals:type_name -> powergate.user.v1.FilStorage
-	68, // 29: powergate.user.v1.ColdInfo.filecoin:type_name -> powergate.user.v1.FilInfo
-	66, // 30: powergate.user.v1.StorageInfo.hot:type_name -> powergate.user.v1.HotInfo
-	69, // 31: powergate.user.v1.StorageInfo.cold:type_name -> powergate.user.v1.ColdInfo
-	64, // 32: powergate.user.v1.CidInfo.latest_pushed_storage_config:type_name -> powergate.user.v1.StorageConfig
-	70, // 33: powergate.user.v1.CidInfo.current_storage_info:type_name -> powergate.user.v1.StorageInfo
-	73, // 34: powergate.user.v1.CidInfo.queued_storage_jobs:type_name -> powergate.user.v1.StorageJob
-	73, // 35: powergate.user.v1.CidInfo.executing_storage_job:type_name -> powergate.user.v1.StorageJob
-	73, // 36: powergate.user.v1.CidInfo.latest_final_storage_job:type_name -> powergate.user.v1.StorageJob
-	73, // 37: powergate.user.v1.CidInfo.latest_successful_storage_job:type_name -> powergate.user.v1.StorageJob
-	0,  // 38: powergate.user.v1.StorageJob.status:type_name -> powergate.user.v1.JobStatus
-	72, // 39: powergate.user.v1.StorageJob.deal_info:type_name -> powergate.user.v1.DealInfo
-	74, // 40: powergate.user.v1.StorageJob.deal_errors:type_name -> powergate.user.v1.DealError
-	77, // 41: powergate.user.v1.StorageDealRecord.deal_info:type_name -> powergate.user.v1.StorageDealInfo
-	79, // 42: powergate.user.v1.RetrievalDealRecord.deal_info:type_name -> powergate.user.v1.RetrievalDealInfo
-	1,  // 43: powergate.user.v1.UserService.BuildInfo:input_type -> powergate.user.v1.BuildInfoRequest
-	3,  // 44: powergate.user.v1.UserService.UserIdentifier:input_type -> powergate.user.v1.UserIdentifierRequest
-	5,  // 45: powergate.user.v1.UserService.DefaultStorageConfig:input_type -> powergate.user.v1.DefaultStorageConfigRequest
-	7,  // 46: powergate.user.v1.UserService.SetDefaultStorageConfig:input_type -> powergate.user.v1.SetDefaultStorageConfigRequest
-	11, // 47: powergate.user.v1.UserService.ApplyStorageConfig:input_type -> powergate.user.v1.ApplyStorageConfigRequest
-	17, // 48: powergate.user.v1.UserService.Remove:input_type -> powergate.user.v1.RemoveRequest
-	9,  // 49: powergate.user.v1.UserService.Stage:input_type -> powergate.user.v1.StageRequest
-	13, // 50: powergate.user.v1.UserService.ReplaceData:input_type -> powergate.user.v1.ReplaceDataRequest
-	15, // 51: powergate.user.v1.UserService.Get:input_type -> powergate.user.v1.GetRequest
-	19, // 52: powergate.user.v1.UserService.WatchLogs:input_type -> powergate.user.v1.WatchLogsRequest
-	21, // 53: powergate.user.v1.UserService.CidInfo:input_type -> powergate.user.v1.CidInfoRequest
-	23, // 54: powergate.user.v1.UserService.Balance:input_type -> powergate.user.v1.BalanceRequest
-	25, // 55: powergate.user.v1.UserService.NewAddress:input_type -> powergate.user.v1.NewAddressRequest
-	27, // 56: powergate.user.v1.UserService.Addresses:input_type -> powergate.user.v1.AddressesRequest
-	29, // 57: powergate.user.v1.UserService.SendFil:input_type -> powergate.user.v1.SendFilRequest
-	31, // 58: powergate.user.v1.UserService.SignMessage:input_type -> powergate.user.v1.SignMessageRequest
-	33, // 59: powergate.user.v1.UserService.VerifyMessage:input_type -> powergate.user.v1.VerifyMessageRequest
-	37, // 60: powergate.user.v1.UserService.StorageJob:input_type -> powergate.user.v1.StorageJobRequest
-	39, // 61: powergate.user.v1.UserService.StorageConfigForJob:input_type -> powergate.user.v1.StorageConfigForJobRequest
-	41, // 62: powergate.user.v1.UserService.QueuedStorageJobs:input_type -> powergate.user.v1.QueuedStorageJobsRequest
-	43, // 63: powergate.user.v1.UserService.ExecutingStorageJobs:input_type -> powergate.user.v1.ExecutingStorageJobsRequest
-	45, // 64: powergate.user.v1.UserService.LatestFinalStorageJobs:input_type -> powergate.user.v1.LatestFinalStorageJobsRequest
-	47, // 65: powergate.user.v1.UserService.LatestSuccessfulStorageJobs:input_type -> powergate.user.v1.LatestSuccessfulStorageJobsRequest
-	49, // 66: powergate.user.v1.UserService.StorageJobsSummary:input_type -> powergate.user.v1.StorageJobsSummaryRequest
-	51, // 67: powergate.user.v1.UserService.WatchStorageJobs:input_type -> powergate.user.v1.WatchStorageJobsRequest
-	35, // 68: powergate.user.v1.UserService.CancelStorageJob:input_type -> powergate.user.v1.CancelStorageJobRequest
-	53, // 69: powergate.user.v1.UserService.StorageDealRecords:input_type -> powergate.user.v1.StorageDealRecordsRequest
-	55, // 70: powergate.user.v1.UserService.RetrievalDealRecords:input_type -> powergate.user.v1.RetrievalDealRecordsRequest
-	2,  // 71: powergate.user.v1.UserService.BuildInfo:output_type -> powergate.user.v1.BuildInfoResponse
-	4,  // 72: powergate.user.v1.UserService.UserIdentifier:output_type -> powergate.user.v1.UserIdentifierResponse
-	6,  // 73: powergate.user.v1.UserService.DefaultStorageConfig:output_type -> powergate.user.v1.DefaultStorageConfigResponse
-	8,  // 74: powergate.user.v1.UserService.SetDefaultStorageConfig:output_type -> powergate.user.v1.SetDefaultStorageConfigResponse
-	12, // 75: powergate.user.v1.UserService.ApplyStorageConfig:output_type -> powergate.user.v1.ApplyStorageConfigResponse
-	18, // 76: powergate.user.v1.UserService.Remove:output_type -> powergate.user.v1.RemoveResponse
-	10, // 77: powergate.user.v1.UserService.Stage:output_type -> powergate.user.v1.StageResponse
-	14, // 78: powergate.user.v1.UserService.ReplaceData:output_type -> powergate.user.v1.ReplaceDataResponse
-	16, // 79: powergate.user.v1.UserService.Get:output_type -> powergate.user.v1.GetResponse
-	20, // 80: powergate.user.v1.UserService.WatchLogs:output_type -> powergate.user.v1.WatchLogsResponse
-	22, // 81: powergate.user.v1.UserService.CidInfo:output_type -> powergate.user.v1.CidInfoResponse
-	24, // 82: powergate.user.v1.UserService.Balance:output_type -> powergate.user.v1.BalanceResponse
-	26, // 83: powergate.user.v1.UserService.NewAddress:output_type -> powergate.user.v1.NewAddressResponse
-	28, // 84: powergate.user.v1.UserService.Addresses:output_type -> powergate.user.v1.AddressesResponse
-	30, // 85: powergate.user.v1.UserService.SendFil:output_type -> powergate.user.v1.SendFilResponse
-	32, // 86: powergate.user.v1.UserService.SignMessage:output_type -> powergate.user.v1.SignMessageResponse
-	34, // 87: powergate.user.v1.UserService.VerifyMessage:output_type -> powergate.user.v1.VerifyMessageResponse
-	38, // 88: powergate.user.v1.UserService.StorageJob:output_type -> powergate.user.v1.StorageJobResponse
-	40, // 89: powergate.user.v1.UserService.StorageConfigForJob:output_type -> powergate.user.v1.StorageConfigForJobResponse
-	42, // 90: powergate.user.v1.UserService.QueuedStorageJobs:output_type -> powergate.user.v1.QueuedStorageJobsResponse
-	44, // 91: powergate.user.v1.UserService.ExecutingStorageJobs:output_type -> powergate.user.v1.ExecutingStorageJobsResponse
-	46, // 92: powergate.user.v1.UserService.LatestFinalStorageJobs:output_type -> powergate.user.v1.LatestFinalStorageJobsResponse
-	48, // 93: powergate.user.v1.UserService.LatestSuccessfulStorageJobs:output_type -> powergate.user.v1.LatestSuccessfulStorageJobsResponse
-	50, // 94: powergate.user.v1.UserService.StorageJobsSummary:output_type -> powergate.user.v1.StorageJobsSummaryResponse
-	52, // 95: powergate.user.v1.UserService.WatchStorageJobs:output_type -> powergate.user.v1.WatchStorageJobsResponse
-	36, // 96: powergate.user.v1.UserService.CancelStorageJob:output_type -> powergate.user.v1.CancelStorageJobResponse
-	54, // 97: powergate.user.v1.UserService.StorageDealRecords:output_type -> powergate.user.v1.StorageDealRecordsResponse
-	56, // 98: powergate.user.v1.UserService.RetrievalDealRecords:output_type -> powergate.user.v1.RetrievalDealRecordsResponse
-	71, // [71:99] is the sub-list for method output_type
-	43, // [43:71] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	94,  // 0: powergate.user.v1.DefaultStorageConfigResponse.default_storage_config:type_name -> powergate.user.v1.StorageConfig
+	94,  // 1: powergate.user.v1.SetDefaultStorageConfigRequest.config:type_name -> powergate.user.v1.StorageConfig
+	94,  // 2: powergate.user.v1.ApplyStorageConfigRequest.config:type_name -> powergate.user.v1.StorageConfig
+	4,   // 3: powergate.user.v1.WatchLogsRequest.min_level:type_name -> powergate.user.v1.LogLevel
+	106, // 4: powergate.user.v1.WatchLogsResponse.log_entry:type_name -> powergate.user.v1.LogEntry
+	4,   // 5: powergate.user.v1.LogsRequest.min_level:type_name -> powergate.user.v1.LogLevel
+	106, // 6: powergate.user.v1.LogsResponse.log_entries:type_name -> powergate.user.v1.LogEntry
+	101, // 7: powergate.user.v1.CidInfoResponse.cid_infos:type_name -> powergate.user.v1.CidInfo
+	83,  // 8: powergate.user.v1.AddressesResponse.addresses:type_name -> powergate.user.v1.AddrInfo
+	87,  // 9: powergate.user.v1.TransactionsResponse.transactions:type_name -> powergate.user.v1.Transaction
+	85,  // 10: powergate.user.v1.MultisigPendingResponse.transactions:type_name -> powergate.user.v1.MultisigTransaction
+	103, // 11: powergate.user.v1.StorageJobResponse.storage_job:type_name -> powergate.user.v1.StorageJob
+	94,  // 12: powergate.user.v1.StorageConfigForJobResponse.storage_config:type_name -> powergate.user.v1.StorageConfig
+	103, // 13: powergate.user.v1.QueuedStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 14: powergate.user.v1.ExecutingStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 15: powergate.user.v1.LatestFinalStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 16: powergate.user.v1.LatestSuccessfulStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	82,  // 17: powergate.user.v1.StorageJobsSummaryResponse.job_counts:type_name -> powergate.user.v1.JobCounts
+	103, // 18: powergate.user.v1.StorageJobsSummaryResponse.queued_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 19: powergate.user.v1.StorageJobsSummaryResponse.executing_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 20: powergate.user.v1.StorageJobsSummaryResponse.latest_final_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 21: powergate.user.v1.StorageJobsSummaryResponse.latest_successful_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 22: powergate.user.v1.WatchStorageJobsResponse.storage_job:type_name -> powergate.user.v1.StorageJob
+	107, // 23: powergate.user.v1.StorageDealRecordsRequest.config:type_name -> powergate.user.v1.DealRecordsConfig
+	111, // 24: powergate.user.v1.StorageDealRecordsResponse.records:type_name -> powergate.user.v1.StorageDealRecord
+	108, // 25: powergate.user.v1.StorageDealRecordsResponse.summary:type_name -> powergate.user.v1.DealRecordsSummary
+	107, // 26: powergate.user.v1.RetrievalDealRecordsRequest.config:type_name -> powergate.user.v1.DealRecordsConfig
+	113, // 27: powergate.user.v1.RetrievalDealRecordsResponse.records:type_name -> powergate.user.v1.RetrievalDealRecord
+	108, // 28: powergate.user.v1.RetrievalDealRecordsResponse.summary:type_name -> powergate.user.v1.DealRecordsSummary
+	109, // 29: powergate.user.v1.ExportDealRecordsRequest.config:type_name -> powergate.user.v1.DealRecordsExportConfig
+	86,  // 30: powergate.user.v1.AddrInfo.spend_policy:type_name -> powergate.user.v1.SpendPolicy
+	84,  // 31: powergate.user.v1.AddrInfo.multisig:type_name -> powergate.user.v1.MultisigInfo
+	0,   // 32: powergate.user.v1.Transaction.kind:type_name -> powergate.user.v1.TransactionKind
+	1,   // 33: powergate.user.v1.Transaction.status:type_name -> powergate.user.v1.TransactionStatus
+	88,  // 34: powergate.user.v1.HotConfig.ipfs:type_name -> powergate.user.v1.IpfsConfig
+	90,  // 35: powergate.user.v1.FilConfig.renew:type_name -> powergate.user.v1.FilRenew
+	91,  // 36: powergate.user.v1.FilConfig.escalation:type_name -> powergate.user.v1.FilEscalation
+	92,  // 37: powergate.user.v1.ColdConfig.filecoin:type_name -> powergate.user.v1.FilConfig
+	89,  // 38: powergate.user.v1.StorageConfig.hot:type_name -> powergate.user.v1.HotConfig
+	93,  // 39: powergate.user.v1.StorageConfig.cold:type_name -> powergate.user.v1.ColdConfig
+	95,  // 40: powergate.user.v1.HotInfo.ipfs:type_name -> powergate.user.v1.IpfsHotInfo
+	2,   // 41: powergate.user.v1.FilStorage.state:type_name -> powergate.user.v1.DealState
+	97,  // 42: powergate.user.v1.FilInfo.proposals:type_name -> powergate.user.v1.FilStorage
+	98,  // 43: powergate.user.v1.ColdInfo.filecoin:type_name -> powergate.user.v1.FilInfo
+	96,  // 44: powergate.user.v1.StorageInfo.hot:type_name -> powergate.user.v1.HotInfo
+	99,  // 45: powergate.user.v1.StorageInfo.cold:type_name -> powergate.user.v1.ColdInfo
+	94,  // 46: powergate.user.v1.CidInfo.latest_pushed_storage_config:type_name -> powergate.user.v1.StorageConfig
+	100, // 47: powergate.user.v1.CidInfo.current_storage_info:type_name -> powergate.user.v1.StorageInfo
+	103, // 48: powergate.user.v1.CidInfo.queued_storage_jobs:type_name -> powergate.user.v1.StorageJob
+	103, // 49: powergate.user.v1.CidInfo.executing_storage_job:type_name -> powergate.user.v1.StorageJob
+	103, // 50: powergate.user.v1.CidInfo.latest_final_storage_job:type_name -> powergate.user.v1.StorageJob
+	103, // 51: powergate.user.v1.CidInfo.latest_successful_storage_job:type_name -> powergate.user.v1.StorageJob
+	3,   // 52: powergate.user.v1.StorageJob.status:type_name -> powergate.user.v1.JobStatus
+	102, // 53: powergate.user.v1.StorageJob.deal_info:type_name -> powergate.user.v1.DealInfo
+	105, // 54: powergate.user.v1.StorageJob.deal_errors:type_name -> powergate.user.v1.DealError
+	104, // 55: powergate.user.v1.StorageJob.steps:type_name -> powergate.user.v1.JobStep
+	4,   // 56: powergate.user.v1.LogEntry.level:type_name -> powergate.user.v1.LogLevel
+	114, // 57: powergate.user.v1.LogEntry.fields:type_name -> powergate.user.v1.LogEntry.FieldsEntry
+	5,   // 58: powergate.user.v1.DealRecordsExportConfig.kind:type_name -> powergate.user.v1.DealRecordsKind
+	6,   // 59: powergate.user.v1.DealRecordsExportConfig.format:type_name -> powergate.user.v1.ExportFormat
+	110, // 60: powergate.user.v1.StorageDealRecord.deal_info:type_name -> powergate.user.v1.StorageDealInfo
+	112, // 61: powergate.user.v1.RetrievalDealRecord.deal_info:type_name -> powergate.user.v1.RetrievalDealInfo
+	7,   // 62: powergate.user.v1.RetrievalDealRecord.status:type_name -> powergate.user.v1.RetrievalDealStatus
+	8,   // 63: powergate.user.v1.UserService.BuildInfo:input_type -> powergate.user.v1.BuildInfoRequest
+	10,  // 64: powergate.user.v1.UserService.UserIdentifier:input_type -> powergate.user.v1.UserIdentifierRequest
+	12,  // 65: powergate.user.v1.UserService.DefaultStorageConfig:input_type -> powergate.user.v1.DefaultStorageConfigRequest
+	14,  // 66: powergate.user.v1.UserService.SetDefaultStorageConfig:input_type -> powergate.user.v1.SetDefaultStorageConfigRequest
+	18,  // 67: powergate.user.v1.UserService.ApplyStorageConfig:input_type -> powergate.user.v1.ApplyStorageConfigRequest
+	26,  // 68: powergate.user.v1.UserService.Remove:input_type -> powergate.user.v1.RemoveRequest
+	16,  // 69: powergate.user.v1.UserService.Stage:input_type -> powergate.user.v1.StageRequest
+	20,  // 70: powergate.user.v1.UserService.ReplaceData:input_type -> powergate.user.v1.ReplaceDataRequest
+	22,  // 71: powergate.user.v1.UserService.Get:input_type -> powergate.user.v1.GetRequest
+	24,  // 72: powergate.user.v1.UserService.GetCAR:input_type -> powergate.user.v1.GetCARRequest
+	28,  // 73: powergate.user.v1.UserService.WatchLogs:input_type -> powergate.user.v1.WatchLogsRequest
+	30,  // 74: powergate.user.v1.UserService.Logs:input_type -> powergate.user.v1.LogsRequest
+	32,  // 75: powergate.user.v1.UserService.CidInfo:input_type -> powergate.user.v1.CidInfoRequest
+	34,  // 76: powergate.user.v1.UserService.Balance:input_type -> powergate.user.v1.BalanceRequest
+	36,  // 77: powergate.user.v1.UserService.NewAddress:input_type -> powergate.user.v1.NewAddressRequest
+	38,  // 78: powergate.user.v1.UserService.Addresses:input_type -> powergate.user.v1.AddressesRequest
+	40,  // 79: powergate.user.v1.UserService.SendFil:input_type -> powergate.user.v1.SendFilRequest
+	42,  // 80: powergate.user.v1.UserService.SignMessage:input_type -> powergate.user.v1.SignMessageRequest
+	44,  // 81: powergate.user.v1.UserService.VerifyMessage:input_type -> powergate.user.v1.VerifyMessageRequest
+	46,  // 82: powergate.user.v1.UserService.Transactions:input_type -> powergate.user.v1.TransactionsRequest
+	48,  // 83: powergate.user.v1.UserService.NewMultisigAddress:input_type -> powergate.user.v1.NewMultisigAddressRequest
+	50,  // 84: powergate.user.v1.UserService.MultisigPending:input_type -> powergate.user.v1.MultisigPendingRequest
+	52,  // 85: powergate.user.v1.UserService.MultisigPropose:input_type -> powergate.user.v1.MultisigProposeRequest
+	54,  // 86: powergate.user.v1.UserService.MultisigProposeEscrowTopUp:input_type -> powergate.user.v1.MultisigProposeEscrowTopUpRequest
+	56,  // 87: powergate.user.v1.UserService.MultisigApprove:input_type -> powergate.user.v1.MultisigApproveRequest
+	60,  // 88: powergate.user.v1.UserService.StorageJob:input_type -> powergate.user.v1.StorageJobRequest
+	62,  // 89: powergate.user.v1.UserService.StorageConfigForJob:input_type -> powergate.user.v1.StorageConfigForJobRequest
+	64,  // 90: powergate.user.v1.UserService.QueuedStorageJobs:input_type -> powergate.user.v1.QueuedStorageJobsRequest
+	66,  // 91: powergate.user.v1.UserService.ExecutingStorageJobs:input_type -> powergate.user.v1.ExecutingStorageJobsRequest
+	68,  // 92: powergate.user.v1.UserService.LatestFinalStorageJobs:input_type -> powergate.user.v1.LatestFinalStorageJobsRequest
+	70,  // 93: powergate.user.v1.UserService.LatestSuccessfulStorageJobs:input_type -> powergate.user.v1.LatestSuccessfulStorageJobsRequest
+	72,  // 94: powergate.user.v1.UserService.StorageJobsSummary:input_type -> powergate.user.v1.StorageJobsSummaryRequest
+	74,  // 95: powergate.user.v1.UserService.WatchStorageJobs:input_type -> powergate.user.v1.WatchStorageJobsRequest
+	58,  // 96: powergate.user.v1.UserService.CancelStorageJob:input_type -> powergate.user.v1.CancelStorageJobRequest
+	76,  // 97: powergate.user.v1.UserService.StorageDealRecords:input_type -> powergate.user.v1.StorageDealRecordsRequest
+	78,  // 98: powergate.user.v1.UserService.RetrievalDealRecords:input_type -> powergate.user.v1.RetrievalDealRecordsRequest
+	80,  // 99: powergate.user.v1.UserService.ExportDealRecords:input_type -> powergate.user.v1.ExportDealRecordsRequest
+	9,   // 100: powergate.user.v1.UserService.BuildInfo:output_type -> powergate.user.v1.BuildInfoResponse
+	11,  // 101: powergate.user.v1.UserService.UserIdentifier:output_type -> powergate.user.v1.UserIdentifierResponse
+	13,  // 102: powergate.user.v1.UserService.DefaultStorageConfig:output_type -> powergate.user.v1.DefaultStorageConfigResponse
+	15,  // 103: powergate.user.v1.UserService.SetDefaultStorageConfig:output_type -> powergate.user.v1.SetDefaultStorageConfigResponse
+	19,  // 104: powergate.user.v1.UserService.ApplyStorageConfig:output_type -> powergate.user.v1.ApplyStorageConfigResponse
+	27,  // 105: powergate.user.v1.UserService.Remove:output_type -> powergate.user.v1.RemoveResponse
+	17,  // 106: powergate.user.v1.UserService.Stage:output_type -> powergate.user.v1.StageResponse
+	21,  // 107: powergate.user.v1.UserService.ReplaceData:output_type -> powergate.user.v1.ReplaceDataResponse
+	23,  // 108: powergate.user.v1.UserService.Get:output_type -> powergate.user.v1.GetResponse
+	25,  // 109: powergate.user.v1.UserService.GetCAR:output_type -> powergate.user.v1.GetCARResponse
+	29,  // 110: powergate.user.v1.UserService.WatchLogs:output_type -> powergate.user.v1.WatchLogsResponse
+	31,  // 111: powergate.user.v1.UserService.Logs:output_type -> powergate.user.v1.LogsResponse
+	33,  // 112: powergate.user.v1.UserService.CidInfo:output_type -> powergate.user.v1.CidInfoResponse
+	35,  // 113: powergate.user.v1.UserService.Balance:output_type -> powergate.user.v1.BalanceResponse
+	37,  // 114: powergate.user.v1.UserService.NewAddress:output_type -> powergate.user.v1.NewAddressResponse
+	39,  // 115: powergate.user.v1.UserService.Addresses:output_type -> powergate.user.v1.AddressesResponse
+	41,  // 116: powergate.user.v1.UserService.SendFil:output_type -> powergate.user.v1.SendFilResponse
+	43,  // 117: powergate.user.v1.UserService.SignMessage:output_type -> powergate.user.v1.SignMessageResponse
+	45,  // 118: powergate.user.v1.UserService.VerifyMessage:output_type -> powergate.user.v1.VerifyMessageResponse
+	47,  // 119: powergate.user.v1.UserService.Transactions:output_type -> powergate.user.v1.TransactionsResponse
+	49,  // 120: powergate.user.v1.UserService.NewMultisigAddress:output_type -> powergate.user.v1.NewMultisigAddressResponse
+	51,  // 121: powergate.user.v1.UserService.MultisigPending:output_type -> powergate.user.v1.MultisigPendingResponse
+	53,  // 122: powergate.user.v1.UserService.MultisigPropose:output_type -> powergate.user.v1.MultisigProposeResponse
+	55,  // 123: powergate.user.v1.UserService.MultisigProposeEscrowTopUp:output_type -> powergate.user.v1.MultisigProposeEscrowTopUpResponse
+	57,  // 124: powergate.user.v1.UserService.MultisigApprove:output_type -> powergate.user.v1.MultisigApproveResponse
+	61,  // 125: powergate.user.v1.UserService.StorageJob:output_type -> powergate.user.v1.StorageJobResponse
+	63,  // 126: powergate.user.v1.UserService.StorageConfigForJob:output_type -> powergate.user.v1.StorageConfigForJobResponse
+	65,  // 127: powergate.user.v1.UserService.QueuedStorageJobs:output_type -> powergate.user.v1.QueuedStorageJobsResponse
+	67,  // 128: powergate.user.v1.UserService.ExecutingStorageJobs:output_type -> powergate.user.v1.ExecutingStorageJobsResponse
+	69,  // 129: powergate.user.v1.UserService.LatestFinalStorageJobs:output_type -> powergate.user.v1.LatestFinalStorageJobsResponse
+	71,  // 130: powergate.user.v1.UserService.LatestSuccessfulStorageJobs:output_type -> powergate.user.v1.LatestSuccessfulStorageJobsResponse
+	73,  // 131: powergate.user.v1.UserService.StorageJobsSummary:output_type -> powergate.user.v1.StorageJobsSummaryResponse
+	75,  // 132: powergate.user.v1.UserService.WatchStorageJobs:output_type -> powergate.user.v1.WatchStorageJobsResponse
+	59,  // 133: powergate.user.v1.UserService.CancelStorageJob:output_type -> powergate.user.v1.CancelStorageJobResponse
+	77,  // 134: powergate.user.v1.UserService.StorageDealRecords:output_type -> powergate.user.v1.StorageDealRecordsResponse
+	79,  // 135: powergate.user.v1.UserService.RetrievalDealRecords:output_type -> powergate.user.v1.RetrievalDealRecordsResponse
+	81,  // 136: powergate.user.v1.UserService.ExportDealRecords:output_type -> powergate.user.v1.ExportDealRecordsResponse
+	100, // [100:137] is the sub-list for method output_type
+	63,  // [63:100] is the sub-list for method input_type
+	63,  // [63:63] is the sub-list for extension type_name
+	63,  // [63:63] is the sub-list for extension extendee
+	0,   // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_powergate_user_v1_user_proto_init() }
@@ -5682,8 +8519,320 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildInfoResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdentifierRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdentifierResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultStorageConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultStorageConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDefaultStorageConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDefaultStorageConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyStorageConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyStorageConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCARRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCARResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CidInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CidInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_user_v1_user_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5694,8 +8843,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIdentifierRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5706,8 +8855,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIdentifierResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5718,8 +8867,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultStorageConfigRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5730,8 +8879,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultStorageConfigResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5742,8 +8891,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDefaultStorageConfigRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFilRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5754,8 +8903,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDefaultStorageConfigResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFilResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5766,8 +8915,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StageRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5778,8 +8927,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StageResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5790,8 +8939,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyStorageConfigRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5802,8 +8951,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyStorageConfigResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5814,8 +8963,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplaceDataRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5826,8 +8975,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplaceDataResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5838,8 +8987,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewMultisigAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5850,8 +8999,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewMultisigAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5862,8 +9011,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigPendingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5874,8 +9023,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigPendingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5886,8 +9035,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchLogsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigProposeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5898,8 +9047,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchLogsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigProposeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5910,8 +9059,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CidInfoRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigProposeEscrowTopUpRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5922,8 +9071,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CidInfoResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigProposeEscrowTopUpResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5934,8 +9083,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalanceRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigApproveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5946,8 +9095,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalanceResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigApproveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5958,8 +9107,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAddressRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelStorageJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5970,8 +9119,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAddressResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelStorageJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5982,8 +9131,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressesRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5994,8 +9143,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressesResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6006,8 +9155,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFilRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageConfigForJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6018,8 +9167,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFilResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageConfigForJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6030,8 +9179,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignMessageRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedStorageJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6042,8 +9191,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignMessageResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedStorageJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6054,8 +9203,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyMessageRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutingStorageJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6066,8 +9215,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyMessageResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutingStorageJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6078,8 +9227,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelStorageJobRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatestFinalStorageJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6090,8 +9239,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelStorageJobResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatestFinalStorageJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6102,8 +9251,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageJobRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatestSuccessfulStorageJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6114,8 +9263,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageJobResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatestSuccessfulStorageJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6126,8 +9275,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageConfigForJobRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageJobsSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6138,8 +9287,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageConfigForJobResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageJobsSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6150,8 +9299,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueuedStorageJobsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStorageJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6162,8 +9311,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueuedStorageJobsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStorageJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6174,8 +9323,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutingStorageJobsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageDealRecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6186,8 +9335,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutingStorageJobsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageDealRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6198,8 +9347,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestFinalStorageJobsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrievalDealRecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6210,8 +9359,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestFinalStorageJobsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrievalDealRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6222,8 +9371,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestSuccessfulStorageJobsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDealRecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6234,8 +9383,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestSuccessfulStorageJobsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDealRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6246,8 +9395,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageJobsSummaryRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobCounts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6258,8 +9407,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageJobsSummaryResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6270,8 +9419,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchStorageJobsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6282,8 +9431,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchStorageJobsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6294,8 +9443,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageDealRecordsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6306,8 +9455,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageDealRecordsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6318,8 +9467,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrievalDealRecordsRequest); i {
+		file_powergate_user_v1_user_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IpfsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6330,8 +9479,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrievalDealRecordsResponse); i {
+		file_powergate_user_v1_user_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HotConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6342,8 +9491,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobCounts); i {
+		file_powergate_user_v1_user_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilRenew); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6354,8 +9503,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilEscalation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6366,8 +9515,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IpfsConfig); i {
+		file_powergate_user_v1_user_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6378,8 +9527,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HotConfig); i {
+		file_powergate_user_v1_user_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColdConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6390,8 +9539,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilRenew); i {
+		file_powergate_user_v1_user_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6402,8 +9551,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilConfig); i {
+		file_powergate_user_v1_user_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IpfsHotInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6414,8 +9563,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColdConfig); i {
+		file_powergate_user_v1_user_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HotInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6426,8 +9575,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageConfig); i {
+		file_powergate_user_v1_user_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilStorage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6438,8 +9587,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IpfsHotInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6450,8 +9599,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HotInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColdInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6462,8 +9611,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilStorage); i {
+		file_powergate_user_v1_user_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6474,8 +9623,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CidInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6486,8 +9635,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColdInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DealInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6498,8 +9647,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6510,8 +9659,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CidInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6522,8 +9671,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DealInfo); i {
+		file_powergate_user_v1_user_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DealError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6534,8 +9683,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageJob); i {
+		file_powergate_user_v1_user_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6546,8 +9695,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DealError); i {
+		file_powergate_user_v1_user_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DealRecordsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6558,8 +9707,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogEntry); i {
+		file_powergate_user_v1_user_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DealRecordsSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6570,8 +9719,8 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DealRecordsConfig); i {
+		file_powergate_user_v1_user_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DealRecordsExportConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6582,7 +9731,7 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_user_v1_user_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StorageDealInfo); i {
 			case 0:
 				return &v.state
@@ -6594,7 +9743,7 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_user_v1_user_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StorageDealRecord); i {
 			case 0:
 				return &v.state
@@ -6606,7 +9755,7 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_user_v1_user_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RetrievalDealInfo); i {
 			case 0:
 				return &v.state
@@ -6618,7 +9767,7 @@ func file_powergate_user_v1_user_proto_init() {
 				return nil
 			}
 		}
-		file_powergate_user_v1_user_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_powergate_user_v1_user_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RetrievalDealRecord); i {
 			case 0:
 				return &v.state
@@ -6636,8 +9785,8 @@ func file_powergate_user_v1_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_powergate_user_v1_user_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   80,
+			NumEnums:      8,
+			NumMessages:   107,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
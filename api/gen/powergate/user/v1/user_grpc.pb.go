@@ -29,7 +29,9 @@ type UserServiceClient interface {
 	Stage(ctx context.Context, opts ...grpc.CallOption) (UserService_StageClient, error)
 	ReplaceData(ctx context.Context, in *ReplaceDataRequest, opts ...grpc.CallOption) (*ReplaceDataResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (UserService_GetClient, error)
+	GetCAR(ctx context.Context, in *GetCARRequest, opts ...grpc.CallOption) (UserService_GetCARClient, error)
 	WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (UserService_WatchLogsClient, error)
+	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (*LogsResponse, error)
 	CidInfo(ctx context.Context, in *CidInfoRequest, opts ...grpc.CallOption) (*CidInfoResponse, error)
 	// Wallet
 	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
@@ -38,6 +40,12 @@ type UserServiceClient interface {
 	SendFil(ctx context.Context, in *SendFilRequest, opts ...grpc.CallOption) (*SendFilResponse, error)
 	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
 	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	Transactions(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (*TransactionsResponse, error)
+	NewMultisigAddress(ctx context.Context, in *NewMultisigAddressRequest, opts ...grpc.CallOption) (*NewMultisigAddressResponse, error)
+	MultisigPending(ctx context.Context, in *MultisigPendingRequest, opts ...grpc.CallOption) (*MultisigPendingResponse, error)
+	MultisigPropose(ctx context.Context, in *MultisigProposeRequest, opts ...grpc.CallOption) (*MultisigProposeResponse, error)
+	MultisigProposeEscrowTopUp(ctx context.Context, in *MultisigProposeEscrowTopUpRequest, opts ...grpc.CallOption) (*MultisigProposeEscrowTopUpResponse, error)
+	MultisigApprove(ctx context.Context, in *MultisigApproveRequest, opts ...grpc.CallOption) (*MultisigApproveResponse, error)
 	// Storage Jobs
 	StorageJob(ctx context.Context, in *StorageJobRequest, opts ...grpc.CallOption) (*StorageJobResponse, error)
 	StorageConfigForJob(ctx context.Context, in *StorageConfigForJobRequest, opts ...grpc.CallOption) (*StorageConfigForJobResponse, error)
@@ -51,6 +59,7 @@ type UserServiceClient interface {
 	// Deals
 	StorageDealRecords(ctx context.Context, in *StorageDealRecordsRequest, opts ...grpc.CallOption) (*StorageDealRecordsResponse, error)
 	RetrievalDealRecords(ctx context.Context, in *RetrievalDealRecordsRequest, opts ...grpc.CallOption) (*RetrievalDealRecordsResponse, error)
+	ExportDealRecords(ctx context.Context, in *ExportDealRecordsRequest, opts ...grpc.CallOption) (UserService_ExportDealRecordsClient, error)
 }
 
 type userServiceClient struct {
@@ -190,8 +199,40 @@ func (x *userServiceGetClient) Recv() (*GetResponse, error) {
 	return m, nil
 }
 
+func (c *userServiceClient) GetCAR(ctx context.Context, in *GetCARRequest, opts ...grpc.CallOption) (UserService_GetCARClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[2], "/powergate.user.v1.UserService/GetCAR", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceGetCARClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_GetCARClient interface {
+	Recv() (*GetCARResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceGetCARClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceGetCARClient) Recv() (*GetCARResponse, error) {
+	m := new(GetCARResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *userServiceClient) WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (UserService_WatchLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[2], "/powergate.user.v1.UserService/WatchLogs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/WatchLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -222,6 +263,15 @@ func (x *userServiceWatchLogsClient) Recv() (*WatchLogsResponse, error) {
 	return m, nil
 }
 
+func (c *userServiceClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (*LogsResponse, error) {
+	out := new(LogsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/Logs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CidInfo(ctx context.Context, in *CidInfoRequest, opts ...grpc.CallOption) (*CidInfoResponse, error) {
 	out := new(CidInfoResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/CidInfo", in, out, opts...)
@@ -285,6 +335,60 @@ func (c *userServiceClient) VerifyMessage(ctx context.Context, in *VerifyMessage
 	return out, nil
 }
 
+func (c *userServiceClient) Transactions(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (*TransactionsResponse, error) {
+	out := new(TransactionsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/Transactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) NewMultisigAddress(ctx context.Context, in *NewMultisigAddressRequest, opts ...grpc.CallOption) (*NewMultisigAddressResponse, error) {
+	out := new(NewMultisigAddressResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/NewMultisigAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) MultisigPending(ctx context.Context, in *MultisigPendingRequest, opts ...grpc.CallOption) (*MultisigPendingResponse, error) {
+	out := new(MultisigPendingResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/MultisigPending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) MultisigPropose(ctx context.Context, in *MultisigProposeRequest, opts ...grpc.CallOption) (*MultisigProposeResponse, error) {
+	out := new(MultisigProposeResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/MultisigPropose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) MultisigProposeEscrowTopUp(ctx context.Context, in *MultisigProposeEscrowTopUpRequest, opts ...grpc.CallOption) (*MultisigProposeEscrowTopUpResponse, error) {
+	out := new(MultisigProposeEscrowTopUpResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/MultisigProposeEscrowTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) MultisigApprove(ctx context.Context, in *MultisigApproveRequest, opts ...grpc.CallOption) (*MultisigApproveResponse, error) {
+	out := new(MultisigApproveResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/MultisigApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) StorageJob(ctx context.Context, in *StorageJobRequest, opts ...grpc.CallOption) (*StorageJobResponse, error) {
 	out := new(StorageJobResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/StorageJob", in, out, opts...)
@@ -349,7 +453,7 @@ func (c *userServiceClient) StorageJobsSummary(ctx context.Context, in *StorageJ
 }
 
 func (c *userServiceClient) WatchStorageJobs(ctx context.Context, in *WatchStorageJobsRequest, opts ...grpc.CallOption) (UserService_WatchStorageJobsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[4], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +511,38 @@ func (c *userServiceClient) RetrievalDealRecords(ctx context.Context, in *Retrie
 	return out, nil
 }
 
+func (c *userServiceClient) ExportDealRecords(ctx context.Context, in *ExportDealRecordsRequest, opts ...grpc.CallOption) (UserService_ExportDealRecordsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[5], "/powergate.user.v1.UserService/ExportDealRecords", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceExportDealRecordsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_ExportDealRecordsClient interface {
+	Recv() (*ExportDealRecordsResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceExportDealRecordsClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceExportDealRecordsClient) Recv() (*ExportDealRecordsResponse, error) {
+	m := new(ExportDealRecordsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -423,7 +559,9 @@ type UserServiceServer interface {
 	Stage(UserService_StageServer) error
 	ReplaceData(context.Context, *ReplaceDataRequest) (*ReplaceDataResponse, error)
 	Get(*GetRequest, UserService_GetServer) error
+	GetCAR(*GetCARRequest, UserService_GetCARServer) error
 	WatchLogs(*WatchLogsRequest, UserService_WatchLogsServer) error
+	Logs(context.Context, *LogsRequest) (*LogsResponse, error)
 	CidInfo(context.Context, *CidInfoRequest) (*CidInfoResponse, error)
 	// Wallet
 	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
@@ -432,6 +570,12 @@ type UserServiceServer interface {
 	SendFil(context.Context, *SendFilRequest) (*SendFilResponse, error)
 	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
 	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	Transactions(context.Context, *TransactionsRequest) (*TransactionsResponse, error)
+	NewMultisigAddress(context.Context, *NewMultisigAddressRequest) (*NewMultisigAddressResponse, error)
+	MultisigPending(context.Context, *MultisigPendingRequest) (*MultisigPendingResponse, error)
+	MultisigPropose(context.Context, *MultisigProposeRequest) (*MultisigProposeResponse, error)
+	MultisigProposeEscrowTopUp(context.Context, *MultisigProposeEscrowTopUpRequest) (*MultisigProposeEscrowTopUpResponse, error)
+	MultisigApprove(context.Context, *MultisigApproveRequest) (*MultisigApproveResponse, error)
 	// Storage Jobs
 	StorageJob(context.Context, *StorageJobRequest) (*StorageJobResponse, error)
 	StorageConfigForJob(context.Context, *StorageConfigForJobRequest) (*StorageConfigForJobResponse, error)
@@ -445,6 +589,7 @@ type UserServiceServer interface {
 	// Deals
 	StorageDealRecords(context.Context, *StorageDealRecordsRequest) (*StorageDealRecordsResponse, error)
 	RetrievalDealRecords(context.Context, *RetrievalDealRecordsRequest) (*RetrievalDealRecordsResponse, error)
+	ExportDealRecords(*ExportDealRecordsRequest, UserService_ExportDealRecordsServer) error
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -479,9 +624,15 @@ func (UnimplementedUserServiceServer) ReplaceData(context.Context, *ReplaceDataR
 func (UnimplementedUserServiceServer) Get(*GetRequest, UserService_GetServer) error {
 	return status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedUserServiceServer) GetCAR(*GetCARRequest, UserService_GetCARServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetCAR not implemented")
+}
 func (UnimplementedUserServiceServer) WatchLogs(*WatchLogsRequest, UserService_WatchLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchLogs not implemented")
 }
+func (UnimplementedUserServiceServer) Logs(context.Context, *LogsRequest) (*LogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
 func (UnimplementedUserServiceServer) CidInfo(context.Context, *CidInfoRequest) (*CidInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CidInfo not implemented")
 }
@@ -503,6 +654,24 @@ func (UnimplementedUserServiceServer) SignMessage(context.Context, *SignMessageR
 func (UnimplementedUserServiceServer) VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyMessage not implemented")
 }
+func (UnimplementedUserServiceServer) Transactions(context.Context, *TransactionsRequest) (*TransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transactions not implemented")
+}
+func (UnimplementedUserServiceServer) NewMultisigAddress(context.Context, *NewMultisigAddressRequest) (*NewMultisigAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewMultisigAddress not implemented")
+}
+func (UnimplementedUserServiceServer) MultisigPending(context.Context, *MultisigPendingRequest) (*MultisigPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigPending not implemented")
+}
+func (UnimplementedUserServiceServer) MultisigPropose(context.Context, *MultisigProposeRequest) (*MultisigProposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigPropose not implemented")
+}
+func (UnimplementedUserServiceServer) MultisigProposeEscrowTopUp(context.Context, *MultisigProposeEscrowTopUpRequest) (*MultisigProposeEscrowTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigProposeEscrowTopUp not implemented")
+}
+func (UnimplementedUserServiceServer) MultisigApprove(context.Context, *MultisigApproveRequest) (*MultisigApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigApprove not implemented")
+}
 func (UnimplementedUserServiceServer) StorageJob(context.Context, *StorageJobRequest) (*StorageJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageJob not implemented")
 }
@@ -536,6 +705,9 @@ func (UnimplementedUserServiceServer) StorageDealRecords(context.Context, *Stora
 func (UnimplementedUserServiceServer) RetrievalDealRecords(context.Context, *RetrievalDealRecordsRequest) (*RetrievalDealRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrievalDealRecords not implemented")
 }
+func (UnimplementedUserServiceServer) ExportDealRecords(*ExportDealRecordsRequest, UserService_ExportDealRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDealRecords not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -722,6 +894,27 @@ func (x *userServiceGetServer) Send(m *GetResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _UserService_GetCAR_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCARRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).GetCAR(m, &userServiceGetCARServer{stream})
+}
+
+type UserService_GetCARServer interface {
+	Send(*GetCARResponse) error
+	grpc.ServerStream
+}
+
+type userServiceGetCARServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceGetCARServer) Send(m *GetCARResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _UserService_WatchLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(WatchLogsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -743,6 +936,24 @@ func (x *userServiceWatchLogsServer) Send(m *WatchLogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _UserService_Logs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Logs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/Logs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Logs(ctx, req.(*LogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CidInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CidInfoRequest)
 	if err := dec(in); err != nil {
@@ -869,6 +1080,114 @@ func _UserService_VerifyMessage_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_Transactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Transactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/Transactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Transactions(ctx, req.(*TransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_NewMultisigAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewMultisigAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).NewMultisigAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/NewMultisigAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).NewMultisigAddress(ctx, req.(*NewMultisigAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_MultisigPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultisigPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MultisigPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/MultisigPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MultisigPending(ctx, req.(*MultisigPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_MultisigPropose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultisigProposeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MultisigPropose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/MultisigPropose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MultisigPropose(ctx, req.(*MultisigProposeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_MultisigProposeEscrowTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultisigProposeEscrowTopUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MultisigProposeEscrowTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/MultisigProposeEscrowTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MultisigProposeEscrowTopUp(ctx, req.(*MultisigProposeEscrowTopUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_MultisigApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultisigApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MultisigApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/MultisigApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MultisigApprove(ctx, req.(*MultisigApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_StorageJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StorageJobRequest)
 	if err := dec(in); err != nil {
@@ -1070,6 +1389,27 @@ func _UserService_RetrievalDealRecords_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExportDealRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDealRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).ExportDealRecords(m, &userServiceExportDealRecordsServer{stream})
+}
+
+type UserService_ExportDealRecordsServer interface {
+	Send(*ExportDealRecordsResponse) error
+	grpc.ServerStream
+}
+
+type userServiceExportDealRecordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceExportDealRecordsServer) Send(m *ExportDealRecordsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _UserService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "powergate.user.v1.UserService",
 	HandlerType: (*UserServiceServer)(nil),
@@ -1102,6 +1442,10 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReplaceData",
 			Handler:    _UserService_ReplaceData_Handler,
 		},
+		{
+			MethodName: "Logs",
+			Handler:    _UserService_Logs_Handler,
+		},
 		{
 			MethodName: "CidInfo",
 			Handler:    _UserService_CidInfo_Handler,
@@ -1130,6 +1474,30 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyMessage",
 			Handler:    _UserService_VerifyMessage_Handler,
 		},
+		{
+			MethodName: "Transactions",
+			Handler:    _UserService_Transactions_Handler,
+		},
+		{
+			MethodName: "NewMultisigAddress",
+			Handler:    _UserService_NewMultisigAddress_Handler,
+		},
+		{
+			MethodName: "MultisigPending",
+			Handler:    _UserService_MultisigPending_Handler,
+		},
+		{
+			MethodName: "MultisigPropose",
+			Handler:    _UserService_MultisigPropose_Handler,
+		},
+		{
+			MethodName: "MultisigProposeEscrowTopUp",
+			Handler:    _UserService_MultisigProposeEscrowTopUp_Handler,
+		},
+		{
+			MethodName: "MultisigApprove",
+			Handler:    _UserService_MultisigApprove_Handler,
+		},
 		{
 			MethodName: "StorageJob",
 			Handler:    _UserService_StorageJob_Handler,
@@ -1182,6 +1550,11 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UserService_Get_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetCAR",
+			Handler:       _UserService_GetCAR_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "WatchLogs",
 			Handler:       _UserService_WatchLogs_Handler,
@@ -1192,6 +1565,11 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UserService_WatchStorageJobs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportDealRecords",
+			Handler:       _UserService_ExportDealRecords_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "powergate/user/v1/user.proto",
 }
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"bufio"
+
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/backup"
+	"github.com/textileio/powergate/ffs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Backup streams an archive of a consistent snapshot of the datastore. If
+// a user id is provided, only that user data is included.
+func (a *Service) Backup(req *adminPb.BackupRequest, srv adminPb.AdminService_BackupServer) error {
+	iid := ffs.APIID(req.UserId)
+	if iid != ffs.EmptyInstanceID {
+		if _, err := a.getInstance(req.UserId); err != nil {
+			return err
+		}
+	}
+	w := bufio.NewWriterSize(&backupWriter{srv: srv}, 1024*32)
+	if _, err := backup.Backup(srv.Context(), w, a.ds, iid); err != nil {
+		return status.Errorf(codes.Internal, "creating backup: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "sending backup: %v", err)
+	}
+	return nil
+}
+
+type backupWriter struct {
+	srv adminPb.AdminService_BackupServer
+}
+
+func (bw *backupWriter) Write(p []byte) (int, error) {
+	if err := bw.srv.Send(&adminPb.BackupResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
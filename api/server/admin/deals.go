@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"bufio"
+
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/api/server/user"
+	"github.com/textileio/powergate/deals/export"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportDealRecords streams the deal records of all users as CSV or
+// Parquet. If a user id is provided, only that user records are included.
+func (a *Service) ExportDealRecords(req *adminPb.ExportDealRecordsRequest, srv adminPb.AdminService_ExportDealRecordsServer) error {
+	var storage export.StorageLister = a.drm.ListStorageDealRecords
+	var retrieval export.RetrievalLister = a.drm.ListRetrievalDealRecords
+	if req.UserId != "" {
+		i, err := a.getInstance(req.UserId)
+		if err != nil {
+			return err
+		}
+		storage = i.StorageDealRecords
+		retrieval = i.RetrievalDealRecords
+	}
+	w := bufio.NewWriterSize(&exportWriter{srv: srv}, 1024*32)
+	if err := user.ExportDealRecords(srv.Context(), w, req.Config, storage, retrieval); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "sending export: %v", err)
+	}
+	return nil
+}
+
+type exportWriter struct {
+	srv adminPb.AdminService_ExportDealRecordsServer
+}
+
+func (ew *exportWriter) Write(p []byte) (int, error) {
+	if err := ew.srv.Send(&adminPb.ExportDealRecordsResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PurgeLogs deletes job logs older than the requested age in seconds. If
+// a cid is provided, only logs of the cid are deleted.
+func (a *Service) PurgeLogs(ctx context.Context, req *adminPb.PurgeLogsRequest) (*adminPb.PurgeLogsResponse, error) {
+	if req.OlderThan < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "older than can't be negative")
+	}
+	c := cid.Undef
+	if req.Cid != "" {
+		var err error
+		c, err = util.CidFromString(req.Cid)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing cid: %v", err)
+		}
+	}
+	before := time.Now().Add(-time.Second * time.Duration(req.OlderThan))
+	deleted, err := a.l.Purge(ctx, c, before)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "purging logs: %v", err)
+	}
+	return &adminPb.PurgeLogsResponse{Deleted: int64(deleted)}, nil
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"sort"
+
+	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetCountryOverride pins the country of a miner.
+func (a *Service) SetCountryOverride(ctx context.Context, req *adminPb.SetCountryOverrideRequest) (*adminPb.SetCountryOverrideResponse, error) {
+	if err := a.mi.SetCountryOverride(req.MinerAddress, req.Country); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "setting country override: %v", err)
+	}
+	return &adminPb.SetCountryOverrideResponse{}, nil
+}
+
+// RemoveCountryOverride removes the pinned country of a miner.
+func (a *Service) RemoveCountryOverride(ctx context.Context, req *adminPb.RemoveCountryOverrideRequest) (*adminPb.RemoveCountryOverrideResponse, error) {
+	if err := a.mi.RemoveCountryOverride(req.MinerAddress); err != nil {
+		return nil, status.Errorf(codes.Internal, "removing country override: %v", err)
+	}
+	return &adminPb.RemoveCountryOverrideResponse{}, nil
+}
+
+// CountryOverrides lists all pinned miner countries.
+func (a *Service) CountryOverrides(ctx context.Context, req *adminPb.CountryOverridesRequest) (*adminPb.CountryOverridesResponse, error) {
+	overrides := a.mi.CountryOverrides()
+	res := make([]*adminPb.CountryOverride, 0, len(overrides))
+	for addr, country := range overrides {
+		res = append(res, &adminPb.CountryOverride{
+			MinerAddress: addr,
+			Country:      country,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].MinerAddress < res[j].MinerAddress })
+	return &adminPb.CountryOverridesResponse{
+		CountryOverrides: res,
+	}, nil
+}
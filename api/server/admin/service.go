@@ -1,25 +1,56 @@
 package admin
 
 import (
+	"github.com/ipfs/go-datastore"
 	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/api"
+	"github.com/textileio/powergate/ffs/fundsmonitor"
+	"github.com/textileio/powergate/ffs/joblogger"
 	"github.com/textileio/powergate/ffs/manager"
 	"github.com/textileio/powergate/ffs/scheduler"
+	minerModule "github.com/textileio/powergate/index/miner/module"
 	"github.com/textileio/powergate/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Service implements the Admin API.
 type Service struct {
 	adminPb.UnimplementedAdminServiceServer
-	m  *manager.Manager
-	s  *scheduler.Scheduler
-	wm wallet.Module
+	m   *manager.Manager
+	s   *scheduler.Scheduler
+	wm  wallet.Module
+	mi  *minerModule.Index
+	fm  *fundsmonitor.Monitor
+	ds  datastore.TxnDatastore
+	l   *joblogger.Logger
+	drm ffs.DealRecordsManager
 }
 
-// New creates a new AdminService.
-func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module) *Service {
+// New creates a new AdminService. The funds monitor is optional. ds is the
+// root datastore of the server, used for backups. l is the job logger,
+// used to purge logs. drm provides the deal records of all users.
+func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, mi *minerModule.Index, fm *fundsmonitor.Monitor, ds datastore.TxnDatastore, l *joblogger.Logger, drm ffs.DealRecordsManager) *Service {
 	return &Service{
-		m:  m,
-		s:  s,
-		wm: wm,
+		m:   m,
+		s:   s,
+		wm:  wm,
+		mi:  mi,
+		fm:  fm,
+		ds:  ds,
+		l:   l,
+		drm: drm,
 	}
 }
+
+func (a *Service) getInstance(userID string) (*api.API, error) {
+	i, err := a.m.GetByID(ffs.APIID(userID))
+	if err == manager.ErrInstanceNotFound {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", userID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting user: %v", err)
+	}
+	return i, nil
+}
@@ -2,9 +2,20 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"math/big"
+	"sort"
+	"time"
 
+	"github.com/ipfs/go-cid"
 	adminPb "github.com/textileio/powergate/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/api/server/user"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/api"
+	"github.com/textileio/powergate/ffs/fundsmonitor"
+	"github.com/textileio/powergate/ffs/manager"
+	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -37,9 +48,214 @@ func (a *Service) SendFil(ctx context.Context, req *adminPb.SendFilRequest) (*ad
 	if !ok {
 		return nil, status.Errorf(codes.InvalidArgument, "parsing amount %v", req.Amount)
 	}
-	err := a.wm.SendFil(ctx, req.From, req.To, amt)
+	// If the sender address is managed by a user, the transfer
+	// should be allowed by its spend policy.
+	var c cid.Cid
+	i, err := a.m.GetByAddr(req.From)
+	switch {
+	case err == nil:
+		c, err = i.SendFilApproved(ctx, req.From, req.To, amt)
+	case err == manager.ErrInstanceNotFound:
+		c, err = a.wm.SendFil(ctx, req.From, req.To, amt)
+	default:
+		return nil, status.Errorf(codes.Internal, "getting user of sender address: %v", err)
+	}
+	if errors.Is(err, api.ErrSpendPolicyViolation) {
+		return nil, status.Errorf(codes.PermissionDenied, "sending fil: %v", err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "sending fil: %v", err)
 	}
-	return &adminPb.SendFilResponse{}, nil
+	return &adminPb.SendFilResponse{MessageCid: util.CidToString(c)}, nil
+}
+
+// SetSpendPolicy sets the spend policy of a user address.
+func (a *Service) SetSpendPolicy(ctx context.Context, req *adminPb.SetSpendPolicyRequest) (*adminPb.SetSpendPolicyResponse, error) {
+	policy, err := user.FromRPCSpendPolicy(req.SpendPolicy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing spend policy: %v", err)
+	}
+	i, err := a.getInstance(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.SetSpendPolicy(req.Address, policy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "setting spend policy: %v", err)
+	}
+	return &adminPb.SetSpendPolicyResponse{}, nil
+}
+
+// SendRequests lists transfers waiting for approval. If a user id is
+// provided, only requests of that user are returned.
+func (a *Service) SendRequests(ctx context.Context, req *adminPb.SendRequestsRequest) (*adminPb.SendRequestsResponse, error) {
+	var iids []ffs.APIID
+	if req.UserId != "" {
+		iids = append(iids, ffs.APIID(req.UserId))
+	} else {
+		lst, err := a.m.List()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "listing users: %v", err)
+		}
+		for _, auth := range lst {
+			iids = append(iids, auth.APIID)
+		}
+	}
+	var res []*adminPb.SendRequest
+	for _, iid := range iids {
+		i, err := a.getInstance(iid.String())
+		if err != nil {
+			return nil, err
+		}
+		srs, err := i.SendRequests()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "getting send requests: %v", err)
+		}
+		for _, sr := range srs {
+			res = append(res, &adminPb.SendRequest{
+				Id:        sr.ID.String(),
+				UserId:    sr.APIID.String(),
+				From:      sr.From,
+				To:        sr.To,
+				Amount:    sr.Amount.String(),
+				CreatedAt: sr.CreatedAt,
+			})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].CreatedAt < res[j].CreatedAt })
+	return &adminPb.SendRequestsResponse{SendRequests: res}, nil
+}
+
+// ApproveSendRequest approves and sends a transfer waiting for approval.
+func (a *Service) ApproveSendRequest(ctx context.Context, req *adminPb.ApproveSendRequestRequest) (*adminPb.ApproveSendRequestResponse, error) {
+	i, err := a.getInstance(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	c, err := i.ApproveSendRequest(ctx, api.SendRequestID(req.Id))
+	if errors.Is(err, api.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "approving send request: %v", err)
+	}
+	if errors.Is(err, api.ErrSpendPolicyViolation) {
+		return nil, status.Errorf(codes.PermissionDenied, "approving send request: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "approving send request: %v", err)
+	}
+	return &adminPb.ApproveSendRequestResponse{MessageCid: util.CidToString(c)}, nil
+}
+
+// RejectSendRequest rejects a transfer waiting for approval.
+func (a *Service) RejectSendRequest(ctx context.Context, req *adminPb.RejectSendRequestRequest) (*adminPb.RejectSendRequestResponse, error) {
+	i, err := a.getInstance(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	err = i.RejectSendRequest(api.SendRequestID(req.Id))
+	if errors.Is(err, api.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "rejecting send request: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "rejecting send request: %v", err)
+	}
+	return &adminPb.RejectSendRequestResponse{}, nil
+}
+
+// ExportUserKeys exports the private keys of a user addresses encrypted with a passphrase.
+func (a *Service) ExportUserKeys(ctx context.Context, req *adminPb.ExportUserKeysRequest) (*adminPb.ExportUserKeysResponse, error) {
+	if req.Passphrase == "" {
+		return nil, status.Error(codes.InvalidArgument, "passphrase is empty")
+	}
+	i, err := a.getInstance(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := i.ExportKeys(ctx, req.Passphrase)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "exporting keys: %v", err)
+	}
+	res := make([]*adminPb.ExportedKey, len(keys))
+	for j, k := range keys {
+		res[j] = &adminPb.ExportedKey{
+			Name:    k.Name,
+			Address: k.Addr,
+			Type:    k.Type,
+			Key:     k.Key,
+		}
+	}
+	return &adminPb.ExportUserKeysResponse{Keys: res}, nil
+}
+
+// ImportUserKey imports an exported key into the wallet and adds its address to a user.
+func (a *Service) ImportUserKey(ctx context.Context, req *adminPb.ImportUserKeyRequest) (*adminPb.ImportUserKeyResponse, error) {
+	i, err := a.getInstance(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := i.ImportKey(ctx, req.Name, req.Key, req.Passphrase, api.WithMakeDefault(req.MakeDefault))
+	if errors.Is(err, wallet.ErrInvalidPassphrase) {
+		return nil, status.Errorf(codes.InvalidArgument, "importing key: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "importing key: %v", err)
+	}
+	return &adminPb.ImportUserKeyResponse{Address: addr}, nil
+}
+
+// FundsStatus returns the last known funds status of user addresses.
+func (a *Service) FundsStatus(ctx context.Context, req *adminPb.FundsStatusRequest) (*adminPb.FundsStatusResponse, error) {
+	if a.fm == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "funds monitor is disabled")
+	}
+	sts := a.fm.Status()
+	res := make([]*adminPb.AddrFundsStatus, len(sts))
+	for i, st := range sts {
+		res[i] = &adminPb.AddrFundsStatus{
+			Address:         st.Addr,
+			Balance:         st.Balance.String(),
+			EscrowAvailable: st.EscrowAvailable.String(),
+			EscrowLocked:    st.EscrowLocked.String(),
+			Low:             st.Low,
+			LastTopUp:       st.LastTopUp,
+			LastChecked:     st.LastChecked,
+		}
+	}
+	return &adminPb.FundsStatusResponse{Statuses: res}, nil
+}
+
+// FundsEvents returns the funds events of user addresses since a time.
+func (a *Service) FundsEvents(ctx context.Context, req *adminPb.FundsEventsRequest) (*adminPb.FundsEventsResponse, error) {
+	if a.fm == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "funds monitor is disabled")
+	}
+	events, err := a.fm.Events(time.Unix(req.Since, 0))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting funds events: %v", err)
+	}
+	res := make([]*adminPb.FundsEvent, len(events))
+	for i, e := range events {
+		var kind adminPb.FundsEventKind
+		switch e.Kind {
+		case fundsmonitor.EventLowFunds:
+			kind = adminPb.FundsEventKind_FUNDS_EVENT_KIND_LOW_FUNDS
+		case fundsmonitor.EventFundsRecovered:
+			kind = adminPb.FundsEventKind_FUNDS_EVENT_KIND_FUNDS_RECOVERED
+		case fundsmonitor.EventTopUp:
+			kind = adminPb.FundsEventKind_FUNDS_EVENT_KIND_TOP_UP
+		case fundsmonitor.EventTopUpLimitReached:
+			kind = adminPb.FundsEventKind_FUNDS_EVENT_KIND_TOP_UP_LIMIT_REACHED
+		default:
+			kind = adminPb.FundsEventKind_FUNDS_EVENT_KIND_UNSPECIFIED
+		}
+		res[i] = &adminPb.FundsEvent{
+			Address: e.Addr,
+			Kind:    kind,
+			Funds:   e.Funds.String(),
+			Time:    e.Time,
+		}
+		if e.Kind == fundsmonitor.EventTopUp {
+			res[i].Amount = e.Amount.String()
+			res[i].MessageCid = util.CidToString(e.MessageCid)
+		}
+	}
+	return &adminPb.FundsEventsResponse{Events: res}, nil
 }
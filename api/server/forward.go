@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/textileio/powergate/leader"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// followerMethods are the read-only methods, which are served by every
+// instance. Mutating methods are forwarded to the leader, as are read-only
+// methods which depend on state kept in memory by the leader, like job
+// queues, watches, the funds monitor status and country overrides, or
+// which record retrievals, like GetCAR.
+var followerMethods = map[string]bool{
+	"/powergate.user.v1.UserService/BuildInfo":            true,
+	"/powergate.user.v1.UserService/UserIdentifier":       true,
+	"/powergate.user.v1.UserService/DefaultStorageConfig": true,
+	"/powergate.user.v1.UserService/Get":                  true,
+	"/powergate.user.v1.UserService/Logs":                 true,
+	"/powergate.user.v1.UserService/Balance":              true,
+	"/powergate.user.v1.UserService/Addresses":            true,
+	"/powergate.user.v1.UserService/SignMessage":          true,
+	"/powergate.user.v1.UserService/VerifyMessage":        true,
+	"/powergate.user.v1.UserService/Transactions":         true,
+	"/powergate.user.v1.UserService/MultisigPending":      true,
+	"/powergate.user.v1.UserService/StorageJob":           true,
+	"/powergate.user.v1.UserService/StorageConfigForJob":  true,
+	"/powergate.user.v1.UserService/StorageDealRecords":   true,
+	"/powergate.user.v1.UserService/RetrievalDealRecords": true,
+	"/powergate.user.v1.UserService/ExportDealRecords":    true,
+	"/powergate.admin.v1.AdminService/Addresses":          true,
+	"/powergate.admin.v1.AdminService/SendRequests":       true,
+	"/powergate.admin.v1.AdminService/ExportUserKeys":     true,
+	"/powergate.admin.v1.AdminService/FundsEvents":        true,
+	"/powergate.admin.v1.AdminService/Users":              true,
+	"/powergate.admin.v1.AdminService/Backup":             true,
+	"/powergate.admin.v1.AdminService/ExportDealRecords":  true,
+}
+
+// forwarder forwards gRPC calls to the leader when the instance is a
+// follower.
+type forwarder struct {
+	e *leader.Elector
+
+	lock sync.Mutex
+	addr string
+	conn *grpc.ClientConn
+}
+
+func newForwarder(e *leader.Elector) *forwarder {
+	return &forwarder{e: e}
+}
+
+func (f *forwarder) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if f.e.IsLeader() || followerMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		conn, err := f.leaderConn()
+		if err != nil {
+			return nil, err
+		}
+		res, err := newMessage(info.FullMethod, false)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "creating response: %s", err)
+		}
+		if err := conn.Invoke(outgoingContext(ctx), info.FullMethod, req, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+}
+
+func (f *forwarder) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if f.e.IsLeader() || followerMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		conn, err := f.leaderConn()
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(outgoingContext(ss.Context()))
+		defer cancel()
+		desc := &grpc.StreamDesc{
+			StreamName:    info.FullMethod,
+			ServerStreams: info.IsServerStream,
+			ClientStreams: info.IsClientStream,
+		}
+		cs, err := conn.NewStream(ctx, desc, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			for {
+				req, err := newMessage(info.FullMethod, true)
+				if err != nil {
+					log.Errorf("creating forwarded request: %s", err)
+					cancel()
+					return
+				}
+				if err := ss.RecvMsg(req); err == io.EOF {
+					_ = cs.CloseSend()
+					return
+				} else if err != nil {
+					cancel()
+					return
+				}
+				if err := cs.SendMsg(req); err != nil {
+					return
+				}
+			}
+		}()
+
+		md, err := cs.Header()
+		if err != nil {
+			return err
+		}
+		if err := ss.SendHeader(md); err != nil {
+			return err
+		}
+		for {
+			res, err := newMessage(info.FullMethod, false)
+			if err != nil {
+				return status.Errorf(codes.Internal, "creating response: %s", err)
+			}
+			if err := cs.RecvMsg(res); err == io.EOF {
+				ss.SetTrailer(cs.Trailer())
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if err := ss.SendMsg(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// leaderConn returns a connection to the current leader.
+func (f *forwarder) leaderConn() (*grpc.ClientConn, error) {
+	l, err := f.e.Leader()
+	if err == leader.ErrNoLeader {
+		return nil, status.Errorf(codes.Unavailable, "no leader elected, retry later")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting leader: %s", err)
+	}
+	if l.Holder == f.e.ID() {
+		return nil, status.Errorf(codes.Unavailable, "leadership is changing, retry later")
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.conn != nil && f.addr == l.Addr {
+		return f.conn, nil
+	}
+	if f.conn != nil {
+		if err := f.conn.Close(); err != nil {
+			log.Errorf("closing connection to previous leader: %s", err)
+		}
+		f.conn = nil
+	}
+	conn, err := grpc.Dial(l.Addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "connecting to leader %s: %s", l.Holder, err)
+	}
+	f.addr, f.conn = l.Addr, conn
+	return conn, nil
+}
+
+// Close closes the connection to the leader.
+func (f *forwarder) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.conn == nil {
+		return nil
+	}
+	if err := f.conn.Close(); err != nil {
+		return fmt.Errorf("closing leader connection: %s", err)
+	}
+	f.conn = nil
+	return nil
+}
+
+// outgoingContext returns a context to forward a call with the metadata
+// of the received call, e.g: auth tokens.
+func outgoingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return metadata.NewOutgoingContext(ctx, md.Copy())
+}
+
+// newMessage returns an empty request or response message of a gRPC
+// method.
+func newMessage(fullMethod string, request bool) (proto.Message, error) {
+	i := strings.LastIndex(fullMethod, "/")
+	if i <= 0 {
+		return nil, fmt.Errorf("invalid method name %s", fullMethod)
+	}
+	service := protoreflect.FullName(strings.TrimPrefix(fullMethod[:i], "/"))
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(service)
+	if err != nil {
+		return nil, fmt.Errorf("finding service %s: %s", service, err)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(fullMethod[i+1:]))
+	if md == nil {
+		return nil, fmt.Errorf("method %s not found", fullMethod)
+	}
+	msg := md.Output()
+	if request {
+		msg = md.Input()
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(msg.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("finding message %s: %s", msg.FullName(), err)
+	}
+	return mt.New().Interface(), nil
+}
@@ -34,19 +34,29 @@ import (
 	"github.com/textileio/powergate/fchost"
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/ffs/coreipfs"
+	"github.com/textileio/powergate/ffs/dealtracker"
 	"github.com/textileio/powergate/ffs/filcold"
+	"github.com/textileio/powergate/ffs/fundsmonitor"
 	"github.com/textileio/powergate/ffs/joblogger"
 	"github.com/textileio/powergate/ffs/manager"
+	"github.com/textileio/powergate/ffs/memstorage"
 	"github.com/textileio/powergate/ffs/minerselector/reptop"
 	"github.com/textileio/powergate/ffs/minerselector/sr2"
+	"github.com/textileio/powergate/ffs/piece"
 	"github.com/textileio/powergate/ffs/scheduler"
 	"github.com/textileio/powergate/filchain"
 	"github.com/textileio/powergate/gateway"
 	ask "github.com/textileio/powergate/index/ask/runner"
 	faultsModule "github.com/textileio/powergate/index/faults/module"
 	minerModule "github.com/textileio/powergate/index/miner/module"
+	"github.com/textileio/powergate/iplocation"
+	"github.com/textileio/powergate/iplocation/cache"
 	"github.com/textileio/powergate/iplocation/maxmind"
+	"github.com/textileio/powergate/iplocation/ranges"
+	"github.com/textileio/powergate/leader"
 	"github.com/textileio/powergate/lotus"
+	"github.com/textileio/powergate/lotus/fakelotus"
+	"github.com/textileio/powergate/migration"
 	"github.com/textileio/powergate/reputation"
 	txndstr "github.com/textileio/powergate/txndstransform"
 	"github.com/textileio/powergate/util"
@@ -73,16 +83,24 @@ type Server struct {
 	ds datastore.TxnDatastore
 
 	mm *maxmind.MaxMind
+	lc *cache.Cache
 	ai *ask.Runner
 	mi *minerModule.Index
 	fi *faultsModule.Index
 	dm *dealsModule.Module
 	wm *walletModule.Module
 	rm *reputation.Module
+	fm *fundsmonitor.Monitor
+	lf *lotus.Failover
+	fl *fakelotus.Node
+	el *leader.Elector
+	fw *forwarder
 
 	ffsManager *manager.Manager
 	sched      *scheduler.Scheduler
 	hs         ffs.HotStorage
+	pc         *piece.Cache
+	dt         *dealtracker.Tracker
 	l          *joblogger.Logger
 
 	grpcServer *grpc.Server
@@ -100,10 +118,16 @@ type Config struct {
 	Devnet          bool
 	IpfsAPIAddr     ma.Multiaddr
 
+	IPLocationRangesFile string
+	IPLocationCacheTTL   time.Duration
+
 	LotusAddress           ma.Multiaddr
 	LotusAuthToken         string
 	LotusMasterAddr        string
 	LotusConnectionRetries int
+	// LotusFailoverEndpoints are Lotus endpoints used when LotusAddress
+	// isn't healthy.
+	LotusFailoverEndpoints []lotus.Endpoint
 
 	GrpcHostNetwork     string
 	GrpcHostAddress     ma.Multiaddr
@@ -122,6 +146,10 @@ type Config struct {
 	FFSDealFinalityTimeout      time.Duration
 	FFSMinimumPieceSize         uint64
 	FFSMaxParallelDealPreparing int
+	FFSMaxStageCARSize          int64
+	FFSMaxStageTarSize          int64
+	FFSJobLogRetention          time.Duration
+	FFSJobLogCompactAge         time.Duration
 	SchedMaxParallel            int
 	MinerSelector               string
 	MinerSelectorParams         string
@@ -129,6 +157,13 @@ type Config struct {
 	AutocreateMasterAddr        bool
 	WalletInitialFunds          big.Int
 
+	FundsMonitorInterval        time.Duration
+	FundsMonitorThreshold       *big.Int
+	FundsMonitorTopUpAmount     *big.Int
+	FundsMonitorMaxTopUpPerAddr *big.Int
+	FundsMonitorMaxTopUpTotal   *big.Int
+	FundsMonitorEventRetention  time.Duration
+
 	AskIndexQueryAskTimeout time.Duration
 	AskindexMaxParallel     int
 	AskIndexRefreshInterval time.Duration
@@ -137,6 +172,32 @@ type Config struct {
 	DisableIndices bool
 
 	DisableNonCompliantAPIs bool
+
+	// Simulation runs the server against an in-process fake Lotus node
+	// and in-memory hot and cold storages, so no IPFS or Lotus nodes
+	// are needed.
+	Simulation              bool
+	SimulationMiners        int
+	SimulationEpochDuration time.Duration
+	SimulationLatency       time.Duration
+	SimulationRejectRate    float64
+	SimulationFailureRate   float64
+	SimulationSlashRate     float64
+	SimulationSeed          int64
+
+	// HA allows running multiple instances sharing a Mongo datastore.
+	// Only the elected leader runs the scheduler daemons, crons and
+	// indices, and followers forward calls which modify state to it.
+	HA bool
+	// HAInstanceID identifies the instance in the leader election, and
+	// should be unique among instances sharing the datastore.
+	HAInstanceID string
+	// HAAdvertiseAddr is the gRPC address where other instances can reach
+	// this instance when it's the leader.
+	HAAdvertiseAddr string
+	// HALeaseTTL is the duration of the leadership lease. If the leader
+	// doesn't renew it within this duration, another instance takes over.
+	HALeaseTTL time.Duration
 }
 
 // NewServer starts and returns a new server with the given configuration.
@@ -144,11 +205,40 @@ func NewServer(conf Config) (*Server, error) {
 	if conf.FFSUseMasterAddr && !conf.Devnet && !(len(conf.LotusMasterAddr) > 0 || conf.AutocreateMasterAddr) {
 		return nil, fmt.Errorf("FFSUseMasterAddr requires LotusMasterAddr or AutocreateMasterAddr to be provided")
 	}
+	if conf.HA && conf.MongoURI == "" {
+		return nil, fmt.Errorf("HA requires a Mongo datastore shared by all instances")
+	}
+	if conf.HA && conf.HAAdvertiseAddr == "" {
+		return nil, fmt.Errorf("HA requires an address where other instances can reach this instance")
+	}
+	if conf.HA && conf.Simulation {
+		return nil, fmt.Errorf("HA isn't supported in simulation mode")
+	}
 
 	var err error
-	clientBuilder, err := lotus.NewBuilder(conf.LotusAddress, conf.LotusAuthToken, conf.LotusConnectionRetries)
-	if err != nil {
-		return nil, fmt.Errorf("creating lotus client builder: %s", err)
+	var clientBuilder lotus.ClientBuilder
+	var lf *lotus.Failover
+	var fl *fakelotus.Node
+	if conf.Simulation {
+		log.Info("Running in simulation mode with a fake Lotus node and in-memory storages")
+		conf.Devnet = true
+		fl, err = fakelotus.New(fakelotus.WithMiners(conf.SimulationMiners), fakelotus.WithBlockTime(conf.SimulationEpochDuration))
+		if err != nil {
+			return nil, fmt.Errorf("creating fake lotus node: %s", err)
+		}
+		clientBuilder = fl.Builder()
+	} else if len(conf.LotusFailoverEndpoints) > 0 {
+		endpoints := append([]lotus.Endpoint{{Addr: conf.LotusAddress, AuthToken: conf.LotusAuthToken}}, conf.LotusFailoverEndpoints...)
+		lf, err = lotus.NewFailover(endpoints, conf.LotusConnectionRetries)
+		if err != nil {
+			return nil, fmt.Errorf("creating lotus failover: %s", err)
+		}
+		clientBuilder = lf.Build
+	} else {
+		clientBuilder, err = lotus.NewBuilder(conf.LotusAddress, conf.LotusAuthToken, conf.LotusConnectionRetries)
+		if err != nil {
+			return nil, fmt.Errorf("creating lotus client builder: %s", err)
+		}
 	}
 	lsm, err := lotus.NewSyncMonitor(clientBuilder)
 	if err != nil {
@@ -190,12 +280,33 @@ func NewServer(conf Config) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating datastore: %s", err)
 	}
+	if err := migrateDatastore(ds, false); err != nil {
+		return nil, fmt.Errorf("migrating datastore: %s", err)
+	}
+
+	var el *leader.Elector
+	// fds is the datastore of components which only write in the leader.
+	// In HA mode writes are fenced, so a leader which lost its lease
+	// can't modify the state managed by the new leader.
+	fds := ds
+	if conf.HA {
+		log.Infof("Running in HA mode as instance %s", conf.HAInstanceID)
+		el, err = leader.New(ds, conf.HAInstanceID, conf.HAAdvertiseAddr, leader.WithTTL(conf.HALeaseTTL), leader.WithRenewInterval(conf.HALeaseTTL/3))
+		if err != nil {
+			return nil, fmt.Errorf("creating leader elector: %s", err)
+		}
+		fds = el.Fence(ds)
+	}
 
 	log.Info("Wiring internal components...")
 	mm, err := maxmind.New(filepath.Join(conf.MaxMindDBFolder, "GeoLite2-City.mmdb"))
 	if err != nil {
 		return nil, fmt.Errorf("opening maxmind database: %s", err)
 	}
+	lr, lc, err := createLocationResolver(conf, ds, mm)
+	if err != nil {
+		return nil, fmt.Errorf("creating location resolver: %s", err)
+	}
 	askConf := ask.Config{
 		Disable:         conf.DisableIndices,
 		QueryAskTimeout: conf.AskIndexQueryAskTimeout,
@@ -203,58 +314,100 @@ func NewServer(conf Config) (*Server, error) {
 		RefreshInterval: conf.AskIndexRefreshInterval,
 		RefreshOnStart:  conf.Devnet || conf.AskIndexRefreshOnStart,
 	}
-	ai, err := ask.New(txndstr.Wrap(ds, "index/ask"), clientBuilder, askConf)
+	var minerOpts []minerModule.Option
+	var faultsOpts []faultsModule.Option
+	if el != nil {
+		askConf.IsLeader = el.IsLeader
+		minerOpts = append(minerOpts, minerModule.WithIsLeader(el.IsLeader))
+		faultsOpts = append(faultsOpts, faultsModule.WithIsLeader(el.IsLeader))
+	}
+	ai, err := ask.New(txndstr.Wrap(fds, "index/ask"), clientBuilder, askConf)
 	if err != nil {
 		return nil, fmt.Errorf("creating ask index: %s", err)
 	}
-	mi, err := minerModule.New(txndstr.Wrap(ds, "index/miner"), clientBuilder, fchost, mm, conf.DisableIndices)
+	mi, err := minerModule.New(txndstr.Wrap(fds, "index/miner"), clientBuilder, fchost, lr, conf.DisableIndices, minerOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("creating miner index: %s", err)
 	}
-	si, err := faultsModule.New(txndstr.Wrap(ds, "index/faults"), clientBuilder, conf.DisableIndices)
+	si, err := faultsModule.New(txndstr.Wrap(fds, "index/faults"), clientBuilder, conf.DisableIndices, faultsOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("creating faults index: %s", err)
 	}
 	if conf.Devnet {
 		conf.DealWatchPollDuration = time.Second
 	}
-	dm, err := dealsModule.New(txndstr.Wrap(ds, "deals"), clientBuilder, conf.DealWatchPollDuration, conf.FFSDealFinalityTimeout, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")))
+	dm, err := dealsModule.New(txndstr.Wrap(fds, "deals"), clientBuilder, conf.DealWatchPollDuration, conf.FFSDealFinalityTimeout, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")))
 	if err != nil {
 		return nil, fmt.Errorf("creating deal module: %s", err)
 	}
-	wm, err := walletModule.New(clientBuilder, masterAddr, conf.WalletInitialFunds, conf.AutocreateMasterAddr, networkName)
+	wm, err := walletModule.New(txndstr.Wrap(ds, "wallet"), clientBuilder, masterAddr, conf.WalletInitialFunds, conf.AutocreateMasterAddr, networkName)
 	if err != nil {
 		return nil, fmt.Errorf("creating wallet module: %s", err)
 	}
 	rm := reputation.New(txndstr.Wrap(ds, "reputation"), mi, si, ai)
 
-	ipfs, err := httpapi.NewApi(conf.IpfsAPIAddr)
-	if err != nil {
-		return nil, fmt.Errorf("creating ipfs client: %s", err)
-	}
-
-	chain := filchain.New(clientBuilder)
-
 	ms, err := getMinerSelector(conf, rm, ai, clientBuilder)
 	if err != nil {
 		return nil, fmt.Errorf("creating miner selector: %s", err)
 	}
 
-	l := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger"))
+	loggerOpts := []joblogger.Option{
+		joblogger.WithRetention(conf.FFSJobLogRetention),
+		joblogger.WithCompaction(conf.FFSJobLogCompactAge, ffs.LogWarn),
+	}
+	if el != nil {
+		loggerOpts = append(loggerOpts, joblogger.WithIsLeader(el.IsLeader))
+	}
+	l, err := joblogger.New(txndstr.Wrap(fds, "ffs/joblogger"), loggerOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating job logger: %s", err)
+	}
 	if conf.Devnet {
 		conf.FFSMinimumPieceSize = 0
 	}
-	cs := filcold.New(ms, dm, ipfs, chain, l, lsm, conf.FFSMinimumPieceSize, conf.FFSMaxParallelDealPreparing)
-	hs, err := coreipfs.New(ipfs, l)
-	if err != nil {
-		return nil, fmt.Errorf("creating coreipfs: %s", err)
+	var hs ffs.HotStorage
+	var cs ffs.ColdStorage
+	var pc *piece.Cache
+	var dt *dealtracker.Tracker
+	if conf.Simulation {
+		hs, cs, err = createMemStorages(conf, l)
+		if err != nil {
+			return nil, fmt.Errorf("creating in-memory storages: %s", err)
+		}
+	} else {
+		ipfs, err := httpapi.NewApi(conf.IpfsAPIAddr)
+		if err != nil {
+			return nil, fmt.Errorf("creating ipfs client: %s", err)
+		}
+		chain := filchain.New(clientBuilder)
+		pc = piece.New(txndstr.Wrap(ds, "ffs/piece"), ipfs.Dag(), conf.FFSMaxParallelDealPreparing)
+		cs = filcold.New(ms, dm, wm, ipfs, pc, chain, l, lsm, conf.FFSMinimumPieceSize, conf.FFSMaxParallelDealPreparing)
+		hs, err = coreipfs.New(ipfs, l)
+		if err != nil {
+			return nil, fmt.Errorf("creating coreipfs: %s", err)
+		}
+		var dtOpts []dealtracker.Option
+		if el != nil {
+			dtOpts = append(dtOpts, dealtracker.WithIsLeader(el.IsLeader))
+		}
+		dt, err = dealtracker.New(txndstr.Wrap(fds, "ffs/dealtracker"), clientBuilder, dtOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("creating deal tracker: %s", err)
+		}
 	}
 
 	var sr2rf func() (int, error)
 	if ms, ok := ms.(*sr2.MinerSelector); ok {
 		sr2rf = ms.GetReplicationFactor
 	}
-	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hs, cs, conf.SchedMaxParallel, conf.FFSDealFinalityTimeout, sr2rf)
+	var schedOpts []scheduler.Option
+	if el != nil {
+		schedOpts = append(schedOpts, scheduler.WithElector(el))
+	}
+	if dt != nil {
+		schedOpts = append(schedOpts, scheduler.WithDealTracker(dt))
+	}
+	sched, err := scheduler.New(txndstr.Wrap(fds, "ffs/scheduler"), l, hs, cs, conf.SchedMaxParallel, conf.FFSDealFinalityTimeout, sr2rf, schedOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("creating scheduler: %s", err)
 	}
@@ -264,15 +417,42 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("creating ffs instance: %s", err)
 	}
 
+	var fm *fundsmonitor.Monitor
+	if conf.FundsMonitorThreshold != nil {
+		fmConf := fundsmonitor.Config{
+			Interval:        conf.FundsMonitorInterval,
+			Threshold:       conf.FundsMonitorThreshold,
+			TopUpAmount:     conf.FundsMonitorTopUpAmount,
+			MaxTopUpPerAddr: conf.FundsMonitorMaxTopUpPerAddr,
+			MaxTopUpTotal:   conf.FundsMonitorMaxTopUpTotal,
+			EventRetention:  conf.FundsMonitorEventRetention,
+		}
+		if el != nil {
+			fmConf.IsLeader = el.IsLeader
+		}
+		fm, err = fundsmonitor.New(txndstr.Wrap(fds, "ffs/fundsmonitor"), wm, ffsManager, fmConf)
+		if err != nil {
+			return nil, fmt.Errorf("creating funds monitor: %s", err)
+		}
+	}
+
 	log.Info("Starting gRPC, gateway and index HTTP servers...")
 
 	unaryInterceptors := []grpc.UnaryServerInterceptor{adminAuth(conf)}
 	if conf.DisableNonCompliantAPIs {
 		unaryInterceptors = append(unaryInterceptors, nonCompliantAPIsInterceptor(nonCompliantAPIs))
 	}
+	var streamInterceptors []grpc.StreamServerInterceptor
+	var fw *forwarder
+	if el != nil {
+		fw = newForwarder(el)
+		unaryInterceptors = append(unaryInterceptors, fw.unaryInterceptor())
+		streamInterceptors = append(streamInterceptors, fw.streamInterceptor())
+	}
 	unaryInterceptorChain := grpcm.WithUnaryServerChain(unaryInterceptors...)
+	streamInterceptorChain := grpcm.WithStreamServerChain(streamInterceptors...)
 
-	opts := append(conf.GrpcServerOpts, unaryInterceptorChain)
+	opts := append(conf.GrpcServerOpts, unaryInterceptorChain, streamInterceptorChain)
 	grpcServer := grpc.NewServer(opts...)
 	wrappedGRPCServer := wrapGRPCServer(grpcServer)
 	httpFFSAuthInterceptor, err := newHTTPFFSAuthInterceptor(conf, ffsManager)
@@ -288,6 +468,7 @@ func NewServer(conf Config) (*Server, error) {
 		ds: ds,
 
 		mm: mm,
+		lc: lc,
 
 		ai: ai,
 		mi: mi,
@@ -295,10 +476,17 @@ func NewServer(conf Config) (*Server, error) {
 		dm: dm,
 		wm: wm,
 		rm: rm,
+		fm: fm,
+		lf: lf,
+		fl: fl,
+		el: el,
+		fw: fw,
 
 		ffsManager: ffsManager,
 		sched:      sched,
 		hs:         hs,
+		pc:         pc,
+		dt:         dt,
 		l:          l,
 
 		grpcServer: grpcServer,
@@ -306,7 +494,7 @@ func NewServer(conf Config) (*Server, error) {
 		gateway:    gateway,
 	}
 
-	if err := startGRPCServices(grpcServer, webProxy, s, conf.GrpcHostNetwork, conf.GrpcHostAddress); err != nil {
+	if err := startGRPCServices(grpcServer, webProxy, s, conf.GrpcHostNetwork, conf.GrpcHostAddress, conf.FFSMaxStageCARSize, conf.FFSMaxStageTarSize); err != nil {
 		return nil, fmt.Errorf("starting GRPC services: %s", err)
 	}
 
@@ -391,9 +579,9 @@ func wrapGRPCServer(grpcServer *grpc.Server) *grpcweb.WrappedGrpcServer {
 	return wrappedServer
 }
 
-func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork string, hostAddress ma.Multiaddr) error {
-	userService := user.New(s.ffsManager, s.wm, s.hs)
-	adminService := admin.New(s.ffsManager, s.sched, s.wm)
+func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork string, hostAddress ma.Multiaddr, maxStageCARSize, maxStageTarSize int64) error {
+	userService := user.New(s.ffsManager, s.wm, s.hs, s.pc, maxStageCARSize, maxStageTarSize)
+	adminService := admin.New(s.ffsManager, s.sched, s.wm, s.mi, s.fm, s.ds, s.l, s.dm)
 
 	hostAddr, err := util.TCPAddrFromMultiAddr(hostAddress)
 	if err != nil {
@@ -473,6 +661,15 @@ func (s *Server) Close() {
 		log.Errorf("closing down index server: %s", err)
 	}
 
+	if s.el != nil {
+		// Resigning first lets another instance take over while this
+		// one finishes serving requests, which are forwarded to the
+		// new leader.
+		if err := s.el.Close(); err != nil {
+			log.Errorf("closing leader elector: %s", err)
+		}
+	}
+
 	log.Info("closing gRPC endpoints...")
 	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -492,13 +689,33 @@ func (s *Server) Close() {
 		t.Stop()
 	}
 	log.Info("gRPC endpoints closed")
+	if s.fw != nil {
+		if err := s.fw.Close(); err != nil {
+			log.Errorf("closing forwarder: %s", err)
+		}
+	}
 
+	if s.fm != nil {
+		if err := s.fm.Close(); err != nil {
+			log.Errorf("closing funds monitor: %s", err)
+		}
+	}
 	if err := s.ffsManager.Close(); err != nil {
 		log.Errorf("closing ffs manager: %s", err)
 	}
 	if err := s.sched.Close(); err != nil {
 		log.Errorf("closing ffs scheduler: %s", err)
 	}
+	if s.pc != nil {
+		if err := s.pc.Close(); err != nil {
+			log.Errorf("closing piece cache: %s", err)
+		}
+	}
+	if s.dt != nil {
+		if err := s.dt.Close(); err != nil {
+			log.Errorf("closing deal tracker: %s", err)
+		}
+	}
 	if err := s.l.Close(); err != nil {
 		log.Errorf("closing joblogger: %s", err)
 	}
@@ -511,9 +728,27 @@ func (s *Server) Close() {
 	if err := s.mi.Close(); err != nil {
 		log.Errorf("closing miner index: %s", err)
 	}
+	if s.lc != nil {
+		if err := s.lc.Close(); err != nil {
+			log.Errorf("closing iplocation cache: %s", err)
+		}
+	}
 	if err := s.fi.Close(); err != nil {
 		log.Errorf("closing faults index: %s", err)
 	}
+	if err := s.wm.Close(); err != nil {
+		log.Errorf("closing wallet module: %s", err)
+	}
+	if s.lf != nil {
+		if err := s.lf.Close(); err != nil {
+			log.Errorf("closing lotus failover: %s", err)
+		}
+	}
+	if s.fl != nil {
+		if err := s.fl.Close(); err != nil {
+			log.Errorf("closing fake lotus node: %s", err)
+		}
+	}
 
 	log.Info("closing datastore...")
 	if err := s.ds.Close(); err != nil {
@@ -529,6 +764,35 @@ func (s *Server) Close() {
 	}
 }
 
+// OpenDatastore opens the datastore configured in conf, to work with it
+// without starting the server.
+func OpenDatastore(conf Config) (datastore.TxnDatastore, error) {
+	return createDatastore(conf)
+}
+
+// MigrateDatastore runs pending datastore migrations without starting the
+// server. If dryRun is true, migrations are run but changes are discarded.
+func MigrateDatastore(conf Config, dryRun bool) error {
+	ds, err := createDatastore(conf)
+	if err != nil {
+		return fmt.Errorf("creating datastore: %s", err)
+	}
+	defer func() {
+		if err := ds.Close(); err != nil {
+			log.Errorf("closing datastore: %s", err)
+		}
+	}()
+	return migrateDatastore(ds, dryRun)
+}
+
+func migrateDatastore(ds datastore.TxnDatastore, dryRun bool) error {
+	m, err := migration.New(ds, migration.Migrations())
+	if err != nil {
+		return fmt.Errorf("creating migrator: %s", err)
+	}
+	return m.Migrate(dryRun)
+}
+
 func createDatastore(conf Config) (datastore.TxnDatastore, error) {
 	if conf.MongoURI != "" {
 		log.Info("Opening Mongo database...")
@@ -557,6 +821,27 @@ func createDatastore(conf Config) (datastore.TxnDatastore, error) {
 	return ds, nil
 }
 
+// createLocationResolver returns the configured location resolver and,
+// if caching is enabled, the cache which must be closed on shutdown.
+func createLocationResolver(conf Config, ds datastore.TxnDatastore, mm *maxmind.MaxMind) (iplocation.LocationResolver, *cache.Cache, error) {
+	var resolvers []iplocation.LocationResolver
+	if conf.IPLocationRangesFile != "" {
+		r, err := ranges.New(conf.IPLocationRangesFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("loading ip ranges file: %s", err)
+		}
+		resolvers = append(resolvers, r)
+	}
+	resolvers = append(resolvers, mm)
+
+	chain := iplocation.NewChain(resolvers...)
+	if conf.IPLocationCacheTTL <= 0 {
+		return chain, nil, nil
+	}
+	lc := cache.New(txndstr.Wrap(ds, "iplocation/cache"), chain, conf.IPLocationCacheTTL)
+	return lc, lc, nil
+}
+
 func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lotus.ClientBuilder) (ffs.MinerSelector, error) {
 	if conf.Devnet {
 		return reptop.New(rm, ai), nil
@@ -579,11 +864,34 @@ func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lot
 	return ms, nil
 }
 
+func createMemStorages(conf Config, l ffs.JobLogger) (ffs.HotStorage, ffs.ColdStorage, error) {
+	opts := []memstorage.Option{
+		memstorage.WithLatency(conf.SimulationLatency),
+		memstorage.WithSeed(conf.SimulationSeed),
+		memstorage.WithEpochDuration(conf.SimulationEpochDuration),
+		memstorage.WithMiners(conf.SimulationMiners, fakelotus.DefaultMinerConfig().AskPrice.Uint64()),
+		memstorage.WithRejectRate(conf.SimulationRejectRate),
+		memstorage.WithFailureRate(conf.SimulationFailureRate),
+		memstorage.WithSlashRate(conf.SimulationSlashRate),
+	}
+	hs, err := memstorage.NewHotStorage(l, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating hot storage: %s", err)
+	}
+	cs, err := memstorage.NewColdStorage(hs, l, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating cold storage: %s", err)
+	}
+	return hs, cs, nil
+}
+
 func evaluateMasterAddr(conf Config, c *apistruct.FullNodeStruct) (address.Address, error) {
 	var res address.Address
 	if conf.Devnet {
-		// Wait for the devnet to bootstrap completely and generate at least 1 block.
-		time.Sleep(time.Second * 6)
+		if !conf.Simulation {
+			// Wait for the devnet to bootstrap completely and generate at least 1 block.
+			time.Sleep(time.Second * 6)
+		}
 		res, err := c.WalletDefaultAddress(context.Background())
 		if err != nil {
 			return address.Address{}, fmt.Errorf("getting default wallet addr as masteraddr: %s", err)
@@ -1,13 +1,16 @@
 package user
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
 
+	"github.com/ipfs/go-cid"
 	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/ffs/api"
+	"github.com/textileio/powergate/ffs/dagcar"
 	"github.com/textileio/powergate/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,6 +23,15 @@ func (s *Service) Stage(srv userPb.UserService_StageServer) error {
 		return err
 	}
 
+	// the first message indicates if the data is a tar archive of a directory or a car file
+	first, err := srv.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if first.GetTar() && first.GetCar() {
+		return status.Error(codes.InvalidArgument, "data can't be both a tar archive and a car file")
+	}
+
 	reader, writer := io.Pipe()
 	defer func() {
 		if err := reader.Close(); err != nil {
@@ -27,14 +39,44 @@ func (s *Service) Stage(srv userPb.UserService_StageServer) error {
 		}
 	}()
 
-	go receiveFile(srv, writer)
+	go receiveFile(srv, writer, first)
 
-	c, err := s.hot.Add(srv.Context(), reader)
+	var roots []cid.Cid
+	switch {
+	case first.GetCar():
+		var r io.Reader = reader
+		if s.maxCARSize > 0 {
+			r = &limitedReader{r: reader, name: "car file", max: s.maxCARSize}
+		}
+		roots, err = s.hot.AddCAR(srv.Context(), r)
+	case first.GetTar():
+		var r io.Reader = reader
+		if s.maxTarSize > 0 {
+			r = &limitedReader{r: reader, name: "tar archive", max: s.maxTarSize}
+		}
+		var c cid.Cid
+		c, err = s.hot.AddTar(srv.Context(), r)
+		roots = []cid.Cid{c}
+	default:
+		var c cid.Cid
+		c, err = s.hot.Add(srv.Context(), reader)
+		roots = []cid.Cid{c}
+	}
 	if err != nil {
 		return fmt.Errorf("adding data to hot storage: %s", err)
 	}
+	if len(roots) == 0 {
+		return status.Error(codes.InvalidArgument, "car file doesn't have roots")
+	}
+	res := &userPb.StageResponse{Cid: util.CidToString(roots[0])}
+	for _, c := range roots {
+		if s.pc != nil {
+			s.pc.Precompute(c)
+		}
+		res.Roots = append(res.Roots, util.CidToString(c))
+	}
 
-	return srv.SendAndClose(&userPb.StageResponse{Cid: util.CidToString(c)})
+	return srv.SendAndClose(res)
 }
 
 // ReplaceData calls ffs.Replace.
@@ -57,6 +99,7 @@ func (s *Service) ReplaceData(ctx context.Context, req *userPb.ReplaceDataReques
 	if err != nil {
 		return nil, err
 	}
+	s.precompute(i, c2)
 
 	return &userPb.ReplaceDataResponse{JobId: jid.String()}, nil
 }
@@ -71,10 +114,15 @@ func (s *Service) Get(req *userPb.GetRequest, srv userPb.UserService_GetServer)
 	if err != nil {
 		return err
 	}
-	r, err := i.Get(srv.Context(), c)
+	r, isDir, err := i.GetPath(srv.Context(), c, req.Path)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("closing reader: %s", err)
+		}
+	}()
 
 	buffer := make([]byte, 1024*32)
 	for {
@@ -82,7 +130,7 @@ func (s *Service) Get(req *userPb.GetRequest, srv userPb.UserService_GetServer)
 		if err != nil && err != io.EOF {
 			return err
 		}
-		if sendErr := srv.Send(&userPb.GetResponse{Chunk: buffer[:bytesRead]}); sendErr != nil {
+		if sendErr := srv.Send(&userPb.GetResponse{Chunk: buffer[:bytesRead], Directory: isDir}); sendErr != nil {
 			return sendErr
 		}
 		if err == io.EOF {
@@ -91,6 +139,51 @@ func (s *Service) Get(req *userPb.GetRequest, srv userPb.UserService_GetServer)
 	}
 }
 
+// GetCAR streams a stored Cid DAG as a CAR file.
+func (s *Service) GetCAR(req *userPb.GetCARRequest, srv userPb.UserService_GetCARServer) error {
+	i, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+	c, err := util.CidFromString(req.Cid)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "parsing cid: %v", err)
+	}
+	opts := []api.GetCAROption{api.WithCARFromCold(req.FromCold)}
+	if req.Selector != "" {
+		sel, err := dagcar.ParseSelector(req.Selector)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid selector: %v", err)
+		}
+		opts = append(opts, api.WithCARSelector(sel))
+	}
+	if req.CarVersion != 0 {
+		if req.CarVersion != dagcar.V1 && req.CarVersion != dagcar.V2 {
+			return status.Errorf(codes.InvalidArgument, "unsupported car version %d", req.CarVersion)
+		}
+		opts = append(opts, api.WithCARVersion(int(req.CarVersion)))
+	}
+	w := bufio.NewWriterSize(&carWriter{srv: srv}, 1024*32)
+	if err := i.GetCAR(srv.Context(), c, w, opts...); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "sending car file: %v", err)
+	}
+	return nil
+}
+
+type carWriter struct {
+	srv userPb.UserService_GetCARServer
+}
+
+func (cw *carWriter) Write(p []byte) (int, error) {
+	if err := cw.srv.Send(&userPb.GetCARResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
 // WatchLogs returns a stream of human-readable messages related to executions of a Cid.
 // The listener is automatically unsubscribed when the client closes the stream.
 func (s *Service) WatchLogs(req *userPb.WatchLogsRequest, srv userPb.UserService_WatchLogsServer) error {
@@ -99,28 +192,31 @@ func (s *Service) WatchLogs(req *userPb.WatchLogsRequest, srv userPb.UserService
 		return err
 	}
 
-	opts := []api.GetLogsOption{api.WithHistory(req.History)}
+	opts := []api.GetLogsOption{
+		api.WithHistory(req.History),
+		api.WithMinLevel(fromRPCLogLevel(req.MinLevel)),
+		api.WithTextFilter(req.Text),
+	}
 	if req.JobId != ffs.EmptyJobID.String() {
 		opts = append(opts, api.WithJidFilter(ffs.JobID(req.JobId)))
 	}
 
-	c, err := util.CidFromString(req.Cid)
+	t, err := resolveLogsTarget(i, req.Cid, req.JobId)
 	if err != nil {
 		return err
 	}
 	ch := make(chan ffs.LogEntry, 100)
 	go func() {
-		err = i.WatchLogs(srv.Context(), ch, c, opts...)
+		if t.rid != ffs.EmptyRetrievalID {
+			err = i.WatchRetrievalLogs(srv.Context(), ch, t.rid, opts...)
+		} else {
+			err = i.WatchLogs(srv.Context(), ch, t.cid, opts...)
+		}
 		close(ch)
 	}()
 	for l := range ch {
 		reply := &userPb.WatchLogsResponse{
-			LogEntry: &userPb.LogEntry{
-				Cid:     util.CidToString(c),
-				JobId:   l.Jid.String(),
-				Time:    l.Timestamp.Unix(),
-				Message: l.Msg,
-			},
+			LogEntry: toRPCLogEntry(l),
 		}
 		if err := srv.Send(reply); err != nil {
 			return err
@@ -133,6 +229,74 @@ func (s *Service) WatchLogs(req *userPb.WatchLogsRequest, srv userPb.UserService
 	return nil
 }
 
+// Logs returns the history messages related to executions of a Cid, or of
+// a Job if the Cid isn't provided, matching the request filters.
+func (s *Service) Logs(ctx context.Context, req *userPb.LogsRequest) (*userPb.LogsResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []api.GetLogsOption{
+		api.WithMinLevel(fromRPCLogLevel(req.MinLevel)),
+		api.WithTextFilter(req.Text),
+		api.WithTimeRange(fromUnix(req.From), fromUnix(req.To)),
+		api.WithLimit(int(req.Limit)),
+	}
+	if req.JobId != ffs.EmptyJobID.String() {
+		opts = append(opts, api.WithJidFilter(ffs.JobID(req.JobId)))
+	}
+
+	t, err := resolveLogsTarget(i, req.Cid, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	var lgs []ffs.LogEntry
+	if t.rid != ffs.EmptyRetrievalID {
+		lgs, err = i.RetrievalLogs(ctx, t.rid, opts...)
+	} else {
+		lgs, err = i.Logs(ctx, t.cid, opts...)
+	}
+	if err == api.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "getting logs: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting logs: %v", err)
+	}
+	res := make([]*userPb.LogEntry, len(lgs))
+	for i, l := range lgs {
+		res[i] = toRPCLogEntry(l)
+	}
+	return &userPb.LogsResponse{LogEntries: res}, nil
+}
+
+// logsTarget is the Cid or the retrieval whose logs are requested.
+type logsTarget struct {
+	cid cid.Cid
+	rid ffs.RetrievalID
+}
+
+// resolveLogsTarget returns the target of the logs of a request. If only
+// the Job is provided, the target is the Cid of the storage Job or the
+// retrieval of the retrieval Job.
+func resolveLogsTarget(i *api.API, c, jid string) (logsTarget, error) {
+	if c != "" || jid == "" {
+		res, err := util.CidFromString(c)
+		if err != nil {
+			return logsTarget{}, status.Errorf(codes.InvalidArgument, "parsing cid: %v", err)
+		}
+		return logsTarget{cid: res}, nil
+	}
+	if sj, err := i.GetStorageJob(ffs.JobID(jid)); err == nil {
+		return logsTarget{cid: sj.Cid}, nil
+	}
+	rj, err := i.GetRetrievalJob(ffs.JobID(jid))
+	if err != nil {
+		return logsTarget{}, status.Errorf(codes.NotFound, "getting job %s: %v", jid, err)
+	}
+	return logsTarget{rid: rj.RetrievalID}, nil
+}
+
 // CidInfo returns information about cids managed by the FFS instance.
 func (s *Service) CidInfo(ctx context.Context, req *userPb.CidInfoRequest) (*userPb.CidInfoResponse, error) {
 	i, err := s.getInstanceByToken(ctx)
@@ -205,21 +369,42 @@ func (s *Service) CidInfo(ctx context.Context, req *userPb.CidInfoRequest) (*use
 	return &userPb.CidInfoResponse{CidInfos: res}, nil
 }
 
-func receiveFile(srv userPb.UserService_StageServer, writer *io.PipeWriter) {
-	for {
-		req, err := srv.Recv()
-		if err == io.EOF {
-			_ = writer.Close()
-			break
-		} else if err != nil {
-			_ = writer.CloseWithError(err)
-			break
-		}
+// limitedReader reads the data named name from r, failing if more than
+// max bytes are read.
+type limitedReader struct {
+	r    io.Reader
+	name string
+	max  int64
+	read int64
+}
+
+func (lr *limitedReader) Read(p []byte) (int, error) {
+	n, err := lr.r.Read(p)
+	lr.read += int64(n)
+	if lr.read > lr.max {
+		return 0, fmt.Errorf("%s exceeds the maximum size of %d bytes", lr.name, lr.max)
+	}
+	return n, err
+}
+
+// receiveFile writes the chunks of req and the following received
+// messages to writer. A nil req means the stream already ended.
+func receiveFile(srv userPb.UserService_StageServer, writer *io.PipeWriter, req *userPb.StageRequest) {
+	for req != nil {
 		_, writeErr := writer.Write(req.GetChunk())
 		if writeErr != nil {
 			if err := writer.CloseWithError(writeErr); err != nil {
 				log.Errorf("closing with error: %s", err)
 			}
 		}
+		var err error
+		req, err = srv.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = writer.CloseWithError(err)
+			return
+		}
 	}
+	_ = writer.Close()
 }
@@ -1,9 +1,16 @@
 package user
 
 import (
+	"bufio"
 	"context"
+	"io"
+	"time"
 
 	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
+	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/deals/export"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // StorageDealRecords calls ffs.ListStorageDealRecords.
@@ -12,11 +19,15 @@ func (s *Service) StorageDealRecords(ctx context.Context, req *userPb.StorageDea
 	if err != nil {
 		return nil, err
 	}
-	records, err := i.StorageDealRecords(buildListDealRecordsOptions(req.Config)...)
+	page, err := i.StorageDealRecords(buildListDealRecordsOptions(req.Config)...)
 	if err != nil {
 		return nil, err
 	}
-	return &userPb.StorageDealRecordsResponse{Records: toRPCStorageDealRecords(records)}, nil
+	return &userPb.StorageDealRecordsResponse{
+		Records:    toRPCStorageDealRecords(page.Records),
+		NextCursor: page.NextCursor,
+		Summary:    toRPCDealRecordsSummary(page.Summary),
+	}, nil
 }
 
 // RetrievalDealRecords calls ffs.ListRetrievalDealRecords.
@@ -25,9 +36,80 @@ func (s *Service) RetrievalDealRecords(ctx context.Context, req *userPb.Retrieva
 	if err != nil {
 		return nil, err
 	}
-	records, err := i.RetrievalDealRecords(buildListDealRecordsOptions(req.Config)...)
+	page, err := i.RetrievalDealRecords(buildListDealRecordsOptions(req.Config)...)
 	if err != nil {
 		return nil, err
 	}
-	return &userPb.RetrievalDealRecordsResponse{Records: toRPCRetrievalDealRecords(records)}, nil
+	return &userPb.RetrievalDealRecordsResponse{
+		Records:    toRPCRetrievalDealRecords(page.Records),
+		NextCursor: page.NextCursor,
+		Summary:    toRPCDealRecordsSummary(page.Summary),
+	}, nil
+}
+
+// ExportDealRecords streams the deal records of the user as CSV or Parquet.
+func (s *Service) ExportDealRecords(req *userPb.ExportDealRecordsRequest, srv userPb.UserService_ExportDealRecordsServer) error {
+	i, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(&exportWriter{srv: srv}, 1024*32)
+	if err := ExportDealRecords(srv.Context(), w, req.Config, i.StorageDealRecords, i.RetrievalDealRecords); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "sending export: %v", err)
+	}
+	return nil
+}
+
+// ExportDealRecords writes the deal records listed by storage or
+// retrieval to w, as configured by conf.
+func ExportDealRecords(ctx context.Context, w io.Writer, conf *userPb.DealRecordsExportConfig, storage export.StorageLister, retrieval export.RetrievalLister) error {
+	if conf == nil {
+		return status.Error(codes.InvalidArgument, "export config is required")
+	}
+	var format export.Format
+	switch conf.Format {
+	case userPb.ExportFormat_EXPORT_FORMAT_CSV, userPb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
+		format = export.CSV
+	case userPb.ExportFormat_EXPORT_FORMAT_PARQUET:
+		format = export.Parquet
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown export format %v", conf.Format)
+	}
+	var from, to time.Time
+	if conf.FromTime > 0 {
+		from = time.Unix(conf.FromTime, 0)
+	}
+	if conf.ToTime > 0 {
+		to = time.Unix(conf.ToTime, 0)
+	}
+	opts := []deals.DealRecordsOption{deals.WithTimeRange(from, to)}
+
+	var err error
+	switch conf.Kind {
+	case userPb.DealRecordsKind_DEAL_RECORDS_KIND_STORAGE:
+		opts = append(opts, deals.WithIncludeFinal(true), deals.WithIncludePending(conf.IncludePending))
+		err = export.StorageDealRecords(ctx, w, format, storage, opts...)
+	case userPb.DealRecordsKind_DEAL_RECORDS_KIND_RETRIEVAL:
+		err = export.RetrievalDealRecords(ctx, w, format, retrieval, opts...)
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown deal records kind %v", conf.Kind)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "exporting deal records: %v", err)
+	}
+	return nil
+}
+
+type exportWriter struct {
+	srv userPb.UserService_ExportDealRecordsServer
+}
+
+func (ew *exportWriter) Write(p []byte) (int, error) {
+	if err := ew.srv.Send(&userPb.ExportDealRecordsResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/ffs/api"
 	"github.com/textileio/powergate/ffs/manager"
+	"github.com/textileio/powergate/ffs/piece"
 	"github.com/textileio/powergate/wallet"
 )
 
@@ -27,14 +28,23 @@ type Service struct {
 	m   *manager.Manager
 	w   wallet.Module
 	hot ffs.HotStorage
+	pc  *piece.Cache
+
+	maxCARSize int64
+	maxTarSize int64
 }
 
-// New creates a new powergate Service.
-func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage) *Service {
+// New creates a new powergate Service. If pc isn't nil, pieces of staged
+// data, and of data pushed to the cold tier, are precomputed. Staged CAR files can't exceed maxCARSize bytes,
+// and staged tar archives can't exceed maxTarSize bytes, unless they're zero.
+func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage, pc *piece.Cache, maxCARSize, maxTarSize int64) *Service {
 	return &Service{
-		m:   m,
-		w:   w,
-		hot: hot,
+		m:          m,
+		w:          w,
+		hot:        hot,
+		pc:         pc,
+		maxCARSize: maxCARSize,
+		maxTarSize: maxTarSize,
 	}
 }
 
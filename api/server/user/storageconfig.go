@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"github.com/ipfs/go-cid"
 	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/ffs/api"
@@ -69,6 +70,7 @@ func (s *Service) ApplyStorageConfig(ctx context.Context, req *userPb.ApplyStora
 	if err != nil {
 		return nil, err
 	}
+	s.precompute(i, c)
 
 	return &userPb.ApplyStorageConfigResponse{
 		JobId: jid.String(),
@@ -93,3 +95,19 @@ func (s *Service) Remove(ctx context.Context, req *userPb.RemoveRequest) (*userP
 
 	return &userPb.RemoveResponse{}, nil
 }
+
+// precompute calculates the piece of c in the background if its storage
+// config stores it in the cold tier, so deals don't wait for it.
+func (s *Service) precompute(i *api.API, c cid.Cid) {
+	if s.pc == nil {
+		return
+	}
+	cfgs, err := i.GetStorageConfigs(c)
+	if err != nil {
+		log.Errorf("getting storage config of %s: %s", c, err)
+		return
+	}
+	if cfgs[c].Cold.Enabled {
+		s.pc.Precompute(c)
+	}
+}
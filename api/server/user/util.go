@@ -2,12 +2,16 @@ package user
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/deals"
 	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/api"
 	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
 )
 
 // ToRPCStorageConfig converts from a ffs.StorageConfig to a rpc StorageConfig.
@@ -43,10 +47,17 @@ func toRPCColdConfig(config ffs.ColdConfig) *userPb.ColdConfig {
 				Enabled:   config.Filecoin.Renew.Enabled,
 				Threshold: int64(config.Filecoin.Renew.Threshold),
 			},
-			Address:         config.Filecoin.Addr,
-			MaxPrice:        config.Filecoin.MaxPrice,
-			FastRetrieval:   config.Filecoin.FastRetrieval,
-			DealStartOffset: config.Filecoin.DealStartOffset,
+			Address:           config.Filecoin.Addr,
+			MaxPrice:          config.Filecoin.MaxPrice,
+			FastRetrieval:     config.Filecoin.FastRetrieval,
+			DealStartOffset:   config.Filecoin.DealStartOffset,
+			MaxRetrievalPrice: config.Filecoin.MaxRetrievalPrice,
+			Escalation: &userPb.FilEscalation{
+				Enabled:       config.Filecoin.Escalation.Enabled,
+				MaxRounds:     int64(config.Filecoin.Escalation.MaxRounds),
+				PriceIncrease: int64(config.Filecoin.Escalation.PriceIncrease),
+				Deadline:      int64(config.Filecoin.Escalation.Deadline),
+			},
 		},
 	}
 }
@@ -89,15 +100,16 @@ func fromRPCColdConfig(config *userPb.ColdConfig) ffs.ColdConfig {
 		res.Enabled = config.Enabled
 		if config.Filecoin != nil {
 			filecoin := ffs.FilConfig{
-				RepFactor:       int(config.Filecoin.ReplicationFactor),
-				DealMinDuration: config.Filecoin.DealMinDuration,
-				ExcludedMiners:  config.Filecoin.ExcludedMiners,
-				CountryCodes:    config.Filecoin.CountryCodes,
-				TrustedMiners:   config.Filecoin.TrustedMiners,
-				Addr:            config.Filecoin.Address,
-				MaxPrice:        config.Filecoin.MaxPrice,
-				FastRetrieval:   config.Filecoin.FastRetrieval,
-				DealStartOffset: config.Filecoin.DealStartOffset,
+				RepFactor:         int(config.Filecoin.ReplicationFactor),
+				DealMinDuration:   config.Filecoin.DealMinDuration,
+				ExcludedMiners:    config.Filecoin.ExcludedMiners,
+				CountryCodes:      config.Filecoin.CountryCodes,
+				TrustedMiners:     config.Filecoin.TrustedMiners,
+				Addr:              config.Filecoin.Address,
+				MaxPrice:          config.Filecoin.MaxPrice,
+				FastRetrieval:     config.Filecoin.FastRetrieval,
+				DealStartOffset:   config.Filecoin.DealStartOffset,
+				MaxRetrievalPrice: config.Filecoin.MaxRetrievalPrice,
 			}
 			if config.Filecoin.Renew != nil {
 				renew := ffs.FilRenew{
@@ -106,6 +118,14 @@ func fromRPCColdConfig(config *userPb.ColdConfig) ffs.ColdConfig {
 				}
 				filecoin.Renew = renew
 			}
+			if config.Filecoin.Escalation != nil {
+				filecoin.Escalation = ffs.FilEscalation{
+					Enabled:       config.Filecoin.Escalation.Enabled,
+					MaxRounds:     int(config.Filecoin.Escalation.MaxRounds),
+					PriceIncrease: int(config.Filecoin.Escalation.PriceIncrease),
+					Deadline:      int(config.Filecoin.Escalation.Deadline),
+				}
+			}
 			res.Filecoin = filecoin
 		}
 	}
@@ -151,23 +171,64 @@ func toRPCStorageInfo(info ffs.StorageInfo) *userPb.StorageInfo {
 			StartEpoch:      p.StartEpoch,
 			Miner:           p.Miner,
 			EpochPrice:      p.EpochPrice,
+			DealId:          p.DealID,
+			State:           toRPCDealState(p.State),
+			StateReason:     p.StateReason,
 		}
 	}
 	return storageInfo
 }
 
+func toRPCDealState(state ffs.DealState) userPb.DealState {
+	switch state {
+	case ffs.DealActive:
+		return userPb.DealState_DEAL_STATE_ACTIVE
+	case ffs.DealSlashed:
+		return userPb.DealState_DEAL_STATE_SLASHED
+	case ffs.DealExpired:
+		return userPb.DealState_DEAL_STATE_EXPIRED
+	default:
+		return userPb.DealState_DEAL_STATE_UNSPECIFIED
+	}
+}
+
 func buildListDealRecordsOptions(conf *userPb.DealRecordsConfig) []deals.DealRecordsOption {
-	var opts []deals.DealRecordsOption
-	if conf != nil {
-		opts = []deals.DealRecordsOption{
-			deals.WithAscending(conf.Ascending),
-			deals.WithDataCids(conf.DataCids...),
-			deals.WithFromAddrs(conf.FromAddrs...),
-			deals.WithIncludePending(conf.IncludePending),
-			deals.WithIncludeFinal(conf.IncludeFinal),
-		}
+	if conf == nil {
+		return nil
+	}
+	var from, to time.Time
+	if conf.FromTime > 0 {
+		from = time.Unix(conf.FromTime, 0)
+	}
+	if conf.ToTime > 0 {
+		to = time.Unix(conf.ToTime, 0)
+	}
+	return []deals.DealRecordsOption{
+		deals.WithAscending(conf.Ascending),
+		deals.WithDataCids(conf.DataCids...),
+		deals.WithFromAddrs(conf.FromAddrs...),
+		deals.WithIncludePending(conf.IncludePending),
+		deals.WithIncludeFinal(conf.IncludeFinal),
+		deals.WithMiners(conf.Miners...),
+		deals.WithPieceCids(conf.PieceCids...),
+		deals.WithStateIDs(conf.StateIds...),
+		deals.WithTimeRange(from, to),
+		deals.WithPriceRange(conf.MinPrice, conf.MaxPrice),
+		deals.WithLimit(int(conf.Limit)),
+		deals.WithCursor(conf.Cursor),
+		deals.WithIncludeSummary(conf.IncludeSummary),
+	}
+}
+
+func toRPCDealRecordsSummary(s *deals.DealRecordsSummary) *userPb.DealRecordsSummary {
+	if s == nil {
+		return nil
+	}
+	return &userPb.DealRecordsSummary{
+		Count:        s.Count,
+		TotalAttofil: s.TotalAttoFil.String(),
+		TotalBytes:   s.TotalBytes,
 	}
-	return opts
 }
 
 func toRPCStorageDealRecords(records []deals.StorageDealRecord) []*userPb.StorageDealRecord {
@@ -178,6 +239,7 @@ func toRPCStorageDealRecords(records []deals.StorageDealRecord) []*userPb.Storag
 			Address: r.Addr,
 			Time:    r.Time,
 			Pending: r.Pending,
+			JobId:   r.JobID,
 			DealInfo: &userPb.StorageDealInfo{
 				ProposalCid:     util.CidToString(r.DealInfo.ProposalCid),
 				StateId:         r.DealInfo.StateID,
@@ -201,8 +263,15 @@ func toRPCRetrievalDealRecords(records []deals.RetrievalDealRecord) []*userPb.Re
 	ret := make([]*userPb.RetrievalDealRecord, len(records))
 	for i, r := range records {
 		ret[i] = &userPb.RetrievalDealRecord{
-			Address: r.Addr,
-			Time:    r.Time,
+			Address:       r.Addr,
+			Time:          r.Time,
+			JobId:         r.JobID,
+			RetrievalId:   r.RetrievalID,
+			Status:        toRPCRetrievalDealStatus(r.Status),
+			Error:         r.Error,
+			BytesReceived: r.BytesReceived,
+			FundsSpent:    r.FundsSpent,
+			ElapsedMs:     r.Elapsed.Milliseconds(),
 			DealInfo: &userPb.RetrievalDealInfo{
 				RootCid:                 util.CidToString(r.DealInfo.RootCid),
 				Size:                    r.DealInfo.Size,
@@ -217,6 +286,21 @@ func toRPCRetrievalDealRecords(records []deals.RetrievalDealRecord) []*userPb.Re
 	return ret
 }
 
+func toRPCRetrievalDealStatus(s deals.RetrievalStatus) userPb.RetrievalDealStatus {
+	switch s {
+	case deals.RetrievalSucceeded:
+		return userPb.RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_SUCCEEDED
+	case deals.RetrievalInProgress:
+		return userPb.RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_IN_PROGRESS
+	case deals.RetrievalFailed:
+		return userPb.RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_FAILED
+	case deals.RetrievalCanceled:
+		return userPb.RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_CANCELED
+	default:
+		return userPb.RetrievalDealStatus_RETRIEVAL_DEAL_STATUS_UNSPECIFIED
+	}
+}
+
 // ToProtoStorageJobs converts a slice of ffs.StorageJobs to proto Jobs.
 func ToProtoStorageJobs(jobs []ffs.StorageJob) ([]*userPb.StorageJob, error) {
 	var res []*userPb.StorageJob
@@ -276,9 +360,146 @@ func toRPCJob(job ffs.StorageJob) (*userPb.StorageJob, error) {
 		DealErrors: toRPCDealErrors(job.DealErrors),
 		CreatedAt:  job.CreatedAt,
 		DealInfo:   dealInfo,
+		Steps:      toRPCJobSteps(job.Steps),
 	}, nil
 }
 
+func toRPCJobSteps(steps []ffs.JobStep) []*userPb.JobStep {
+	ret := make([]*userPb.JobStep, len(steps))
+	for i, s := range steps {
+		ret[i] = &userPb.JobStep{
+			Name:       s.Name,
+			Detail:     s.Detail,
+			StartedAt:  s.StartedAt,
+			FinishedAt: s.FinishedAt,
+			Result:     s.Result,
+			Error:      s.Error,
+		}
+	}
+	return ret
+}
+
+func toRPCTransactions(txs []wallet.Transaction) ([]*userPb.Transaction, error) {
+	ret := make([]*userPb.Transaction, len(txs))
+	for i, tx := range txs {
+		var kind userPb.TransactionKind
+		switch tx.Kind {
+		case wallet.TxTransfer:
+			kind = userPb.TransactionKind_TRANSACTION_KIND_TRANSFER
+		case wallet.TxEscrowDeposit:
+			kind = userPb.TransactionKind_TRANSACTION_KIND_ESCROW_DEPOSIT
+		case wallet.TxEscrowWithdrawal:
+			kind = userPb.TransactionKind_TRANSACTION_KIND_ESCROW_WITHDRAWAL
+		default:
+			return nil, fmt.Errorf("unknown transaction kind: %v", tx.Kind)
+		}
+		var status userPb.TransactionStatus
+		switch tx.Status {
+		case wallet.TxPending:
+			status = userPb.TransactionStatus_TRANSACTION_STATUS_PENDING
+		case wallet.TxConfirmed:
+			status = userPb.TransactionStatus_TRANSACTION_STATUS_CONFIRMED
+		case wallet.TxFailed:
+			status = userPb.TransactionStatus_TRANSACTION_STATUS_FAILED
+		case wallet.TxDropped:
+			status = userPb.TransactionStatus_TRANSACTION_STATUS_DROPPED
+		default:
+			return nil, fmt.Errorf("unknown transaction status: %v", tx.Status)
+		}
+		var amount string
+		if tx.Amount != nil {
+			amount = tx.Amount.String()
+		}
+		ret[i] = &userPb.Transaction{
+			MessageCid: util.CidToString(tx.MessageCid),
+			From:       tx.From,
+			To:         tx.To,
+			Amount:     amount,
+			Kind:       kind,
+			Status:     status,
+			ExitCode:   tx.ExitCode,
+			Height:     tx.Height,
+			Time:       tx.Time,
+		}
+	}
+	return ret, nil
+}
+
+// ToRPCSpendPolicy converts from an api.SpendPolicy to a rpc SpendPolicy.
+func ToRPCSpendPolicy(policy api.SpendPolicy) *userPb.SpendPolicy {
+	amountString := func(amt *big.Int) string {
+		if amt == nil {
+			return ""
+		}
+		return amt.String()
+	}
+	return &userPb.SpendPolicy{
+		MaxPerTx:            amountString(policy.MaxPerTx),
+		MaxPerDay:           amountString(policy.MaxPerDay),
+		AllowedDestinations: policy.AllowedDestinations,
+		ApprovalThreshold:   amountString(policy.ApprovalThreshold),
+	}
+}
+
+// FromRPCSpendPolicy converts from a rpc SpendPolicy to an api.SpendPolicy.
+func FromRPCSpendPolicy(policy *userPb.SpendPolicy) (api.SpendPolicy, error) {
+	var res api.SpendPolicy
+	if policy == nil {
+		return res, nil
+	}
+	parseAmount := func(s string) (*big.Int, error) {
+		if s == "" {
+			return nil, nil
+		}
+		amt, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("parsing amount %v", s)
+		}
+		return amt, nil
+	}
+	var err error
+	if res.MaxPerTx, err = parseAmount(policy.MaxPerTx); err != nil {
+		return api.SpendPolicy{}, err
+	}
+	if res.MaxPerDay, err = parseAmount(policy.MaxPerDay); err != nil {
+		return api.SpendPolicy{}, err
+	}
+	if res.ApprovalThreshold, err = parseAmount(policy.ApprovalThreshold); err != nil {
+		return api.SpendPolicy{}, err
+	}
+	res.AllowedDestinations = policy.AllowedDestinations
+	return res, nil
+}
+
+func toRPCMultisigInfo(mi *api.MultisigInfo) *userPb.MultisigInfo {
+	if mi == nil {
+		return nil
+	}
+	return &userPb.MultisigInfo{
+		Creator:   mi.Creator,
+		Signers:   mi.Signers,
+		Threshold: mi.Threshold,
+	}
+}
+
+func toRPCMultisigTransactions(txs []wallet.MultisigTx) []*userPb.MultisigTransaction {
+	ret := make([]*userPb.MultisigTransaction, len(txs))
+	for i, tx := range txs {
+		amount := "0"
+		if tx.Amount != nil {
+			amount = tx.Amount.String()
+		}
+		ret[i] = &userPb.MultisigTransaction{
+			Id:       tx.ID,
+			To:       tx.To,
+			Amount:   amount,
+			Method:   tx.Method,
+			Approved: tx.Approved,
+		}
+	}
+	return ret
+}
+
 func fromProtoCids(cids []string) ([]cid.Cid, error) {
 	var res []cid.Cid
 	for _, cid := range cids {
@@ -290,3 +511,55 @@ func fromProtoCids(cids []string) ([]cid.Cid, error) {
 	}
 	return res, nil
 }
+
+func toRPCLogEntry(l ffs.LogEntry) *userPb.LogEntry {
+	return &userPb.LogEntry{
+		Cid:         util.CidToString(l.Cid),
+		RetrievalId: l.RetrievalID.String(),
+		JobId:       l.Jid.String(),
+		Time:        l.Timestamp.Unix(),
+		Message:     l.Msg,
+		Level:       toRPCLogLevel(l.Level),
+		Event:       l.Event,
+		Fields:      l.Fields,
+	}
+}
+
+func toRPCLogLevel(level ffs.LogLevel) userPb.LogLevel {
+	switch level {
+	case ffs.LogDebug:
+		return userPb.LogLevel_LOG_LEVEL_DEBUG
+	case ffs.LogInfo:
+		return userPb.LogLevel_LOG_LEVEL_INFO
+	case ffs.LogWarn:
+		return userPb.LogLevel_LOG_LEVEL_WARN
+	case ffs.LogError:
+		return userPb.LogLevel_LOG_LEVEL_ERROR
+	default:
+		return userPb.LogLevel_LOG_LEVEL_UNSPECIFIED
+	}
+}
+
+func fromRPCLogLevel(level userPb.LogLevel) ffs.LogLevel {
+	switch level {
+	case userPb.LogLevel_LOG_LEVEL_DEBUG:
+		return ffs.LogDebug
+	case userPb.LogLevel_LOG_LEVEL_INFO:
+		return ffs.LogInfo
+	case userPb.LogLevel_LOG_LEVEL_WARN:
+		return ffs.LogWarn
+	case userPb.LogLevel_LOG_LEVEL_ERROR:
+		return ffs.LogError
+	default:
+		return ffs.LogUnspecified
+	}
+}
+
+// fromUnix returns the time of unix seconds, or the zero time if sec is
+// zero.
+func fromUnix(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
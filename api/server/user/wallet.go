@@ -2,11 +2,14 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/ffs/api"
+	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -56,10 +59,12 @@ func (s *Service) Addresses(ctx context.Context, req *userPb.AddressesRequest) (
 			return nil, status.Errorf(codes.Internal, "getting address balance: %v", err)
 		}
 		res[i] = &userPb.AddrInfo{
-			Name:    addr.Name,
-			Address: addr.Addr,
-			Type:    addr.Type,
-			Balance: bal.String(),
+			Name:        addr.Name,
+			Address:     addr.Addr,
+			Type:        addr.Type,
+			Balance:     bal.String(),
+			SpendPolicy: ToRPCSpendPolicy(addr.SpendPolicy),
+			Multisig:    toRPCMultisigInfo(addr.Multisig),
 		}
 	}
 	return &userPb.AddressesResponse{Addresses: res}, nil
@@ -75,10 +80,126 @@ func (s *Service) SendFil(ctx context.Context, req *userPb.SendFilRequest) (*use
 	if !ok {
 		return nil, status.Errorf(codes.InvalidArgument, "parsing amount %v", req.Amount)
 	}
-	if err := i.SendFil(ctx, req.From, req.To, amt); err != nil {
+	c, srID, err := i.SendFil(ctx, req.From, req.To, amt)
+	if errors.Is(err, api.ErrSpendPolicyViolation) {
+		return nil, status.Errorf(codes.PermissionDenied, "sending fil: %v", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if srID != "" {
+		return &userPb.SendFilResponse{SendRequestId: srID.String()}, nil
+	}
+	return &userPb.SendFilResponse{MessageCid: util.CidToString(c)}, nil
+}
+
+// Transactions returns the recorded transactions of managed addresses.
+func (s *Service) Transactions(ctx context.Context, req *userPb.TransactionsRequest) (*userPb.TransactionsResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset and limit can't be negative")
+	}
+	opts := []wallet.TransactionsOption{
+		wallet.WithTxAscending(req.Ascending),
+		wallet.WithTxOffset(int(req.Offset)),
+		wallet.WithTxLimit(int(req.Limit)),
+	}
+	txs, err := i.Transactions(ctx, req.Address, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting transactions: %v", err)
+	}
+	res, err := toRPCTransactions(txs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting transactions: %v", err)
+	}
+	return &userPb.TransactionsResponse{Transactions: res}, nil
+}
+
+// NewMultisigAddress creates a new multisig address managed by the user.
+func (s *Service) NewMultisigAddress(ctx context.Context, req *userPb.NewMultisigAddressRequest) (*userPb.NewMultisigAddressResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amt := big.NewInt(0)
+	if req.Amount != "" {
+		var ok bool
+		if amt, ok = new(big.Int).SetString(req.Amount, 10); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing amount %v", req.Amount)
+		}
+	}
+	var opts []api.NewAddressOption
+	if req.MakeDefault {
+		opts = append(opts, api.WithMakeDefault(req.MakeDefault))
+	}
+	addr, err := i.NewMultisigAddr(ctx, req.Name, req.Signers, req.Threshold, amt, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating multisig address: %v", err)
+	}
+	return &userPb.NewMultisigAddressResponse{Address: addr}, nil
+}
+
+// MultisigPending returns the transactions of a multisig address waiting for approvals.
+func (s *Service) MultisigPending(ctx context.Context, req *userPb.MultisigPendingRequest) (*userPb.MultisigPendingResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
 		return nil, err
 	}
-	return &userPb.SendFilResponse{}, nil
+	txs, err := i.MultisigPending(ctx, req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting pending multisig transactions: %v", err)
+	}
+	return &userPb.MultisigPendingResponse{Transactions: toRPCMultisigTransactions(txs)}, nil
+}
+
+// MultisigPropose proposes a transfer from a multisig address.
+func (s *Service) MultisigPropose(ctx context.Context, req *userPb.MultisigProposeRequest) (*userPb.MultisigProposeResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amt, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing amount %v", req.Amount)
+	}
+	c, err := i.MultisigPropose(ctx, req.Address, req.To, amt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "proposing multisig transfer: %v", err)
+	}
+	return &userPb.MultisigProposeResponse{MessageCid: util.CidToString(c)}, nil
+}
+
+// MultisigProposeEscrowTopUp proposes a storage market escrow deposit from a multisig address.
+func (s *Service) MultisigProposeEscrowTopUp(ctx context.Context, req *userPb.MultisigProposeEscrowTopUpRequest) (*userPb.MultisigProposeEscrowTopUpResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amt, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing amount %v", req.Amount)
+	}
+	c, err := i.MultisigProposeEscrowTopUp(ctx, req.Address, amt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "proposing multisig escrow top-up: %v", err)
+	}
+	return &userPb.MultisigProposeEscrowTopUpResponse{MessageCid: util.CidToString(c)}, nil
+}
+
+// MultisigApprove approves a pending transaction of a multisig address.
+func (s *Service) MultisigApprove(ctx context.Context, req *userPb.MultisigApproveRequest) (*userPb.MultisigApproveResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := i.MultisigApprove(ctx, req.Address, req.Approver, req.TransactionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "approving multisig transaction: %v", err)
+	}
+	return &userPb.MultisigApproveResponse{MessageCid: util.CidToString(c)}, nil
 }
 
 // SignMessage calls ffs.SignMessage.
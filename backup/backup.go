@@ -0,0 +1,579 @@
+// Package backup creates and restores portable archives of the Powergate
+// datastore, and copies the datastore between backends. An archive is a
+// gzipped tar file which starts with a manifest.json entry, followed by
+// one entry per datastore key.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/migration"
+)
+
+const (
+	// FormatVersion is the version of the archive format.
+	FormatVersion = 1
+
+	manifestName = "manifest.json"
+	dataDir      = "data"
+
+	// restoreBatchSize is the number of keys restored per transaction.
+	restoreBatchSize = 1000
+)
+
+var (
+	log = logging.Logger("backup")
+
+	managerAPIPrefix     = "/ffs/manager/api/"
+	managerAuthPrefix    = "/ffs/manager/auth/"
+	storageJobPrefix     = "/ffs/scheduler/sjstore/job/"
+	retrievalJobPrefix   = "/ffs/scheduler/rjstore/job/"
+	jobLogPrefix         = "/ffs/joblogger/"
+	dealsPrefix          = "/deals/"
+	dealsAddrsPrefix     = "/deals/index-addrs/"
+	dealsSummariesPrefix = "/deals/summaries/"
+	versionKey           = "/migration/version"
+
+	keyRestoreCheckpoint = datastore.NewKey("/backup/restorecheckpoint")
+
+	// dealRecordPrefixes are the prefixes of deal records and their
+	// index entries.
+	dealRecordPrefixes = []string{
+		"/deals/storage-pending/",
+		"/deals/storage-final/",
+		"/deals/retrieval/",
+		"/deals/storage-index/",
+		"/deals/retrieval-index/",
+	}
+)
+
+// Manifest describes the content of an archive.
+type Manifest struct {
+	// FormatVersion is the version of the archive format.
+	FormatVersion int
+	// CreatedAt is the time the snapshot was taken.
+	CreatedAt time.Time
+	// DatastoreVersion is the migration version of the datastore.
+	DatastoreVersion int
+	// UserID is the user instance of a selective export, empty for
+	// full backups.
+	UserID ffs.APIID
+	// Total is the total number of keys.
+	Total int
+	// Prefixes is the number of keys per prefix, considering the first
+	// two namespaces of keys, e.g: /ffs/scheduler.
+	Prefixes map[string]int
+}
+
+// Backup writes an archive of a consistent snapshot of ds to w. If iid
+// isn't empty, only the data of that user instance is included.
+func Backup(ctx context.Context, w io.Writer, ds datastore.TxnDatastore, iid ffs.APIID) (Manifest, error) {
+	txn, err := ds.NewTransaction(true)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+
+	dsVersion, err := migration.ReadVersion(txn)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("getting datastore version: %s", err)
+	}
+	filter := func(string, []byte) (bool, error) { return true, nil }
+	if iid != ffs.EmptyInstanceID {
+		filter, err = userFilter(txn, iid)
+		if err != nil {
+			return Manifest{}, fmt.Errorf("creating user filter: %s", err)
+		}
+	}
+
+	manifest := Manifest{
+		FormatVersion:    FormatVersion,
+		CreatedAt:        time.Now(),
+		DatastoreVersion: dsVersion,
+		UserID:           iid,
+		Prefixes:         make(map[string]int),
+	}
+	// The manifest goes first so restores can be validated before
+	// writing any key, so keys are iterated twice in the same snapshot.
+	if err := iterate(ctx, txn, filter, func(k string, _ []byte) error {
+		manifest.Total++
+		manifest.Prefixes[prefixOf(k)]++
+		return nil
+	}); err != nil {
+		return Manifest{}, fmt.Errorf("counting keys: %s", err)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	buf, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, fmt.Errorf("marshaling manifest: %s", err)
+	}
+	if err := writeEntry(tw, manifestName, buf, manifest.CreatedAt); err != nil {
+		return Manifest{}, fmt.Errorf("writing manifest: %s", err)
+	}
+	var count int
+	if err := iterate(ctx, txn, filter, func(k string, v []byte) error {
+		count++
+		return writeEntry(tw, dataDir+k, v, manifest.CreatedAt)
+	}); err != nil {
+		return Manifest{}, fmt.Errorf("writing keys: %s", err)
+	}
+	if count != manifest.Total {
+		return Manifest{}, fmt.Errorf("snapshot changed while writing, counted %d keys but wrote %d", manifest.Total, count)
+	}
+	if err := tw.Close(); err != nil {
+		return Manifest{}, fmt.Errorf("closing tar writer: %s", err)
+	}
+	if err := gw.Close(); err != nil {
+		return Manifest{}, fmt.Errorf("closing gzip writer: %s", err)
+	}
+	log.Infof("backup of %d keys finished", manifest.Total)
+	return manifest, nil
+}
+
+// Restore restores an archive read from r into ds. Restoring into a
+// non-empty datastore is only allowed if it has the same datastore version
+// as the archive. Existing keys are overwritten only if overwrite is true,
+// otherwise the restore fails before writing any key. Keys are restored in
+// batches of restoreBatchSize keys, and the datastore version is written
+// last together with removing a restore checkpoint. If a restore fails,
+// calling Restore again with the same archive restores it from scratch.
+func Restore(r io.Reader, ds datastore.TxnDatastore, overwrite bool) (Manifest, error) {
+	tr, manifest, err := openArchive(r)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return Manifest{}, fmt.Errorf("unsupported archive format version %d", manifest.FormatVersion)
+	}
+	cp, resuming, err := getRestoreCheckpoint(ds)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if resuming {
+		if !cp.Manifest.CreatedAt.Equal(manifest.CreatedAt) || cp.Manifest.UserID != manifest.UserID || cp.Manifest.Total != manifest.Total {
+			return Manifest{}, fmt.Errorf("a restore of another archive created at %s was interrupted", cp.Manifest.CreatedAt)
+		}
+		log.Infof("restarting interrupted restore of archive created at %s", manifest.CreatedAt)
+	} else {
+		if err := checkVersion(ds, manifest); err != nil {
+			return Manifest{}, err
+		}
+		wasEmpty, err := isEmpty(ds)
+		if err != nil {
+			return Manifest{}, err
+		}
+		if !overwrite {
+			if err := checkConflicts(ds, manifest); err != nil {
+				return Manifest{}, err
+			}
+		}
+		// Selective exports don't include the datastore version, so it's
+		// set to avoid re-running migrations on restored data.
+		cp = restoreCheckpoint{
+			Manifest:   manifest,
+			SetVersion: wasEmpty && manifest.UserID != ffs.EmptyInstanceID,
+		}
+		if err := putRestoreCheckpoint(ds, cp); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	restored := make(map[string]int)
+	var total, pending int
+	var version []byte
+	txn, err := ds.NewTransaction(false)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("creating transaction: %s", err)
+	}
+	defer func() { txn.Discard() }()
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Manifest{}, fmt.Errorf("reading archive: %s", err)
+		}
+		k, err := keyFromEntry(hdr.Name)
+		if err != nil {
+			return Manifest{}, err
+		}
+		v, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return Manifest{}, fmt.Errorf("reading value of %s: %s", k, err)
+		}
+		restored[prefixOf(k)]++
+		total++
+		if k == versionKey {
+			version = v
+			continue
+		}
+		if err := txn.Put(datastore.NewKey(k), v); err != nil {
+			return Manifest{}, fmt.Errorf("putting %s: %s", k, err)
+		}
+		pending++
+		if pending < restoreBatchSize {
+			continue
+		}
+		if err := txn.Commit(); err != nil {
+			return Manifest{}, fmt.Errorf("committing batch: %s", err)
+		}
+		txn.Discard()
+		if txn, err = ds.NewTransaction(false); err != nil {
+			return Manifest{}, fmt.Errorf("creating transaction: %s", err)
+		}
+		pending = 0
+	}
+	if total != manifest.Total {
+		return Manifest{}, fmt.Errorf("manifest has %d keys but %d were restored", manifest.Total, total)
+	}
+	for p, c := range manifest.Prefixes {
+		if restored[p] != c {
+			return Manifest{}, fmt.Errorf("manifest has %d keys with prefix %s but %d were restored", c, p, restored[p])
+		}
+	}
+	if version != nil {
+		if err := txn.Put(datastore.NewKey(versionKey), version); err != nil {
+			return Manifest{}, fmt.Errorf("putting datastore version: %s", err)
+		}
+	}
+	if cp.SetVersion {
+		m, err := migration.New(ds, migration.Migrations())
+		if err != nil {
+			return Manifest{}, fmt.Errorf("creating migrator: %s", err)
+		}
+		if err := m.SetVersion(txn, manifest.DatastoreVersion); err != nil {
+			return Manifest{}, fmt.Errorf("setting datastore version: %s", err)
+		}
+	}
+	if err := txn.Delete(keyRestoreCheckpoint); err != nil {
+		return Manifest{}, fmt.Errorf("deleting restore checkpoint: %s", err)
+	}
+	if err := txn.Commit(); err != nil {
+		return Manifest{}, fmt.Errorf("committing transaction: %s", err)
+	}
+	log.Infof("restore of %d keys finished", total)
+	return manifest, nil
+}
+
+// restoreCheckpoint is saved while an archive is being restored.
+type restoreCheckpoint struct {
+	Manifest   Manifest
+	SetVersion bool
+}
+
+func getRestoreCheckpoint(ds datastore.Datastore) (restoreCheckpoint, bool, error) {
+	buf, err := ds.Get(keyRestoreCheckpoint)
+	if err == datastore.ErrNotFound {
+		return restoreCheckpoint{}, false, nil
+	}
+	if err != nil {
+		return restoreCheckpoint{}, false, fmt.Errorf("getting restore checkpoint: %s", err)
+	}
+	var cp restoreCheckpoint
+	if err := json.Unmarshal(buf, &cp); err != nil {
+		return restoreCheckpoint{}, false, fmt.Errorf("unmarshaling restore checkpoint: %s", err)
+	}
+	return cp, true, nil
+}
+
+func putRestoreCheckpoint(ds datastore.Datastore, cp restoreCheckpoint) error {
+	buf, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("marshaling restore checkpoint: %s", err)
+	}
+	if err := ds.Put(keyRestoreCheckpoint, buf); err != nil {
+		return fmt.Errorf("saving restore checkpoint: %s", err)
+	}
+	return nil
+}
+
+// ReadManifest returns the manifest of an archive.
+func ReadManifest(r io.Reader) (Manifest, error) {
+	_, manifest, err := openArchive(r)
+	return manifest, err
+}
+
+// openArchive reads the manifest of an archive, returning a reader
+// positioned at the first key entry.
+func openArchive(r io.Reader) (*tar.Reader, Manifest, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, Manifest{}, fmt.Errorf("opening gzip reader: %s", err)
+	}
+	tr := tar.NewReader(gr)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, Manifest{}, fmt.Errorf("reading manifest header: %s", err)
+	}
+	if hdr.Name != manifestName {
+		return nil, Manifest{}, fmt.Errorf("archive should start with %s, got %s", manifestName, hdr.Name)
+	}
+	var manifest Manifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, Manifest{}, fmt.Errorf("decoding manifest: %s", err)
+	}
+	return tr, manifest, nil
+}
+
+// checkVersion checks that the archive can be restored in ds considering
+// datastore versions.
+func checkVersion(ds datastore.TxnDatastore, manifest Manifest) error {
+	m, err := migration.New(ds, migration.Migrations())
+	if err != nil {
+		return fmt.Errorf("creating migrator: %s", err)
+	}
+	if manifest.DatastoreVersion > m.LatestVersion() {
+		return fmt.Errorf("archive version %d, latest known %d: %w", manifest.DatastoreVersion, m.LatestVersion(), migration.ErrUnknownVersion)
+	}
+	empty, err := isEmpty(ds)
+	if err != nil {
+		return err
+	}
+	if empty {
+		return nil
+	}
+	current, err := m.Version()
+	if err != nil {
+		return fmt.Errorf("getting datastore version: %s", err)
+	}
+	if current != manifest.DatastoreVersion {
+		return fmt.Errorf("archive has datastore version %d but target datastore has version %d", manifest.DatastoreVersion, current)
+	}
+	return nil
+}
+
+// checkConflicts returns an error if keys of the archive might exist in ds.
+func checkConflicts(ds datastore.TxnDatastore, manifest Manifest) error {
+	empty, err := isEmpty(ds)
+	if err != nil {
+		return err
+	}
+	if empty {
+		return nil
+	}
+	if manifest.UserID == ffs.EmptyInstanceID {
+		return fmt.Errorf("restoring a full backup requires an empty datastore or overwriting")
+	}
+	// Keys of user instances are namespaced by its id, so it's enough to
+	// check that the instance doesn't exist.
+	res, err := ds.Query(query.Query{Prefix: managerAPIPrefix + manifest.UserID.String(), KeysOnly: true, Limit: 1})
+	if err != nil {
+		return fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iterating results: %s", r.Error)
+		}
+		return fmt.Errorf("user %s already exists", manifest.UserID)
+	}
+	return nil
+}
+
+// userFilter returns a filter of keys of the iid user instance. These are
+// the instance namespace in the manager, which includes storage configs
+// and send requests, its auth tokens, log entries of its jobs, and deal
+// records and summaries of its wallet addresses. Deal records of addresses
+// shared with other users, e.g. the master address, are included too. Scheduler state
+// such as jobs and storage infos isn't included, since it's rebuilt by
+// pushing the storage configs of the restored instance.
+func userFilter(txn datastore.Txn, iid ffs.APIID) (func(string, []byte) (bool, error), error) {
+	addrs, err := instanceAddrs(txn, iid)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make(map[ffs.JobID]struct{})
+	for _, prefix := range []string{storageJobPrefix, retrievalJobPrefix} {
+		if err := userJobs(txn, prefix, iid, jobs); err != nil {
+			return nil, fmt.Errorf("getting jobs: %s", err)
+		}
+	}
+
+	instancePrefix := managerAPIPrefix + iid.String()
+	return func(k string, v []byte) (bool, error) {
+		switch {
+		case k == instancePrefix || strings.HasPrefix(k, instancePrefix+"/"):
+			return true, nil
+		case strings.HasPrefix(k, managerAuthPrefix):
+			var e ffs.AuthEntry
+			if err := json.Unmarshal(v, &e); err != nil {
+				return false, fmt.Errorf("unmarshaling auth entry: %s", err)
+			}
+			return e.APIID == iid, nil
+		case strings.HasPrefix(k, jobLogPrefix):
+			var le struct{ Jid ffs.JobID }
+			if err := json.Unmarshal(v, &le); err != nil {
+				return false, fmt.Errorf("unmarshaling log entry: %s", err)
+			}
+			_, ok := jobs[le.Jid]
+			return ok, nil
+		case strings.HasPrefix(k, dealsAddrsPrefix):
+			_, ok := addrs[datastore.RawKey(k).Name()]
+			return ok, nil
+		case strings.HasPrefix(k, dealsSummariesPrefix):
+			// Summaries are keyed by /deals/summaries/<base>/<addr>/<miner>.
+			parts := datastore.RawKey(k).List()
+			if len(parts) != 5 {
+				return false, nil
+			}
+			_, ok := addrs[parts[3]]
+			return ok, nil
+		case strings.HasPrefix(k, dealsPrefix):
+			for _, p := range dealRecordPrefixes {
+				if !strings.HasPrefix(k, p) {
+					continue
+				}
+				var r struct{ Addr string }
+				if err := json.Unmarshal(v, &r); err != nil {
+					return false, fmt.Errorf("unmarshaling deal record: %s", err)
+				}
+				_, ok := addrs[r.Addr]
+				return ok, nil
+			}
+		}
+		return false, nil
+	}, nil
+}
+
+// userJobs adds to jobs the ids of jobs under prefix of the iid user
+// instance.
+func userJobs(txn datastore.Txn, prefix string, iid ffs.APIID, jobs map[ffs.JobID]struct{}) error {
+	res, err := txn.Query(query.Query{Prefix: prefix})
+	if err != nil {
+		return fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iterating results: %s", r.Error)
+		}
+		var j struct {
+			ID    ffs.JobID
+			APIID ffs.APIID
+		}
+		if err := json.Unmarshal(r.Value, &j); err != nil {
+			return fmt.Errorf("unmarshaling job: %s", err)
+		}
+		if j.APIID == iid {
+			jobs[j.ID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// instanceAddrs returns the wallet addresses of the iid user instance.
+func instanceAddrs(txn datastore.Txn, iid ffs.APIID) (map[string]struct{}, error) {
+	buf, err := txn.Get(datastore.NewKey(managerAPIPrefix + iid.String() + "/istore/instanceconfig"))
+	if err == datastore.ErrNotFound {
+		return nil, fmt.Errorf("user %s doesn't exist", iid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting instance config: %s", err)
+	}
+	var config struct {
+		Addrs map[string]json.RawMessage
+	}
+	if err := json.Unmarshal(buf, &config); err != nil {
+		return nil, fmt.Errorf("unmarshaling instance config: %s", err)
+	}
+	addrs := make(map[string]struct{}, len(config.Addrs))
+	for addr := range config.Addrs {
+		addrs[addr] = struct{}{}
+	}
+	return addrs, nil
+}
+
+func iterate(ctx context.Context, txn datastore.Txn, filter func(string, []byte) (bool, error), f func(string, []byte) error) error {
+	res, err := txn.Query(query.Query{Orders: []query.Order{query.OrderByKey{}}})
+	if err != nil {
+		return fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	for r := range res.Next() {
+		if ctx.Err() != nil {
+			return fmt.Errorf("canceled by context")
+		}
+		if r.Error != nil {
+			return fmt.Errorf("iterating results: %s", r.Error)
+		}
+		ok, err := filter(r.Key, r.Value)
+		if err != nil {
+			return fmt.Errorf("filtering key %s: %s", r.Key, err)
+		}
+		if !ok {
+			continue
+		}
+		if err := f(r.Key, r.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+		Format:   tar.FormatPAX,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("writing header of %s: %s", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("writing data of %s: %s", name, err)
+	}
+	return nil
+}
+
+func keyFromEntry(name string) (string, error) {
+	if !strings.HasPrefix(name, dataDir+"/") {
+		return "", fmt.Errorf("unexpected archive entry %s", name)
+	}
+	return strings.TrimPrefix(name, dataDir), nil
+}
+
+// prefixOf returns the first two namespaces of a key.
+func prefixOf(k string) string {
+	parts := datastore.RawKey(k).List()
+	n := len(parts) - 1
+	if n > 2 {
+		n = 2
+	}
+	if n <= 0 {
+		return "/"
+	}
+	return "/" + strings.Join(parts[:n], "/")
+}
+
+func isEmpty(ds datastore.Datastore) (bool, error) {
+	res, err := ds.Query(query.Query{KeysOnly: true, Limit: 1})
+	if err != nil {
+		return false, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return false, fmt.Errorf("iterating results: %s", r.Error)
+		}
+		return false, nil
+	}
+	return true, nil
+}
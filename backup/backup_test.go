@@ -0,0 +1,201 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/joblogger"
+	"github.com/textileio/powergate/migration"
+	"github.com/textileio/powergate/tests"
+	txndstr "github.com/textileio/powergate/txndstransform"
+	"github.com/textileio/powergate/util"
+)
+
+const (
+	iid1 = ffs.APIID("c06382e0-2021-4234-be53-6e07a8d40065")
+	iid2 = ffs.APIID("7d4a7c6b-96e5-4de4-a4e8-2eb3e38e0a34")
+)
+
+func TestFullBackupRestore(t *testing.T) {
+	t.Parallel()
+	ds := newDatastore(t)
+	var buf bytes.Buffer
+	manifest, err := Backup(context.Background(), &buf, ds, ffs.EmptyInstanceID)
+	require.NoError(t, err)
+	require.Equal(t, 16, manifest.Total)
+	require.Equal(t, len(migration.Migrations()), manifest.DatastoreVersion)
+	require.Equal(t, map[string]int{
+		"/ffs/manager":         6,
+		"/ffs/scheduler":       2,
+		"/ffs/joblogger":       2,
+		"/deals/storage-final": 2,
+		"/deals/index-addrs":   1,
+		"/deals/summaries":     2,
+		"/migration":           1,
+	}, manifest.Prefixes)
+
+	rm, err := ReadManifest(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, manifest.Total, rm.Total)
+
+	ds2 := tests.NewTxMapDatastore()
+	_, err = Restore(bytes.NewReader(buf.Bytes()), ds2, false)
+	require.NoError(t, err)
+	require.Equal(t, dump(t, ds), dump(t, ds2))
+
+	// Restoring a full backup in a non-empty datastore requires
+	// overwriting.
+	_, err = Restore(bytes.NewReader(buf.Bytes()), ds2, false)
+	require.Error(t, err)
+	_, err = Restore(bytes.NewReader(buf.Bytes()), ds2, true)
+	require.NoError(t, err)
+	require.Equal(t, dump(t, ds), dump(t, ds2))
+}
+
+func TestUserBackupRestore(t *testing.T) {
+	t.Parallel()
+	ds := newDatastore(t)
+	var buf bytes.Buffer
+	manifest, err := Backup(context.Background(), &buf, ds, iid1)
+	require.NoError(t, err)
+	require.Equal(t, iid1, manifest.UserID)
+	require.Equal(t, 7, manifest.Total)
+
+	ds2 := tests.NewTxMapDatastore()
+	_, err = Restore(bytes.NewReader(buf.Bytes()), ds2, false)
+	require.NoError(t, err)
+	keys := dump(t, ds2)
+	require.Len(t, keys, 8)
+	require.Contains(t, keys, "/ffs/manager/api/"+iid1.String()+"/istore/instanceconfig")
+	require.Contains(t, keys, "/ffs/manager/api/"+iid1.String()+"/istore/sendrequest/s1")
+	require.Contains(t, keys, "/ffs/manager/auth/token1")
+	require.Contains(t, keys, "/deals/storage-final/1")
+	require.Contains(t, keys, "/deals/index-addrs/storage-index/addr1")
+	require.Contains(t, keys, "/deals/summaries/storage-final/addr1/f01000")
+	var logs []string
+	for k, v := range keys {
+		if strings.HasPrefix(k, jobLogPrefix) {
+			logs = append(logs, v)
+		}
+	}
+	require.Len(t, logs, 1)
+	require.Contains(t, logs[0], `"Jid":"j1"`)
+	m, err := migration.New(ds2, migration.Migrations())
+	require.NoError(t, err)
+	v, err := m.Version()
+	require.NoError(t, err)
+	require.Equal(t, m.LatestVersion(), v)
+
+	// The user already exists.
+	_, err = Restore(bytes.NewReader(buf.Bytes()), ds2, false)
+	require.Error(t, err)
+}
+
+func TestRestoreVersionMismatch(t *testing.T) {
+	t.Parallel()
+	ds := newDatastore(t)
+	var buf bytes.Buffer
+	_, err := Backup(context.Background(), &buf, ds, iid1)
+	require.NoError(t, err)
+
+	ds2 := tests.NewTxMapDatastore()
+	require.NoError(t, ds2.Put(datastore.NewKey("/migration/version"), []byte("0")))
+	_, err = Restore(bytes.NewReader(buf.Bytes()), ds2, true)
+	require.Error(t, err)
+}
+
+func TestRestoreInterrupted(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Now()
+	archive := func(keys ...string) []byte {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gw)
+		manifest, err := json.Marshal(Manifest{FormatVersion: FormatVersion, CreatedAt: createdAt, Total: 2, Prefixes: map[string]int{"/a": 2}})
+		require.NoError(t, err)
+		require.NoError(t, writeEntry(tw, manifestName, manifest, createdAt))
+		for _, k := range keys {
+			require.NoError(t, writeEntry(tw, dataDir+k, []byte(k), createdAt))
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, gw.Close())
+		return buf.Bytes()
+	}
+
+	// The archive has less keys than its manifest, so the restore fails
+	// leaving the restore checkpoint.
+	ds := tests.NewTxMapDatastore()
+	_, err := Restore(bytes.NewReader(archive("/a/1")), ds, false)
+	require.Error(t, err)
+	_, resuming, err := getRestoreCheckpoint(ds)
+	require.NoError(t, err)
+	require.True(t, resuming)
+
+	// Restoring the archive again restores it from scratch.
+	_, err = Restore(bytes.NewReader(archive("/a/1", "/a/2")), ds, false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"/a/1": "/a/1", "/a/2": "/a/2"}, dump(t, ds))
+}
+
+func newDatastore(t *testing.T) datastore.TxnDatastore {
+	ds := tests.NewTxMapDatastore()
+	m, err := migration.New(ds, migration.Migrations())
+	require.NoError(t, err)
+	require.NoError(t, m.Migrate(false))
+
+	put := func(k string, v []byte) {
+		require.NoError(t, ds.Put(datastore.NewKey(k), v))
+	}
+	authEntry := func(token string, iid ffs.APIID) []byte {
+		buf, err := json.Marshal(ffs.AuthEntry{Token: token, APIID: iid})
+		require.NoError(t, err)
+		return buf
+	}
+	put("/ffs/manager/auth/token1", authEntry("token1", iid1))
+	put("/ffs/manager/auth/token2", authEntry("token2", iid2))
+	put("/ffs/manager/api/"+iid1.String()+"/istore/instanceconfig", []byte(`{"Addrs":{"addr1":{}}}`))
+	put("/ffs/manager/api/"+iid1.String()+"/istore/sendrequest/s1", []byte("request"))
+	put("/ffs/manager/api/"+iid2.String()+"/istore/instanceconfig", []byte(`{"Addrs":{"addr2":{}}}`))
+	put("/ffs/manager/defaultstorageconfig", []byte("default"))
+	put("/ffs/scheduler/sjstore/job/j1", []byte(`{"ID":"j1","APIID":"`+iid1.String()+`"}`))
+	put("/ffs/scheduler/sjstore/job/j2", []byte(`{"ID":"j2","APIID":"`+iid2.String()+`"}`))
+	l, err := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger"))
+	require.NoError(t, err)
+	c, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	require.NoError(t, err)
+	for _, jid := range []ffs.JobID{"j1", "j2"} {
+		ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, c)
+		ctx = context.WithValue(ctx, ffs.CtxKeyJid, jid)
+		l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealProposal, ffs.LogFields{ffs.LogFieldMiner: "f01000"}, "Proposing deal...")
+	}
+	require.NoError(t, l.Close())
+	put("/deals/storage-final/1", []byte(`{"Addr":"addr1"}`))
+	put("/deals/storage-final/2", []byte(`{"Addr":"addr2"}`))
+	put("/deals/index-addrs/storage-index/addr1", []byte{})
+	put("/deals/summaries/storage-final/addr1/f01000", []byte(`{"Count":1}`))
+	put("/deals/summaries/storage-final/addr2/f01000", []byte(`{"Count":1}`))
+	return ds
+}
+
+func dump(t *testing.T, ds datastore.Datastore) map[string]string {
+	res, err := ds.Query(query.Query{})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, res.Close()) }()
+	all, err := res.Rest()
+	require.NoError(t, err)
+	m := make(map[string]string, len(all))
+	for _, e := range all {
+		m[e.Key] = string(e.Value)
+	}
+	return m
+}
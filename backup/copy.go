@@ -0,0 +1,195 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+	"strings"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+var keyCopyCheckpoint = datastore.NewKey("/backup/copycheckpoint")
+
+// PrefixStats are the number of keys and a checksum of keys and values
+// with a prefix.
+type PrefixStats struct {
+	Count    int
+	Checksum string
+}
+
+// Copy copies every key from src to dst in batches of batchSize keys,
+// and verifies that both have the same keys and values. Each batch is
+// committed in a transaction with a checkpoint, so if a copy is
+// interrupted, calling Copy again resumes it. Unless resuming, dst should
+// be empty. Since src is iterated once, it shouldn't be modified during
+// the copy, e.g: powd should be stopped.
+func Copy(ctx context.Context, src, dst datastore.TxnDatastore, batchSize int) (map[string]PrefixStats, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("batch size should be positive")
+	}
+	var checkpoint string
+	buf, err := dst.Get(keyCopyCheckpoint)
+	switch err {
+	case nil:
+		checkpoint = string(buf)
+		log.Infof("resuming copy after key %s", checkpoint)
+	case datastore.ErrNotFound:
+		empty, err := isEmpty(dst)
+		if err != nil {
+			return nil, err
+		}
+		if !empty {
+			return nil, fmt.Errorf("target datastore isn't empty")
+		}
+	default:
+		return nil, fmt.Errorf("getting checkpoint: %s", err)
+	}
+
+	stxn, err := src.NewTransaction(true)
+	if err != nil {
+		return nil, fmt.Errorf("creating source transaction: %s", err)
+	}
+	defer stxn.Discard()
+	res, err := stxn.Query(query.Query{Orders: []query.Order{query.OrderByKey{}}})
+	if err != nil {
+		return nil, fmt.Errorf("querying source datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+
+	dtxn, err := dst.NewTransaction(false)
+	if err != nil {
+		return nil, fmt.Errorf("creating target transaction: %s", err)
+	}
+	defer func() { dtxn.Discard() }()
+	var pending, copied int
+	var lastKey string
+	commit := func() error {
+		if err := dtxn.Put(keyCopyCheckpoint, []byte(lastKey)); err != nil {
+			return fmt.Errorf("saving checkpoint: %s", err)
+		}
+		if err := dtxn.Commit(); err != nil {
+			return fmt.Errorf("committing batch: %s", err)
+		}
+		dtxn.Discard()
+		copied += pending
+		pending = 0
+		log.Infof("copied %d keys, last key %s", copied, lastKey)
+		dtxn, err = dst.NewTransaction(false)
+		if err != nil {
+			return fmt.Errorf("creating target transaction: %s", err)
+		}
+		return nil
+	}
+	for r := range res.Next() {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("canceled by context")
+		}
+		if r.Error != nil {
+			return nil, fmt.Errorf("iterating source datastore: %s", r.Error)
+		}
+		if r.Key == keyCopyCheckpoint.String() || (checkpoint != "" && r.Key <= checkpoint) {
+			continue
+		}
+		if err := dtxn.Put(datastore.NewKey(r.Key), r.Value); err != nil {
+			return nil, fmt.Errorf("putting %s: %s", r.Key, err)
+		}
+		lastKey = r.Key
+		pending++
+		if pending == batchSize {
+			if err := commit(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if pending > 0 {
+		if err := commit(); err != nil {
+			return nil, err
+		}
+	}
+
+	stats, err := Verify(ctx, src, dst)
+	if err != nil {
+		return nil, err
+	}
+	if err := dst.Delete(keyCopyCheckpoint); err != nil {
+		return nil, fmt.Errorf("deleting checkpoint: %s", err)
+	}
+	return stats, nil
+}
+
+// Verify returns an error if src and dst don't have the same number of
+// keys and checksums per prefix, and the stats otherwise.
+func Verify(ctx context.Context, src, dst datastore.TxnDatastore) (map[string]PrefixStats, error) {
+	srcStats, err := Stats(ctx, src)
+	if err != nil {
+		return nil, fmt.Errorf("getting source stats: %s", err)
+	}
+	dstStats, err := Stats(ctx, dst)
+	if err != nil {
+		return nil, fmt.Errorf("getting target stats: %s", err)
+	}
+	var mismatches []string
+	for p, s := range srcStats {
+		d := dstStats[p]
+		if s != d {
+			mismatches = append(mismatches, fmt.Sprintf("%s has %d keys (%s) in source and %d keys (%s) in target", p, s.Count, s.Checksum, d.Count, d.Checksum))
+		}
+	}
+	for p, d := range dstStats {
+		if _, ok := srcStats[p]; !ok {
+			mismatches = append(mismatches, fmt.Sprintf("%s has %d keys in target but doesn't exist in source", p, d.Count))
+		}
+	}
+	if len(mismatches) > 0 {
+		sort.Strings(mismatches)
+		return nil, fmt.Errorf("datastores differ: %s", strings.Join(mismatches, "; "))
+	}
+	return srcStats, nil
+}
+
+// Stats returns the number of keys and checksums per prefix of ds,
+// considering the first two namespaces of keys.
+func Stats(ctx context.Context, ds datastore.TxnDatastore) (map[string]PrefixStats, error) {
+	txn, err := ds.NewTransaction(true)
+	if err != nil {
+		return nil, fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	counts := make(map[string]int)
+	hashes := make(map[string]hash.Hash)
+	skip := func(k string, _ []byte) (bool, error) { return k != keyCopyCheckpoint.String(), nil }
+	if err := iterate(ctx, txn, skip, func(k string, v []byte) error {
+		p := prefixOf(k)
+		h, ok := hashes[p]
+		if !ok {
+			h = sha256.New()
+			hashes[p] = h
+		}
+		writeChecksumEntry(h, []byte(k))
+		writeChecksumEntry(h, v)
+		counts[p]++
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	stats := make(map[string]PrefixStats, len(counts))
+	for p, c := range counts {
+		stats[p] = PrefixStats{Count: c, Checksum: hex.EncodeToString(hashes[p].Sum(nil))}
+	}
+	return stats, nil
+}
+
+// writeChecksumEntry writes a length-prefixed value to h, so different
+// key and value splits can't have the same checksum.
+func writeChecksumEntry(h hash.Hash, b []byte) {
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+	_, _ = h.Write(l[:])
+	_, _ = h.Write(b)
+}
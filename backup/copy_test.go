@@ -0,0 +1,87 @@
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	badger "github.com/ipfs/go-ds-badger2"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/tests"
+)
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	src := newDatastore(t)
+	dst := tests.NewTxMapDatastore()
+
+	stats, err := Copy(ctx, src, dst, 3)
+	require.NoError(t, err)
+	require.Equal(t, dump(t, src), dump(t, dst))
+	require.Equal(t, 6, stats["/ffs/manager"].Count)
+	require.NotEmpty(t, stats["/ffs/manager"].Checksum)
+
+	// Copying again to a non-empty datastore fails.
+	_, err = Copy(ctx, src, dst, 3)
+	require.Error(t, err)
+}
+
+func TestCopyBadger(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	src := newDatastore(t)
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	dst, err := badger.NewDatastore(dir, &badger.DefaultOptions)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dst.Close()) }()
+
+	_, err = Copy(ctx, src, dst, 3)
+	require.NoError(t, err)
+	require.Equal(t, dump(t, src), dump(t, dst))
+
+	// And back.
+	src2 := tests.NewTxMapDatastore()
+	_, err = Copy(ctx, dst, src2, 3)
+	require.NoError(t, err)
+	require.Equal(t, dump(t, src), dump(t, src2))
+}
+
+func TestCopyResume(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	src := newDatastore(t)
+	dst := tests.NewTxMapDatastore()
+
+	// Simulate an interrupted copy which only copied the first key.
+	require.NoError(t, dst.Put(datastore.NewKey("/deals/index-addrs/storage-index/addr1"), []byte{}))
+	require.NoError(t, dst.Put(keyCopyCheckpoint, []byte("/deals/index-addrs/storage-index/addr1")))
+
+	_, err := Copy(ctx, src, dst, 2)
+	require.NoError(t, err)
+	require.Equal(t, dump(t, src), dump(t, dst))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	src := newDatastore(t)
+	dst := tests.NewTxMapDatastore()
+	_, err := Copy(ctx, src, dst, 100)
+	require.NoError(t, err)
+
+	require.NoError(t, dst.Put(datastore.NewKey("/deals/storage-final/1"), []byte("changed")))
+	_, err = Verify(ctx, src, dst)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "/deals/storage-final")
+
+	require.NoError(t, dst.Put(datastore.NewKey("/deals/storage-final/1"), []byte(`{"Addr":"addr1"}`)))
+	require.NoError(t, dst.Put(datastore.NewKey("/extra/key"), []byte("extra")))
+	_, err = Verify(ctx, src, dst)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "/extra")
+}
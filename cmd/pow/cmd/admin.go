@@ -9,7 +9,11 @@ func init() {
 
 	rootCmd.AddCommand(adminCmd)
 	adminCmd.AddCommand(
+		adminBackupCmd,
+		adminDealsCmd,
 		adminJobsCmd,
+		adminLogsCmd,
+		adminMinersCmd,
 		adminUsersCmd,
 		adminWalletCmd,
 	)
@@ -21,6 +25,13 @@ var adminCmd = &cobra.Command{
 	Long:  `Provides admin commands`,
 }
 
+var adminDealsCmd = &cobra.Command{
+	Use:     "deals",
+	Aliases: []string{"deal"},
+	Short:   "Provides admin deals commands",
+	Long:    `Provides admin deals commands`,
+}
+
 var adminJobsCmd = &cobra.Command{
 	Use:     "jobs",
 	Aliases: []string{"job"},
@@ -28,6 +39,20 @@ var adminJobsCmd = &cobra.Command{
 	Long:    `Provides admin jobs commands`,
 }
 
+var adminLogsCmd = &cobra.Command{
+	Use:     "logs",
+	Aliases: []string{"log"},
+	Short:   "Provides admin job logs commands",
+	Long:    `Provides admin job logs commands`,
+}
+
+var adminMinersCmd = &cobra.Command{
+	Use:     "miners",
+	Aliases: []string{"miner"},
+	Short:   "Provides admin miners commands",
+	Long:    `Provides admin miners commands`,
+}
+
 var adminUsersCmd = &cobra.Command{
 	Use:     "users",
 	Aliases: []string{"user"},
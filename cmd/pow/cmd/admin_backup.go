@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/backup"
+)
+
+func init() {
+	adminBackupCmd.Flags().String("user", "", "Only include the data of this user id")
+}
+
+var adminBackupCmd = &cobra.Command{
+	Use:   "backup [file]",
+	Short: "Writes a backup of the Powergate datastore to a file.",
+	Long:  `Writes an archive of a consistent snapshot of the Powergate datastore to a file, while Powergate is running. Archives can be restored with 'powd restore'.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour*8)
+		defer cancel()
+
+		f, err := os.OpenFile(args[0], os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		checkErr(err)
+		err = powClient.Admin.Backups.Create(adminAuthCtx(ctx), f, viper.GetString("user"))
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(args[0])
+			checkErr(err)
+		}
+		checkErr(f.Close())
+
+		f, err = os.Open(args[0])
+		checkErr(err)
+		defer func() { checkErr(f.Close()) }()
+		manifest, err := backup.ReadManifest(f)
+		checkErr(err)
+
+		json, err := json.MarshalIndent(manifest, "", "  ")
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
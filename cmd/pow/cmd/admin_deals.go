@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
+)
+
+func init() {
+	addDealsExportFlags(adminDealsExportCmd)
+	adminDealsExportCmd.Flags().String("user", "", "only export the records of this user id")
+
+	adminDealsCmd.AddCommand(adminDealsExportCmd)
+}
+
+var adminDealsExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Exports the deal records of all users to a file",
+	Long:  `Exports the storage or retrieval deal records of all users, or of a single user, to a CSV or Parquet file, sorted by ascending time. Storage records include the total cost of the deal for its duration and the storage job which made it.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		exportDealRecords(args[0], func(ctx context.Context, w io.Writer, conf *userPb.DealRecordsExportConfig) error {
+			return powClient.Admin.Deals.Export(adminAuthCtx(ctx), w, viper.GetString("user"), conf)
+		})
+	},
+}
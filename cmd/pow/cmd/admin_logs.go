@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	adminLogsPurgeCmd.Flags().String("cid", "", "Only purge the logs of this cid")
+	adminLogsPurgeCmd.Flags().Duration("older-than", 0, "Purge logs older than this duration, e.g: 720h")
+
+	adminLogsCmd.AddCommand(adminLogsPurgeCmd)
+}
+
+var adminLogsPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Deletes job logs older than a duration.",
+	Long:  `Deletes job logs older than a duration, of all cids or only of the cid provided with --cid.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		res, err := powClient.Admin.Logs.Purge(adminAuthCtx(ctx), viper.GetString("cid"), viper.GetDuration("older-than"))
+		checkErr(err)
+
+		Success("Deleted %d log entries", res.Deleted)
+	},
+}
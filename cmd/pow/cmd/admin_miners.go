@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	adminMinersCmd.AddCommand(
+		adminMinersSetCountryCmd,
+		adminMinersRemoveCountryCmd,
+		adminMinersCountryOverridesCmd,
+	)
+}
+
+var adminMinersSetCountryCmd = &cobra.Command{
+	Use:   "set-country [miner] [country]",
+	Short: "Pins the country of a miner.",
+	Long:  `Pins the country of a miner, overriding the one resolved from its IP addresses. The country is an ISO 3166-1 alpha-2 code.`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		_, err := powClient.Admin.Miners.SetCountryOverride(adminAuthCtx(ctx), args[0], args[1])
+		checkErr(err)
+	},
+}
+
+var adminMinersRemoveCountryCmd = &cobra.Command{
+	Use:   "remove-country [miner]",
+	Short: "Removes the pinned country of a miner.",
+	Long:  `Removes the pinned country of a miner.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		_, err := powClient.Admin.Miners.RemoveCountryOverride(adminAuthCtx(ctx), args[0])
+		checkErr(err)
+	},
+}
+
+var adminMinersCountryOverridesCmd = &cobra.Command{
+	Use:   "country-overrides",
+	Short: "List all pinned miner countries.",
+	Long:  `List all pinned miner countries.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		res, err := powClient.Admin.Miners.CountryOverrides(adminAuthCtx(ctx))
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -3,20 +3,47 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func init() {
 	adminWalletNewCmd.Flags().StringP("format", "f", "bls", "Optionally specify address format bls or secp256k1")
 
+	adminWalletSetSpendPolicyCmd.Flags().String("max-per-tx", "", "maximum amount of attoFIL per transfer, empty means no limit")
+	adminWalletSetSpendPolicyCmd.Flags().String("max-per-day", "", "maximum amount of attoFIL transferred in the last 24 hours, empty means no limit")
+	adminWalletSetSpendPolicyCmd.Flags().StringSlice("allowed-destinations", []string{}, "addresses allowed as destination of transfers, empty means any address")
+	adminWalletSetSpendPolicyCmd.Flags().String("approval-threshold", "", "transfers above this amount of attoFIL require admin approval, empty means no approval required")
+
+	adminWalletSendRequestsCmd.Flags().StringP("user", "u", "", "only list requests of the specified user id")
+
+	adminWalletExportKeysCmd.Flags().StringP("passphrase", "p", "", "passphrase used to encrypt the keys")
+
+	adminWalletImportKeyCmd.Flags().StringP("passphrase", "p", "", "passphrase used to encrypt the key")
+	adminWalletImportKeyCmd.Flags().BoolP("default", "d", false, "make the imported address the user default")
+
+	adminWalletFundsEventsCmd.Flags().Int("since", 24, "list events of the last specified hours")
+
 	adminWalletCmd.AddCommand(
 		adminWalletNewCmd,
 		adminWalletAddrsCmd,
 		adminWalletSendCmd,
+		adminWalletSetSpendPolicyCmd,
+		adminWalletSendRequestsCmd,
+		adminWalletApproveSendCmd,
+		adminWalletRejectSendCmd,
+		adminWalletExportKeysCmd,
+		adminWalletImportKeyCmd,
+		adminWalletFundsStatusCmd,
+		adminWalletFundsEventsCmd,
 	)
 }
 
@@ -86,7 +113,211 @@ var adminWalletSendCmd = &cobra.Command{
 			checkErr(fmt.Errorf("parsing amount %v", args[2]))
 		}
 
-		_, err := powClient.Admin.Wallet.SendFil(adminAuthCtx(ctx), args[0], args[1], amount)
+		res, err := powClient.Admin.Wallet.SendFil(adminAuthCtx(ctx), args[0], args[1], amount)
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var adminWalletSetSpendPolicyCmd = &cobra.Command{
+	Use:   "set-spend-policy [user-id] [address]",
+	Short: "Sets the spend policy of a user address.",
+	Long:  `Sets the spend policy of a user address. Unset flags remove the corresponding limit.`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		policy := &userPb.SpendPolicy{
+			MaxPerTx:            viper.GetString("max-per-tx"),
+			MaxPerDay:           viper.GetString("max-per-day"),
+			AllowedDestinations: viper.GetStringSlice("allowed-destinations"),
+			ApprovalThreshold:   viper.GetString("approval-threshold"),
+		}
+
+		_, err := powClient.Admin.Wallet.SetSpendPolicy(adminAuthCtx(ctx), args[0], args[1], policy)
 		checkErr(err)
 	},
 }
+
+var adminWalletSendRequestsCmd = &cobra.Command{
+	Use:   "send-requests",
+	Short: "List transfers waiting for approval.",
+	Long:  `List transfers waiting for approval.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		res, err := powClient.Admin.Wallet.SendRequests(adminAuthCtx(ctx), viper.GetString("user"))
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var adminWalletApproveSendCmd = &cobra.Command{
+	Use:   "approve-send [user-id] [request-id]",
+	Short: "Approves and sends a transfer waiting for approval.",
+	Long:  `Approves and sends a transfer waiting for approval.`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		res, err := powClient.Admin.Wallet.ApproveSendRequest(adminAuthCtx(ctx), args[0], args[1])
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var adminWalletRejectSendCmd = &cobra.Command{
+	Use:   "reject-send [user-id] [request-id]",
+	Short: "Rejects a transfer waiting for approval.",
+	Long:  `Rejects a transfer waiting for approval.`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		_, err := powClient.Admin.Wallet.RejectSendRequest(adminAuthCtx(ctx), args[0], args[1])
+		checkErr(err)
+	},
+}
+
+var adminWalletFundsStatusCmd = &cobra.Command{
+	Use:   "funds-status",
+	Short: "List the funds status of user addresses.",
+	Long:  `List the last known wallet balance and market escrow of user addresses, and if they're low in funds.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		res, err := powClient.Admin.Wallet.FundsStatus(adminAuthCtx(ctx))
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var adminWalletFundsEventsCmd = &cobra.Command{
+	Use:   "funds-events",
+	Short: "List the funds events of user addresses.",
+	Long:  `List low funds, recovery and automatic top-up events of user addresses, most recent first.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		since := time.Now().Add(-time.Hour * time.Duration(viper.GetInt("since")))
+		res, err := powClient.Admin.Wallet.FundsEvents(adminAuthCtx(ctx), since)
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var adminWalletExportKeysCmd = &cobra.Command{
+	Use:   "export-keys [user-id] [dir]",
+	Short: "Exports the keys of a user addresses encrypted with a passphrase.",
+	Long:  `Exports the keys of a user addresses encrypted with a passphrase. Each key is written to an <address>.key file in the provided directory.`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		passphrase := viper.GetString("passphrase")
+		if passphrase == "" {
+			checkErr(fmt.Errorf("passphrase is required"))
+		}
+
+		res, err := powClient.Admin.Wallet.ExportUserKeys(adminAuthCtx(ctx), args[0], passphrase)
+		checkErr(err)
+
+		err = os.MkdirAll(args[1], 0700)
+		checkErr(err)
+		for _, k := range res.Keys {
+			path := filepath.Join(args[1], k.Address+".key")
+			err = ioutil.WriteFile(path, k.Key, 0600)
+			checkErr(err)
+			k.Key = nil
+		}
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var adminWalletImportKeyCmd = &cobra.Command{
+	Use:   "import-key [user-id] [name] [key-file]",
+	Short: "Imports an exported key and adds its address to a user.",
+	Long:  `Imports a key exported with export-keys into the wallet and adds its address to a user.`,
+	Args:  cobra.ExactArgs(3),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		key, err := ioutil.ReadFile(args[2])
+		checkErr(err)
+
+		res, err := powClient.Admin.Wallet.ImportUserKey(adminAuthCtx(ctx), args[0], args[1], key, viper.GetString("passphrase"), viper.GetBool("default"))
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
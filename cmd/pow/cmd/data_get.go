@@ -10,11 +10,16 @@ import (
 	"github.com/caarlos0/spin"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/textileio/powergate/api/client"
 )
 
 func init() {
-	dataGetCmd.Flags().String("ipfsrevproxy", "localhost:6002", "Powergate IPFS reverse proxy DNS address. If port 443, is assumed is a HTTPS endpoint.")
 	dataGetCmd.Flags().BoolP("folder", "f", false, "Indicates that the retrieved Cid is a folder")
+	dataGetCmd.Flags().String("path", "", "Path of a file or folder within the Cid DAG to retrieve")
+	dataGetCmd.Flags().Bool("car", false, "Writes the Cid DAG as a CAR file")
+	dataGetCmd.Flags().String("selector", "", "dag-json encoded IPLD selector of the part of the DAG to write in the CAR file")
+	dataGetCmd.Flags().Int("car-version", 1, "Version of the CAR file format, 1 or 2")
+	dataGetCmd.Flags().Bool("cold", false, "Retrieves the CAR file from the Filecoin deals of the Cid instead of the hot layer")
 	dataCmd.AddCommand(dataGetCmd)
 }
 
@@ -36,10 +41,21 @@ var dataGetCmd = &cobra.Command{
 
 		isFolder := viper.GetBool("folder")
 		if isFolder {
-			err := powClient.Data.GetFolder(mustAuthCtx(ctx), viper.GetString("ipfsrevproxy"), args[0], args[1])
+			err := powClient.Data.GetFolder(mustAuthCtx(ctx), args[0], args[1], client.WithGetPath(viper.GetString("path")))
 			checkErr(err)
 		} else {
-			reader, err := powClient.Data.Get(mustAuthCtx(ctx), args[0])
+			var reader io.Reader
+			var err error
+			if viper.GetBool("car") {
+				opts := []client.GetCAROption{
+					client.WithCARSelector(viper.GetString("selector")),
+					client.WithCARFromCold(viper.GetBool("cold")),
+					client.WithCARVersion(viper.GetInt("car-version")),
+				}
+				reader, err = powClient.Data.GetCAR(mustAuthCtx(ctx), args[0], opts...)
+			} else {
+				reader, err = powClient.Data.Get(mustAuthCtx(ctx), args[0], client.WithGetPath(viper.GetString("path")))
+			}
 			checkErr(err)
 
 			dir := path.Dir(args[1])
@@ -2,34 +2,78 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/powergate/api/client"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 )
 
+var logLevels = map[string]userPb.LogLevel{
+	"debug": userPb.LogLevel_LOG_LEVEL_DEBUG,
+	"info":  userPb.LogLevel_LOG_LEVEL_INFO,
+	"warn":  userPb.LogLevel_LOG_LEVEL_WARN,
+	"error": userPb.LogLevel_LOG_LEVEL_ERROR,
+}
+
 func init() {
 	dataLogCmd.Flags().StringP("jid", "j", "", "Display information for only this job id")
+	dataLogCmd.Flags().StringP("level", "l", "", "Display only logs with at least this level: debug, info, warn or error")
+	dataLogCmd.Flags().String("text", "", "Display only logs containing this text, ignoring case")
+	dataLogCmd.Flags().BoolP("no-follow", "n", false, "Display the matching history logs and exit instead of watching new logs")
+	dataLogCmd.Flags().Duration("since", 0, "With --no-follow, display only logs created in this duration before now")
+	dataLogCmd.Flags().Duration("until", 0, "With --no-follow, display only logs created before this duration before now")
+	dataLogCmd.Flags().Int("limit", 0, "With --no-follow, display at most this number of the most recent logs")
 
 	dataCmd.AddCommand(dataLogCmd)
 }
 
 var dataLogCmd = &cobra.Command{
-	Use:   "log [cid]",
+	Use:   "log [optional cid]",
 	Short: "Display logs for specified cid",
-	Long:  `Display logs for specified cid`,
-	Args:  cobra.ExactArgs(1),
+	Long:  `Display logs for specified cid, or for the cid of the job specified with --jid`,
+	Args:  cobra.MaximumNArgs(1),
 	PreRun: func(cmd *cobra.Command, args []string) {
 		err := viper.BindPFlags(cmd.Flags())
 		checkErr(err)
 	},
 	Run: func(cmd *cobra.Command, args []string) {
-		opts := []client.WatchLogsOption{client.WithHistory(true)}
+		var c string
+		if len(args) > 0 {
+			c = args[0]
+		}
 		jid := viper.GetString("jid")
+		if c == "" && jid == "" {
+			Fatal(errors.New("a cid or a job id is required"))
+		}
+		var level userPb.LogLevel
+		if l := viper.GetString("level"); l != "" {
+			var ok bool
+			level, ok = logLevels[strings.ToLower(l)]
+			if !ok {
+				Fatal(fmt.Errorf("unknown log level %s", l))
+			}
+		}
+		text := viper.GetString("text")
+
+		if viper.GetBool("no-follow") {
+			searchLogs(c, jid, level, text)
+			return
+		}
+
+		opts := []client.WatchLogsOption{
+			client.WithHistory(true),
+			client.WithMinLogLevel(level),
+			client.WithLogText(text),
+		}
 		if jid != "" {
 			opts = append(opts, client.WithJobIDFilter(jid))
 		}
@@ -38,13 +82,13 @@ var dataLogCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		err := powClient.Data.WatchLogs(mustAuthCtx(ctx), ch, args[0], opts...)
+		err := powClient.Data.WatchLogs(mustAuthCtx(ctx), ch, c, opts...)
 		checkErr(err)
 
-		c := make(chan os.Signal)
-		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+		s := make(chan os.Signal)
+		signal.Notify(s, os.Interrupt, syscall.SIGTERM)
 		go func() {
-			<-c
+			<-s
 			cancel()
 			os.Exit(0)
 		}()
@@ -58,8 +102,48 @@ var dataLogCmd = &cobra.Command{
 				Fatal(event.Err)
 				break
 			}
-			ts := time.Unix(event.Res.LogEntry.Time, 0)
-			Message("%v - %v", ts.Format("2006-01-02T15:04:05"), event.Res.LogEntry.Message)
+			printLogEntry(event.Res.LogEntry)
 		}
 	},
 }
+
+func searchLogs(c, jid string, level userPb.LogLevel, text string) {
+	ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+	defer cancel()
+
+	now := time.Now()
+	var from, to time.Time
+	if since := viper.GetDuration("since"); since > 0 {
+		from = now.Add(-since)
+	}
+	if until := viper.GetDuration("until"); until > 0 {
+		to = now.Add(-until)
+	}
+	opts := []client.LogsOption{
+		client.WithLogsJobID(jid),
+		client.WithLogsMinLevel(level),
+		client.WithLogsText(text),
+		client.WithLogsTimeRange(from, to),
+		client.WithLogsLimit(viper.GetInt("limit")),
+	}
+	res, err := powClient.Data.Logs(mustAuthCtx(ctx), c, opts...)
+	checkErr(err)
+	for _, l := range res.LogEntries {
+		printLogEntry(l)
+	}
+}
+
+func printLogEntry(l *userPb.LogEntry) {
+	ts := time.Unix(l.Time, 0)
+	level := strings.ToUpper(strings.TrimPrefix(l.Level.String(), "LOG_LEVEL_"))
+	fields := make([]string, 0, len(l.Fields))
+	for k, v := range l.Fields {
+		fields = append(fields, k+"="+v)
+	}
+	sort.Strings(fields)
+	msg := l.Message
+	if len(fields) > 0 {
+		msg += " (" + strings.Join(fields, " ") + ")"
+	}
+	Message("%v - %v - %v", ts.Format("2006-01-02T15:04:05"), level, msg)
+}
@@ -12,12 +12,13 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func init() {
-	dataStageCmd.Flags().String("ipfsrevproxy", "127.0.0.1:6002", "Powergate IPFS reverse proxy multiaddr")
-
+	dataStageCmd.Flags().Bool("tar", false, "Indicates that the file or url is a tar archive to stage as a folder")
+	dataStageCmd.Flags().Bool("car", false, "Indicates that the file or url is a CARv1 or CARv2 file whose blocks are imported")
 	dataCmd.AddCommand(dataStageCmd)
 }
 
@@ -50,7 +51,7 @@ var dataStageCmd = &cobra.Command{
 			Fatal(fmt.Errorf("getting file/folder information: %s", err))
 		}
 		if fi.IsDir() {
-			c, err := powClient.Data.StageFolder(mustAuthCtx(ctx), viper.GetString("ipfsrevproxy"), args[0])
+			c, err := powClient.Data.StageFolder(mustAuthCtx(ctx), args[0])
 			checkErr(err)
 			Success("Staged folder with cid: %s", c)
 		} else {
@@ -63,7 +64,18 @@ var dataStageCmd = &cobra.Command{
 }
 
 func stageReader(ctx context.Context, reader io.Reader) {
-	res, err := powClient.Data.Stage(mustAuthCtx(ctx), reader)
+	var res *userPb.StageResponse
+	var err error
+	switch {
+	case viper.GetBool("tar") && viper.GetBool("car"):
+		Fatal(errors.New("--tar and --car can't be used together"))
+	case viper.GetBool("tar"):
+		res, err = powClient.Data.StageTar(mustAuthCtx(ctx), reader)
+	case viper.GetBool("car"):
+		res, err = powClient.Data.StageCAR(mustAuthCtx(ctx), reader)
+	default:
+		res, err = powClient.Data.Stage(mustAuthCtx(ctx), reader)
+	}
 	checkErr(err)
 
 	json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
@@ -1,7 +1,14 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/api/client"
 )
 
 func init() {
@@ -13,3 +20,65 @@ var dealsCmd = &cobra.Command{
 	Short: "Provides commands to view Filecoin deal information",
 	Long:  `Provides commands to view Filecoin deal information`,
 }
+
+// addDealRecordsFlags adds the filter and pagination flags shared by deal
+// records commands.
+func addDealRecordsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("miners", []string{}, "limit the records to deals with the specified miners")
+	cmd.Flags().Duration("since", 0, "limit the records to the ones created in this duration before now")
+	cmd.Flags().Duration("until", 0, "limit the records to the ones created before this duration before now")
+	cmd.Flags().Uint64("min-price", 0, "limit the records to deals with at least this price in attoFIL, per epoch for storage deals")
+	cmd.Flags().Uint64("max-price", 0, "limit the records to deals with at most this price in attoFIL, per epoch for storage deals")
+	cmd.Flags().Int("limit", 0, "maximum number of records to return, default is 1000")
+	cmd.Flags().String("cursor", "", "next cursor returned with the previous page of records")
+	cmd.Flags().Bool("summary", false, "include a summary with the count, total price and size of all matching records")
+}
+
+// dealRecordsOptions returns the options of the flags added with
+// addDealRecordsFlags.
+func dealRecordsOptions() []client.DealRecordsOption {
+	var opts []client.DealRecordsOption
+	if viper.IsSet("miners") {
+		opts = append(opts, client.WithMiners(viper.GetStringSlice("miners")...))
+	}
+	now := time.Now()
+	var from, to time.Time
+	if since := viper.GetDuration("since"); since > 0 {
+		from = now.Add(-since)
+	}
+	if until := viper.GetDuration("until"); until > 0 {
+		to = now.Add(-until)
+	}
+	opts = append(opts, client.WithTimeRange(from, to))
+	opts = append(opts, client.WithPriceRange(viper.GetUint64("min-price"), viper.GetUint64("max-price")))
+	if viper.IsSet("limit") {
+		opts = append(opts, client.WithLimit(viper.GetInt("limit")))
+	}
+	if viper.IsSet("cursor") {
+		opts = append(opts, client.WithCursor(viper.GetString("cursor")))
+	}
+	if viper.IsSet("summary") {
+		opts = append(opts, client.WithIncludeSummary(viper.GetBool("summary")))
+	}
+	return opts
+}
+
+// parseDealStates parses storage deal state names, e.g: StorageDealActive
+// or active, into state ids.
+func parseDealStates(names []string) ([]uint64, error) {
+	ids := make([]uint64, 0, len(names))
+	for _, name := range names {
+		found := false
+		for id, state := range storagemarket.DealStates {
+			if strings.EqualFold(state, name) || strings.EqualFold(state, "StorageDeal"+name) {
+				ids = append(ids, id)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown deal state %s", name)
+		}
+	}
+	return ids, nil
+}
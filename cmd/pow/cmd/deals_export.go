@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
+)
+
+func init() {
+	addDealsExportFlags(dealsExportCmd)
+	dealsCmd.AddCommand(dealsExportCmd)
+}
+
+var dealsExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Exports the deal records of the user to a file",
+	Long:  `Exports the storage or retrieval deal records of the user to a CSV or Parquet file, sorted by ascending time. Storage records include the total cost of the deal for its duration and the storage job which made it.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		exportDealRecords(args[0], func(ctx context.Context, w io.Writer, conf *userPb.DealRecordsExportConfig) error {
+			return powClient.Deals.Export(mustAuthCtx(ctx), w, conf)
+		})
+	},
+}
+
+// addDealsExportFlags adds the flags of deal records export commands.
+func addDealsExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String("kind", "storage", "kind of deal records to export, storage or retrieval")
+	cmd.Flags().String("format", "csv", "format of the exported file, csv or parquet")
+	cmd.Flags().Duration("since", 0, "limit the records to the ones created in this duration before now")
+	cmd.Flags().Duration("until", 0, "limit the records to the ones created before this duration before now")
+	cmd.Flags().BoolP("include-pending", "p", false, "include pending storage deals")
+}
+
+// exportDealRecords writes to path the deal records exported with the
+// config of the flags added with addDealsExportFlags.
+func exportDealRecords(path string, export func(context.Context, io.Writer, *userPb.DealRecordsExportConfig) error) {
+	conf := &userPb.DealRecordsExportConfig{IncludePending: viper.GetBool("include-pending")}
+	switch strings.ToLower(viper.GetString("kind")) {
+	case "storage":
+		conf.Kind = userPb.DealRecordsKind_DEAL_RECORDS_KIND_STORAGE
+	case "retrieval":
+		conf.Kind = userPb.DealRecordsKind_DEAL_RECORDS_KIND_RETRIEVAL
+	default:
+		Fatal(fmt.Errorf("unknown deal records kind %s", viper.GetString("kind")))
+	}
+	switch strings.ToLower(viper.GetString("format")) {
+	case "csv":
+		conf.Format = userPb.ExportFormat_EXPORT_FORMAT_CSV
+	case "parquet":
+		conf.Format = userPb.ExportFormat_EXPORT_FORMAT_PARQUET
+	default:
+		Fatal(fmt.Errorf("unknown export format %s", viper.GetString("format")))
+	}
+	now := time.Now()
+	if since := viper.GetDuration("since"); since > 0 {
+		conf.FromTime = now.Add(-since).Unix()
+	}
+	if until := viper.GetDuration("until"); until > 0 {
+		conf.ToTime = now.Add(-until).Unix()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	checkErr(err)
+	if err := export(ctx, f, conf); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		checkErr(err)
+	}
+	checkErr(f.Close())
+}
@@ -14,6 +14,7 @@ func init() {
 	dealsRetrievalsCmd.Flags().BoolP("ascending", "a", false, "sort records ascending, default is descending")
 	dealsRetrievalsCmd.Flags().StringSlice("cids", []string{}, "limit the records to deals for the specified data cids")
 	dealsRetrievalsCmd.Flags().StringSlice("addrs", []string{}, "limit the records to deals initiated from  the specified wallet addresses")
+	addDealRecordsFlags(dealsRetrievalsCmd)
 
 	dealsCmd.AddCommand(dealsRetrievalsCmd)
 }
@@ -42,6 +43,8 @@ var dealsRetrievalsCmd = &cobra.Command{
 			opts = append(opts, client.WithFromAddrs(viper.GetStringSlice("addrs")...))
 		}
 
+		opts = append(opts, dealRecordsOptions()...)
+
 		res, err := powClient.Deals.RetrievalDealRecords(mustAuthCtx(ctx), opts...)
 		checkErr(err)
 
@@ -16,6 +16,9 @@ func init() {
 	dealsStorageCmd.Flags().StringSlice("addrs", []string{}, "limit the records to deals initiated from  the specified wallet addresses, treated as and AND operation if --cids is also provided")
 	dealsStorageCmd.Flags().BoolP("include-pending", "p", false, "include pending deals")
 	dealsStorageCmd.Flags().BoolP("include-final", "f", false, "include final deals")
+	dealsStorageCmd.Flags().StringSlice("piece-cids", []string{}, "limit the records to deals for the specified piece cids")
+	dealsStorageCmd.Flags().StringSlice("states", []string{}, "limit the records to deals in the specified states, e.g: active or StorageDealActive")
+	addDealRecordsFlags(dealsStorageCmd)
 
 	dealsCmd.AddCommand(dealsStorageCmd)
 }
@@ -49,6 +52,15 @@ var dealsStorageCmd = &cobra.Command{
 		if viper.IsSet("include-final") {
 			opts = append(opts, client.WithIncludeFinal(viper.GetBool("include-final")))
 		}
+		if viper.IsSet("piece-cids") {
+			opts = append(opts, client.WithPieceCids(viper.GetStringSlice("piece-cids")...))
+		}
+		if viper.IsSet("states") {
+			ids, err := parseDealStates(viper.GetStringSlice("states"))
+			checkErr(err)
+			opts = append(opts, client.WithStateIDs(ids...))
+		}
+		opts = append(opts, dealRecordsOptions()...)
 
 		res, err := powClient.Deals.StorageDealRecords(mustAuthCtx(ctx), opts...)
 		checkErr(err)
@@ -3,20 +3,24 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	userPb "github.com/textileio/powergate/api/gen/powergate/user/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func init() {
+	storageJobGetCmd.Flags().BoolP("timeline", "l", false, "Print the steps of the job execution as a timeline table")
 	storageJobsCmd.AddCommand(storageJobGetCmd)
 }
 
 var storageJobGetCmd = &cobra.Command{
 	Use:   "get [jobid]",
 	Short: "Get a storage job's current status",
-	Long:  `Get a storage job's current status`,
+	Long:  `Get a storage job's current status, including the timed steps of its execution`,
 	Args:  cobra.ExactArgs(1),
 	PreRun: func(cmd *cobra.Command, args []string) {
 		err := viper.BindPFlags(cmd.Flags())
@@ -29,9 +33,37 @@ var storageJobGetCmd = &cobra.Command{
 		res, err := powClient.StorageJobs.StorageJob(mustAuthCtx(ctx), args[0])
 		checkErr(err)
 
+		if viper.GetBool("timeline") {
+			renderJobSteps(res.StorageJob.Steps)
+			return
+		}
+
 		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res.StorageJob)
 		checkErr(err)
 
 		fmt.Println(string(json))
 	},
 }
+
+func renderJobSteps(steps []*userPb.JobStep) {
+	data := make([][]string, len(steps))
+	for i, s := range steps {
+		started := time.Unix(0, s.StartedAt)
+		duration := "running"
+		if s.FinishedAt != 0 {
+			duration = time.Unix(0, s.FinishedAt).Sub(started).Round(time.Millisecond).String()
+		}
+		outcome := s.Result
+		if s.Error != "" {
+			outcome = "error: " + s.Error
+		}
+		data[i] = []string{
+			s.Name,
+			s.Detail,
+			started.Format(time.RFC3339),
+			duration,
+			outcome,
+		}
+	}
+	RenderTable(os.Stdout, []string{"step", "detail", "started", "duration", "result"}, data)
+}
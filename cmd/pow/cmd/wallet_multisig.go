@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	walletMultisigNewCmd.Flags().StringSlice("signers", []string{}, "signer addresses of the multisig, at least one should be a managed address")
+	walletMultisigNewCmd.Flags().Uint64("threshold", 1, "number of approvals required to execute a multisig transaction")
+	walletMultisigNewCmd.Flags().StringP("amount", "a", "0", "amount of attoFIL to fund the multisig with")
+	walletMultisigNewCmd.Flags().BoolP("default", "d", false, "Make the new address the user default")
+
+	walletCmd.AddCommand(walletMultisigCmd)
+	walletMultisigCmd.AddCommand(
+		walletMultisigNewCmd,
+		walletMultisigPendingCmd,
+		walletMultisigProposeCmd,
+		walletMultisigTopUpCmd,
+		walletMultisigApproveCmd,
+	)
+}
+
+var walletMultisigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "Provides commands to manage multisig addresses",
+	Long:  `Provides commands to manage multisig addresses`,
+}
+
+var walletMultisigNewCmd = &cobra.Command{
+	Use:   "new [name]",
+	Short: "Create a new multisig address",
+	Long:  `Create a new multisig address funded by the first managed signer address, which also proposes multisig transactions`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+
+		amount, ok := new(big.Int).SetString(viper.GetString("amount"), 10)
+		if !ok {
+			checkErr(fmt.Errorf("parsing amount %v", viper.GetString("amount")))
+		}
+
+		res, err := powClient.Wallet.NewMultisigAddress(mustAuthCtx(ctx), args[0], viper.GetStringSlice("signers"), viper.GetUint64("threshold"), amount, viper.GetBool("default"))
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var walletMultisigPendingCmd = &cobra.Command{
+	Use:   "pending [address]",
+	Short: "List the transactions of a multisig address waiting for approvals",
+	Long:  `List the transactions of a multisig address waiting for approvals`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		res, err := powClient.Wallet.MultisigPending(mustAuthCtx(ctx), args[0])
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var walletMultisigProposeCmd = &cobra.Command{
+	Use:   "propose [address] [to address] [amount]",
+	Short: "Propose a transfer from a multisig address to any other address",
+	Long:  `Propose a transfer from a multisig address to any other address`,
+	Args:  cobra.ExactArgs(3),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		amount, ok := new(big.Int).SetString(args[2], 10)
+		if !ok {
+			checkErr(fmt.Errorf("parsing amount %v", args[2]))
+		}
+
+		res, err := powClient.Wallet.MultisigPropose(mustAuthCtx(ctx), args[0], args[1], amount)
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var walletMultisigTopUpCmd = &cobra.Command{
+	Use:   "escrow-top-up [address] [amount]",
+	Short: "Propose a deposit from a multisig address into the market escrow used by its deals",
+	Long:  `Propose a deposit from a multisig address into the market escrow used by its deals`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		amount, ok := new(big.Int).SetString(args[1], 10)
+		if !ok {
+			checkErr(fmt.Errorf("parsing amount %v", args[1]))
+		}
+
+		res, err := powClient.Wallet.MultisigProposeEscrowTopUp(mustAuthCtx(ctx), args[0], amount)
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
+
+var walletMultisigApproveCmd = &cobra.Command{
+	Use:   "approve [address] [approver address] [transaction id]",
+	Short: "Approve a pending multisig transaction with a managed signer address",
+	Long:  `Approve a pending multisig transaction with a managed signer address`,
+	Args:  cobra.ExactArgs(3),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		txID, err := strconv.ParseInt(args[2], 10, 64)
+		checkErr(err)
+
+		res, err := powClient.Wallet.MultisigApprove(mustAuthCtx(ctx), args[0], args[1], txID)
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func init() {
@@ -34,7 +35,12 @@ var walletSendCmd = &cobra.Command{
 			checkErr(fmt.Errorf("parsing amount %v", args[2]))
 		}
 
-		_, err := powClient.Wallet.SendFil(mustAuthCtx(ctx), from, to, amount)
+		res, err := powClient.Wallet.SendFil(mustAuthCtx(ctx), from, to, amount)
 		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
 	},
 }
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/api/client"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	walletTxsCmd.Flags().BoolP("ascending", "a", false, "sort transactions ascending, default is sort descending")
+	walletTxsCmd.Flags().Int64("offset", 0, "skip the first offset transactions")
+	walletTxsCmd.Flags().Int64("limit", 0, "max number of transactions to return, 0 means no limit")
+
+	walletCmd.AddCommand(walletTxsCmd)
+}
+
+var walletTxsCmd = &cobra.Command{
+	Use:   "txs [optional address]",
+	Short: "List transactions of the wallet addresses of the current user",
+	Long:  `List transactions of the wallet addresses of the current user, or of a single address if provided`,
+	Args:  cobra.MaximumNArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		var opts []client.TransactionsOption
+		if len(args) > 0 {
+			opts = append(opts, client.WithTxAddress(args[0]))
+		}
+		if viper.IsSet("ascending") {
+			opts = append(opts, client.WithTxAscending(viper.GetBool("ascending")))
+		}
+		if viper.IsSet("offset") {
+			opts = append(opts, client.WithTxOffset(viper.GetInt64("offset")))
+		}
+		if viper.IsSet("limit") {
+			opts = append(opts, client.WithTxLimit(viper.GetInt64("limit")))
+		}
+
+		res, err := powClient.Wallet.Transactions(mustAuthCtx(ctx), opts...)
+		checkErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		checkErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/textileio/powergate/api/server"
+	"github.com/textileio/powergate/backup"
+	"github.com/textileio/powergate/ffs"
+)
+
+// runDatastoreCommand runs commands which work on the datastore of a
+// stopped powd: backup, restore and copy.
+func runDatastoreCommand(cmd string, args []string) error {
+	switch cmd {
+	case "backup", "restore":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: powd %s <archive-file> [flags]", cmd)
+		}
+	case "copy":
+		if len(args) != 0 {
+			return fmt.Errorf("usage: powd copy [flags]")
+		}
+	default:
+		return fmt.Errorf("unknown command %s", cmd)
+	}
+	conf, err := datastoreConfigFromFlags()
+	if err != nil {
+		return fmt.Errorf("creating config from flags: %s", err)
+	}
+	if err := setupLogging(conf.RepoPath); err != nil {
+		return fmt.Errorf("configuring up logging: %s", err)
+	}
+	ds, err := server.OpenDatastore(conf)
+	if err != nil {
+		return fmt.Errorf("opening datastore: %s", err)
+	}
+	defer func() {
+		if err := ds.Close(); err != nil {
+			log.Errorf("closing datastore: %s", err)
+		}
+	}()
+
+	var res interface{}
+	switch cmd {
+	case "backup":
+		f, err := os.OpenFile(args[0], os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("creating archive file: %s", err)
+		}
+		res, err = backup.Backup(context.Background(), f, ds, ffs.APIID(config.GetString("backupuser")))
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(args[0])
+			return fmt.Errorf("creating backup: %s", err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("closing archive file: %s", err)
+		}
+	case "restore":
+		f, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("opening archive file: %s", err)
+		}
+		defer func() { _ = f.Close() }()
+		res, err = backup.Restore(f, ds, config.GetBool("restoreoverwrite"))
+		if err != nil {
+			return fmt.Errorf("restoring backup: %s", err)
+		}
+	case "copy":
+		res, err = copyDatastore(conf, ds)
+		if err != nil {
+			return fmt.Errorf("copying datastore: %s", err)
+		}
+	}
+
+	buf, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling result: %s", err)
+	}
+	fmt.Println(string(buf))
+	return nil
+}
+
+// copyDatastore copies ds to the datastore configured with the --copy*
+// flags.
+func copyDatastore(conf server.Config, ds datastore.TxnDatastore) (map[string]backup.PrefixStats, error) {
+	dstConf := server.Config{
+		RepoPath: config.GetString("copyrepopath"),
+		MongoURI: config.GetString("copymongouri"),
+		MongoDB:  config.GetString("copymongodb"),
+	}
+	if dstConf.MongoURI == "" && dstConf.RepoPath == "" {
+		return nil, fmt.Errorf("--copyrepopath or --copymongouri is required")
+	}
+	if dstConf.MongoURI == conf.MongoURI && dstConf.MongoDB == conf.MongoDB && (dstConf.MongoURI != "" || dstConf.RepoPath == conf.RepoPath) {
+		return nil, fmt.Errorf("source and target datastores are the same")
+	}
+	dst, err := server.OpenDatastore(dstConf)
+	if err != nil {
+		return nil, fmt.Errorf("opening target datastore: %s", err)
+	}
+	defer func() {
+		if err := dst.Close(); err != nil {
+			log.Errorf("closing target datastore: %s", err)
+		}
+	}()
+	return backup.Copy(context.Background(), ds, dst, config.GetInt("copybatchsize"))
+}
+
+// datastoreConfigFromFlags returns a server configuration with the
+// datastore settings.
+func datastoreConfigFromFlags() (server.Config, error) {
+	repoPath, err := getRepoPath(false)
+	if err != nil {
+		return server.Config{}, fmt.Errorf("getting repo path: %s", err)
+	}
+	return server.Config{
+		RepoPath: repoPath,
+		MongoURI: config.GetString("mongouri"),
+		MongoDB:  config.GetString("mongodb"),
+	}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/textileio/powergate/api/server"
 	"github.com/textileio/powergate/buildinfo"
+	"github.com/textileio/powergate/lotus"
 	"github.com/textileio/powergate/util"
 	"go.opencensus.io/plugin/runmetrics"
 )
@@ -36,6 +37,14 @@ func main() {
 		log.Fatalf("configuring flags: %s", err)
 	}
 
+	// Run offline datastore commands.
+	if cmd := pflag.Arg(0); cmd != "" {
+		if err := runDatastoreCommand(cmd, pflag.Args()[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	// Create configuration from flags/envs.
 	conf, err := configFromFlags()
 	if err != nil {
@@ -64,6 +73,16 @@ func main() {
 	}
 	log.Infof("%s", confJSON)
 
+	if config.GetBool("dry-run") {
+		log.Info("dry-running datastore migrations...")
+		if err := server.MigrateDatastore(conf, true); err != nil {
+			log.Fatalf("dry-running datastore migrations: %s", err)
+		}
+		log.Info("datastore migrations dry-run finished successfully.")
+		closeInstr()
+		return
+	}
+
 	// Start server.
 	log.Info("starting server...")
 	powd, err := server.NewServer(conf)
@@ -88,7 +107,8 @@ func main() {
 }
 
 func configFromFlags() (server.Config, error) {
-	devnet := config.GetBool("devnet")
+	simulation := config.GetBool("simulation")
+	devnet := config.GetBool("devnet") || simulation
 
 	lotusToken, err := getLotusToken(devnet)
 	if err != nil {
@@ -110,7 +130,30 @@ func configFromFlags() (server.Config, error) {
 		return server.Config{}, fmt.Errorf("parsing lotus api multiaddr: %s", err)
 	}
 
+	lotusFailoverEndpoints, err := getLotusFailoverEndpoints(lotusToken)
+	if err != nil {
+		return server.Config{}, fmt.Errorf("getting lotus failover endpoints: %s", err)
+	}
+
 	walletInitialFunds := *big.NewInt(config.GetInt64("walletinitialfund"))
+	fundsMonitorInterval := time.Minute * time.Duration(config.GetInt("fundsmonitorinterval"))
+	fundsMonitorEventRetention := time.Hour * time.Duration(config.GetInt("fundsmonitoreventretention"))
+	fundsMonitorThreshold, err := parseOptionalAmount("fundsmonitorthreshold")
+	if err != nil {
+		return server.Config{}, err
+	}
+	fundsMonitorTopUpAmount, err := parseOptionalAmount("fundsmonitortopupamount")
+	if err != nil {
+		return server.Config{}, err
+	}
+	fundsMonitorMaxTopUpPerAddr, err := parseOptionalAmount("fundsmonitormaxtopupperaddr")
+	if err != nil {
+		return server.Config{}, err
+	}
+	fundsMonitorMaxTopUpTotal, err := parseOptionalAmount("fundsmonitormaxtopuptotal")
+	if err != nil {
+		return server.Config{}, err
+	}
 	ipfsAPIAddr := util.MustParseAddr(config.GetString("ipfsapiaddr"))
 	lotusMasterAddr := config.GetString("lotusmasteraddr")
 	lotusConnectionRetries := config.GetInt("lotusconnectionretries")
@@ -121,6 +164,8 @@ func configFromFlags() (server.Config, error) {
 	gatewayBasePath := config.GetString("gatewaybasepath")
 	indexRawJSONHostAddr := config.GetString("indexrawjsonhostaddr")
 	maxminddbfolder := config.GetString("maxminddbfolder")
+	ipLocationRangesFile := config.GetString("iplocationrangesfile")
+	ipLocationCacheTTL := time.Hour * time.Duration(config.GetInt("iplocationcachettl"))
 	mongoURI := config.GetString("mongouri")
 	mongoDB := config.GetString("mongodb")
 	minerSelector := config.GetString("ffsminerselector")
@@ -130,6 +175,10 @@ func configFromFlags() (server.Config, error) {
 	ffsDealWatchFinalityTimeout := time.Minute * time.Duration(config.GetInt("ffsdealfinalitytimeout"))
 	ffsMinimumPieceSize := config.GetUint64("ffsminimumpiecesize")
 	ffsMaxParallelDealPreparing := config.GetInt("ffsmaxparalleldealpreparing")
+	ffsMaxStageCARSize := config.GetInt64("ffsmaxstagecarsize")
+	ffsMaxStageTarSize := config.GetInt64("ffsmaxstagetarsize")
+	ffsJobLogRetention := time.Hour * time.Duration(config.GetInt("ffsjoblogretention"))
+	ffsJobLogCompactAge := time.Hour * time.Duration(config.GetInt("ffsjoblogcompactage"))
 	dealWatchPollDuration := time.Second * time.Duration(config.GetInt("dealwatchpollduration"))
 	askIndexQueryAskTimeout := time.Second * time.Duration(config.GetInt("askindexqueryasktimeout"))
 	askIndexRefreshInterval := time.Minute * time.Duration(config.GetInt("askindexrefreshinterval"))
@@ -137,6 +186,23 @@ func configFromFlags() (server.Config, error) {
 	askIndexMaxParallel := config.GetInt("askindexmaxparallel")
 	disableIndices := config.GetBool("disableindices")
 	disableNonCompliantAPIs := config.GetBool("disablenoncompliantapis")
+	simulationMiners := config.GetInt("simulationminers")
+	simulationEpochDuration := time.Millisecond * time.Duration(config.GetInt("simulationepochduration"))
+	simulationLatency := time.Millisecond * time.Duration(config.GetInt("simulationlatency"))
+	simulationRejectRate := config.GetFloat64("simulationrejectrate")
+	simulationFailureRate := config.GetFloat64("simulationfailurerate")
+	simulationSlashRate := config.GetFloat64("simulationslashrate")
+	simulationSeed := config.GetInt64("simulationseed")
+	ha := config.GetBool("ha")
+	haInstanceID := config.GetString("hainstanceid")
+	if ha && haInstanceID == "" {
+		haInstanceID, err = os.Hostname()
+		if err != nil {
+			return server.Config{}, fmt.Errorf("getting hostname as instance id: %s", err)
+		}
+	}
+	haAdvertiseAddr := config.GetString("haadvertiseaddr")
+	haLeaseTTL := time.Second * time.Duration(config.GetInt("haleasettl"))
 
 	return server.Config{
 		WalletInitialFunds: walletInitialFunds,
@@ -145,10 +211,21 @@ func configFromFlags() (server.Config, error) {
 		RepoPath:           repoPath,
 		MaxMindDBFolder:    maxminddbfolder,
 
+		FundsMonitorInterval:        fundsMonitorInterval,
+		FundsMonitorThreshold:       fundsMonitorThreshold,
+		FundsMonitorTopUpAmount:     fundsMonitorTopUpAmount,
+		FundsMonitorMaxTopUpPerAddr: fundsMonitorMaxTopUpPerAddr,
+		FundsMonitorMaxTopUpTotal:   fundsMonitorMaxTopUpTotal,
+		FundsMonitorEventRetention:  fundsMonitorEventRetention,
+
+		IPLocationRangesFile: ipLocationRangesFile,
+		IPLocationCacheTTL:   ipLocationCacheTTL,
+
 		LotusAddress:           lotusHost,
 		LotusAuthToken:         lotusToken,
 		LotusConnectionRetries: lotusConnectionRetries,
 		LotusMasterAddr:        lotusMasterAddr,
+		LotusFailoverEndpoints: lotusFailoverEndpoints,
 
 		// ToDo: Support secure gRPC connection
 		GrpcHostNetwork:     "tcp",
@@ -167,6 +244,10 @@ func configFromFlags() (server.Config, error) {
 		FFSDealFinalityTimeout:      ffsDealWatchFinalityTimeout,
 		FFSMinimumPieceSize:         ffsMinimumPieceSize,
 		FFSMaxParallelDealPreparing: ffsMaxParallelDealPreparing,
+		FFSMaxStageCARSize:          ffsMaxStageCARSize,
+		FFSMaxStageTarSize:          ffsMaxStageTarSize,
+		FFSJobLogRetention:          ffsJobLogRetention,
+		FFSJobLogCompactAge:         ffsJobLogCompactAge,
 		AutocreateMasterAddr:        autocreateMasterAddr,
 		MinerSelector:               minerSelector,
 		MinerSelectorParams:         minerSelectorParams,
@@ -181,6 +262,20 @@ func configFromFlags() (server.Config, error) {
 		DisableIndices: disableIndices,
 
 		DisableNonCompliantAPIs: disableNonCompliantAPIs,
+
+		Simulation:              simulation,
+		SimulationMiners:        simulationMiners,
+		SimulationEpochDuration: simulationEpochDuration,
+		SimulationLatency:       simulationLatency,
+		SimulationRejectRate:    simulationRejectRate,
+		SimulationFailureRate:   simulationFailureRate,
+		SimulationSlashRate:     simulationSlashRate,
+		SimulationSeed:          simulationSeed,
+
+		HA:              ha,
+		HAInstanceID:    haInstanceID,
+		HAAdvertiseAddr: haAdvertiseAddr,
+		HALeaseTTL:      haLeaseTTL,
 	}, nil
 }
 
@@ -231,6 +326,9 @@ func setupLogging(repoPath string) error {
 		// Top-level
 		"powd",
 		"server",
+		"migration",
+		"backup",
+		"leader",
 
 		// Indexes & Reputation
 		"index-miner",
@@ -241,9 +339,13 @@ func setupLogging(repoPath string) error {
 		"chainstore",
 		"fchost",
 		"maxmind",
+		"iplocation",
+		"iplocation-ranges",
+		"iplocation-cache",
 
 		// Lotus client
 		"lotus-client",
+		"fakelotus",
 
 		// Deals Module
 		"deals",
@@ -257,10 +359,12 @@ func setupLogging(repoPath string) error {
 		// FFS
 		"ffs-scheduler",
 		"ffs-manager",
+		"ffs-fundsmonitor",
 		"ffs-auth",
 		"ffs-api",
 		"ffs-coreipfs",
 		"ffs-filcold",
+		"ffs-memstorage",
 		"ffs-sched-sjstore",
 		"ffs-sched-rjstore",
 		"ffs-cidlogger",
@@ -328,11 +432,53 @@ func getLotusToken(devnet bool) (string, error) {
 	return string(b), nil
 }
 
+// getLotusFailoverEndpoints returns the failover Lotus endpoints. If no
+// failover tokens are provided, the main Lotus token is used for all of them.
+func getLotusFailoverEndpoints(lotusToken string) ([]lotus.Endpoint, error) {
+	hosts := config.GetStringSlice("lotusfailoverhosts")
+	tokens := config.GetStringSlice("lotusfailovertokens")
+	if len(tokens) > 0 && len(tokens) != len(hosts) {
+		return nil, fmt.Errorf("lotusfailovertokens should have one token per failover host")
+	}
+	res := make([]lotus.Endpoint, len(hosts))
+	for i, h := range hosts {
+		addr, err := ma.NewMultiaddr(h)
+		if err != nil {
+			return nil, fmt.Errorf("parsing failover host %s: %s", h, err)
+		}
+		token := lotusToken
+		if len(tokens) > 0 {
+			token = tokens[i]
+		}
+		res[i] = lotus.Endpoint{Addr: addr, AuthToken: token}
+	}
+	return res, nil
+}
+
+// parseOptionalAmount parses an attoFIL amount flag, returning nil if it's empty.
+func parseOptionalAmount(flag string) (*big.Int, error) {
+	str := config.GetString(flag)
+	if str == "" {
+		return nil, nil
+	}
+	amt, ok := new(big.Int).SetString(str, 10)
+	if !ok || amt.Sign() < 0 {
+		return nil, fmt.Errorf("parsing %s: invalid amount %s", flag, str)
+	}
+	return amt, nil
+}
+
 func setupFlags() error {
 	pflag.Bool("debug", false, "Enable debug log level in all loggers.")
 
 	pflag.Bool("autocreatemasteraddr", false, "Automatically creates & funds a master address if none is provided.")
 	pflag.Int64("walletinitialfund", 250_000_000_000_000_000, "FFS initial funding transaction amount in attoFIL received by --lotusmasteraddr. (if set)")
+	pflag.String("fundsmonitorthreshold", "", "Amount of attoFIL, considering wallet balance and available market escrow, below which user addresses are low in funds. Empty disables the funds monitor.")
+	pflag.String("fundsmonitorinterval", "10", "Interval in minutes between funds checks of user addresses.")
+	pflag.String("fundsmonitortopupamount", "", "Amount of attoFIL sent from --lotusmasteraddr to user addresses low in funds. Empty disables automatic top-ups.")
+	pflag.String("fundsmonitormaxtopupperaddr", "", "Maximum amount of attoFIL topped up to a single user address in the last 24hs. Empty means no limit.")
+	pflag.String("fundsmonitormaxtopuptotal", "", "Maximum amount of attoFIL topped up to all user addresses in the last 24hs. Empty means no limit.")
+	pflag.Int("fundsmonitoreventretention", 720, "Retention in hours of funds monitor events. It should be at least 24. Zero keeps events forever.")
 
 	pflag.String("grpchostaddr", "/ip4/0.0.0.0/tcp/5002", "gRPC host listening address.")
 	pflag.String("grpcwebproxyaddr", "0.0.0.0:6002", "gRPC webproxy listening address.")
@@ -341,6 +487,8 @@ func setupFlags() error {
 	pflag.String("lotushost", "/ip4/127.0.0.1/tcp/1234", "Lotus client API endpoint multiaddress.")
 	pflag.String("lotustoken", "", "Lotus API authorization token. This flag or --lotustoken file are mandatory.")
 	pflag.String("lotustokenfile", "", "Path of a file that contains the Lotus API authorization token.")
+	pflag.StringSlice("lotusfailoverhosts", []string{}, "Lotus client API endpoint multiaddresses used when --lotushost isn't healthy. (Optional)")
+	pflag.StringSlice("lotusfailovertokens", []string{}, "Lotus API authorization tokens of --lotusfailoverhosts, in the same order. If empty, the --lotustoken token is used.")
 	pflag.String("lotusmasteraddr", "", "Existing wallet address in Lotus to be used as source of funding for new FFS instances. (Optional)")
 	pflag.Int64("lotusconnectionretries", 180, "Maximum amount of connection retries when making API calls before considering them a failure. Retries are spaced by 10s. (default ~30min).")
 
@@ -348,9 +496,18 @@ func setupFlags() error {
 	pflag.String("gatewaybasepath", "/", "Gateway base path.")
 
 	pflag.String("repopath", "~/.powergate", "Path of the repository where Powergate state will be saved.")
+	pflag.String("backupuser", "", "In 'powd backup <file>', only include the data of this user id.")
+	pflag.Bool("restoreoverwrite", false, "In 'powd restore <file>', overwrite existing keys in a non-empty datastore.")
+	pflag.String("copyrepopath", "", "In 'powd copy', path of the repository of the target Badger datastore.")
+	pflag.String("copymongouri", "", "In 'powd copy', Mongo URI of the target MongoDB datastore. Takes precedence over --copyrepopath.")
+	pflag.String("copymongodb", "", "In 'powd copy', Mongo database name of the target MongoDB datastore.")
+	pflag.String("copybatchsize", "1000", "In 'powd copy', number of keys copied per transaction.")
+	pflag.Bool("dry-run", false, "Run pending datastore migrations discarding changes, and exit without starting the server.")
 	pflag.Bool("devnet", false, "Indicate that will be running on an ephemeral devnet. --repopath will be autocleaned on exit.")
 	pflag.String("ipfsapiaddr", "/ip4/127.0.0.1/tcp/5001", "IPFS API endpoint multiaddress. (Optional, only needed if FFS is used)")
 	pflag.String("maxminddbfolder", ".", "Path of the folder containing GeoLite2-City.mmdb")
+	pflag.String("iplocationrangesfile", "", "Path of a CSV file with IP ranges locations (start_ip,end_ip,country[,latitude,longitude]) which takes precedence over MaxMind. (Optional)")
+	pflag.Int("iplocationcachettl", 168, "TTL in hours of cached IP location resolutions. Zero disables caching")
 
 	pflag.String("mongouri", "", "Mongo URI to connect to MongoDB database. (Optional: if empty, will use Badger)")
 	pflag.String("mongodb", "", "Mongo database name. (if --mongouri is used, is mandatory")
@@ -363,6 +520,10 @@ func setupFlags() error {
 	pflag.String("ffsschedmaxparallel", "1000", "Maximum amount of Jobs executed in parallel")
 	pflag.String("ffsdealfinalitytimeout", "4320", "Deadline in minutes in which a deal must prove liveness changing status before considered abandoned")
 	pflag.String("ffsmaxparalleldealpreparing", "2", "Max parallel deal preparing tasks")
+	pflag.String("ffsmaxstagecarsize", "34359738368", "Maximum size in bytes of CAR files staged through the API. Zero disables the limit")
+	pflag.String("ffsmaxstagetarsize", "34359738368", "Maximum size in bytes of tar archives of directories staged through the API. Zero disables the limit")
+	pflag.String("ffsjoblogretention", "0", "Age in hours after which job logs are deleted. Zero keeps them forever")
+	pflag.String("ffsjoblogcompactage", "0", "Age in hours after which job logs below the warn level are deleted. Zero disables compaction")
 	pflag.String("dealwatchpollduration", "900", "Poll interval in seconds used by Deals Module watch to detect state changes")
 
 	pflag.String("askindexqueryasktimeout", "15", "Timeout in seconds for a query ask")
@@ -373,6 +534,20 @@ func setupFlags() error {
 	pflag.Bool("disableindices", false, "Disable all indices updates, useful to help Lotus syncing process")
 	pflag.Bool("disablenoncompliantapis", false, "Disable APIs that may not easily comply with US law")
 
+	pflag.Bool("simulation", false, "Run with a fake Lotus node and in-memory hot and cold storages, without IPFS or Lotus. Implies --devnet.")
+	pflag.String("simulationminers", "3", "Number of simulated miners")
+	pflag.String("simulationepochduration", "1000", "Duration in milliseconds of a simulated epoch")
+	pflag.String("simulationlatency", "0", "Latency in milliseconds of simulated storage operations")
+	pflag.String("simulationrejectrate", "0", "Probability between 0 and 1 of simulated deals being rejected")
+	pflag.String("simulationfailurerate", "0", "Probability between 0 and 1 of accepted simulated deals failing before being active")
+	pflag.String("simulationslashrate", "0", "Probability between 0 and 1 of active simulated deals being slashed")
+	pflag.String("simulationseed", "0", "Seed of simulated failures, to replay simulations")
+
+	pflag.Bool("ha", false, "Run as one of multiple instances sharing a MongoDB datastore. Only the elected leader runs the scheduler and indices, and other instances forward writes to it.")
+	pflag.String("hainstanceid", "", "Unique id of the instance in HA mode. (Optional: if empty, will use the hostname)")
+	pflag.String("haadvertiseaddr", "", "gRPC address (host:port) where other instances reach this instance in HA mode")
+	pflag.String("haleasettl", "15", "Duration in seconds of the leadership lease in HA mode")
+
 	pflag.Parse()
 
 	config.SetEnvPrefix("POWD")
@@ -0,0 +1,37 @@
+package deals
+
+import "context"
+
+type ctxKey int
+
+const (
+	ctxKeyJobID ctxKey = iota
+	ctxKeyRetrievalID
+)
+
+// ContextWithJobID returns a copy of ctx which attributes the deals made
+// with it to the job with id jobID. The job id is saved in the deal
+// records.
+func ContextWithJobID(ctx context.Context, jobID string) context.Context {
+	return context.WithValue(ctx, ctxKeyJobID, jobID)
+}
+
+// JobIDFromContext returns the job id set in ctx with ContextWithJobID,
+// or an empty string if there isn't one.
+func JobIDFromContext(ctx context.Context) string {
+	jobID, _ := ctx.Value(ctxKeyJobID).(string)
+	return jobID
+}
+
+// ContextWithRetrievalID returns a copy of ctx which attributes the
+// retrievals made with it to the FFS retrieval with id retrievalID.
+func ContextWithRetrievalID(ctx context.Context, retrievalID string) context.Context {
+	return context.WithValue(ctx, ctxKeyRetrievalID, retrievalID)
+}
+
+// RetrievalIDFromContext returns the retrieval id set in ctx with
+// ContextWithRetrievalID, or an empty string if there isn't one.
+func RetrievalIDFromContext(ctx context.Context) string {
+	retrievalID, _ := ctx.Value(ctxKeyRetrievalID).(string)
+	return retrievalID
+}
@@ -0,0 +1,312 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/deals"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Format is a file format for exported deal records.
+type Format int
+
+const (
+	// CSV exports records as comma-separated values with a header row.
+	CSV Format = iota
+	// Parquet exports records as an Apache Parquet file.
+	Parquet
+)
+
+// pageSize is the number of records listed per page while exporting.
+const pageSize = 1000
+
+// StorageLister lists pages of storage deal records.
+type StorageLister func(opts ...deals.DealRecordsOption) (deals.StorageDealRecordsPage, error)
+
+// RetrievalLister lists pages of retrieval deal records.
+type RetrievalLister func(opts ...deals.DealRecordsOption) (deals.RetrievalDealRecordsPage, error)
+
+// StorageDealRecords writes the storage deal records listed by list with
+// opts to w, sorted by ascending time.
+func StorageDealRecords(ctx context.Context, w io.Writer, f Format, list StorageLister, opts ...deals.DealRecordsOption) error {
+	rw, err := newRowWriter(w, f, new(storageRow), storageColumns)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, deals.WithAscending(true), deals.WithLimit(pageSize))
+	var cursor string
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		page, err := list(append(opts, deals.WithCursor(cursor))...)
+		if err != nil {
+			return fmt.Errorf("listing storage deal records: %s", err)
+		}
+		for _, r := range page.Records {
+			if err := rw.write(newStorageRow(r)); err != nil {
+				return fmt.Errorf("writing storage deal record: %s", err)
+			}
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if err := rw.close(); err != nil {
+		return fmt.Errorf("closing writer: %s", err)
+	}
+	return nil
+}
+
+// RetrievalDealRecords writes the retrieval deal records listed by list
+// with opts to w, sorted by ascending time.
+func RetrievalDealRecords(ctx context.Context, w io.Writer, f Format, list RetrievalLister, opts ...deals.DealRecordsOption) error {
+	rw, err := newRowWriter(w, f, new(retrievalRow), retrievalColumns)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, deals.WithAscending(true), deals.WithLimit(pageSize))
+	var cursor string
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		page, err := list(append(opts, deals.WithCursor(cursor))...)
+		if err != nil {
+			return fmt.Errorf("listing retrieval deal records: %s", err)
+		}
+		for _, r := range page.Records {
+			if err := rw.write(newRetrievalRow(r)); err != nil {
+				return fmt.Errorf("writing retrieval deal record: %s", err)
+			}
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if err := rw.close(); err != nil {
+		return fmt.Errorf("closing writer: %s", err)
+	}
+	return nil
+}
+
+// row is a record which can be written as a CSV row.
+type row interface {
+	csv() []string
+}
+
+type rowWriter interface {
+	write(r row) error
+	close() error
+}
+
+func newRowWriter(w io.Writer, f Format, schema interface{}, columns []string) (rowWriter, error) {
+	switch f {
+	case CSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return nil, fmt.Errorf("writing csv header: %s", err)
+		}
+		return &csvWriter{w: cw}, nil
+	case Parquet:
+		pw, err := writer.NewParquetWriterFromWriter(w, schema, 1)
+		if err != nil {
+			return nil, fmt.Errorf("creating parquet writer: %s", err)
+		}
+		return &parquetWriter{w: pw}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %d", f)
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (cw *csvWriter) write(r row) error {
+	return cw.w.Write(r.csv())
+}
+
+func (cw *csvWriter) close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type parquetWriter struct {
+	w *writer.ParquetWriter
+}
+
+func (pw *parquetWriter) write(r row) error {
+	return pw.w.Write(r)
+}
+
+func (pw *parquetWriter) close() error {
+	return pw.w.WriteStop()
+}
+
+var storageColumns = []string{
+	"time", "wallet_address", "job_id", "root_cid", "pending", "proposal_cid",
+	"state_id", "state_name", "miner", "piece_cid", "size", "price_per_epoch",
+	"start_epoch", "duration", "deal_id", "activation_epoch", "message", "total_cost_attofil",
+}
+
+// storageRow is an exported storage deal record. Field order matches
+// storageColumns.
+type storageRow struct {
+	Time             int64  `parquet:"name=time, type=TIMESTAMP_MILLIS"`
+	WalletAddress    string `parquet:"name=wallet_address, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	JobID            string `parquet:"name=job_id, type=UTF8"`
+	RootCid          string `parquet:"name=root_cid, type=UTF8"`
+	Pending          bool   `parquet:"name=pending, type=BOOLEAN"`
+	ProposalCid      string `parquet:"name=proposal_cid, type=UTF8"`
+	StateID          uint64 `parquet:"name=state_id, type=UINT_64"`
+	StateName        string `parquet:"name=state_name, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Miner            string `parquet:"name=miner, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	PieceCid         string `parquet:"name=piece_cid, type=UTF8"`
+	Size             uint64 `parquet:"name=size, type=UINT_64"`
+	PricePerEpoch    uint64 `parquet:"name=price_per_epoch, type=UINT_64"`
+	StartEpoch       uint64 `parquet:"name=start_epoch, type=UINT_64"`
+	Duration         uint64 `parquet:"name=duration, type=UINT_64"`
+	DealID           uint64 `parquet:"name=deal_id, type=UINT_64"`
+	ActivationEpoch  int64  `parquet:"name=activation_epoch, type=INT64"`
+	Message          string `parquet:"name=message, type=UTF8"`
+	TotalCostAttoFil string `parquet:"name=total_cost_attofil, type=UTF8"`
+}
+
+func newStorageRow(r deals.StorageDealRecord) *storageRow {
+	cost := new(big.Int).SetUint64(r.DealInfo.PricePerEpoch)
+	cost.Mul(cost, new(big.Int).SetUint64(r.DealInfo.Duration))
+	return &storageRow{
+		Time:             r.Time * 1000,
+		WalletAddress:    r.Addr,
+		JobID:            r.JobID,
+		RootCid:          cidString(r.RootCid),
+		Pending:          r.Pending,
+		ProposalCid:      cidString(r.DealInfo.ProposalCid),
+		StateID:          r.DealInfo.StateID,
+		StateName:        r.DealInfo.StateName,
+		Miner:            r.DealInfo.Miner,
+		PieceCid:         cidString(r.DealInfo.PieceCID),
+		Size:             r.DealInfo.Size,
+		PricePerEpoch:    r.DealInfo.PricePerEpoch,
+		StartEpoch:       r.DealInfo.StartEpoch,
+		Duration:         r.DealInfo.Duration,
+		DealID:           r.DealInfo.DealID,
+		ActivationEpoch:  r.DealInfo.ActivationEpoch,
+		Message:          r.DealInfo.Message,
+		TotalCostAttoFil: cost.String(),
+	}
+}
+
+func (r *storageRow) csv() []string {
+	return []string{
+		csvTime(r.Time),
+		r.WalletAddress,
+		r.JobID,
+		r.RootCid,
+		strconv.FormatBool(r.Pending),
+		r.ProposalCid,
+		strconv.FormatUint(r.StateID, 10),
+		r.StateName,
+		r.Miner,
+		r.PieceCid,
+		strconv.FormatUint(r.Size, 10),
+		strconv.FormatUint(r.PricePerEpoch, 10),
+		strconv.FormatUint(r.StartEpoch, 10),
+		strconv.FormatUint(r.Duration, 10),
+		strconv.FormatUint(r.DealID, 10),
+		strconv.FormatInt(r.ActivationEpoch, 10),
+		r.Message,
+		r.TotalCostAttoFil,
+	}
+}
+
+var retrievalColumns = []string{
+	"time", "wallet_address", "job_id", "retrieval_id", "root_cid", "size", "min_price",
+	"payment_interval", "payment_interval_increase", "miner", "miner_peer_id",
+	"status", "error", "bytes_received", "elapsed_ms", "total_cost_attofil",
+}
+
+// retrievalRow is an exported retrieval deal record. Field order matches
+// retrievalColumns.
+type retrievalRow struct {
+	Time                    int64  `parquet:"name=time, type=TIMESTAMP_MILLIS"`
+	WalletAddress           string `parquet:"name=wallet_address, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	JobID                   string `parquet:"name=job_id, type=UTF8"`
+	RetrievalID             string `parquet:"name=retrieval_id, type=UTF8"`
+	RootCid                 string `parquet:"name=root_cid, type=UTF8"`
+	Size                    uint64 `parquet:"name=size, type=UINT_64"`
+	MinPrice                uint64 `parquet:"name=min_price, type=UINT_64"`
+	PaymentInterval         uint64 `parquet:"name=payment_interval, type=UINT_64"`
+	PaymentIntervalIncrease uint64 `parquet:"name=payment_interval_increase, type=UINT_64"`
+	Miner                   string `parquet:"name=miner, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	MinerPeerID             string `parquet:"name=miner_peer_id, type=UTF8"`
+	Status                  string `parquet:"name=status, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Error                   string `parquet:"name=error, type=UTF8"`
+	BytesReceived           uint64 `parquet:"name=bytes_received, type=UINT_64"`
+	ElapsedMs               int64  `parquet:"name=elapsed_ms, type=INT64"`
+	TotalCostAttoFil        string `parquet:"name=total_cost_attofil, type=UTF8"`
+}
+
+func newRetrievalRow(r deals.RetrievalDealRecord) *retrievalRow {
+	return &retrievalRow{
+		Time:                    r.Time * 1000,
+		WalletAddress:           r.Addr,
+		JobID:                   r.JobID,
+		RetrievalID:             r.RetrievalID,
+		RootCid:                 cidString(r.DealInfo.RootCid),
+		Size:                    r.DealInfo.Size,
+		MinPrice:                r.DealInfo.MinPrice,
+		PaymentInterval:         r.DealInfo.PaymentInterval,
+		PaymentIntervalIncrease: r.DealInfo.PaymentIntervalIncrease,
+		Miner:                   r.DealInfo.Miner,
+		MinerPeerID:             r.DealInfo.MinerPeerID,
+		Status:                  deals.RetrievalStatusStr[r.Status],
+		Error:                   r.Error,
+		BytesReceived:           r.BytesReceived,
+		ElapsedMs:               r.Elapsed.Milliseconds(),
+		TotalCostAttoFil:        new(big.Int).SetUint64(r.FundsSpent).String(),
+	}
+}
+
+func (r *retrievalRow) csv() []string {
+	return []string{
+		csvTime(r.Time),
+		r.WalletAddress,
+		r.JobID,
+		r.RetrievalID,
+		r.RootCid,
+		strconv.FormatUint(r.Size, 10),
+		strconv.FormatUint(r.MinPrice, 10),
+		strconv.FormatUint(r.PaymentInterval, 10),
+		strconv.FormatUint(r.PaymentIntervalIncrease, 10),
+		r.Miner,
+		r.MinerPeerID,
+		r.Status,
+		r.Error,
+		strconv.FormatUint(r.BytesReceived, 10),
+		strconv.FormatInt(r.ElapsedMs, 10),
+		r.TotalCostAttoFil,
+	}
+}
+
+func csvTime(millis int64) string {
+	return time.Unix(0, millis*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
+
+// cidString returns c as a string, or an empty string if c is undefined.
+func cidString(c cid.Cid) string {
+	if !c.Defined() {
+		return ""
+	}
+	return c.String()
+}
@@ -0,0 +1,172 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/deals"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+func TestStorageDealRecords(t *testing.T) {
+	records := make([]deals.StorageDealRecord, pageSize+1)
+	for i := range records {
+		records[i] = deals.StorageDealRecord{
+			RootCid: makeCid(t, "root"),
+			Addr:    "f3addr",
+			Time:    int64(i),
+			JobID:   "job",
+			DealInfo: deals.StorageDealInfo{
+				ProposalCid:   makeCid(t, "proposal"),
+				Miner:         "f01000",
+				PricePerEpoch: 1 << 63,
+				Duration:      4,
+				DealID:        math.MaxUint64,
+				Size:          uint64(i),
+			},
+		}
+	}
+	list := fakeStorageLister(records)
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := StorageDealRecords(context.Background(), &buf, CSV, list, deals.WithIncludeFinal(true))
+		require.NoError(t, err)
+		rows, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, len(records)+1)
+		require.Equal(t, storageColumns, rows[0])
+		require.Equal(t, []string{
+			"1970-01-01T00:00:01Z", "f3addr", "job", makeCid(t, "root").String(), "false", makeCid(t, "proposal").String(),
+			"0", "", "f01000", "", "1", "9223372036854775808", "0", "4", "18446744073709551615", "0", "", "36893488147419103232",
+		}, rows[2])
+	})
+
+	t.Run("Parquet", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := StorageDealRecords(context.Background(), &buf, Parquet, list)
+		require.NoError(t, err)
+		pr, err := reader.NewParquetReader(newBufferFile(buf.Bytes()), new(storageRow), 1)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(records)), pr.GetNumRows())
+		rows := make([]storageRow, len(records))
+		require.NoError(t, pr.Read(&rows))
+		pr.ReadStop()
+		require.Equal(t, *newStorageRow(records[pageSize]), rows[pageSize])
+	})
+}
+
+func TestRetrievalDealRecords(t *testing.T) {
+	records := []deals.RetrievalDealRecord{{
+		Addr:          "f3addr",
+		Time:          60,
+		JobID:         "job",
+		RetrievalID:   "retrieval",
+		Status:        deals.RetrievalFailed,
+		Error:         "miner disconnected",
+		BytesReceived: 50,
+		FundsSpent:    5,
+		Elapsed:       time.Second,
+		DealInfo: deals.RetrievalDealInfo{
+			RootCid:  makeCid(t, "root"),
+			Size:     100,
+			MinPrice: math.MaxUint64,
+			Miner:    "f01000",
+		},
+	}}
+	list := func(opts ...deals.DealRecordsOption) (deals.RetrievalDealRecordsPage, error) {
+		return deals.RetrievalDealRecordsPage{Records: records}, nil
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RetrievalDealRecords(context.Background(), &buf, CSV, list)
+		require.NoError(t, err)
+		rows, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			retrievalColumns,
+			{"1970-01-01T00:01:00Z", "f3addr", "job", "retrieval", makeCid(t, "root").String(), "100", "18446744073709551615", "0", "0", "f01000", "", "Failed", "miner disconnected", "50", "1000", "5"},
+		}, rows)
+	})
+
+	t.Run("Parquet", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RetrievalDealRecords(context.Background(), &buf, Parquet, list)
+		require.NoError(t, err)
+		pr, err := reader.NewParquetReader(newBufferFile(buf.Bytes()), new(retrievalRow), 1)
+		require.NoError(t, err)
+		rows := make([]retrievalRow, 1)
+		require.NoError(t, pr.Read(&rows))
+		pr.ReadStop()
+		require.Equal(t, *newRetrievalRow(records[0]), rows[0])
+	})
+}
+
+// fakeStorageLister pages through records using the index of the last
+// record of a page as cursor.
+func fakeStorageLister(records []deals.StorageDealRecord) StorageLister {
+	return func(opts ...deals.DealRecordsOption) (deals.StorageDealRecordsPage, error) {
+		var c deals.DealRecordsConfig
+		for _, o := range opts {
+			o(&c)
+		}
+		var start int
+		if c.Cursor != "" {
+			last, err := strconv.Atoi(c.Cursor)
+			if err != nil {
+				return deals.StorageDealRecordsPage{}, err
+			}
+			start = last + 1
+		}
+		end := start + c.Limit
+		if end >= len(records) {
+			return deals.StorageDealRecordsPage{Records: records[start:]}, nil
+		}
+		return deals.StorageDealRecordsPage{
+			Records:    records[start:end],
+			NextCursor: strconv.Itoa(end - 1),
+		}, nil
+	}
+}
+
+// bufferFile is a read-only in-memory source.ParquetFile.
+type bufferFile struct {
+	*bytes.Reader
+	data []byte
+}
+
+func newBufferFile(data []byte) *bufferFile {
+	return &bufferFile{Reader: bytes.NewReader(data), data: data}
+}
+
+func (f *bufferFile) Write(p []byte) (int, error) {
+	panic("not implemented")
+}
+
+func (f *bufferFile) Close() error {
+	return nil
+}
+
+func (f *bufferFile) Open(name string) (source.ParquetFile, error) {
+	return newBufferFile(f.data), nil
+}
+
+func (f *bufferFile) Create(name string) (source.ParquetFile, error) {
+	panic("not implemented")
+}
+
+func makeCid(t *testing.T, data string) cid.Cid {
+	c, err := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum([]byte(data))
+	require.NoError(t, err)
+	return c
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"sort"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-fil-markets/retrievalmarket"
 	"github.com/filecoin-project/go-fil-markets/storagemarket"
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/go-state-types/big"
@@ -30,6 +32,9 @@ import (
 
 const (
 	chanWriteTimeout = time.Second
+	// retrievalsBatchSize is the number of retrievals loaded at once when
+	// checking for interrupted retrievals.
+	retrievalsBatchSize = 500
 
 	defaultDealStartOffset = 48 * 60 * 60 / util.EpochDurationSeconds // 48hs
 )
@@ -71,6 +76,7 @@ func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, pollDurat
 		dealFinalityTimeout: dealFinalityTimeout,
 	}
 	m.initPendingDeals()
+	m.failInterruptedRetrievals()
 	return m, nil
 }
 
@@ -119,7 +125,9 @@ func (m *Module) Store(ctx context.Context, waddr string, dataCid cid.Cid, piece
 	if err != nil {
 		return nil, fmt.Errorf("parsing wallet address: %s", err)
 	}
-	lapi, cls, err := m.clientBuilder(ctx)
+	// Deals are tracked in the node where they're proposed, so remember it.
+	var endpoint string
+	lapi, cls, err := m.clientBuilder(lotus.WithEndpoint(ctx, &endpoint))
 	if err != nil {
 		return nil, fmt.Errorf("creating lotus client: %s", err)
 	}
@@ -170,7 +178,12 @@ func (m *Module) Store(ctx context.Context, waddr string, dataCid cid.Cid, piece
 			ProposalCid: *p,
 			Success:     true,
 		}
-		m.recordDeal(params, *p)
+		if endpoint != "" {
+			if err := m.store.putDealEndpoint(*p, endpoint); err != nil {
+				log.Errorf("storing deal endpoint: %s", err)
+			}
+		}
+		m.recordDeal(params, *p, deals.JobIDFromContext(ctx))
 	}
 	return res, nil
 }
@@ -192,14 +205,15 @@ func (m *Module) CalculateDealPiece(ctx context.Context, c cid.Cid) (api.DataCID
 
 // Fetch fetches deal data to the underlying blockstore of the Filecoin client.
 // This API is meant for clients that use external implementations of blockstores with
-// their own API, e.g: IPFS.
-func (m *Module) Fetch(ctx context.Context, waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) (string, <-chan marketevents.RetrievalEvent, error) {
+// their own API, e.g: IPFS. If maxPrice isn't zero, miners asking more than
+// maxPrice attoFIL for the retrieval aren't considered.
+func (m *Module) Fetch(ctx context.Context, waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, maxPrice uint64) (string, <-chan marketevents.RetrievalEvent, error) {
 	lapi, cls, err := m.clientBuilder(ctx)
 	if err != nil {
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
 
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, nil)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, maxPrice, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -222,7 +236,7 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	if err != nil {
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, &ref)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, 0, &ref)
 	if err != nil {
 		return "", nil, fmt.Errorf("retrieving from lotus: %s", err)
 	}
@@ -239,7 +253,7 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	return miner, &autodeleteFile{File: f}, nil
 }
 
-func (m *Module) retrieve(ctx context.Context, lapi *apistruct.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
+func (m *Module) retrieve(ctx context.Context, lapi *apistruct.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, maxPrice uint64, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
 	addr, err := address.NewFromString(waddr)
 	if err != nil {
 		return "", nil, fmt.Errorf("parsing wallet address: %s", err)
@@ -257,6 +271,10 @@ func (m *Module) retrieve(ctx context.Context, lapi *apistruct.FullNodeStruct, l
 			log.Infof("asking miner %s query-offer failed: %s", m, err)
 			continue
 		}
+		if maxPrice > 0 && qo.MinPrice.GreaterThan(types.NewInt(maxPrice)) {
+			log.Infof("miner %s retrieval price %s is above max price %d", mi, qo.MinPrice, maxPrice)
+			continue
+		}
 		offers = append(offers, qo)
 	}
 
@@ -273,43 +291,72 @@ func (m *Module) retrieve(ctx context.Context, lapi *apistruct.FullNodeStruct, l
 
 	// Try with sorted miners until we got in the process of receiving data.
 	var o api.QueryOffer
+	var rr deals.RetrievalDealRecord
+	var start time.Time
 	for _, o = range offers {
+		start = time.Now()
+		rr = newRetrievalRecord(ctx, waddr, o, start)
 		events, err = lapi.ClientRetrieveWithEvents(ctx, o.Order(addr), ref)
 		if err != nil {
 			log.Infof("fetching/retrieving cid %s from %s: %s", payloadCid, o.Miner, err)
+			rr.Status = deals.RetrievalFailed
+			rr.Error = err.Error()
+			m.recordRetrieval(rr)
 			continue
 		}
 		break
 	}
+	if events == nil {
+		lapiCls()
+		return "", nil, fmt.Errorf("starting retrieval with miners: %s", err)
+	}
+	m.recordRetrieval(rr)
 
 	go func() {
 		defer lapiCls()
 		defer close(out)
-		// Redirect received events to the output channel
-		var errored, canceled bool
+		// Redirect received events to the output channel, and save
+		// the retrieval progress when its status changes.
+		var status retrievalmarket.DealStatus
 	Loop:
 		for {
 			select {
 			case <-ctx.Done():
 				log.Infof("in progress retrieval canceled")
-				canceled = true
+				rr.Status = deals.RetrievalCanceled
 				break Loop
 			case e, ok := <-events:
 				if !ok {
 					break Loop
 				}
+				rr.BytesReceived = e.BytesReceived
+				if e.FundsSpent.Int != nil {
+					if e.FundsSpent.IsUint64() {
+						rr.FundsSpent = e.FundsSpent.Uint64()
+					} else {
+						log.Warnf("funds spent %s in retrieval overflow, saving the max value", e.FundsSpent)
+						rr.FundsSpent = math.MaxUint64
+					}
+				}
+				rr.Elapsed = time.Since(start)
 				if e.Err != "" {
-					log.Infof("in progress retrieval errored: %s", err)
-					errored = true
+					log.Infof("in progress retrieval errored: %s", e.Err)
+					rr.Status = deals.RetrievalFailed
+					rr.Error = e.Err
+				}
+				if e.Status != status {
+					status = e.Status
+					m.recordRetrieval(rr)
 				}
 				out <- e
 			}
 		}
 
-		// Only register retrieval if successful
-		if !errored && !canceled {
-			m.recordRetrieval(waddr, o)
+		rr.Elapsed = time.Since(start)
+		if rr.Status == deals.RetrievalInProgress {
+			rr.Status = deals.RetrievalSucceeded
 		}
+		m.recordRetrieval(rr)
 	}()
 
 	return o.Miner.String(), out, nil
@@ -317,19 +364,37 @@ func (m *Module) retrieve(ctx context.Context, lapi *apistruct.FullNodeStruct, l
 
 // GetDealStatus returns the current status of the deal.
 func (m *Module) GetDealStatus(ctx context.Context, pcid cid.Cid) (storagemarket.StorageDealStatus, error) {
-	lapi, cls, err := m.clientBuilder(ctx)
+	di, err := m.getDealInfo(ctx, pcid)
 	if err != nil {
-		return storagemarket.StorageDealUnknown, fmt.Errorf("creating lotus client: %s", err)
+		return storagemarket.StorageDealUnknown, err
+	}
+	return di.State, nil
+}
+
+// GetDealID returns the on-chain id of the deal, which is zero if the
+// deal wasn't published yet.
+func (m *Module) GetDealID(ctx context.Context, pcid cid.Cid) (uint64, error) {
+	di, err := m.getDealInfo(ctx, pcid)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(di.DealID), nil
+}
+
+func (m *Module) getDealInfo(ctx context.Context, pcid cid.Cid) (*api.DealInfo, error) {
+	lapi, cls, err := m.dealClient(ctx, pcid)
+	if err != nil {
+		return nil, fmt.Errorf("creating lotus client: %s", err)
 	}
 	defer cls()
 	di, err := robustClientGetDealInfo(ctx, lapi, pcid)
 	if err != nil {
 		if strings.Contains(err.Error(), "datastore: key not found") {
-			return storagemarket.StorageDealUnknown, ErrDealNotFound
+			return nil, ErrDealNotFound
 		}
-		return storagemarket.StorageDealUnknown, fmt.Errorf("getting deal info: %s", err)
+		return nil, fmt.Errorf("getting deal info: %s", err)
 	}
-	return di.State, nil
+	return di, nil
 }
 
 // Watch returns a channel with state changes of indicated proposals.
@@ -337,6 +402,14 @@ func (m *Module) Watch(ctx context.Context, proposals []cid.Cid) (<-chan deals.S
 	if len(proposals) == 0 {
 		return nil, fmt.Errorf("proposals list can't be empty")
 	}
+	byEndpoint := make(map[string][]cid.Cid)
+	for _, p := range proposals {
+		endpoint, err := m.store.getDealEndpoint(p)
+		if err != nil {
+			return nil, fmt.Errorf("getting deal endpoint: %s", err)
+		}
+		byEndpoint[endpoint] = append(byEndpoint[endpoint], p)
+	}
 	ch := make(chan deals.StorageDealInfo)
 	go func() {
 		defer close(ch)
@@ -344,14 +417,18 @@ func (m *Module) Watch(ctx context.Context, proposals []cid.Cid) (<-chan deals.S
 		currentState := make(map[cid.Cid]*api.DealInfo)
 
 		makeClientAndNotify := func() error {
-			client, cls, err := m.clientBuilder(ctx)
-			if err != nil {
-				return fmt.Errorf("creating lotus client: %s", err)
-			}
-			if err := notifyChanges(ctx, client, currentState, proposals, ch); err != nil {
-				return fmt.Errorf("pushing new proposal states: %s", err)
+			for endpoint, proposals := range byEndpoint {
+				endpoint := endpoint
+				client, cls, err := m.clientBuilder(lotus.WithEndpoint(ctx, &endpoint))
+				if err != nil {
+					return fmt.Errorf("creating lotus client: %s", err)
+				}
+				err = notifyChanges(ctx, client, currentState, proposals, ch)
+				cls()
+				if err != nil {
+					return fmt.Errorf("pushing new proposal states: %s", err)
+				}
 			}
-			cls()
 			return nil
 		}
 
@@ -378,119 +455,34 @@ func (m *Module) Watch(ctx context.Context, proposals []cid.Cid) (<-chan deals.S
 }
 
 // ListStorageDealRecords lists storage deals according to the provided options.
-func (m *Module) ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error) {
+func (m *Module) ListStorageDealRecords(opts ...deals.DealRecordsOption) (deals.StorageDealRecordsPage, error) {
 	c := deals.DealRecordsConfig{}
 	for _, opt := range opts {
 		opt(&c)
 	}
 
 	if !c.IncludeFinal && !c.IncludePending {
-		return nil, fmt.Errorf("you must specify one or both options of IncludePending and IncludeFinal")
+		return deals.StorageDealRecordsPage{}, fmt.Errorf("you must specify one or both options of IncludePending and IncludeFinal")
 	}
 
-	var final []deals.StorageDealRecord
-	if c.IncludeFinal {
-		recs, err := m.store.getFinalDeals()
-		if err != nil {
-			return nil, fmt.Errorf("getting final deals: %v", err)
-		}
-		final = recs
-	}
-
-	var pending []deals.StorageDealRecord
-	if c.IncludePending {
-		recs, err := m.store.getPendingDeals()
-		if err != nil {
-			return nil, fmt.Errorf("getting pending deals: %v", err)
-		}
-		pending = recs
-	}
-
-	combined := append(final, pending...)
-
-	var filtered []deals.StorageDealRecord
-
-	if len(c.FromAddrs) > 0 || len(c.DataCids) > 0 {
-		fromAddrsFilter := make(map[string]struct{})
-		dataCidsFilter := make(map[string]struct{})
-		for _, addr := range c.FromAddrs {
-			fromAddrsFilter[addr] = struct{}{}
-		}
-		for _, cid := range c.DataCids {
-			dataCidsFilter[cid] = struct{}{}
-		}
-		for _, record := range combined {
-			_, inFromAddrsFilter := fromAddrsFilter[record.Addr]
-			_, inDataCidsFilter := dataCidsFilter[util.CidToString(record.RootCid)]
-			includeViaFromAddrs := len(c.FromAddrs) == 0 || inFromAddrsFilter
-			includeViaDataCids := len(c.DataCids) == 0 || inDataCidsFilter
-			if includeViaFromAddrs && includeViaDataCids {
-				filtered = append(filtered, record)
-			}
-		}
-	} else {
-		filtered = combined
+	page, err := m.store.queryStorageDeals(c)
+	if err != nil {
+		return deals.StorageDealRecordsPage{}, fmt.Errorf("querying storage deals: %v", err)
 	}
-
-	sort.Slice(filtered, func(i, j int) bool {
-		l := filtered[j]
-		r := filtered[i]
-		if c.Ascending {
-			l = filtered[i]
-			r = filtered[j]
-		}
-		return l.Time < r.Time
-	})
-
-	return filtered, nil
+	return page, nil
 }
 
 // ListRetrievalDealRecords returns a list of retrieval deals according to the provided options.
-func (m *Module) ListRetrievalDealRecords(opts ...deals.DealRecordsOption) ([]deals.RetrievalDealRecord, error) {
+func (m *Module) ListRetrievalDealRecords(opts ...deals.DealRecordsOption) (deals.RetrievalDealRecordsPage, error) {
 	c := deals.DealRecordsConfig{}
 	for _, opt := range opts {
 		opt(&c)
 	}
-	ret, err := m.store.getRetrievals()
+	page, err := m.store.queryRetrievals(c)
 	if err != nil {
-		return nil, fmt.Errorf("getting retrievals: %v", err)
+		return deals.RetrievalDealRecordsPage{}, fmt.Errorf("querying retrievals: %v", err)
 	}
-
-	var filtered []deals.RetrievalDealRecord
-
-	if len(c.FromAddrs) > 0 || len(c.DataCids) > 0 {
-		fromAddrsFilter := make(map[string]struct{})
-		dataCidsFilter := make(map[string]struct{})
-		for _, addr := range c.FromAddrs {
-			fromAddrsFilter[addr] = struct{}{}
-		}
-		for _, cid := range c.DataCids {
-			dataCidsFilter[cid] = struct{}{}
-		}
-		for _, record := range ret {
-			_, inFromAddrsFilter := fromAddrsFilter[record.Addr]
-			_, inDataCidsFilter := dataCidsFilter[util.CidToString(record.DealInfo.RootCid)]
-			includeViaFromAddrs := len(c.FromAddrs) == 0 || inFromAddrsFilter
-			includeViaDataCids := len(dataCidsFilter) == 0 || inDataCidsFilter
-			if includeViaFromAddrs && includeViaDataCids {
-				filtered = append(filtered, record)
-			}
-		}
-	} else {
-		filtered = ret
-	}
-
-	sort.Slice(filtered, func(i, j int) bool {
-		l := filtered[j]
-		r := filtered[i]
-		if c.Ascending {
-			l = filtered[i]
-			r = filtered[j]
-		}
-		return l.Time < r.Time
-	})
-
-	return filtered, nil
+	return page, nil
 }
 
 func (m *Module) initPendingDeals() {
@@ -509,7 +501,32 @@ func (m *Module) initPendingDeals() {
 	}
 }
 
-func (m *Module) recordDeal(params *api.StartDealParams, proposalCid cid.Cid) {
+// failInterruptedRetrievals marks retrievals which were in progress when
+// the module was stopped as failed, since they won't make more progress.
+func (m *Module) failInterruptedRetrievals() {
+	// Records keep their keys when updated, so pages aren't shifted.
+	for offset := 0; ; offset += retrievalsBatchSize {
+		rrs, err := m.store.getRetrievalsPage(offset, retrievalsBatchSize)
+		if err != nil {
+			log.Errorf("getting retrievals: %v", err)
+			return
+		}
+		for _, rr := range rrs {
+			if rr.Status != deals.RetrievalInProgress {
+				continue
+			}
+			log.Infof("retrieval %s of %s was interrupted, marking it as failed", rr.RetrievalID, util.CidToString(rr.DealInfo.RootCid))
+			rr.Status = deals.RetrievalFailed
+			rr.Error = "retrieval interrupted by a restart"
+			m.recordRetrieval(rr)
+		}
+		if len(rrs) < retrievalsBatchSize {
+			return
+		}
+	}
+}
+
+func (m *Module) recordDeal(params *api.StartDealParams, proposalCid cid.Cid, jobID string) {
 	di := deals.StorageDealInfo{
 		Duration:      params.MinBlocksDuration,
 		PricePerEpoch: params.EpochPrice.Uint64(),
@@ -522,6 +539,7 @@ func (m *Module) recordDeal(params *api.StartDealParams, proposalCid cid.Cid) {
 		Time:     time.Now().Unix(),
 		DealInfo: di,
 		Pending:  true,
+		JobID:    jobID,
 	}
 	log.Infof("storing pending deal record for proposal cid: %s", util.CidToString(proposalCid))
 	if err := m.store.putPendingDeal(record); err != nil {
@@ -532,7 +550,7 @@ func (m *Module) recordDeal(params *api.StartDealParams, proposalCid cid.Cid) {
 }
 
 func (m *Module) finalizePendingDeal(dr deals.StorageDealRecord) {
-	lapi, cls, err := m.clientBuilder(context.Background())
+	lapi, cls, err := m.dealClient(context.Background(), dr.DealInfo.ProposalCid)
 	if err != nil {
 		log.Errorf("finalize pending deal, creating client: %s", err)
 		return
@@ -567,6 +585,7 @@ func (m *Module) finalizePendingDeal(dr deals.StorageDealRecord) {
 			Time:     time.Now().Unix(), // Note: This can be much later in time than the deal actually became active on chain
 			DealInfo: di,
 			Pending:  false,
+			JobID:    dr.JobID,
 		}
 		if err := m.store.putFinalDeal(record); err != nil {
 			log.Errorf("storing proposal cid %s deal record: %v", util.CidToString(dr.DealInfo.ProposalCid), err)
@@ -605,6 +624,7 @@ func (m *Module) eventuallyFinalizeDeal(dr deals.StorageDealRecord, timeout time
 					Time:     time.Now().Unix(),
 					DealInfo: info,
 					Pending:  false,
+					JobID:    dr.JobID,
 				}
 				log.Infof("proposal cid %s is active, storing deal record", util.CidToString(info.ProposalCid))
 				if err := m.store.putFinalDeal(record); err != nil {
@@ -624,10 +644,15 @@ func (m *Module) eventuallyFinalizeDeal(dr deals.StorageDealRecord, timeout time
 	}
 }
 
-func (m *Module) recordRetrieval(addr string, offer api.QueryOffer) {
-	rr := deals.RetrievalDealRecord{
-		Addr: addr,
-		Time: time.Now().Unix(),
+// newRetrievalRecord returns the record of an in progress retrieval
+// from the miner of offer, started at start.
+func newRetrievalRecord(ctx context.Context, addr string, offer api.QueryOffer, start time.Time) deals.RetrievalDealRecord {
+	return deals.RetrievalDealRecord{
+		Addr:        addr,
+		Time:        start.Unix(),
+		JobID:       deals.JobIDFromContext(ctx),
+		RetrievalID: deals.RetrievalIDFromContext(ctx),
+		Status:      deals.RetrievalInProgress,
 		DealInfo: deals.RetrievalDealInfo{
 			RootCid:                 offer.Root,
 			Size:                    offer.Size,
@@ -638,11 +663,24 @@ func (m *Module) recordRetrieval(addr string, offer api.QueryOffer) {
 			PaymentIntervalIncrease: offer.PaymentIntervalIncrease,
 		},
 	}
+}
+
+func (m *Module) recordRetrieval(rr deals.RetrievalDealRecord) {
 	if err := m.store.putRetrieval(rr); err != nil {
 		log.Errorf("storing retrieval: %v", err)
 	}
 }
 
+// dealClient creates a Lotus client connected to the node where
+// a deal was proposed.
+func (m *Module) dealClient(ctx context.Context, proposalCid cid.Cid) (*apistruct.FullNodeStruct, func(), error) {
+	endpoint, err := m.store.getDealEndpoint(proposalCid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting deal endpoint: %s", err)
+	}
+	return m.clientBuilder(lotus.WithEndpoint(ctx, &endpoint))
+}
+
 func notifyChanges(ctx context.Context, lapi *apistruct.FullNodeStruct, currState map[cid.Cid]*api.DealInfo, proposals []cid.Cid, ch chan<- deals.StorageDealInfo) error {
 	for _, pcid := range proposals {
 		dinfo, err := robustClientGetDealInfo(ctx, lapi, pcid)
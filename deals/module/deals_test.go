@@ -127,6 +127,56 @@ func TestRetrieve(t *testing.T) {
 	}
 }
 
+func TestStoreFake(t *testing.T) {
+	t.Parallel()
+	data := randomBytes(600)
+	clientBuilder, addr, miners := tests.CreateFakeDevnet(t, 2)
+	m, err := New(tests.NewTxMapDatastore(), clientBuilder, util.AvgBlockTime, time.Minute*10, deals.WithImportPath(filepath.Join(tmpDir, "imports")))
+	require.NoError(t, err)
+	c, cls, err := clientBuilder(context.Background())
+	require.NoError(t, err)
+	defer cls()
+
+	dcid, pcids, err := storeMultiMiner(m, c, 2, data)
+	require.NoError(t, err)
+	err = waitForDealComplete(c, pcids)
+	require.NoError(t, err)
+	for _, pcid := range pcids {
+		status, err := m.GetDealStatus(context.Background(), pcid)
+		require.NoError(t, err)
+		require.Equal(t, storagemarket.StorageDealActive, status)
+	}
+
+	miner, r, err := m.Retrieve(context.Background(), addr.String(), dcid, nil, []string{miners[0].String()}, false)
+	require.NoError(t, err)
+	require.Equal(t, miners[0].String(), miner)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	rdata, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(data, rdata), "retrieved data doesn't match with stored data")
+}
+
+func TestFailInterruptedRetrievals(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	s := newStore(ds)
+	inProgress := deals.RetrievalDealRecord{Addr: "a", Time: 1, Status: deals.RetrievalInProgress}
+	succeeded := deals.RetrievalDealRecord{Addr: "a", Time: 2, Status: deals.RetrievalSucceeded}
+	require.NoError(t, s.putRetrieval(inProgress))
+	require.NoError(t, s.putRetrieval(succeeded))
+
+	m, err := New(ds, nil, util.AvgBlockTime, time.Minute*10)
+	require.NoError(t, err)
+	page, err := m.ListRetrievalDealRecords(deals.WithAscending(true))
+	require.NoError(t, err)
+	require.Len(t, page.Records, 2)
+	require.Equal(t, deals.RetrievalFailed, page.Records[0].Status)
+	require.NotEmpty(t, page.Records[0].Error)
+	require.Equal(t, deals.RetrievalSucceeded, page.Records[1].Status)
+}
+
 func storeMultiMiner(m *Module, client *apistruct.FullNodeStruct, numMiners int, data []byte) (cid.Cid, []cid.Cid, error) {
 	ctx := context.Background()
 	miners, err := client.StateListMiners(ctx, types.EmptyTSK)
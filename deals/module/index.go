@@ -0,0 +1,589 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	dsextensions "github.com/textileio/go-datastore-extensions"
+	"github.com/textileio/powergate/deals"
+	txndstr "github.com/textileio/powergate/txndstransform"
+	"github.com/textileio/powergate/util"
+)
+
+// Deal records are indexed by wallet address and time, so they can be
+// listed in order with cursor pagination without loading all of them:
+// - /storage-index/<addr>/<time>/<proposal-cid>
+// - /retrieval-index/<addr>/<time>/<retrieval-id>
+// Index entries are copies of the records. Addresses with records are
+// saved in /index-addrs/<index-base>/<addr>. Records are also summarized
+// by wallet address and miner, so summaries don't iterate records:
+// - /summaries/<record-base>/<addr>/<miner>
+// where <miner> is "-" for records without a miner.
+// Records, index entries and summaries are written in the same
+// transaction. Changes to the layout need a datastore migration.
+
+// recordsTxn writes records with their index entries and summaries in a
+// transaction. Changes to summaries are accumulated and written on
+// commit, since transactions might not read their own writes.
+type recordsTxn struct {
+	txn       datastore.Txn
+	summaries map[datastore.Key]*deals.DealRecordsSummary
+}
+
+// update runs op in a recordsTxn which is committed if op succeeds.
+func (s *store) update(op func(*recordsTxn) error) error {
+	txn, err := s.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	rt := &recordsTxn{txn: txn, summaries: make(map[datastore.Key]*deals.DealRecordsSummary)}
+	if err := op(rt); err != nil {
+		return err
+	}
+	if err := rt.putSummaries(); err != nil {
+		return err
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	return nil
+}
+
+func (rt *recordsTxn) putStorageDeal(base datastore.Key, dr deals.StorageDealRecord) error {
+	buf, err := json.Marshal(dr)
+	if err != nil {
+		return fmt.Errorf("marshaling record: %s", err)
+	}
+	if err := rt.txn.Put(makeStorageDealKey(base, dr.DealInfo.ProposalCid), buf); err != nil {
+		return fmt.Errorf("put record: %s", err)
+	}
+	if err := rt.txn.Put(makeIndexKey(dsBaseStorageIndex, dr.Addr, dr.Time, util.CidToString(dr.DealInfo.ProposalCid)), buf); err != nil {
+		return fmt.Errorf("put index entry: %s", err)
+	}
+	if err := rt.txn.Put(makeIndexAddrKey(dsBaseStorageIndex, dr.Addr), []byte{}); err != nil {
+		return fmt.Errorf("put index address: %s", err)
+	}
+	return rt.summarize(base, dr.Addr, dr.DealInfo.Miner, storageSummary(dr), 1)
+}
+
+// deleteStorageDeal deletes the storage record saved under base, if it
+// exists, with its index entry.
+func (rt *recordsTxn) deleteStorageDeal(base datastore.Key, proposalCid cid.Cid) error {
+	key := makeStorageDealKey(base, proposalCid)
+	buf, err := rt.txn.Get(key)
+	if err == datastore.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get record: %s", err)
+	}
+	var dr deals.StorageDealRecord
+	if err := json.Unmarshal(buf, &dr); err != nil {
+		return fmt.Errorf("unmarshaling record: %s", err)
+	}
+	if err := rt.txn.Delete(key); err != nil {
+		return fmt.Errorf("delete record: %s", err)
+	}
+	if err := rt.txn.Delete(makeIndexKey(dsBaseStorageIndex, dr.Addr, dr.Time, util.CidToString(dr.DealInfo.ProposalCid))); err != nil {
+		return fmt.Errorf("delete index entry: %s", err)
+	}
+	return rt.summarize(base, dr.Addr, dr.DealInfo.Miner, storageSummary(dr), -1)
+}
+
+func (rt *recordsTxn) putRetrieval(rr deals.RetrievalDealRecord) error {
+	key := makeRetrievalKey(rr)
+	// The same retrieval might have been saved before.
+	old, err := rt.txn.Get(key)
+	if err != nil && err != datastore.ErrNotFound {
+		return fmt.Errorf("get record: %s", err)
+	}
+	if err == nil {
+		var prev deals.RetrievalDealRecord
+		if err := json.Unmarshal(old, &prev); err != nil {
+			return fmt.Errorf("unmarshaling record: %s", err)
+		}
+		if err := rt.summarize(dsBaseRetrieval, prev.Addr, prev.DealInfo.Miner, retrievalSummary(prev), -1); err != nil {
+			return err
+		}
+	}
+	buf, err := json.Marshal(rr)
+	if err != nil {
+		return fmt.Errorf("marshaling record: %s", err)
+	}
+	if err := rt.txn.Put(key, buf); err != nil {
+		return fmt.Errorf("put record: %s", err)
+	}
+	if err := rt.txn.Put(makeIndexKey(dsBaseRetrievalIndex, rr.Addr, rr.Time, retrievalID(rr)), buf); err != nil {
+		return fmt.Errorf("put index entry: %s", err)
+	}
+	if err := rt.txn.Put(makeIndexAddrKey(dsBaseRetrievalIndex, rr.Addr), []byte{}); err != nil {
+		return fmt.Errorf("put index address: %s", err)
+	}
+	return rt.summarize(dsBaseRetrieval, rr.Addr, rr.DealInfo.Miner, retrievalSummary(rr), 1)
+}
+
+// summarize adds, or subtracts if sign is negative, a record summary to
+// the summary of the records under base of addr and miner.
+func (rt *recordsTxn) summarize(base datastore.Key, addr, miner string, rs deals.DealRecordsSummary, sign int) error {
+	key := makeSummaryKey(base, addr, miner)
+	sum, ok := rt.summaries[key]
+	if !ok {
+		var err error
+		sum, err = getSummary(rt.txn, key)
+		if err != nil {
+			return err
+		}
+		rt.summaries[key] = sum
+	}
+	if sign < 0 {
+		sum.Count -= rs.Count
+		sum.TotalAttoFil.Sub(sum.TotalAttoFil, rs.TotalAttoFil)
+		sum.TotalBytes -= rs.TotalBytes
+		return nil
+	}
+	addSummary(sum, rs)
+	return nil
+}
+
+func (rt *recordsTxn) putSummaries() error {
+	for key, sum := range rt.summaries {
+		if sum.Count == 0 {
+			if err := rt.txn.Delete(key); err != nil {
+				return fmt.Errorf("delete summary: %s", err)
+			}
+			continue
+		}
+		buf, err := json.Marshal(sum)
+		if err != nil {
+			return fmt.Errorf("marshaling summary: %s", err)
+		}
+		if err := rt.txn.Put(key, buf); err != nil {
+			return fmt.Errorf("put summary: %s", err)
+		}
+	}
+	return nil
+}
+
+func getSummary(r datastore.Read, key datastore.Key) (*deals.DealRecordsSummary, error) {
+	sum := &deals.DealRecordsSummary{TotalAttoFil: big.NewInt(0)}
+	buf, err := r.Get(key)
+	if err == datastore.ErrNotFound {
+		return sum, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get summary: %s", err)
+	}
+	if err := json.Unmarshal(buf, sum); err != nil {
+		return nil, fmt.Errorf("unmarshaling summary: %s", err)
+	}
+	return sum, nil
+}
+
+func addSummary(sum *deals.DealRecordsSummary, rs deals.DealRecordsSummary) {
+	sum.Count += rs.Count
+	sum.TotalAttoFil.Add(sum.TotalAttoFil, rs.TotalAttoFil)
+	sum.TotalBytes += rs.TotalBytes
+}
+
+// storageSummary returns the summary of a storage record. The price of a
+// storage deal is its price per epoch times its duration.
+func storageSummary(dr deals.StorageDealRecord) deals.DealRecordsSummary {
+	price := new(big.Int).SetUint64(dr.DealInfo.PricePerEpoch)
+	price.Mul(price, new(big.Int).SetUint64(dr.DealInfo.Duration))
+	return deals.DealRecordsSummary{Count: 1, TotalAttoFil: price, TotalBytes: dr.DealInfo.Size}
+}
+
+// retrievalSummary returns the summary of a retrieval record. The price
+// of a retrieval deal are the funds spent in it.
+func retrievalSummary(rr deals.RetrievalDealRecord) deals.DealRecordsSummary {
+	return deals.DealRecordsSummary{Count: 1, TotalAttoFil: new(big.Int).SetUint64(rr.FundsSpent), TotalBytes: rr.DealInfo.Size}
+}
+
+// queryStorageDeals returns a page of the storage records matching c.
+func (s *store) queryStorageDeals(c deals.DealRecordsConfig) (deals.StorageDealRecordsPage, error) {
+	f := newRecordsFilter(c)
+	var page deals.StorageDealRecordsPage
+	var last string
+	err := s.iterateIndex(dsBaseStorageIndex, c, func(cursor string, buf []byte) (bool, error) {
+		var dr deals.StorageDealRecord
+		if err := json.Unmarshal(buf, &dr); err != nil {
+			return false, fmt.Errorf("unmarshaling record: %s", err)
+		}
+		if !f.matchStorage(dr) {
+			return true, nil
+		}
+		if c.Limit > 0 && len(page.Records) == c.Limit {
+			page.NextCursor = last
+			return false, nil
+		}
+		page.Records = append(page.Records, dr)
+		last = cursor
+		return true, nil
+	})
+	if err != nil {
+		return deals.StorageDealRecordsPage{}, err
+	}
+	if !c.IncludeSummary {
+		return page, nil
+	}
+	var bases []datastore.Key
+	if c.IncludePending {
+		bases = append(bases, dsBaseStoragePending)
+	}
+	if c.IncludeFinal {
+		bases = append(bases, dsBaseStorageFinal)
+	}
+	if page.Summary, err = s.summary(bases, c); err != nil {
+		return deals.StorageDealRecordsPage{}, fmt.Errorf("summarizing records: %s", err)
+	}
+	return page, nil
+}
+
+// queryRetrievals returns a page of the retrieval records matching c.
+func (s *store) queryRetrievals(c deals.DealRecordsConfig) (deals.RetrievalDealRecordsPage, error) {
+	f := newRecordsFilter(c)
+	var page deals.RetrievalDealRecordsPage
+	var last string
+	err := s.iterateIndex(dsBaseRetrievalIndex, c, func(cursor string, buf []byte) (bool, error) {
+		var rr deals.RetrievalDealRecord
+		if err := json.Unmarshal(buf, &rr); err != nil {
+			return false, fmt.Errorf("unmarshaling record: %s", err)
+		}
+		if !f.matchRetrieval(rr) {
+			return true, nil
+		}
+		if c.Limit > 0 && len(page.Records) == c.Limit {
+			page.NextCursor = last
+			return false, nil
+		}
+		page.Records = append(page.Records, rr)
+		last = cursor
+		return true, nil
+	})
+	if err != nil {
+		return deals.RetrievalDealRecordsPage{}, err
+	}
+	if !c.IncludeSummary {
+		return page, nil
+	}
+	if page.Summary, err = s.summary([]datastore.Key{dsBaseRetrieval}, c); err != nil {
+		return deals.RetrievalDealRecordsPage{}, fmt.Errorf("summarizing records: %s", err)
+	}
+	return page, nil
+}
+
+// summary adds the summaries of the records under bases of the addresses
+// and miners of c. Other filters aren't summarized, so they can't be
+// combined with a summary.
+func (s *store) summary(bases []datastore.Key, c deals.DealRecordsConfig) (*deals.DealRecordsSummary, error) {
+	if len(c.DataCids) > 0 || len(c.PieceCids) > 0 || len(c.StateIDs) > 0 ||
+		c.FromTime > 0 || c.ToTime > 0 || c.MinPrice > 0 || c.MaxPrice > 0 {
+		return nil, ErrSummaryFilters
+	}
+	f := newRecordsFilter(c)
+	sum := &deals.DealRecordsSummary{TotalAttoFil: big.NewInt(0)}
+	for _, base := range bases {
+		prefixes := []datastore.Key{dsBaseSummaries.Child(base)}
+		if len(c.FromAddrs) > 0 {
+			prefixes = prefixes[:0]
+			for _, addr := range c.FromAddrs {
+				prefixes = append(prefixes, dsBaseSummaries.Child(base).ChildString(addr))
+			}
+		}
+		for _, prefix := range prefixes {
+			if err := s.addSummaries(sum, prefix, f.miners); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sum, nil
+}
+
+// addSummaries adds the summaries under prefix of miners to sum.
+func (s *store) addSummaries(sum *deals.DealRecordsSummary, prefix datastore.Key, miners map[string]struct{}) error {
+	res, err := s.ds.Query(query.Query{Prefix: prefix.String()})
+	if err != nil {
+		return fmt.Errorf("executing query: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		if !inSet(miners, datastore.RawKey(r.Key).Name()) {
+			continue
+		}
+		var rs deals.DealRecordsSummary
+		if err := json.Unmarshal(r.Value, &rs); err != nil {
+			return fmt.Errorf("unmarshaling summary: %s", err)
+		}
+		addSummary(sum, rs)
+	}
+	return nil
+}
+
+// indexStream is an ordered stream of the index entries of an address.
+// Entries start at the seek key of the query, and end before end.
+type indexStream struct {
+	prefix    string
+	ascending bool
+	skip      string
+	end       string
+	res       query.Results
+	cursor    string
+	value     []byte
+	done      bool
+	err       error
+}
+
+func (is *indexStream) next() {
+	for {
+		r, ok := is.res.NextSync()
+		if !ok {
+			is.done = true
+			return
+		}
+		if r.Error != nil {
+			is.err = r.Error
+			return
+		}
+		// Skip keys of other addresses sharing the prefix, and the
+		// entry of the cursor.
+		if !strings.HasPrefix(r.Key, is.prefix) || r.Key == is.skip {
+			continue
+		}
+		if is.end != "" && ((is.ascending && r.Key >= is.end) || (!is.ascending && r.Key < is.end)) {
+			is.done = true
+			return
+		}
+		is.cursor = r.Key[len(is.prefix):]
+		is.value = r.Value
+		return
+	}
+}
+
+// iterateIndex calls fn with the index entries under base of the
+// addresses of c, sorted by time as specified by c, until fn returns
+// false. If c has no addresses, entries of all addresses are iterated.
+// Entries are limited to the time range of c and to the entries after
+// its cursor. Index queries seek to the first entry in range, and stop at
+// the first entry out of range.
+func (s *store) iterateIndex(base datastore.Key, c deals.DealRecordsConfig, fn func(cursor string, value []byte) (bool, error)) error {
+	if c.Cursor != "" {
+		if err := validateCursor(c.Cursor); err != nil {
+			return err
+		}
+	}
+	addrs := c.FromAddrs
+	if len(addrs) == 0 {
+		var err error
+		addrs, err = s.indexAddrs(base)
+		if err != nil {
+			return err
+		}
+	}
+
+	streams := make([]*indexStream, 0, len(addrs))
+	defer func() {
+		for _, is := range streams {
+			if err := is.res.Close(); err != nil {
+				log.Errorf("closing query result: %s", err)
+			}
+		}
+	}()
+	for _, addr := range addrs {
+		prefix := base.ChildString(addr).String() + "/"
+		is := &indexStream{prefix: prefix, ascending: c.Ascending}
+		// Keys of a time are longer than the time key, so seeking to
+		// a time key includes the entries of that time in ascending
+		// order, and excludes them in descending order.
+		q := query.Query{Prefix: prefix, Orders: []query.Order{query.OrderByKeyDescending{}}}
+		var seek string
+		if c.Ascending {
+			q.Orders = []query.Order{query.OrderByKey{}}
+			if c.FromTime > 0 {
+				seek = prefix + timeKey(c.FromTime)
+			}
+			if c.Cursor != "" && prefix+c.Cursor > seek {
+				seek, is.skip = prefix+c.Cursor, prefix+c.Cursor
+			}
+			if c.ToTime > 0 {
+				is.end = prefix + timeKey(c.ToTime)
+			}
+		} else {
+			if c.ToTime > 0 {
+				seek = prefix + timeKey(c.ToTime)
+			}
+			if c.Cursor != "" && (seek == "" || prefix+c.Cursor < seek) {
+				seek, is.skip = prefix+c.Cursor, prefix+c.Cursor
+			}
+			if c.FromTime > 0 {
+				is.end = prefix + timeKey(c.FromTime)
+			}
+		}
+		var err error
+		is.res, err = txndstr.QueryExtended(s.ds, dsextensions.QueryExt{Query: q, SeekPrefix: seek})
+		if err != nil {
+			return fmt.Errorf("executing query: %s", err)
+		}
+		streams = append(streams, is)
+		is.next()
+	}
+
+	// Merge the streams of every address.
+	for {
+		var best *indexStream
+		for _, is := range streams {
+			if is.err != nil {
+				return fmt.Errorf("iter next: %s", is.err)
+			}
+			if is.done {
+				continue
+			}
+			if best == nil || (c.Ascending && is.cursor < best.cursor) || (!c.Ascending && is.cursor > best.cursor) {
+				best = is
+			}
+		}
+		if best == nil {
+			return nil
+		}
+		cont, err := fn(best.cursor, best.value)
+		if err != nil {
+			return err
+		}
+		if !cont {
+			return nil
+		}
+		best.next()
+	}
+}
+
+func (s *store) indexAddrs(base datastore.Key) ([]string, error) {
+	res, err := s.ds.Query(query.Query{Prefix: dsBaseIndexAddrs.Child(base).String(), KeysOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("executing query: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var addrs []string
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		addrs = append(addrs, datastore.RawKey(r.Key).Name())
+	}
+	return addrs, nil
+}
+
+// recordsFilter matches records with the filters of a DealRecordsConfig
+// which aren't resolved by the index.
+type recordsFilter struct {
+	c         deals.DealRecordsConfig
+	dataCids  map[string]struct{}
+	pieceCids map[string]struct{}
+	miners    map[string]struct{}
+	stateIDs  map[uint64]struct{}
+}
+
+func newRecordsFilter(c deals.DealRecordsConfig) recordsFilter {
+	f := recordsFilter{
+		c:         c,
+		dataCids:  make(map[string]struct{}, len(c.DataCids)),
+		pieceCids: make(map[string]struct{}, len(c.PieceCids)),
+		miners:    make(map[string]struct{}, len(c.Miners)),
+		stateIDs:  make(map[uint64]struct{}, len(c.StateIDs)),
+	}
+	for _, c := range c.DataCids {
+		f.dataCids[c] = struct{}{}
+	}
+	for _, c := range c.PieceCids {
+		f.pieceCids[c] = struct{}{}
+	}
+	for _, m := range c.Miners {
+		f.miners[m] = struct{}{}
+	}
+	for _, id := range c.StateIDs {
+		f.stateIDs[id] = struct{}{}
+	}
+	return f
+}
+
+func (f recordsFilter) matchStorage(dr deals.StorageDealRecord) bool {
+	if (dr.Pending && !f.c.IncludePending) || (!dr.Pending && !f.c.IncludeFinal) {
+		return false
+	}
+	if !inSet(f.dataCids, util.CidToString(dr.RootCid)) ||
+		!inSet(f.pieceCids, util.CidToString(dr.DealInfo.PieceCID)) ||
+		!inSet(f.miners, dr.DealInfo.Miner) {
+		return false
+	}
+	if _, ok := f.stateIDs[dr.DealInfo.StateID]; len(f.stateIDs) > 0 && !ok {
+		return false
+	}
+	return f.matchPrice(dr.DealInfo.PricePerEpoch)
+}
+
+func (f recordsFilter) matchRetrieval(rr deals.RetrievalDealRecord) bool {
+	if !inSet(f.dataCids, util.CidToString(rr.DealInfo.RootCid)) ||
+		!inSet(f.miners, rr.DealInfo.Miner) {
+		return false
+	}
+	return f.matchPrice(rr.DealInfo.MinPrice)
+}
+
+func (f recordsFilter) matchPrice(price uint64) bool {
+	return (f.c.MinPrice == 0 || price >= f.c.MinPrice) && (f.c.MaxPrice == 0 || price <= f.c.MaxPrice)
+}
+
+// inSet returns true if set is empty or contains v.
+func inSet(set map[string]struct{}, v string) bool {
+	if len(set) == 0 {
+		return true
+	}
+	_, ok := set[v]
+	return ok
+}
+
+func validateCursor(cursor string) error {
+	parts := strings.SplitN(cursor, "/", 2)
+	if len(parts) != 2 || len(parts[0]) != len(timeKey(0)) || parts[1] == "" {
+		return fmt.Errorf("invalid cursor %q", cursor)
+	}
+	if _, err := strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return nil
+}
+
+func timeKey(t int64) string {
+	return fmt.Sprintf("%019d", t)
+}
+
+func makeIndexKey(base datastore.Key, addr string, t int64, id string) datastore.Key {
+	return base.ChildString(addr).ChildString(timeKey(t)).ChildString(id)
+}
+
+func makeSummaryKey(base datastore.Key, addr, miner string) datastore.Key {
+	if miner == "" {
+		miner = "-"
+	}
+	return dsBaseSummaries.Child(base).ChildString(addr).ChildString(miner)
+}
+
+func makeIndexAddrKey(base datastore.Key, addr string) datastore.Key {
+	return dsBaseIndexAddrs.Child(base).ChildString(addr)
+}
@@ -18,17 +18,25 @@ var (
 	dsBaseStoragePending = datastore.NewKey("storage-pending")
 	dsBaseStorageFinal   = datastore.NewKey("storage-final")
 	dsBaseRetrieval      = datastore.NewKey("retrieval")
+	dsBaseDealEndpoint   = datastore.NewKey("deal-endpoint")
+	dsBaseStorageIndex   = datastore.NewKey("storage-index")
+	dsBaseRetrievalIndex = datastore.NewKey("retrieval-index")
+	dsBaseIndexAddrs     = datastore.NewKey("index-addrs")
+	dsBaseSummaries      = datastore.NewKey("summaries")
 
 	// ErrNotFound indicates the instance doesn't exist.
 	ErrNotFound = errors.New("cid info not found")
+	// ErrSummaryFilters indicates a summary of deal records was requested
+	// with filters which aren't summarized.
+	ErrSummaryFilters = errors.New("summaries can only be filtered by addresses, miners and pending or final records")
 )
 
 type store struct {
-	ds   datastore.Datastore
+	ds   datastore.TxnDatastore
 	lock sync.Mutex
 }
 
-func newStore(ds datastore.Datastore) *store {
+func newStore(ds datastore.TxnDatastore) *store {
 	return &store{
 		ds: ds,
 	}
@@ -37,23 +45,26 @@ func newStore(ds datastore.Datastore) *store {
 func (s *store) putPendingDeal(dr deals.StorageDealRecord) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	buf, err := json.Marshal(dr)
-	if err != nil {
-		return fmt.Errorf("marshaling PendingDeal: %s", err)
-	}
-	if err := s.ds.Put(makePendingDealKey(dr.DealInfo.ProposalCid), buf); err != nil {
-		return fmt.Errorf("put PendingDeal: %s", err)
-	}
-	return nil
+	return s.update(func(rt *recordsTxn) error {
+		if err := rt.deleteStorageDeal(dsBaseStoragePending, dr.DealInfo.ProposalCid); err != nil {
+			return fmt.Errorf("replacing PendingDeal: %s", err)
+		}
+		if err := rt.putStorageDeal(dsBaseStoragePending, dr); err != nil {
+			return fmt.Errorf("put PendingDeal: %s", err)
+		}
+		return nil
+	})
 }
 
 func (s *store) deletePendingDeal(proposalCid cid.Cid) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	if err := s.ds.Delete(makePendingDealKey(proposalCid)); err != nil {
-		return fmt.Errorf("delete PendingDeal: %s", err)
-	}
-	return nil
+	return s.update(func(rt *recordsTxn) error {
+		if err := rt.deleteStorageDeal(dsBaseStoragePending, proposalCid); err != nil {
+			return fmt.Errorf("delete PendingDeal: %s", err)
+		}
+		return nil
+	})
 }
 
 func (s *store) getPendingDeals() ([]deals.StorageDealRecord, error) {
@@ -87,18 +98,19 @@ func (s *store) getPendingDeals() ([]deals.StorageDealRecord, error) {
 func (s *store) putFinalDeal(dr deals.StorageDealRecord) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	err := s.ds.Delete(makePendingDealKey(dr.DealInfo.ProposalCid))
-	if err != nil {
-		return fmt.Errorf("deleting PendingDeal: %s", err)
-	}
-	buf, err := json.Marshal(dr)
-	if err != nil {
-		return fmt.Errorf("marshaling DealRecord: %s", err)
-	}
-	if err := s.ds.Put(makeFinalDealKey(dr.DealInfo.ProposalCid), buf); err != nil {
-		return fmt.Errorf("put DealRecord: %s", err)
-	}
-	return nil
+	return s.update(func(rt *recordsTxn) error {
+		if err := rt.deleteStorageDeal(dsBaseStoragePending, dr.DealInfo.ProposalCid); err != nil {
+			return fmt.Errorf("deleting PendingDeal: %s", err)
+		}
+		// The deal might have been finalized before.
+		if err := rt.deleteStorageDeal(dsBaseStorageFinal, dr.DealInfo.ProposalCid); err != nil {
+			return fmt.Errorf("replacing DealRecord: %s", err)
+		}
+		if err := rt.putStorageDeal(dsBaseStorageFinal, dr); err != nil {
+			return fmt.Errorf("put DealRecord: %s", err)
+		}
+		return nil
+	})
 }
 
 func (s *store) getFinalDeals() ([]deals.StorageDealRecord, error) {
@@ -132,20 +144,53 @@ func (s *store) getFinalDeals() ([]deals.StorageDealRecord, error) {
 func (s *store) putRetrieval(rr deals.RetrievalDealRecord) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	buf, err := json.Marshal(rr)
+	return s.update(func(rt *recordsTxn) error {
+		if err := rt.putRetrieval(rr); err != nil {
+			return fmt.Errorf("put RetrievalRecord: %s", err)
+		}
+		return nil
+	})
+}
+
+func (s *store) getRetrievals() ([]deals.RetrievalDealRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	q := query.Query{Prefix: dsBaseRetrieval.String()}
+	res, err := s.ds.Query(q)
 	if err != nil {
-		return fmt.Errorf("marshaling RetrievalRecord: %s", err)
+		return nil, fmt.Errorf("executing query: %s", err)
 	}
-	if err := s.ds.Put(makeRetrievalKey(rr), buf); err != nil {
-		return fmt.Errorf("put RetrievalRecord: %s", err)
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+
+	var ret []deals.RetrievalDealRecord
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var rr deals.RetrievalDealRecord
+		if err := json.Unmarshal(r.Value, &rr); err != nil {
+			return nil, fmt.Errorf("unmarshaling query result: %s", err)
+		}
+		ret = append(ret, rr)
 	}
-	return nil
+	return ret, nil
 }
 
-func (s *store) getRetrievals() ([]deals.RetrievalDealRecord, error) {
+// getRetrievalsPage returns at most limit retrievals ordered by key,
+// skipping the first offset ones.
+func (s *store) getRetrievalsPage(offset, limit int) ([]deals.RetrievalDealRecord, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	q := query.Query{Prefix: dsBaseRetrieval.String()}
+	q := query.Query{
+		Prefix: dsBaseRetrieval.String(),
+		Orders: []query.Order{query.OrderByKey{}},
+		Offset: offset,
+		Limit:  limit,
+	}
 	res, err := s.ds.Query(q)
 	if err != nil {
 		return nil, fmt.Errorf("executing query: %s", err)
@@ -170,16 +215,45 @@ func (s *store) getRetrievals() ([]deals.RetrievalDealRecord, error) {
 	return ret, nil
 }
 
-func makePendingDealKey(c cid.Cid) datastore.Key {
-	return dsBaseStoragePending.ChildString(util.CidToString(c))
+// putDealEndpoint saves the Lotus endpoint where a deal was proposed.
+func (s *store) putDealEndpoint(proposalCid cid.Cid, endpoint string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.ds.Put(makeDealEndpointKey(proposalCid), []byte(endpoint)); err != nil {
+		return fmt.Errorf("put deal endpoint: %s", err)
+	}
+	return nil
 }
 
-func makeFinalDealKey(c cid.Cid) datastore.Key {
-	return dsBaseStorageFinal.ChildString(util.CidToString(c))
+// getDealEndpoint returns the Lotus endpoint where a deal was proposed,
+// or an empty string if it's unknown.
+func (s *store) getDealEndpoint(proposalCid cid.Cid) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	buf, err := s.ds.Get(makeDealEndpointKey(proposalCid))
+	if err == datastore.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get deal endpoint: %s", err)
+	}
+	return string(buf), nil
+}
+
+func makeDealEndpointKey(c cid.Cid) datastore.Key {
+	return dsBaseDealEndpoint.ChildString(util.CidToString(c))
+}
+
+func makeStorageDealKey(base datastore.Key, c cid.Cid) datastore.Key {
+	return base.ChildString(util.CidToString(c))
 }
 
 func makeRetrievalKey(rr deals.RetrievalDealRecord) datastore.Key {
+	return dsBaseRetrieval.ChildString(retrievalID(rr))
+}
+
+func retrievalID(rr deals.RetrievalDealRecord) string {
 	str := fmt.Sprintf("%v%v%v%v", rr.Time, rr.Addr, rr.DealInfo.Miner, util.CidToString(rr.DealInfo.RootCid))
 	sum := md5.Sum([]byte(str))
-	return dsBaseRetrieval.ChildString(fmt.Sprintf("%x", sum[:]))
+	return fmt.Sprintf("%x", sum[:])
 }
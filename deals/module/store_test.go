@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/deals"
 	"github.com/textileio/powergate/tests"
@@ -160,3 +162,227 @@ func TestGetRetrievalDeals(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, res, 3)
 }
+
+func TestGetRetrievalsPage(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		rr := deals.RetrievalDealRecord{Time: int64(i), Addr: "from", DealInfo: deals.RetrievalDealInfo{RootCid: c1, Miner: "miner"}}
+		require.NoError(t, s.putRetrieval(rr))
+	}
+
+	seen := map[int64]struct{}{}
+	for i, l := range []int{2, 2, 1} {
+		page, err := s.getRetrievalsPage(i*2, 2)
+		require.NoError(t, err)
+		require.Len(t, page, l)
+		for _, rr := range page {
+			seen[rr.Time] = struct{}{}
+		}
+	}
+	require.Len(t, seen, 5)
+}
+
+func TestDealEndpoint(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+
+	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	require.NoError(t, err)
+	ep, err := s.getDealEndpoint(c1)
+	require.NoError(t, err)
+	require.Empty(t, ep)
+
+	err = s.putDealEndpoint(c1, "/ip4/127.0.0.1/tcp/1234")
+	require.NoError(t, err)
+	ep, err = s.getDealEndpoint(c1)
+	require.NoError(t, err)
+	require.Equal(t, "/ip4/127.0.0.1/tcp/1234", ep)
+}
+
+func TestQueryStorageDeals(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+
+	pc1 := makeCid(t, "piece1")
+	pc2 := makeCid(t, "piece2")
+	records := []deals.StorageDealRecord{
+		{Addr: "a", Time: 10, DealInfo: deals.StorageDealInfo{ProposalCid: makeCid(t, "p1"), PieceCID: pc1, Miner: "f01", PricePerEpoch: 10, Duration: 100, Size: 1000, StateID: 7}},
+		{Addr: "b", Time: 20, DealInfo: deals.StorageDealInfo{ProposalCid: makeCid(t, "p2"), PieceCID: pc2, Miner: "f02", PricePerEpoch: 20, Duration: 100, Size: 2000, StateID: 7}},
+		{Addr: "a", Time: 30, DealInfo: deals.StorageDealInfo{ProposalCid: makeCid(t, "p3"), PieceCID: pc1, Miner: "f02", PricePerEpoch: 30, Duration: 100, Size: 3000, StateID: 26}},
+		{Addr: "b", Time: 40, DealInfo: deals.StorageDealInfo{ProposalCid: makeCid(t, "p4"), PieceCID: pc2, Miner: "f01", PricePerEpoch: 40, Duration: 100, Size: 4000, StateID: 7}},
+	}
+	for _, dr := range records {
+		require.NoError(t, s.putFinalDeal(dr))
+	}
+	pending := deals.StorageDealRecord{Addr: "c", Time: 50, Pending: true, DealInfo: deals.StorageDealInfo{ProposalCid: makeCid(t, "p5"), PieceCID: pc1, Miner: "f01"}}
+	require.NoError(t, s.putPendingDeal(pending))
+
+	t.Run("Pagination", func(t *testing.T) {
+		c := deals.DealRecordsConfig{IncludeFinal: true, Limit: 3}
+		page, err := s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 40, 30, 20)
+		require.NotEmpty(t, page.NextCursor)
+
+		c.Cursor = page.NextCursor
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 10)
+		require.Empty(t, page.NextCursor)
+
+		c = deals.DealRecordsConfig{IncludeFinal: true, IncludePending: true, Ascending: true, Limit: 2}
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 10, 20)
+		c.Cursor = page.NextCursor
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 30, 40)
+		c.Cursor = page.NextCursor
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 50)
+		require.Empty(t, page.NextCursor)
+
+		// Cursors continue within time ranges in both orders.
+		c = deals.DealRecordsConfig{IncludeFinal: true, FromTime: 20, ToTime: 50, Limit: 2}
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 40, 30)
+		c.Cursor = page.NextCursor
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 20)
+		c = deals.DealRecordsConfig{IncludeFinal: true, FromTime: 20, ToTime: 40, Ascending: true, Limit: 1}
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 20)
+		c.Cursor = page.NextCursor
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 30)
+		require.Empty(t, page.NextCursor)
+
+		_, err = s.queryStorageDeals(deals.DealRecordsConfig{IncludeFinal: true, Cursor: "invalid"})
+		require.Error(t, err)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			c     deals.DealRecordsConfig
+			times []int64
+		}{
+			{"Addrs", deals.DealRecordsConfig{FromAddrs: []string{"a"}}, []int64{30, 10}},
+			{"Miners", deals.DealRecordsConfig{Miners: []string{"f01"}}, []int64{40, 10}},
+			{"PieceCids", deals.DealRecordsConfig{PieceCids: []string{pc2.String()}}, []int64{40, 20}},
+			{"StateIDs", deals.DealRecordsConfig{StateIDs: []uint64{26}}, []int64{30}},
+			{"TimeRange", deals.DealRecordsConfig{FromTime: 20, ToTime: 40}, []int64{30, 20}},
+			{"PriceRange", deals.DealRecordsConfig{MinPrice: 15, MaxPrice: 30}, []int64{30, 20}},
+			{"Combined", deals.DealRecordsConfig{FromAddrs: []string{"b"}, Miners: []string{"f01"}, FromTime: 30}, []int64{40}},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				tc.c.IncludeFinal = true
+				page, err := s.queryStorageDeals(tc.c)
+				require.NoError(t, err)
+				requireStorageTimes(t, page.Records, tc.times...)
+			})
+		}
+	})
+
+	t.Run("Summary", func(t *testing.T) {
+		c := deals.DealRecordsConfig{IncludeFinal: true, Miners: []string{"f02"}, Limit: 1, IncludeSummary: true}
+		page, err := s.queryStorageDeals(c)
+		require.NoError(t, err)
+		require.Len(t, page.Records, 1)
+		require.NotNil(t, page.Summary)
+		require.Equal(t, uint64(2), page.Summary.Count)
+		require.Equal(t, "5000", page.Summary.TotalAttoFil.String())
+		require.Equal(t, uint64(5000), page.Summary.TotalBytes)
+
+		c = deals.DealRecordsConfig{IncludePending: true, IncludeFinal: true, FromAddrs: []string{"a", "c"}, IncludeSummary: true}
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), page.Summary.Count)
+		require.Equal(t, "4000", page.Summary.TotalAttoFil.String())
+
+		// Filters which aren't summarized can't be combined with a
+		// summary.
+		c = deals.DealRecordsConfig{IncludeFinal: true, FromTime: 20, IncludeSummary: true}
+		_, err = s.queryStorageDeals(c)
+		require.Error(t, err)
+	})
+
+	t.Run("Finalized", func(t *testing.T) {
+		final := pending
+		final.Pending = false
+		final.Time = 60
+		require.NoError(t, s.putFinalDeal(final))
+		page, err := s.queryStorageDeals(deals.DealRecordsConfig{IncludeFinal: true, IncludePending: true, FromAddrs: []string{"c"}})
+		require.NoError(t, err)
+		requireStorageTimes(t, page.Records, 60)
+		require.False(t, page.Records[0].Pending)
+
+		// Summaries follow the record from pending to final.
+		c := deals.DealRecordsConfig{IncludePending: true, FromAddrs: []string{"c"}, IncludeSummary: true}
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		require.Zero(t, page.Summary.Count)
+		c = deals.DealRecordsConfig{IncludeFinal: true, FromAddrs: []string{"c"}, IncludeSummary: true}
+		page, err = s.queryStorageDeals(c)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), page.Summary.Count)
+	})
+}
+
+func TestQueryRetrievals(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+
+	root := makeCid(t, "root")
+	for i, addr := range []string{"a", "b", "a", "b"} {
+		rr := deals.RetrievalDealRecord{Addr: addr, Time: int64(i + 1), FundsSpent: uint64(i + 2), DealInfo: deals.RetrievalDealInfo{RootCid: root, Miner: "f01", MinPrice: uint64(i + 1), Size: 100}}
+		require.NoError(t, s.putRetrieval(rr))
+	}
+
+	c := deals.DealRecordsConfig{FromAddrs: []string{"b"}, Limit: 1, IncludeSummary: true}
+	page, err := s.queryRetrievals(c)
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, int64(4), page.Records[0].Time)
+	require.Equal(t, uint64(2), page.Summary.Count)
+	require.Equal(t, "8", page.Summary.TotalAttoFil.String())
+	require.Equal(t, uint64(200), page.Summary.TotalBytes)
+
+	c.Cursor = page.NextCursor
+	page, err = s.queryRetrievals(c)
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, int64(2), page.Records[0].Time)
+	require.Empty(t, page.NextCursor)
+
+	page, err = s.queryRetrievals(deals.DealRecordsConfig{Ascending: true, MaxPrice: 3})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 3)
+	for i, rr := range page.Records {
+		require.Equal(t, int64(i+1), rr.Time)
+	}
+}
+
+func requireStorageTimes(t *testing.T, records []deals.StorageDealRecord, times ...int64) {
+	t.Helper()
+	got := make([]int64, len(records))
+	for i, dr := range records {
+		got[i] = dr.Time
+	}
+	if len(times) == 0 {
+		times = []int64{}
+	}
+	require.Equal(t, times, got)
+}
+
+func makeCid(t *testing.T, data string) cid.Cid {
+	c, err := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum([]byte(data))
+	require.NoError(t, err)
+	return c
+}
@@ -1,6 +1,9 @@
 package deals
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 // Config contains configuration for storing deals.
 type Config struct {
@@ -29,6 +32,16 @@ type DealRecordsConfig struct {
 	IncludePending bool
 	IncludeFinal   bool
 	Ascending      bool
+	Miners         []string
+	PieceCids      []string
+	StateIDs       []uint64
+	FromTime       int64
+	ToTime         int64
+	MinPrice       uint64
+	MaxPrice       uint64
+	Limit          int
+	Cursor         string
+	IncludeSummary bool
 }
 
 // DealRecordsOption updates a ListDealRecordsConfig.
@@ -73,3 +86,76 @@ func WithAscending(ascending bool) DealRecordsOption {
 		c.Ascending = ascending
 	}
 }
+
+// WithMiners limits the results to deals with the provided miners.
+func WithMiners(miners ...string) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.Miners = miners
+	}
+}
+
+// WithPieceCids limits the results to deals for the provided piece cids.
+// Ignored for ListRetrievalDealRecords.
+func WithPieceCids(cids ...string) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.PieceCids = cids
+	}
+}
+
+// WithStateIDs limits the results to deals in the provided storage deal
+// states. Ignored for ListRetrievalDealRecords.
+func WithStateIDs(ids ...uint64) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.StateIDs = ids
+	}
+}
+
+// WithTimeRange limits the results to records with a timestamp in [from, to).
+// Zero values don't limit the range.
+func WithTimeRange(from, to time.Time) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.FromTime, c.ToTime = 0, 0
+		if !from.IsZero() {
+			c.FromTime = from.Unix()
+		}
+		if !to.IsZero() {
+			c.ToTime = to.Unix()
+		}
+	}
+}
+
+// WithPriceRange limits the results to deals with a price in [min, max].
+// The price is the price per epoch of storage deals, and the total price
+// of retrieval deals. Zero values don't limit the range.
+func WithPriceRange(min, max uint64) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.MinPrice = min
+		c.MaxPrice = max
+	}
+}
+
+// WithLimit limits the number of results. The cursor of the next page is
+// returned with the results. Default is no limit.
+func WithLimit(limit int) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.Limit = limit
+	}
+}
+
+// WithCursor specifies the cursor returned with the previous page of
+// results, to continue listing from there.
+func WithCursor(cursor string) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.Cursor = cursor
+	}
+}
+
+// WithIncludeSummary specifies whether or not to include a summary of all
+// the records matching the filters, ignoring the cursor and limit. Only
+// filters by addresses, miners and pending or final records can be
+// combined with a summary. Default is false.
+func WithIncludeSummary(includeSummary bool) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.IncludeSummary = includeSummary
+	}
+}
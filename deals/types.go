@@ -1,6 +1,9 @@
 package deals
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/ipfs/go-cid"
 )
 
@@ -46,6 +49,19 @@ type StorageDealRecord struct {
 	DealInfo StorageDealInfo
 	Time     int64
 	Pending  bool
+	// JobID is the id of the job which made the deal, if any.
+	JobID string
+}
+
+// StorageDealRecordsPage is a page of storage deal records.
+type StorageDealRecordsPage struct {
+	Records []StorageDealRecord
+	// NextCursor is the cursor of the next page, or empty if there
+	// are no more records.
+	NextCursor string
+	// Summary aggregates all the records matching the filters, if it
+	// was requested.
+	Summary *DealRecordsSummary
 }
 
 // RetrievalDealInfo contains information about a retrieval deal.
@@ -59,9 +75,70 @@ type RetrievalDealInfo struct {
 	MinerPeerID             string
 }
 
-// RetrievalDealRecord represents a retrieval deal log record.
+// RetrievalStatus is the outcome of a retrieval.
+type RetrievalStatus int
+
+const (
+	// RetrievalSucceeded indicates the data was retrieved. It's the
+	// zero value since records were only saved for successful
+	// retrievals before retrieval outcomes were recorded.
+	RetrievalSucceeded RetrievalStatus = iota
+	// RetrievalInProgress indicates the data is being retrieved.
+	RetrievalInProgress
+	// RetrievalFailed indicates the retrieval failed.
+	RetrievalFailed
+	// RetrievalCanceled indicates the retrieval was canceled.
+	RetrievalCanceled
+)
+
+// RetrievalStatusStr maps RetrievalStatus to describing string.
+var RetrievalStatusStr = map[RetrievalStatus]string{
+	RetrievalSucceeded:  "Succeeded",
+	RetrievalInProgress: "InProgress",
+	RetrievalFailed:     "Failed",
+	RetrievalCanceled:   "Canceled",
+}
+
+// RetrievalDealRecord represents a retrieval deal log record. Time is
+// the time when the retrieval started.
 type RetrievalDealRecord struct {
 	Addr     string
 	DealInfo RetrievalDealInfo
 	Time     int64
+	// JobID is the id of the job which made the retrieval, if any.
+	JobID string
+	// RetrievalID is the id of the FFS retrieval which made the
+	// retrieval, if any.
+	RetrievalID string
+
+	Status RetrievalStatus
+	// Error is the cause of a failed retrieval.
+	Error         string
+	BytesReceived uint64
+	// FundsSpent is the attoFIL paid to the miner.
+	FundsSpent uint64
+	Elapsed    time.Duration
+}
+
+// RetrievalDealRecordsPage is a page of retrieval deal records.
+type RetrievalDealRecordsPage struct {
+	Records []RetrievalDealRecord
+	// NextCursor is the cursor of the next page, or empty if there
+	// are no more records.
+	NextCursor string
+	// Summary aggregates all the records matching the filters, if it
+	// was requested.
+	Summary *DealRecordsSummary
+}
+
+// DealRecordsSummary aggregates deal records.
+type DealRecordsSummary struct {
+	// Count is the number of records.
+	Count uint64
+	// TotalAttoFil is the total price of the deals. The price of a
+	// storage deal is its price per epoch times its duration, and the
+	// price of a retrieval deal are the funds spent in it.
+	TotalAttoFil *big.Int
+	// TotalBytes is the total size of the deals data.
+	TotalBytes uint64
 }
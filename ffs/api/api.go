@@ -42,11 +42,13 @@ type API struct {
 
 	sched *scheduler.Scheduler
 
-	lock   sync.Mutex
-	closed bool
-	cfg    InstanceConfig
-	ctx    context.Context
-	cancel context.CancelFunc
+	lock          sync.Mutex
+	sendLock      sync.Mutex
+	closed        bool
+	cfg           InstanceConfig
+	reservedNames map[string]struct{}
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 // New returns a new Api instance.
@@ -86,13 +88,14 @@ func Load(ds datastore.Datastore, iid ffs.APIID, sched *scheduler.Scheduler, wm
 
 func new(ctx context.Context, is *instanceStore, wm ffs.WalletManager, drm ffs.DealRecordsManager, config InstanceConfig, sch *scheduler.Scheduler, cancel context.CancelFunc) *API {
 	i := &API{
-		is:     is,
-		wm:     wm,
-		drm:    drm,
-		cfg:    config,
-		sched:  sch,
-		cancel: cancel,
-		ctx:    ctx,
+		is:            is,
+		wm:            wm,
+		drm:           drm,
+		cfg:           config,
+		reservedNames: make(map[string]struct{}),
+		sched:         sch,
+		cancel:        cancel,
+		ctx:           ctx,
 	}
 	return i
 }
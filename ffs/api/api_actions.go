@@ -7,6 +7,7 @@ import (
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/dagcar"
 )
 
 // PushStorageConfig push a new configuration for the Cid in the Hot and
@@ -109,22 +110,67 @@ func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
 }
 
 // Get returns an io.Reader for reading a stored Cid from the Hot Storage.
+// Directories are read as tar archives.
 func (i *API) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
+	r, _, err := i.GetPath(ctx, c, "")
+	return r, err
+}
+
+// GetPath returns an io.ReadCloser for reading a path within the DAG of a
+// stored Cid from the Hot Storage, and true if the path is a directory,
+// which is read as a tar archive. The caller should close it when done.
+func (i *API) GetPath(ctx context.Context, c cid.Cid, path string) (io.ReadCloser, bool, error) {
 	if !c.Defined() {
-		return nil, fmt.Errorf("cid is undefined")
+		return nil, false, fmt.Errorf("cid is undefined")
 	}
 	cfgs, err := i.is.getStorageConfigs(c)
 	if err != nil {
-		return nil, fmt.Errorf("getting cid config: %s", err)
+		return nil, false, fmt.Errorf("getting cid config: %s", err)
 	}
 	if !cfgs[c].Hot.Enabled {
-		return nil, ErrHotStorageDisabled
+		return nil, false, ErrHotStorageDisabled
 	}
-	r, err := i.sched.GetCidFromHot(ctx, c)
+	r, isDir, err := i.sched.GetCidFromHot(ctx, c, path)
 	if err != nil {
-		return nil, fmt.Errorf("getting from hot layer %s: %s", c, err)
+		return nil, false, fmt.Errorf("getting from hot layer %s: %s", c, err)
 	}
-	return r, nil
+	return r, isDir, nil
+}
+
+// GetCAR writes a stored Cid DAG as a CAR file to w. By default, the
+// complete DAG is read from the Hot Storage as a CARv1 file.
+func (i *API) GetCAR(ctx context.Context, c cid.Cid, w io.Writer, opts ...GetCAROption) error {
+	if !c.Defined() {
+		return fmt.Errorf("cid is undefined")
+	}
+	conf := getCARConfig{version: dagcar.V1}
+	for _, o := range opts {
+		o(&conf)
+	}
+	if conf.version != dagcar.V1 && conf.version != dagcar.V2 {
+		return fmt.Errorf("unsupported car version %d", conf.version)
+	}
+	cfgs, err := i.is.getStorageConfigs(c)
+	if err != nil {
+		return fmt.Errorf("getting cid config: %s", err)
+	}
+	if !conf.fromCold {
+		if !cfgs[c].Hot.Enabled {
+			return ErrHotStorageDisabled
+		}
+		if err := i.sched.GetCARFromHot(ctx, c, conf.selector, conf.version, w); err != nil {
+			return fmt.Errorf("getting car from hot layer %s: %s", c, err)
+		}
+		return nil
+	}
+	waddr := cfgs[c].Cold.Filecoin.Addr
+	if waddr == "" {
+		waddr = i.cfg.DefaultStorageConfig.Cold.Filecoin.Addr
+	}
+	if _, err := i.sched.GetCARFromCold(ctx, c, waddr, conf.selector, conf.version, w); err != nil {
+		return fmt.Errorf("getting car from cold layer %s: %s", c, err)
+	}
+	return nil
 }
 
 // CancelJob cancels an executing Job. If no Job is executing
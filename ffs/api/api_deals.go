@@ -7,7 +7,7 @@ import (
 )
 
 // StorageDealRecords lists storage deals for this FFS instance according to the provided options.
-func (i *API) StorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error) {
+func (i *API) StorageDealRecords(opts ...deals.DealRecordsOption) (deals.StorageDealRecordsPage, error) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
@@ -17,23 +17,17 @@ func (i *API) StorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.Stora
 	}
 	finalAddrs, err := i.finalAddresses(c.FromAddrs)
 	if err != nil {
-		return nil, fmt.Errorf("getting final addrs: %v", err)
+		return deals.StorageDealRecordsPage{}, fmt.Errorf("getting final addrs: %v", err)
 	}
-	recs, err := i.drm.ListStorageDealRecords(
-		deals.WithFromAddrs(finalAddrs...),
-		deals.WithAscending(c.Ascending),
-		deals.WithDataCids(c.DataCids...),
-		deals.WithIncludeFinal(c.IncludeFinal),
-		deals.WithIncludePending(c.IncludePending),
-	)
+	page, err := i.drm.ListStorageDealRecords(append(opts, deals.WithFromAddrs(finalAddrs...))...)
 	if err != nil {
-		return nil, fmt.Errorf("calling ListStorageDealRecords: %v", err)
+		return deals.StorageDealRecordsPage{}, fmt.Errorf("calling ListStorageDealRecords: %v", err)
 	}
-	return recs, nil
+	return page, nil
 }
 
 // RetrievalDealRecords returns a list of retrieval deals for this FFS instance according to the provided options.
-func (i *API) RetrievalDealRecords(opts ...deals.DealRecordsOption) ([]deals.RetrievalDealRecord, error) {
+func (i *API) RetrievalDealRecords(opts ...deals.DealRecordsOption) (deals.RetrievalDealRecordsPage, error) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
@@ -43,17 +37,13 @@ func (i *API) RetrievalDealRecords(opts ...deals.DealRecordsOption) ([]deals.Ret
 	}
 	finalAddrs, err := i.finalAddresses(c.FromAddrs)
 	if err != nil {
-		return nil, fmt.Errorf("getting final addrs: %v", err)
+		return deals.RetrievalDealRecordsPage{}, fmt.Errorf("getting final addrs: %v", err)
 	}
-	recs, err := i.drm.ListRetrievalDealRecords(
-		deals.WithFromAddrs(finalAddrs...),
-		deals.WithAscending(c.Ascending),
-		deals.WithDataCids(c.DataCids...),
-	)
+	page, err := i.drm.ListRetrievalDealRecords(append(opts, deals.WithFromAddrs(finalAddrs...))...)
 	if err != nil {
-		return nil, fmt.Errorf("calling dm.ListRetrievalDealRecords: %v", err)
+		return deals.RetrievalDealRecordsPage{}, fmt.Errorf("calling dm.ListRetrievalDealRecords: %v", err)
 	}
-	return recs, nil
+	return page, nil
 }
 
 func (i *API) finalAddresses(fromAddrs []string) ([]string, error) {
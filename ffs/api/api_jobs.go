@@ -21,6 +21,18 @@ func (i *API) GetStorageJob(jid ffs.JobID) (ffs.StorageJob, error) {
 	return job, nil
 }
 
+// GetRetrievalJob returns the current state of the specified retrieval job.
+func (i *API) GetRetrievalJob(jid ffs.JobID) (ffs.RetrievalJob, error) {
+	job, err := i.sched.RetrievalJob(jid)
+	if err == scheduler.ErrNotFound {
+		return ffs.RetrievalJob{}, fmt.Errorf("job id %s not found", jid)
+	}
+	if err != nil {
+		return ffs.RetrievalJob{}, fmt.Errorf("getting current job state: %s", err)
+	}
+	return job, nil
+}
+
 // WatchJobs subscribes to Job status changes. If jids is empty, it subscribes to
 // all Job status changes corresonding to the instance. If jids is not empty,
 // it immediately sends current state of those Jobs. If empty, it doesn't.
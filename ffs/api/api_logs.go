@@ -8,45 +8,108 @@ import (
 	"github.com/textileio/powergate/ffs"
 )
 
+// Logs returns history messages about Cid executions matching the
+// filters of opts. WithHistory is ignored.
+func (i *API) Logs(ctx context.Context, c cid.Cid, opts ...GetLogsOption) ([]ffs.LogEntry, error) {
+	if err := i.validateLogsCid(c); err != nil {
+		return nil, err
+	}
+	return i.queryLogs(ctx, newGetLogsConfig(opts).query(c, ffs.EmptyRetrievalID))
+}
+
 // WatchLogs pushes human-friendly messages about Cid executions. The method is blocking
 // and will continue to send messages until the context is canceled.
 func (i *API) WatchLogs(ctx context.Context, ch chan<- ffs.LogEntry, c cid.Cid, opts ...GetLogsOption) error {
-	_, err := i.is.getStorageConfigs(c)
-	if err == ErrNotFound {
-		return ErrNotFound
+	if err := i.validateLogsCid(c); err != nil {
+		return err
 	}
-	if err != nil {
-		return fmt.Errorf("validating cid: %s", err)
+	config := newGetLogsConfig(opts)
+	return i.watchLogs(ctx, ch, config.query(c, ffs.EmptyRetrievalID), config.history)
+}
+
+// RetrievalLogs returns history messages about the execution of a
+// retrieval matching the filters of opts. WithHistory is ignored.
+func (i *API) RetrievalLogs(ctx context.Context, rid ffs.RetrievalID, opts ...GetLogsOption) ([]ffs.LogEntry, error) {
+	if err := i.validateLogsRetrieval(rid); err != nil {
+		return nil, err
 	}
+	return i.queryLogs(ctx, newGetLogsConfig(opts).query(cid.Undef, rid))
+}
 
-	config := &GetLogsConfig{}
-	for _, o := range opts {
-		o(config)
+// WatchRetrievalLogs pushes human-friendly messages about the execution
+// of a retrieval. The method is blocking and will continue to send
+// messages until the context is canceled.
+func (i *API) WatchRetrievalLogs(ctx context.Context, ch chan<- ffs.LogEntry, rid ffs.RetrievalID, opts ...GetLogsOption) error {
+	if err := i.validateLogsRetrieval(rid); err != nil {
+		return err
+	}
+	config := newGetLogsConfig(opts)
+	return i.watchLogs(ctx, ch, config.query(cid.Undef, rid), config.history)
+}
+
+func (i *API) queryLogs(ctx context.Context, q ffs.LogQuery) ([]ffs.LogEntry, error) {
+	lgs, err := i.sched.QueryLogs(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("getting history logs: %s", err)
 	}
+	return lgs, nil
+}
 
-	if config.history {
-		lgs, err := i.sched.GetLogsByCid(ctx, c)
+func (i *API) watchLogs(ctx context.Context, ch chan<- ffs.LogEntry, q ffs.LogQuery, history bool) error {
+	if history {
+		lgs, err := i.queryLogs(ctx, q)
 		if err != nil {
-			return fmt.Errorf("getting history logs of %s: %s", c, err)
+			return err
 		}
 		for _, l := range lgs {
 			ch <- l
 		}
 	}
 
+	var err error
 	ichan := make(chan ffs.LogEntry, cap(ch))
 	go func() {
 		err = i.sched.WatchLogs(ctx, ichan)
 		close(ichan)
 	}()
 	for le := range ichan {
-		if c == le.Cid && (config.jid == ffs.EmptyJobID || config.jid == le.Jid) {
+		if q.Matches(le) {
 			ch <- le
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("listening to cid logs: %s", err)
+		return fmt.Errorf("listening to logs: %s", err)
 	}
 
 	return nil
 }
+
+func (i *API) validateLogsCid(c cid.Cid) error {
+	_, err := i.is.getStorageConfigs(c)
+	if err == ErrNotFound {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("validating cid: %s", err)
+	}
+	return nil
+}
+
+func (i *API) validateLogsRetrieval(rid ffs.RetrievalID) error {
+	_, err := i.is.getRetrievalRequest(rid)
+	if err == ErrNotFound {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("validating retrieval: %s", err)
+	}
+	return nil
+}
+
+func newGetLogsConfig(opts []GetLogsOption) GetLogsConfig {
+	config := GetLogsConfig{}
+	for _, o := range opts {
+		o(&config)
+	}
+	return config
+}
@@ -4,6 +4,12 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/wallet"
 )
 
 // Addrs returns the wallet addresses.
@@ -30,15 +36,7 @@ func (i *API) NewAddr(ctx context.Context, name string, options ...NewAddressOpt
 		option(conf)
 	}
 
-	exists := false
-	for _, addr := range i.cfg.Addrs {
-		if addr.Name == name {
-			exists = true
-			break
-		}
-	}
-
-	if exists {
+	if i.nameTaken(name) {
 		return "", fmt.Errorf("address with name %s already exists", name)
 	}
 
@@ -60,6 +58,229 @@ func (i *API) NewAddr(ctx context.Context, name string, options ...NewAddressOpt
 	return addr, nil
 }
 
+// ExportKeys exports the private keys of managed addresses encrypted with passphrase.
+// Multisig addresses don't have keys, so they aren't included.
+func (i *API) ExportKeys(ctx context.Context, passphrase string) ([]ExportedKey, error) {
+	addrs := i.Addrs()
+	var res []ExportedKey
+	for _, ai := range addrs {
+		if ai.Type == AddrTypeMultisig {
+			continue
+		}
+		key, err := i.wm.ExportKey(ctx, ai.Addr, passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("exporting key of %s: %s", ai.Addr, err)
+		}
+		res = append(res, ExportedKey{Name: ai.Name, Addr: ai.Addr, Type: ai.Type, Key: key})
+	}
+	sort.Slice(res, func(a, b int) bool { return res[a].Name < res[b].Name })
+	return res, nil
+}
+
+// ImportKey imports a key exported with ExportKeys into the wallet, and adds its
+// address to the managed addresses of the FFS instance. If the address is already
+// managed, as when restoring keys in a new wallet, only the key is imported.
+func (i *API) ImportKey(ctx context.Context, name string, key []byte, passphrase string, options ...NewAddressOption) (string, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	conf := &NewAddressConfig{}
+	for _, option := range options {
+		option(conf)
+	}
+
+	// The name is checked before importing the key, so it isn't left in
+	// the wallet if the address can't be added to the instance.
+	exported, err := wallet.ExportedKeyAddr(key)
+	if err != nil {
+		return "", fmt.Errorf("parsing key: %s", err)
+	}
+	if _, ok := i.cfg.Addrs[exported]; !ok && i.nameTaken(name) {
+		return "", fmt.Errorf("address with name %s already exists", name)
+	}
+
+	addr, err := i.wm.ImportKey(ctx, key, passphrase)
+	if err != nil {
+		return "", fmt.Errorf("importing key: %w", err)
+	}
+	if _, ok := i.cfg.Addrs[addr]; !ok {
+		a, err := address.NewFromString(addr)
+		if err != nil {
+			return "", fmt.Errorf("parsing imported address: %s", err)
+		}
+		typ := "secp256k1"
+		if a.Protocol() == address.BLS {
+			typ = "bls"
+		}
+		i.cfg.Addrs[addr] = AddrInfo{
+			Name: name,
+			Addr: addr,
+			Type: typ,
+		}
+	}
+	if conf.makeDefault {
+		i.cfg.DefaultStorageConfig.Cold.Filecoin.Addr = addr
+	}
+	if err := i.is.putInstanceConfig(i.cfg); err != nil {
+		return "", err
+	}
+	return addr, nil
+}
+
+// NewMultisigAddr creates a new multisig address managed by the FFS instance, with the
+// provided signers and approval threshold. At least one of the signers must be a managed
+// address, which creates the multisig funding it with amount, and proposes multisig
+// transactions. If the multisig is used as the address of a FilConfig, deals are made
+// by the creator address and funded from the multisig with escrow top-ups.
+func (i *API) NewMultisigAddr(ctx context.Context, name string, signers []string, threshold uint64, amount *big.Int, options ...NewAddressOption) (string, error) {
+	conf := &NewAddressConfig{}
+	for _, option := range options {
+		option(conf)
+	}
+
+	i.lock.Lock()
+	if i.nameTaken(name) {
+		i.lock.Unlock()
+		return "", fmt.Errorf("address with name %s already exists", name)
+	}
+	var creator string
+	for _, s := range signers {
+		if ai, ok := i.cfg.Addrs[s]; ok && ai.Type != AddrTypeMultisig {
+			creator = s
+			break
+		}
+	}
+	if creator == "" {
+		i.lock.Unlock()
+		return "", fmt.Errorf("at least one signer should be a managed address")
+	}
+	// The name is reserved while the multisig creation message is executed
+	// on-chain, so the instance isn't locked meanwhile.
+	i.reservedNames[name] = struct{}{}
+	i.lock.Unlock()
+
+	addr, err := i.wm.NewMultisig(ctx, creator, signers, threshold, amount)
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	delete(i.reservedNames, name)
+	if err != nil {
+		return "", fmt.Errorf("creating multisig: %s", err)
+	}
+	i.cfg.Addrs[addr] = AddrInfo{
+		Name: name,
+		Addr: addr,
+		Type: AddrTypeMultisig,
+		Multisig: &MultisigInfo{
+			Creator:   creator,
+			Signers:   signers,
+			Threshold: threshold,
+		},
+	}
+	if conf.makeDefault {
+		i.cfg.DefaultStorageConfig.Cold.Filecoin.Addr = addr
+	}
+	if err := i.is.putInstanceConfig(i.cfg); err != nil {
+		return "", err
+	}
+	return addr, nil
+}
+
+// nameTaken returns true if name is used by a managed address, or reserved
+// by one being created. It should be called with the lock held.
+func (i *API) nameTaken(name string) bool {
+	if _, ok := i.reservedNames[name]; ok {
+		return true
+	}
+	for _, ai := range i.cfg.Addrs {
+		if ai.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MultisigPending returns the transactions of a managed multisig waiting for approvals.
+func (i *API) MultisigPending(ctx context.Context, addr string) ([]wallet.MultisigTx, error) {
+	if _, err := i.getMultisig(addr); err != nil {
+		return nil, err
+	}
+	txs, err := i.wm.MultisigPending(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("getting pending multisig transactions: %s", err)
+	}
+	return txs, nil
+}
+
+// MultisigPropose proposes a transfer from a managed multisig to any other address.
+// The proposal is approved by the multisig creator.
+func (i *API) MultisigPropose(ctx context.Context, addr string, to string, amount *big.Int) (cid.Cid, error) {
+	mi, err := i.getMultisig(addr)
+	if err != nil {
+		return cid.Undef, err
+	}
+	c, err := i.wm.MultisigPropose(ctx, addr, mi.Creator, to, amount)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("proposing multisig transfer: %s", err)
+	}
+	return c, nil
+}
+
+// MultisigProposeEscrowTopUp proposes a deposit from a managed multisig into the storage
+// market escrow of its creator, which funds deals made with the multisig address.
+// The proposal is approved by the multisig creator.
+func (i *API) MultisigProposeEscrowTopUp(ctx context.Context, addr string, amount *big.Int) (cid.Cid, error) {
+	mi, err := i.getMultisig(addr)
+	if err != nil {
+		return cid.Undef, err
+	}
+	c, err := i.wm.MultisigProposeEscrowTopUp(ctx, addr, mi.Creator, mi.Creator, amount)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("proposing multisig escrow top-up: %s", err)
+	}
+	return c, nil
+}
+
+// MultisigApprove approves a pending transaction of a managed multisig using
+// approver, which should be a managed signer address.
+func (i *API) MultisigApprove(ctx context.Context, addr string, approver string, txID int64) (cid.Cid, error) {
+	mi, err := i.getMultisig(addr)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if !i.isManagedAddress(approver) {
+		return cid.Undef, fmt.Errorf("%v is not managed by ffs instance", approver)
+	}
+	var isSigner bool
+	for _, s := range mi.Signers {
+		if s == approver {
+			isSigner = true
+			break
+		}
+	}
+	if !isSigner {
+		return cid.Undef, fmt.Errorf("%v isn't a signer of multisig %s", approver, addr)
+	}
+	c, err := i.wm.MultisigApprove(ctx, addr, approver, txID)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("approving multisig transaction: %s", err)
+	}
+	return c, nil
+}
+
+func (i *API) getMultisig(addr string) (MultisigInfo, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	ai, ok := i.cfg.Addrs[addr]
+	if !ok {
+		return MultisigInfo{}, fmt.Errorf("%v is not managed by ffs instance", addr)
+	}
+	if ai.Type != AddrTypeMultisig || ai.Multisig == nil {
+		return MultisigInfo{}, fmt.Errorf("%v isn't a multisig address", addr)
+	}
+	return *ai.Multisig, nil
+}
+
 // SignMessage signs a message using a managed address.
 func (i *API) SignMessage(ctx context.Context, addr string, message []byte) ([]byte, error) {
 	if !i.isManagedAddress(addr) {
@@ -82,13 +303,195 @@ func (i *API) VerifyMessage(ctx context.Context, addr string, message, signature
 }
 
 // SendFil sends fil from a managed address to any another address, returns immediately but funds are sent asynchronously.
-func (i *API) SendFil(ctx context.Context, from string, to string, amount *big.Int) error {
-	if !i.isManagedAddress(from) {
-		return fmt.Errorf("%v is not managed by ffs instance", from)
+// The returned message cid can be used to track the transaction status. The transfer must be allowed by the
+// spend policy of the address. If the amount is above the policy approval threshold, no funds are sent and
+// the id of a SendRequest waiting for admin approval is returned instead.
+func (i *API) SendFil(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, SendRequestID, error) {
+	i.sendLock.Lock()
+	defer i.sendLock.Unlock()
+
+	policy, err := i.checkSpendPolicy(ctx, from, to, amount)
+	if err != nil {
+		return cid.Undef, "", err
+	}
+	if policy.ApprovalThreshold != nil && amount.Cmp(policy.ApprovalThreshold) > 0 {
+		sr := SendRequest{
+			ID:        NewSendRequestID(),
+			APIID:     i.cfg.ID,
+			From:      from,
+			To:        to,
+			Amount:    amount,
+			CreatedAt: time.Now().Unix(),
+		}
+		if err := i.is.putSendRequest(sr); err != nil {
+			return cid.Undef, "", fmt.Errorf("saving send request: %s", err)
+		}
+		log.Infof("send request %s of %s from %s to %s waiting for approval", sr.ID, amount, from, to)
+		return cid.Undef, sr.ID, nil
+	}
+	c, err := i.wm.SendFil(ctx, from, to, amount)
+	if err != nil {
+		return cid.Undef, "", err
+	}
+	return c, "", nil
+}
+
+// SendFilApproved sends fil from a managed address to any another address enforcing the spend policy
+// of the address, but skipping the approval step. It should be used for transfers already approved by an admin.
+func (i *API) SendFilApproved(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, error) {
+	i.sendLock.Lock()
+	defer i.sendLock.Unlock()
+
+	if _, err := i.checkSpendPolicy(ctx, from, to, amount); err != nil {
+		return cid.Undef, err
 	}
 	return i.wm.SendFil(ctx, from, to, amount)
 }
 
+// SetSpendPolicy sets the spend policy of a managed address.
+func (i *API) SetSpendPolicy(addr string, policy SpendPolicy) error {
+	for _, amt := range []*big.Int{policy.MaxPerTx, policy.MaxPerDay, policy.ApprovalThreshold} {
+		if amt != nil && amt.Sign() < 0 {
+			return fmt.Errorf("policy amounts can't be negative")
+		}
+	}
+	for _, dest := range policy.AllowedDestinations {
+		if _, err := address.NewFromString(dest); err != nil {
+			return fmt.Errorf("invalid allowed destination %s: %s", dest, err)
+		}
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	ai, ok := i.cfg.Addrs[addr]
+	if !ok {
+		return fmt.Errorf("%v is not managed by ffs instance", addr)
+	}
+	ai.SpendPolicy = policy
+	i.cfg.Addrs[addr] = ai
+	return i.is.putInstanceConfig(i.cfg)
+}
+
+// SendRequests returns the transfers waiting for admin approval.
+func (i *API) SendRequests() ([]SendRequest, error) {
+	srs, err := i.is.getSendRequests()
+	if err != nil {
+		return nil, fmt.Errorf("getting send requests: %s", err)
+	}
+	return srs, nil
+}
+
+// ApproveSendRequest approves a transfer waiting for approval, and sends it.
+// The request is claimed before sending, so concurrent approvals or rejections
+// of the same request fail. If sending fails, the request is restored.
+func (i *API) ApproveSendRequest(ctx context.Context, id SendRequestID) (cid.Cid, error) {
+	sr, err := i.is.claimSendRequest(id)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("claiming send request: %w", err)
+	}
+	c, err := i.SendFilApproved(ctx, sr.From, sr.To, sr.Amount)
+	if err != nil {
+		if err := i.is.putSendRequest(sr); err != nil {
+			log.Errorf("restoring send request %s: %s", sr.ID, err)
+		}
+		return cid.Undef, fmt.Errorf("sending approved request: %w", err)
+	}
+	return c, nil
+}
+
+// RejectSendRequest rejects a transfer waiting for approval.
+func (i *API) RejectSendRequest(id SendRequestID) error {
+	if _, err := i.is.claimSendRequest(id); err != nil {
+		return fmt.Errorf("claiming send request: %w", err)
+	}
+	return nil
+}
+
+// checkSpendPolicy checks that a transfer is allowed by the spend policy of
+// the sender managed address, and returns the policy.
+func (i *API) checkSpendPolicy(ctx context.Context, from string, to string, amount *big.Int) (SpendPolicy, error) {
+	i.lock.Lock()
+	ai, ok := i.cfg.Addrs[from]
+	i.lock.Unlock()
+	if !ok {
+		return SpendPolicy{}, fmt.Errorf("%v is not managed by ffs instance", from)
+	}
+	if ai.Type == AddrTypeMultisig {
+		return SpendPolicy{}, fmt.Errorf("multisig %s can't send funds directly, propose a multisig transaction instead", from)
+	}
+	policy := ai.SpendPolicy
+	if amount.Sign() <= 0 {
+		return SpendPolicy{}, fmt.Errorf("amount should be positive")
+	}
+	if policy.MaxPerTx != nil && amount.Cmp(policy.MaxPerTx) > 0 {
+		return SpendPolicy{}, fmt.Errorf("%w: amount %s is above max per transaction %s", ErrSpendPolicyViolation, amount, policy.MaxPerTx)
+	}
+	if len(policy.AllowedDestinations) > 0 {
+		allowed := false
+		for _, dest := range policy.AllowedDestinations {
+			if dest == to {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return SpendPolicy{}, fmt.Errorf("%w: destination %s isn't allowed", ErrSpendPolicyViolation, to)
+		}
+	}
+	if policy.MaxPerDay != nil {
+		spent, err := i.spentSince(ctx, from, time.Now().Add(-time.Hour*24))
+		if err != nil {
+			return SpendPolicy{}, fmt.Errorf("calculating spent amount in the last day: %s", err)
+		}
+		if big.NewInt(0).Add(spent, amount).Cmp(policy.MaxPerDay) > 0 {
+			return SpendPolicy{}, fmt.Errorf("%w: amount %s plus %s spent in the last day is above max per day %s", ErrSpendPolicyViolation, amount, spent, policy.MaxPerDay)
+		}
+	}
+	return policy, nil
+}
+
+// spentSince returns the total amount transferred from addr since t, excluding
+// failed and dropped transactions. Transactions aren't assumed to be in any
+// order, since re-indexed transactions may change their time.
+func (i *API) spentSince(ctx context.Context, addr string, t time.Time) (*big.Int, error) {
+	txs, err := i.wm.Transactions(ctx, []string{addr})
+	if err != nil {
+		return nil, err
+	}
+	spent := big.NewInt(0)
+	for _, tx := range txs {
+		if tx.Time < t.Unix() || tx.From != addr || tx.Kind != wallet.TxTransfer || tx.Amount == nil {
+			continue
+		}
+		if tx.Status == wallet.TxFailed || tx.Status == wallet.TxDropped {
+			continue
+		}
+		spent.Add(spent, tx.Amount)
+	}
+	return spent, nil
+}
+
+// Transactions returns the recorded transactions of managed addresses. If addr
+// is empty, transactions of all managed addresses are returned.
+func (i *API) Transactions(ctx context.Context, addr string, opts ...wallet.TransactionsOption) ([]wallet.Transaction, error) {
+	var addrs []string
+	if addr != "" {
+		if !i.isManagedAddress(addr) {
+			return nil, fmt.Errorf("%v is not managed by ffs instance", addr)
+		}
+		addrs = []string{addr}
+	} else {
+		for _, a := range i.Addrs() {
+			addrs = append(addrs, a.Addr)
+		}
+	}
+	txs, err := i.wm.Transactions(ctx, addrs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("getting transactions: %s", err)
+	}
+	return txs, nil
+}
+
 func (i *API) isManagedAddress(addr string) bool {
 	for managedAddr := range i.cfg.Addrs {
 		if managedAddr == addr {
@@ -0,0 +1,310 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
+)
+
+const (
+	addrFrom  = "t3vq2ngnnenehw3krfztj5q4mbolsgevixqbfnkr4jf5f7rwgsohh2wnmkvyn2j3lrsdtvptsxd2zpb5fzgnsa"
+	addrTo    = "t01000"
+	addrOther = "t01001"
+)
+
+func TestSpendPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		name   string
+		policy SpendPolicy
+		spent  int64
+		to     string
+		amount int64
+		err    bool
+	}{
+		{name: "NoPolicy", to: addrTo, amount: 100},
+		{name: "NonPositive", to: addrTo, amount: 0, err: true},
+		{name: "MaxPerTx", policy: SpendPolicy{MaxPerTx: big.NewInt(10)}, to: addrTo, amount: 11, err: true},
+		{name: "MaxPerTxEqual", policy: SpendPolicy{MaxPerTx: big.NewInt(10)}, to: addrTo, amount: 10},
+		{name: "AllowedDestination", policy: SpendPolicy{AllowedDestinations: []string{addrTo}}, to: addrTo, amount: 1},
+		{name: "DisallowedDestination", policy: SpendPolicy{AllowedDestinations: []string{addrTo}}, to: addrOther, amount: 1, err: true},
+		{name: "MaxPerDay", policy: SpendPolicy{MaxPerDay: big.NewInt(10)}, spent: 6, to: addrTo, amount: 5, err: true},
+		{name: "MaxPerDayEqual", policy: SpendPolicy{MaxPerDay: big.NewInt(10)}, spent: 5, to: addrTo, amount: 5},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			wm := &walletMock{}
+			if tc.spent > 0 {
+				wm.txs = append(wm.txs, wallet.Transaction{From: addrFrom, To: addrTo, Amount: big.NewInt(tc.spent), Time: time.Now().Unix()})
+			}
+			i := newTestAPI(t, wm)
+			require.NoError(t, i.SetSpendPolicy(addrFrom, tc.policy))
+
+			c, srID, err := i.SendFil(ctx, addrFrom, tc.to, big.NewInt(tc.amount))
+			if tc.err {
+				require.Error(t, err)
+				require.Empty(t, wm.calls())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, c.Defined())
+			require.Empty(t, srID)
+			require.Len(t, wm.calls(), 1)
+		})
+	}
+}
+
+func TestSpentSince(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Now()
+	wm := &walletMock{txs: []wallet.Transaction{
+		{From: addrFrom, To: addrTo, Amount: big.NewInt(1), Time: now.Unix()},
+		{From: addrFrom, To: addrTo, Amount: big.NewInt(2), Time: now.Unix(), Status: wallet.TxFailed},
+		{From: addrFrom, To: addrTo, Amount: big.NewInt(4), Time: now.Unix(), Status: wallet.TxDropped},
+		{From: addrTo, To: addrFrom, Amount: big.NewInt(8), Time: now.Unix()},
+		{From: addrFrom, To: addrTo, Amount: big.NewInt(16), Time: now.Add(-time.Hour * 48).Unix()},
+		// Transactions aren't necessarily sorted by time.
+		{From: addrFrom, To: addrTo, Amount: big.NewInt(32), Time: now.Add(-time.Hour).Unix(), Status: wallet.TxConfirmed},
+	}}
+	i := newTestAPI(t, wm)
+
+	spent, err := i.spentSince(ctx, addrFrom, now.Add(-time.Hour*24))
+	require.NoError(t, err)
+	require.Equal(t, int64(33), spent.Int64())
+}
+
+func TestSendRequestApproval(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	wm := &walletMock{}
+	i := newTestAPI(t, wm)
+	require.NoError(t, i.SetSpendPolicy(addrFrom, SpendPolicy{ApprovalThreshold: big.NewInt(10)}))
+
+	// Transfers above the threshold wait for approval.
+	c, srID, err := i.SendFil(ctx, addrFrom, addrTo, big.NewInt(11))
+	require.NoError(t, err)
+	require.False(t, c.Defined())
+	require.NotEmpty(t, srID)
+	require.Empty(t, wm.calls())
+	srs, err := i.SendRequests()
+	require.NoError(t, err)
+	require.Len(t, srs, 1)
+	require.Equal(t, srID, srs[0].ID)
+
+	// Failed sends restore the request.
+	wm.setErr(errors.New("lotus unavailable"))
+	_, err = i.ApproveSendRequest(ctx, srID)
+	require.Error(t, err)
+	srs, err = i.SendRequests()
+	require.NoError(t, err)
+	require.Len(t, srs, 1)
+	wm.setErr(nil)
+
+	// Concurrent approvals send funds only once.
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for j := 0; j < 10; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := i.ApproveSendRequest(ctx, srID)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	var succeeded int
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		require.True(t, errors.Is(err, ErrNotFound))
+	}
+	require.Equal(t, 1, succeeded)
+	require.Len(t, wm.calls(), 1)
+	srs, err = i.SendRequests()
+	require.NoError(t, err)
+	require.Empty(t, srs)
+
+	// Rejected requests can't be approved.
+	_, srID, err = i.SendFil(ctx, addrFrom, addrTo, big.NewInt(11))
+	require.NoError(t, err)
+	require.NoError(t, i.RejectSendRequest(srID))
+	_, err = i.ApproveSendRequest(ctx, srID)
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.True(t, errors.Is(i.RejectSendRequest(srID), ErrNotFound))
+	require.Len(t, wm.calls(), 1)
+}
+
+func TestNewMultisigAddrUnlocked(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	wm := &multisigWalletMock{started: make(chan struct{}), release: make(chan struct{})}
+	i := newTestAPI(t, wm)
+
+	type result struct {
+		addr string
+		err  error
+	}
+	res := make(chan result)
+	go func() {
+		addr, err := i.NewMultisigAddr(ctx, "msig", []string{addrFrom, addrTo}, 1, big.NewInt(1))
+		res <- result{addr: addr, err: err}
+	}()
+	<-wm.started
+
+	// The instance isn't locked while the multisig is created, but its
+	// name is reserved.
+	require.Len(t, i.Addrs(), 1)
+	_, err := i.ImportKey(ctx, "msig", exportedKey(addrTo), "")
+	require.Error(t, err)
+	require.Empty(t, wm.imported())
+	close(wm.release)
+	r := <-res
+	require.NoError(t, r.err)
+	require.Equal(t, addrOther, r.addr)
+	require.Len(t, i.Addrs(), 2)
+	require.Empty(t, i.reservedNames)
+}
+
+func TestImportKeyNameTaken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	wm := &walletMock{}
+	i := newTestAPI(t, wm)
+
+	// Names of managed addresses can't be reused, and the key
+	// isn't imported in the wallet.
+	_, err := i.ImportKey(ctx, "from", exportedKey(addrTo), "")
+	require.Error(t, err)
+	require.Empty(t, wm.imported())
+
+	// Keys of managed addresses can be imported again.
+	addr, err := i.ImportKey(ctx, "from", exportedKey(addrFrom), "")
+	require.NoError(t, err)
+	require.Equal(t, addrFrom, addr)
+	require.Equal(t, []string{addrFrom}, wm.imported())
+
+	addr, err = i.ImportKey(ctx, "to", exportedKey(addrTo), "")
+	require.NoError(t, err)
+	require.Equal(t, addrTo, addr)
+	require.Len(t, i.Addrs(), 2)
+}
+
+// exportedKey returns a fake exported key of addr.
+func exportedKey(addr string) []byte {
+	return []byte(fmt.Sprintf(`{"Address":%q}`, addr))
+}
+
+func newTestAPI(t *testing.T, wm ffs.WalletManager) *API {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	cfg := InstanceConfig{
+		ID:    ffs.NewAPIID(),
+		Addrs: map[string]AddrInfo{addrFrom: {Name: "from", Addr: addrFrom, Type: "bls"}},
+	}
+	is := newInstanceStore(tests.NewTxMapDatastore())
+	require.NoError(t, is.putInstanceConfig(cfg))
+	return new(ctx, is, wm, nil, cfg, nil, cancel)
+}
+
+// walletMock sends transfers recording them as transactions. Transactions
+// can also be seeded to simulate previous transfers.
+type walletMock struct {
+	ffs.WalletManager
+
+	lock    sync.Mutex
+	err     error
+	txs     []wallet.Transaction
+	sends   []wallet.Transaction
+	imports []string
+}
+
+func (wm *walletMock) SendFil(_ context.Context, from string, to string, amount *big.Int) (cid.Cid, error) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	if wm.err != nil {
+		return cid.Undef, wm.err
+	}
+	tx := wallet.Transaction{From: from, To: to, Amount: amount, Time: time.Now().Unix()}
+	wm.txs = append(wm.txs, tx)
+	wm.sends = append(wm.sends, tx)
+	return util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+}
+
+func (wm *walletMock) Transactions(_ context.Context, addrs []string, _ ...wallet.TransactionsOption) ([]wallet.Transaction, error) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	var res []wallet.Transaction
+	for j := len(wm.txs) - 1; j >= 0; j-- {
+		for _, a := range addrs {
+			if wm.txs[j].From == a || wm.txs[j].To == a {
+				res = append(res, wm.txs[j])
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+// ImportKey imports the address of a key created with exportedKey.
+func (wm *walletMock) ImportKey(_ context.Context, key []byte, _ string) (string, error) {
+	addr, err := wallet.ExportedKeyAddr(key)
+	if err != nil {
+		return "", err
+	}
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	wm.imports = append(wm.imports, addr)
+	return addr, nil
+}
+
+func (wm *walletMock) imported() []string {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	return append([]string(nil), wm.imports...)
+}
+
+func (wm *walletMock) setErr(err error) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	wm.err = err
+}
+
+// calls returns the transfers sent through SendFil.
+func (wm *walletMock) calls() []wallet.Transaction {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	return append([]wallet.Transaction(nil), wm.sends...)
+}
+
+// multisigWalletMock creates multisigs blocking until release is closed.
+type multisigWalletMock struct {
+	walletMock
+	started chan struct{}
+	release chan struct{}
+}
+
+func (wm *multisigWalletMock) NewMultisig(context.Context, string, []string, uint64, *big.Int) (string, error) {
+	close(wm.started)
+	<-wm.release
+	return addrOther, nil
+}
@@ -17,6 +17,7 @@ var (
 	dsInstanceConfig       = datastore.NewKey("instanceconfig")
 	dsBaseCidStorageConfig = datastore.NewKey("cidstorageconfig")
 	dsBaseRetrievalRequest = datastore.NewKey("retrievalrequest")
+	dsBaseSendRequest      = datastore.NewKey("sendrequest")
 )
 
 type instanceStore struct {
@@ -210,3 +211,80 @@ func (s *instanceStore) getRetrievalRequest(rid ffs.RetrievalID) (retrievalReque
 func makeRetrievalRequestKey(rid ffs.RetrievalID) datastore.Key {
 	return dsBaseRetrievalRequest.ChildString(rid.String())
 }
+
+func (s *instanceStore) putSendRequest(sr SendRequest) error {
+	buf, err := json.Marshal(sr)
+	if err != nil {
+		return fmt.Errorf("marshaling send request for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeSendRequestKey(sr.ID), buf); err != nil {
+		return fmt.Errorf("saving send request to datastore: %s", err)
+	}
+	return nil
+}
+
+func (s *instanceStore) getSendRequest(id SendRequestID) (SendRequest, error) {
+	buf, err := s.ds.Get(makeSendRequestKey(id))
+	if err != nil {
+		if err == datastore.ErrNotFound {
+			return SendRequest{}, ErrNotFound
+		}
+		return SendRequest{}, fmt.Errorf("getting send request from datastore: %s", err)
+	}
+	var sr SendRequest
+	if err := json.Unmarshal(buf, &sr); err != nil {
+		return SendRequest{}, fmt.Errorf("unmarshaling send request from datastore: %s", err)
+	}
+	return sr, nil
+}
+
+func (s *instanceStore) getSendRequests() ([]SendRequest, error) {
+	q := query.Query{Prefix: dsBaseSendRequest.String()}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying send requests: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var ret []SendRequest
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var sr SendRequest
+		if err := json.Unmarshal(r.Value, &sr); err != nil {
+			return nil, fmt.Errorf("unmarshaling send request from datastore: %s", err)
+		}
+		ret = append(ret, sr)
+	}
+	return ret, nil
+}
+
+// claimSendRequest atomically gets and removes a send request, so it can
+// be approved or rejected only once.
+func (s *instanceStore) claimSendRequest(id SendRequestID) (SendRequest, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	sr, err := s.getSendRequest(id)
+	if err != nil {
+		return SendRequest{}, err
+	}
+	if err := s.removeSendRequest(id); err != nil {
+		return SendRequest{}, err
+	}
+	return sr, nil
+}
+
+func (s *instanceStore) removeSendRequest(id SendRequestID) error {
+	if err := s.ds.Delete(makeSendRequestKey(id)); err != nil {
+		return fmt.Errorf("removing send request from datastore: %s", err)
+	}
+	return nil
+}
+
+func makeSendRequestKey(id SendRequestID) datastore.Key {
+	return dsBaseSendRequest.ChildString(id.String())
+}
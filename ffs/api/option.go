@@ -2,7 +2,9 @@ package api
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/ipld/go-ipld-prime"
 	"github.com/textileio/powergate/ffs"
 )
 
@@ -76,6 +78,38 @@ func WithHistory(enabled bool) GetLogsOption {
 	}
 }
 
+// WithMinLevel filters only log messages with at least level.
+func WithMinLevel(level ffs.LogLevel) GetLogsOption {
+	return func(c *GetLogsConfig) {
+		c.minLevel = level
+	}
+}
+
+// WithTimeRange filters only log messages created since from, and
+// before to. Zero values don't limit the range.
+func WithTimeRange(from, to time.Time) GetLogsOption {
+	return func(c *GetLogsConfig) {
+		c.from = from
+		c.to = to
+	}
+}
+
+// WithTextFilter filters only log messages containing text in the
+// message or fields, ignoring case.
+func WithTextFilter(text string) GetLogsOption {
+	return func(c *GetLogsConfig) {
+		c.text = text
+	}
+}
+
+// WithLimit limits the history of log messages to the most recent
+// limit messages.
+func WithLimit(limit int) GetLogsOption {
+	return func(c *GetLogsConfig) {
+		c.limit = limit
+	}
+}
+
 type importConfig struct {
 	validate bool
 }
@@ -109,3 +143,36 @@ func WithRetrievalMaxPrice(maxPrice uint64) RetrievalOption {
 		prc.maxPrice = maxPrice
 	}
 }
+
+type getCARConfig struct {
+	selector ipld.Node
+	fromCold bool
+	version  int
+}
+
+// GetCAROption provides configurations for getting a Cid as a CAR file.
+type GetCAROption func(*getCARConfig)
+
+// WithCARSelector indicates the IPLD selector of the part of the DAG to
+// include in the CAR file. By default, the complete DAG is included.
+func WithCARSelector(sel ipld.Node) GetCAROption {
+	return func(c *getCARConfig) {
+		c.selector = sel
+	}
+}
+
+// WithCARVersion indicates the version of the CAR format, which can be
+// dagcar.V1 or dagcar.V2. By default, CARv1 files are written.
+func WithCARVersion(version int) GetCAROption {
+	return func(c *getCARConfig) {
+		c.version = version
+	}
+}
+
+// WithCARFromCold indicates to retrieve the DAG from the miners of the Cid
+// Filecoin deals instead of from the Hot Storage.
+func WithCARFromCold(fromCold bool) GetCAROption {
+	return func(c *getCARConfig) {
+		c.fromCold = fromCold
+	}
+}
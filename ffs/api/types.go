@@ -2,13 +2,20 @@ package api
 
 import (
 	"errors"
+	"math/big"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/ffs"
 )
 
 var (
 	// ErrNotFound returned when instance configuration doesn't exist.
 	ErrNotFound = errors.New("stored item not found")
+	// ErrSpendPolicyViolation returned when a transfer isn't allowed by the
+	// spend policy of the sender address.
+	ErrSpendPolicyViolation = errors.New("transfer not allowed by spend policy")
 )
 
 // InstanceConfig has general information about a Api instance.
@@ -18,11 +25,73 @@ type InstanceConfig struct {
 	DefaultStorageConfig ffs.StorageConfig
 }
 
+// AddrTypeMultisig is the AddrInfo.Type of multisig addresses.
+const AddrTypeMultisig = "multisig"
+
 // AddrInfo provides information about a wallet address.
 type AddrInfo struct {
+	Name        string
+	Addr        string
+	Type        string
+	SpendPolicy SpendPolicy
+	// Multisig is only set for addresses of type AddrTypeMultisig.
+	Multisig *MultisigInfo
+}
+
+// ExportedKey is the private key of a managed address, encrypted
+// with a passphrase.
+type ExportedKey struct {
 	Name string
 	Addr string
 	Type string
+	Key  []byte
+}
+
+// MultisigInfo describes a multisig address.
+type MultisigInfo struct {
+	// Creator is the managed address which created the multisig. It
+	// proposes multisig transactions and is the client of deals funded
+	// by the multisig.
+	Creator   string
+	Signers   []string
+	Threshold uint64
+}
+
+// SpendPolicy restricts transfers sent from a managed address.
+// Nil amounts and an empty allowlist mean no restriction.
+type SpendPolicy struct {
+	// MaxPerTx is the maximum amount of a single transfer.
+	MaxPerTx *big.Int
+	// MaxPerDay is the maximum amount transferred in the last 24hs.
+	MaxPerDay *big.Int
+	// AllowedDestinations is the allowlist of destination addresses.
+	AllowedDestinations []string
+	// ApprovalThreshold is the amount above which transfers require
+	// an admin approval to be sent.
+	ApprovalThreshold *big.Int
+}
+
+// SendRequestID is an identifier of a SendRequest.
+type SendRequestID string
+
+// NewSendRequestID returns a new SendRequestID.
+func NewSendRequestID() SendRequestID {
+	return SendRequestID(uuid.New().String())
+}
+
+// String returns a string representation of SendRequestID.
+func (i SendRequestID) String() string {
+	return string(i)
+}
+
+// SendRequest is a transfer waiting for admin approval.
+type SendRequest struct {
+	ID        SendRequestID
+	APIID     ffs.APIID
+	From      string
+	To        string
+	Amount    *big.Int
+	CreatedAt int64
 }
 
 // NewAddressConfig contains options for creating a new wallet address.
@@ -34,6 +103,24 @@ type NewAddressConfig struct {
 // GetLogsConfig contains configuration for a stream-log
 // of human-friendly messages for a Cid execution.
 type GetLogsConfig struct {
-	jid     ffs.JobID
-	history bool
+	jid      ffs.JobID
+	history  bool
+	minLevel ffs.LogLevel
+	from     time.Time
+	to       time.Time
+	text     string
+	limit    int
+}
+
+func (c GetLogsConfig) query(cid cid.Cid, rid ffs.RetrievalID) ffs.LogQuery {
+	return ffs.LogQuery{
+		Cid:         cid,
+		RetrievalID: rid,
+		JobID:       c.jid,
+		MinLevel:    c.minLevel,
+		From:        c.from,
+		To:          c.to,
+		Text:        c.text,
+		Limit:       c.limit,
+	}
 }
@@ -0,0 +1,36 @@
+package ffs
+
+import "context"
+
+// ColdParams are the parameters of the storage Job execution calling
+// the ColdStorage, besides the configuration of the data.
+type ColdParams struct {
+	// Steps records the execution steps of the Job. If nil, steps
+	// aren't recorded.
+	Steps JobStepRecorder
+	// DealSource makes the data of the Cid being stored available in the
+	// Hot Storage to make new deals, e.g: retrieving it from an existing
+	// deal when the Hot Storage is disabled. If nil, the data is expected
+	// to be available.
+	DealSource func(context.Context) error
+	// EscalationRound is the re-proposal round of new deals, in which
+	// miners are offered the price defined by FilEscalation.EpochPrice.
+	// Zero means deals are being proposed for the first time.
+	EscalationRound int
+}
+
+// StartStep starts a step of the Job, and returns a function to finish
+// it. See StartJobStep.
+func (p ColdParams) StartStep(name, detail string) func(result string, err error) {
+	return StartJobStep(p.Steps, name, detail)
+}
+
+// EnsureDealSource makes the data of the Cid being stored available to
+// make new deals. ColdStorage implementations should call it before
+// preparing new deals.
+func (p ColdParams) EnsureDealSource(ctx context.Context) error {
+	if p.DealSource == nil {
+		return nil
+	}
+	return p.DealSource(ctx)
+}
@@ -1,19 +1,32 @@
 package coreipfs
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"sync"
 	"time"
 
+	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
 	ipfsfiles "github.com/ipfs/go-ipfs-files"
 	logging "github.com/ipfs/go-log/v2"
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/ipld/go-ipld-prime"
 	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/dagcar"
+	"github.com/textileio/powergate/ffs/dirtar"
+)
+
+const (
+	// maxParallelBlockPuts is the maximum number of blocks of a CAR file
+	// being put in the IPFS node at the same time.
+	maxParallelBlockPuts = 16
 )
 
 var (
@@ -52,6 +65,9 @@ func (ci *CoreIpfs) Remove(ctx context.Context, c cid.Cid) error {
 	if err := ci.ipfs.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
 		return fmt.Errorf("unpinning cid from ipfs node: %s", err)
 	}
+	ci.lock.Lock()
+	delete(ci.pinset, c)
+	ci.lock.Unlock()
 	ci.l.Log(ctx, "Cid data was pinned in IPFS node.")
 	return nil
 }
@@ -73,18 +89,152 @@ func (ci *CoreIpfs) Add(ctx context.Context, r io.Reader) (cid.Cid, error) {
 	return p.Cid(), nil
 }
 
-// Get retrieves a cid from the IPFS node.
+// AddTar adds a tar archive as a directory in the IPFS node. The archive
+// is extracted in a temporary directory before being added, and hidden
+// files are included.
+func (ci *CoreIpfs) AddTar(ctx context.Context, r io.Reader) (cid.Cid, error) {
+	log.Debugf("adding tar-stream...")
+	dir, err := ioutil.TempDir("", "powergate-stage-")
+	if err != nil {
+		return cid.Undef, fmt.Errorf("creating temp dir: %s", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Errorf("removing temp dir: %s", err)
+		}
+	}()
+	if err := dirtar.Extract(r, dir); err != nil {
+		return cid.Undef, fmt.Errorf("extracting tar archive: %s", err)
+	}
+	stat, err := os.Lstat(dir)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("getting temp dir stat: %s", err)
+	}
+	sf, err := ipfsfiles.NewSerialFile(dir, true, stat)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("creating serial file: %s", err)
+	}
+	defer func() { _ = sf.Close() }()
+	p, err := ci.ipfs.Unixfs().Add(ctx, ipfsfiles.ToDir(sf), options.Unixfs.CidVersion(1), options.Unixfs.Pin(false))
+	if err != nil {
+		return cid.Undef, fmt.Errorf("adding directory to ipfs: %s", err)
+	}
+	log.Debugf("tar-stream added with cid %s", p.Cid())
+	return p.Cid(), nil
+}
+
+// AddCAR imports the blocks of a CAR file in the IPFS node, and returns
+// its roots. Blocks are verified to match their cids, and up to
+// maxParallelBlockPuts of them are put in the IPFS node concurrently.
+func (ci *CoreIpfs) AddCAR(ctx context.Context, r io.Reader) ([]cid.Cid, error) {
+	log.Debugf("adding car-stream...")
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	semaph := make(chan struct{}, maxParallelBlockPuts)
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var putErr error
+	roots, err := dagcar.Import(r, func(b blocks.Block) error {
+		lock.Lock()
+		err := putErr
+		lock.Unlock()
+		if err != nil {
+			return err
+		}
+		semaph <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaph
+				wg.Done()
+			}()
+			if err := ci.putBlock(ctx, b); err != nil {
+				lock.Lock()
+				if putErr == nil {
+					putErr = fmt.Errorf("putting block %s: %s", b.Cid(), err)
+					cancel()
+				}
+				lock.Unlock()
+			}
+		}()
+		return nil
+	})
+	wg.Wait()
+	if putErr != nil {
+		return nil, fmt.Errorf("importing car file: %s", putErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("importing car file: %s", err)
+	}
+	log.Debugf("car-stream added with roots %v", roots)
+	return roots, nil
+}
+
+// putBlock puts b in the IPFS node, verifying it's stored with its cid.
+func (ci *CoreIpfs) putBlock(ctx context.Context, b blocks.Block) error {
+	c := b.Cid()
+	format := cid.CodecToStr[c.Type()]
+	if c.Version() == 0 {
+		format = "v0"
+	}
+	prefix := c.Prefix()
+	bs, err := ci.ipfs.Block().Put(ctx, bytes.NewReader(b.RawData()), options.Block.Format(format), options.Block.Hash(prefix.MhType, prefix.MhLength))
+	if err != nil {
+		return err
+	}
+	if !bs.Path().Cid().Equals(c) {
+		return fmt.Errorf("ipfs node stored the block as %s", bs.Path().Cid())
+	}
+	return nil
+}
+
+// Get retrieves a cid from the IPFS node. Directories are retrieved as
+// tar archives.
 func (ci *CoreIpfs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
-	log.Debugf("getting cid %s", c)
-	n, err := ci.ipfs.Unixfs().Get(ctx, path.IpfsPath(c))
+	r, _, err := ci.GetPath(ctx, c, "")
+	return r, err
+}
+
+// GetPath retrieves a path within the DAG of a cid from the IPFS node, and
+// returns true if it's a directory retrieved as a tar archive. Closing the
+// returned io.ReadCloser stops writing the tar archive of directories.
+func (ci *CoreIpfs) GetPath(ctx context.Context, c cid.Cid, p string) (io.ReadCloser, bool, error) {
+	log.Debugf("getting cid %s path %s", c, p)
+	var ipath path.Path = path.IpfsPath(c)
+	if p != "" {
+		ipath = path.Join(ipath, p)
+	}
+	n, err := ci.ipfs.Unixfs().Get(ctx, ipath)
 	if err != nil {
-		return nil, fmt.Errorf("getting cid %s from ipfs: %s", c, err)
+		return nil, false, fmt.Errorf("getting cid %s path %s from ipfs: %s", c, p, err)
+	}
+	if file := ipfsfiles.ToFile(n); file != nil {
+		return file, false, nil
 	}
-	file := ipfsfiles.ToFile(n)
-	if file == nil {
-		return nil, fmt.Errorf("node is a directory")
+	dir := ipfsfiles.ToDir(n)
+	if dir == nil {
+		_ = n.Close()
+		return nil, false, fmt.Errorf("cid %s path %s isn't a file or directory", c, p)
 	}
-	return file, nil
+	pr, pw := io.Pipe()
+	go func() {
+		// Writing fails as soon as the reader is closed.
+		_ = pw.CloseWithError(dirtar.Write(pw, dir))
+		if err := dir.Close(); err != nil {
+			log.Errorf("closing directory %s path %s: %s", c, p, err)
+		}
+	}()
+	return pr, true, nil
+}
+
+// GetCAR writes the part of the DAG of a Cid selected by sel as a CAR file
+// of the given version to w. If sel is nil, the complete DAG is written.
+func (ci *CoreIpfs) GetCAR(ctx context.Context, c cid.Cid, sel ipld.Node, version int, w io.Writer) error {
+	log.Debugf("getting cid %s as car", c)
+	if err := dagcar.Write(ctx, dagcar.NodeGetterStore(ctx, ci.ipfs.Dag()), c, sel, version, w); err != nil {
+		return fmt.Errorf("getting cid %s from ipfs: %s", c, err)
+	}
+	return nil
 }
 
 // Store stores a Cid in the HotStorage. At the IPFS level, it also mark the Cid as pinned.
@@ -0,0 +1,229 @@
+// Package dagcar writes DAGs, or parts of them selected with IPLD
+// selectors, as CARv1 or CARv2 files, and imports CARv1 or CARv2 files.
+package dagcar
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipld/go-car"
+	"github.com/ipld/go-car/util"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+)
+
+// Versions of the CAR format.
+const (
+	// V1 is the CARv1 format.
+	V1 = 1
+	// V2 is the CARv2 format. CARv2 files are written without an index.
+	V2 = 2
+)
+
+// v2Pragma is the fixed prefix of CARv2 files, which is a CARv1 header
+// with version 2 and no roots.
+var v2Pragma = []byte{0x0a, 0xa1, 0x67, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x02}
+
+// v2HeaderSize is the size of the CARv2 header following the pragma.
+const v2HeaderSize = 40
+
+// AllSelector selects the complete DAG of a root.
+var AllSelector = func() ipld.Node {
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	return ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreAll(ssb.ExploreRecursiveEdge())).Node()
+}()
+
+// ParseSelector parses a dag-json encoded IPLD selector.
+func ParseSelector(s string) (ipld.Node, error) {
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := dagjson.Decoder(nb, strings.NewReader(s)); err != nil {
+		return nil, fmt.Errorf("decoding selector: %s", err)
+	}
+	n := nb.Build()
+	if _, err := selector.ParseSelector(n); err != nil {
+		return nil, fmt.Errorf("parsing selector: %s", err)
+	}
+	return n, nil
+}
+
+// Write writes the part of the DAG of root selected by sel as a CAR file
+// of the given version to w, loading blocks from store. If sel is nil, the
+// complete DAG is written.
+func Write(ctx context.Context, store car.ReadStore, root cid.Cid, sel ipld.Node, version int, w io.Writer) error {
+	if sel == nil {
+		sel = AllSelector
+	}
+	sc := car.NewSelectiveCar(ctx, store, []car.Dag{{Root: root, Selector: sel}})
+	switch version {
+	case V1:
+		if err := sc.Write(w); err != nil {
+			return fmt.Errorf("writing car file: %s", err)
+		}
+	case V2:
+		// The CARv2 header includes the size of the CARv1 payload, so
+		// the DAG is traversed once before writing it.
+		scp, err := sc.Prepare()
+		if err != nil {
+			return fmt.Errorf("preparing car file: %s", err)
+		}
+		// The header has 16 bytes of characteristics, the offset and
+		// size of the payload, and the offset of the missing index.
+		header := make([]byte, len(v2Pragma)+v2HeaderSize)
+		copy(header, v2Pragma)
+		binary.LittleEndian.PutUint64(header[len(v2Pragma)+16:], uint64(len(header)))
+		binary.LittleEndian.PutUint64(header[len(v2Pragma)+24:], scp.Size())
+		if _, err := w.Write(header); err != nil {
+			return fmt.Errorf("writing car header: %s", err)
+		}
+		if err := scp.Dump(w); err != nil {
+			return fmt.Errorf("writing car file: %s", err)
+		}
+	default:
+		return fmt.Errorf("unsupported car version %d", version)
+	}
+	return nil
+}
+
+// Import reads the blocks of the CARv1 or CARv2 file r, verifying that
+// each block matches its Cid, and calls put with each of them. It returns
+// the roots of the CAR file, which must be included in it. The index of
+// CARv2 files is ignored. The completeness of the DAGs of the roots isn't
+// checked, so CAR files with partial DAGs are accepted.
+func Import(r io.Reader, put func(blocks.Block) error) ([]cid.Cid, error) {
+	payload, err := v1Payload(bufio.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	cr, err := car.NewCarReader(payload)
+	if err != nil {
+		return nil, fmt.Errorf("reading car header: %s", err)
+	}
+	missing := make(map[cid.Cid]struct{}, len(cr.Header.Roots))
+	for _, root := range cr.Header.Roots {
+		missing[root] = struct{}{}
+	}
+	for {
+		b, err := cr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading block: %s", err)
+		}
+		c, err := b.Cid().Prefix().Sum(b.RawData())
+		if err != nil {
+			return nil, fmt.Errorf("hashing block %s: %s", b.Cid(), err)
+		}
+		if !c.Equals(b.Cid()) {
+			return nil, fmt.Errorf("block %s data doesn't match its cid", b.Cid())
+		}
+		if err := put(b); err != nil {
+			return nil, fmt.Errorf("putting block %s: %s", b.Cid(), err)
+		}
+		delete(missing, b.Cid())
+	}
+	for _, root := range cr.Header.Roots {
+		if _, ok := missing[root]; ok {
+			return nil, fmt.Errorf("root %s isn't included in the car file", root)
+		}
+	}
+	return cr.Header.Roots, nil
+}
+
+// v1Payload returns a reader of the CARv1 payload of the CAR file br. If
+// br isn't a CARv2 file, it's assumed to be a CARv1 file.
+func v1Payload(br *bufio.Reader) (io.Reader, error) {
+	pragma, err := br.Peek(len(v2Pragma))
+	if err != nil || !bytes.Equal(pragma, v2Pragma) {
+		return br, nil
+	}
+	header := make([]byte, len(v2Pragma)+v2HeaderSize)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("reading car header: %s", err)
+	}
+	dataOffset := binary.LittleEndian.Uint64(header[len(v2Pragma)+16:])
+	dataSize := binary.LittleEndian.Uint64(header[len(v2Pragma)+24:])
+	if dataOffset < uint64(len(header)) || dataOffset > math.MaxInt64 || dataSize > math.MaxInt64 {
+		return nil, fmt.Errorf("invalid car header")
+	}
+	if _, err := io.CopyN(ioutil.Discard, br, int64(dataOffset)-int64(len(header))); err != nil {
+		return nil, fmt.Errorf("seeking car payload: %s", err)
+	}
+	return io.LimitReader(br, int64(dataSize)), nil
+}
+
+// FileStore returns a car.ReadStore of the blocks of the CARv1 file r.
+// The offsets of the blocks are indexed reading r once, and blocks are
+// read from r when they're needed.
+func FileStore(r io.ReaderAt) (car.ReadStore, error) {
+	br := bufio.NewReader(io.NewSectionReader(r, 0, math.MaxInt64))
+	h, offset, err := car.ReadHeader(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading car header: %s", err)
+	}
+	if h.Version != V1 {
+		return nil, fmt.Errorf("unsupported car version %d", h.Version)
+	}
+	fs := &fileStore{r: r, index: make(map[cid.Cid]blockOffset)}
+	for {
+		c, l, data, err := util.ReadNode(br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading block: %s", err)
+		}
+		offset += l
+		fs.index[c] = blockOffset{offset: int64(offset) - int64(len(data)), size: len(data)}
+	}
+	return fs, nil
+}
+
+type blockOffset struct {
+	offset int64
+	size   int
+}
+
+type fileStore struct {
+	r     io.ReaderAt
+	index map[cid.Cid]blockOffset
+}
+
+func (fs *fileStore) Get(c cid.Cid) (blocks.Block, error) {
+	bo, ok := fs.index[c]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", c)
+	}
+	data := make([]byte, bo.size)
+	if _, err := fs.r.ReadAt(data, bo.offset); err != nil {
+		return nil, fmt.Errorf("reading block %s: %s", c, err)
+	}
+	return blocks.NewBlockWithCid(data, c)
+}
+
+// NodeGetterStore returns a car.ReadStore which gets blocks from ng.
+func NodeGetterStore(ctx context.Context, ng format.NodeGetter) car.ReadStore {
+	return &nodeGetterStore{ctx: ctx, ng: ng}
+}
+
+type nodeGetterStore struct {
+	ctx context.Context
+	ng  format.NodeGetter
+}
+
+func (s *nodeGetterStore) Get(c cid.Cid) (blocks.Block, error) {
+	return s.ng.Get(s.ctx, c)
+}
@@ -0,0 +1,199 @@
+package dagcar
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-merkledag"
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/ipld/go-car"
+	"github.com/ipld/go-car/util"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	ctx := context.Background()
+	dag := mdutils.Mock()
+
+	// root -> middle -> leaf
+	leaf := merkledag.NewRawNode([]byte("leaf"))
+	middle := &merkledag.ProtoNode{}
+	require.NoError(t, middle.AddNodeLink("leaf", leaf))
+	root := &merkledag.ProtoNode{}
+	require.NoError(t, root.AddNodeLink("middle", middle))
+	require.NoError(t, dag.AddMany(ctx, []format.Node{leaf, middle, root}))
+	store := NodeGetterStore(ctx, dag)
+
+	tests := []struct {
+		name     string
+		selector string
+		cids     []cid.Cid
+	}{
+		{"All", "", []cid.Cid{root.Cid(), middle.Cid(), leaf.Cid()}},
+		{"RootOnly", `{".":{}}`, []cid.Cid{root.Cid()}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var sel ipld.Node
+			if tc.selector != "" {
+				var err error
+				sel, err = ParseSelector(tc.selector)
+				require.NoError(t, err)
+			}
+			var buf bytes.Buffer
+			require.NoError(t, Write(ctx, store, root.Cid(), sel, V1, &buf))
+
+			cr, err := car.NewCarReader(&buf)
+			require.NoError(t, err)
+			require.Equal(t, []cid.Cid{root.Cid()}, cr.Header.Roots)
+			var cids []cid.Cid
+			for {
+				b, err := cr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				cids = append(cids, b.Cid())
+			}
+			require.Equal(t, tc.cids, cids)
+		})
+	}
+}
+
+func TestWriteV2(t *testing.T) {
+	ctx := context.Background()
+	dag := mdutils.Mock()
+	leaf := merkledag.NewRawNode([]byte("leaf"))
+	root := &merkledag.ProtoNode{}
+	require.NoError(t, root.AddNodeLink("leaf", leaf))
+	require.NoError(t, dag.AddMany(ctx, []format.Node{leaf, root}))
+	store := NodeGetterStore(ctx, dag)
+
+	var v1, v2 bytes.Buffer
+	require.NoError(t, Write(ctx, store, root.Cid(), nil, V1, &v1))
+	require.NoError(t, Write(ctx, store, root.Cid(), nil, V2, &v2))
+
+	buf := v2.Bytes()
+	require.Equal(t, v2Pragma, buf[:len(v2Pragma)])
+	header := buf[len(v2Pragma) : len(v2Pragma)+v2HeaderSize]
+	dataOffset := binary.LittleEndian.Uint64(header[16:])
+	dataSize := binary.LittleEndian.Uint64(header[24:])
+	require.Equal(t, uint64(len(v2Pragma)+v2HeaderSize), dataOffset)
+	require.Equal(t, uint64(v1.Len()), dataSize)
+	require.Zero(t, binary.LittleEndian.Uint64(header[32:]))
+	require.Equal(t, v1.Bytes(), buf[dataOffset:dataOffset+dataSize])
+
+	require.Error(t, Write(ctx, store, root.Cid(), nil, 3, &v2))
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dag := mdutils.Mock()
+	leaf := merkledag.NewRawNode([]byte("leaf"))
+	root := &merkledag.ProtoNode{}
+	require.NoError(t, root.AddNodeLink("leaf", leaf))
+	require.NoError(t, dag.AddMany(ctx, []format.Node{leaf, root}))
+	var buf bytes.Buffer
+	require.NoError(t, Write(ctx, NodeGetterStore(ctx, dag), root.Cid(), nil, V1, &buf))
+
+	store, err := FileStore(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	for _, n := range []format.Node{root, leaf} {
+		b, err := store.Get(n.Cid())
+		require.NoError(t, err)
+		require.Equal(t, n.RawData(), b.RawData())
+	}
+	_, err = store.Get(merkledag.NewRawNode([]byte("missing")).Cid())
+	require.Error(t, err)
+
+	// The store can be used to write selected parts of the file DAG.
+	sel, err := ParseSelector(`{".":{}}`)
+	require.NoError(t, err)
+	var selected bytes.Buffer
+	require.NoError(t, Write(ctx, store, root.Cid(), sel, V1, &selected))
+	cr, err := car.NewCarReader(&selected)
+	require.NoError(t, err)
+	b, err := cr.Next()
+	require.NoError(t, err)
+	require.Equal(t, root.Cid(), b.Cid())
+	_, err = cr.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	dag := mdutils.Mock()
+	leaf := merkledag.NewRawNode([]byte("leaf"))
+	root := &merkledag.ProtoNode{}
+	require.NoError(t, root.AddNodeLink("leaf", leaf))
+	require.NoError(t, dag.AddMany(ctx, []format.Node{leaf, root}))
+	var buf bytes.Buffer
+	require.NoError(t, Write(ctx, NodeGetterStore(ctx, dag), root.Cid(), nil, V1, &buf))
+
+	t.Run("Valid", func(t *testing.T) {
+		var cids []cid.Cid
+		roots, err := Import(bytes.NewReader(buf.Bytes()), func(b blocks.Block) error {
+			cids = append(cids, b.Cid())
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []cid.Cid{root.Cid()}, roots)
+		require.Equal(t, []cid.Cid{root.Cid(), leaf.Cid()}, cids)
+	})
+	t.Run("V2", func(t *testing.T) {
+		var v2 bytes.Buffer
+		require.NoError(t, Write(ctx, NodeGetterStore(ctx, dag), root.Cid(), nil, V2, &v2))
+		// Data after the payload, like an index, is ignored.
+		v2.WriteString("index")
+		var cids []cid.Cid
+		roots, err := Import(&v2, func(b blocks.Block) error {
+			cids = append(cids, b.Cid())
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []cid.Cid{root.Cid()}, roots)
+		require.Equal(t, []cid.Cid{root.Cid(), leaf.Cid()}, cids)
+	})
+	t.Run("MissingRoot", func(t *testing.T) {
+		r := carFile(t, []cid.Cid{root.Cid()}, leaf.Cid(), leaf.RawData())
+		_, err := Import(r, func(blocks.Block) error { return nil })
+		require.Error(t, err)
+	})
+	t.Run("PartialDAG", func(t *testing.T) {
+		// The root is included without its leaf.
+		r := carFile(t, []cid.Cid{root.Cid()}, root.Cid(), root.RawData())
+		roots, err := Import(r, func(blocks.Block) error { return nil })
+		require.NoError(t, err)
+		require.Equal(t, []cid.Cid{root.Cid()}, roots)
+	})
+	t.Run("CorruptBlock", func(t *testing.T) {
+		r := carFile(t, []cid.Cid{leaf.Cid()}, leaf.Cid(), []byte("corrupt"))
+		_, err := Import(r, func(blocks.Block) error { return nil })
+		require.Error(t, err)
+	})
+}
+
+func TestParseSelector(t *testing.T) {
+	_, err := ParseSelector(`{"R":{"l":{"none":{}},":>":{"a":{">":{"@":{}}}}}}`)
+	require.NoError(t, err)
+	_, err = ParseSelector(`{"R":`)
+	require.Error(t, err)
+	_, err = ParseSelector(`{"unknown":{}}`)
+	require.Error(t, err)
+}
+
+// carFile returns a CAR file with roots and a single block with Cid c and
+// data, which isn't verified.
+func carFile(t *testing.T, roots []cid.Cid, c cid.Cid, data []byte) io.Reader {
+	var buf bytes.Buffer
+	require.NoError(t, car.WriteHeader(&car.CarHeader{Roots: roots, Version: 1}, &buf))
+	require.NoError(t, util.LdWrite(&buf, c.Bytes(), data))
+	return &buf
+}
@@ -0,0 +1,395 @@
+package dealtracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/chainstore"
+	"github.com/textileio/powergate/chainsync"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/lotus"
+	txndstr "github.com/textileio/powergate/txndstransform"
+)
+
+var (
+	// hOffset is the # of tipsets from the heaviest chain to
+	// consider for deal states; this to reduce sensibility to
+	// chain reorgs.
+	hOffset = abi.ChainEpoch(20)
+
+	log = logging.Logger("ffs-dealtracker")
+)
+
+var _ ffs.DealTracker = (*Tracker)(nil)
+
+// Tracker follows tracked deals on-chain through their active, slashed
+// and expired states, and notifies state changes to listeners. State
+// changes are persisted, and notified on every update until they're
+// acknowledged.
+type Tracker struct {
+	clientBuilder  lotus.ClientBuilder
+	store          *chainstore.Store
+	ds             datastore.Datastore
+	events         datastore.Datastore
+	isLeader       func() bool
+	updateInterval time.Duration
+
+	lock      sync.Mutex
+	listeners map[<-chan ffs.DealEvent]*listener
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+type listener struct {
+	c    chan ffs.DealEvent
+	done chan struct{}
+}
+
+// snapshot contains the tracked deals which were active at a TipSet.
+type snapshot struct {
+	Deals map[uint64]trackedDeal
+}
+
+type trackedDeal struct {
+	Cid      cid.Cid
+	EndEpoch int64
+}
+
+// Option configures the Tracker.
+type Option func(*Tracker) error
+
+// WithIsLeader sets a function which returns false if another instance
+// sharing the datastore tracks the deals. In that case, the tracker
+// doesn't check deals states.
+func WithIsLeader(f func() bool) Option {
+	return func(t *Tracker) error {
+		t.isLeader = f
+		return nil
+	}
+}
+
+// WithUpdateInterval sets the interval between checks of the on-chain
+// state of tracked deals.
+func WithUpdateInterval(d time.Duration) Option {
+	return func(t *Tracker) error {
+		if d <= 0 {
+			return fmt.Errorf("update interval should be positive")
+		}
+		t.updateInterval = d
+		return nil
+	}
+}
+
+// New returns a new Tracker. It will load tracked deals from ds, and
+// immediately start following them on-chain.
+func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, opts ...Option) (*Tracker, error) {
+	cs := chainsync.New(clientBuilder)
+	store, err := chainstore.New(txndstr.Wrap(ds, "chainstore"), cs)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &Tracker{
+		clientBuilder:  clientBuilder,
+		store:          store,
+		ds:             txndstr.Wrap(ds, "deals"),
+		events:         txndstr.Wrap(ds, "events"),
+		isLeader:       func() bool { return true },
+		updateInterval: time.Minute * 10,
+		listeners:      make(map[<-chan ffs.DealEvent]*listener),
+		ctx:            ctx,
+		cancel:         cancel,
+		finished:       make(chan struct{}),
+	}
+	for _, o := range opts {
+		if err := o(t); err != nil {
+			cancel()
+			return nil, fmt.Errorf("applying option: %s", err)
+		}
+	}
+
+	go t.start()
+
+	return t, nil
+}
+
+// Track starts following an active deal which stores c and ends at
+// endEpoch.
+func (t *Tracker) Track(c cid.Cid, dealID uint64, endEpoch int64) error {
+	if dealID == 0 {
+		return fmt.Errorf("deal id can't be zero")
+	}
+	buf, err := json.Marshal(trackedDeal{Cid: c, EndEpoch: endEpoch})
+	if err != nil {
+		return fmt.Errorf("marshaling tracked deal: %s", err)
+	}
+	if err := t.ds.Put(makeKey(dealID), buf); err != nil {
+		return fmt.Errorf("saving tracked deal in datastore: %s", err)
+	}
+	return nil
+}
+
+// Listen returns a channel which receives state changes of tracked
+// deals.
+func (t *Tracker) Listen() <-chan ffs.DealEvent {
+	l := &listener{
+		c:    make(chan ffs.DealEvent, 100),
+		done: make(chan struct{}),
+	}
+	t.lock.Lock()
+	t.listeners[l.c] = l
+	t.lock.Unlock()
+	return l.c
+}
+
+// Ack acknowledges that the state change of a deal was handled, so it
+// isn't notified again.
+func (t *Tracker) Ack(dealID uint64) error {
+	if err := t.events.Delete(makeKey(dealID)); err != nil && err != datastore.ErrNotFound {
+		return fmt.Errorf("deleting acknowledged event from datastore: %s", err)
+	}
+	return nil
+}
+
+// Unregister stops sending state changes to a channel returned by
+// Listen.
+func (t *Tracker) Unregister(c <-chan ffs.DealEvent) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if l, ok := t.listeners[c]; ok {
+		close(l.done)
+		delete(t.listeners, c)
+	}
+}
+
+// Close closes the Tracker.
+func (t *Tracker) Close() error {
+	log.Info("closing...")
+	defer log.Info("closed")
+	t.cancel()
+	<-t.finished
+	return nil
+}
+
+// start is a long running job that keeps checking tracked deals states.
+func (t *Tracker) start() {
+	defer close(t.finished)
+	for {
+		select {
+		case <-t.ctx.Done():
+			log.Info("graceful shutdown of background deal tracker")
+			return
+		case <-time.After(t.updateInterval):
+			if !t.isLeader() {
+				continue
+			}
+			if err := t.update(); err != nil {
+				log.Errorf("updating tracked deals: %s", err)
+			}
+			if err := t.notifyPending(); err != nil {
+				log.Errorf("notifying pending events: %s", err)
+			}
+		}
+	}
+}
+
+// update checks the state of tracked deals at the tipset hOffset epochs
+// before the head, saving events of the ones which reached a terminal
+// state to be notified.
+func (t *Tracker) update() error {
+	client, cls, err := t.clientBuilder(t.ctx)
+	if err != nil {
+		return fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	chainHead, err := client.ChainHead(t.ctx)
+	if err != nil {
+		return fmt.Errorf("getting chain head: %s", err)
+	}
+	if chainHead.Height()-hOffset <= 0 {
+		return nil
+	}
+	targetTs, err := client.ChainGetTipSetByHeight(t.ctx, chainHead.Height()-hOffset, chainHead.Key())
+	if err != nil {
+		return fmt.Errorf("getting offseted tipset from head: %s", err)
+	}
+
+	// Load the last saved snapshot which is a parent of the target
+	// TipSet, and include deals tracked since then.
+	var snap snapshot
+	if _, err := t.store.LoadAndPrune(t.ctx, targetTs.Key(), &snap); err != nil {
+		return fmt.Errorf("load tipset state: %s", err)
+	}
+	if snap.Deals == nil {
+		snap.Deals = make(map[uint64]trackedDeal)
+	}
+	tracked, err := t.loadTracked()
+	if err != nil {
+		return err
+	}
+	for id, d := range tracked {
+		snap.Deals[id] = d
+	}
+
+	var events []ffs.DealEvent
+	for id, d := range snap.Deals {
+		md, err := client.StateMarketStorageDeal(t.ctx, abi.DealID(id), targetTs.Key())
+		e, err := evaluate(md, err, d, targetTs.Height())
+		if err != nil {
+			log.Warnf("getting on-chain state of deal %d: %s", id, err)
+			continue
+		}
+		if e.State == ffs.DealActive {
+			continue
+		}
+		e.Cid = d.Cid
+		e.DealID = id
+		events = append(events, e)
+		delete(snap.Deals, id)
+	}
+
+	// Save events before the snapshot, so they're evaluated again if
+	// saving fails. Saving the same event twice is harmless.
+	for _, e := range events {
+		log.Infof("deal %d of %s is %s: %s", e.DealID, e.Cid, ffs.DealStateStr[e.State], e.Reason)
+		buf, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshaling deal event: %s", err)
+		}
+		if err := t.events.Put(makeKey(e.DealID), buf); err != nil {
+			return fmt.Errorf("saving deal event in datastore: %s", err)
+		}
+	}
+	if err := t.store.Save(t.ctx, types.NewTipSetKey(targetTs.Cids()...), snap); err != nil {
+		return fmt.Errorf("saving tracked deals state: %s", err)
+	}
+	for _, e := range events {
+		if err := t.ds.Delete(makeKey(e.DealID)); err != nil {
+			return fmt.Errorf("deleting untracked deal from datastore: %s", err)
+		}
+	}
+	return nil
+}
+
+// evaluate returns the state of a deal at height given its on-chain
+// information, or the error of getting it.
+func evaluate(md *api.MarketDeal, err error, d trackedDeal, height abi.ChainEpoch) (ffs.DealEvent, error) {
+	if err != nil {
+		// Deals are deleted from the market state when they
+		// expire or are slashed.
+		if !strings.Contains(err.Error(), "not found") {
+			return ffs.DealEvent{}, err
+		}
+		if int64(height) >= d.EndEpoch {
+			return ffs.DealEvent{
+				State:  ffs.DealExpired,
+				Reason: fmt.Sprintf("deal reached its end epoch %d", d.EndEpoch),
+				Epoch:  d.EndEpoch,
+			}, nil
+		}
+		return ffs.DealEvent{
+			State:  ffs.DealSlashed,
+			Reason: fmt.Sprintf("deal was removed from the market before its end epoch %d", d.EndEpoch),
+			Epoch:  int64(height),
+		}, nil
+	}
+	if md.State.SlashEpoch != -1 {
+		return ffs.DealEvent{
+			State:  ffs.DealSlashed,
+			Reason: fmt.Sprintf("miner was slashed at epoch %d", md.State.SlashEpoch),
+			Epoch:  int64(md.State.SlashEpoch),
+		}, nil
+	}
+	if md.Proposal.EndEpoch <= height {
+		return ffs.DealEvent{
+			State:  ffs.DealExpired,
+			Reason: fmt.Sprintf("deal reached its end epoch %d", md.Proposal.EndEpoch),
+			Epoch:  int64(md.Proposal.EndEpoch),
+		}, nil
+	}
+	return ffs.DealEvent{State: ffs.DealActive}, nil
+}
+
+// notifyPending notifies the events which weren't acknowledged yet.
+func (t *Tracker) notifyPending() error {
+	res, err := t.events.Query(query.Query{})
+	if err != nil {
+		return fmt.Errorf("querying deal events: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var events []ffs.DealEvent
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iterating deal events: %s", r.Error)
+		}
+		var e ffs.DealEvent
+		if err := json.Unmarshal(r.Value, &e); err != nil {
+			return fmt.Errorf("unmarshaling deal event: %s", err)
+		}
+		events = append(events, e)
+	}
+	for _, e := range events {
+		t.notify(e)
+	}
+	return nil
+}
+
+func (t *Tracker) notify(e ffs.DealEvent) {
+	t.lock.Lock()
+	ls := make([]*listener, 0, len(t.listeners))
+	for _, l := range t.listeners {
+		ls = append(ls, l)
+	}
+	t.lock.Unlock()
+	for _, l := range ls {
+		select {
+		case l.c <- e:
+		case <-l.done:
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *Tracker) loadTracked() (map[uint64]trackedDeal, error) {
+	res, err := t.ds.Query(query.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("querying tracked deals: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	deals := make(map[uint64]trackedDeal)
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iterating tracked deals: %s", r.Error)
+		}
+		id, err := strconv.ParseUint(datastore.RawKey(r.Key).Name(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing deal id: %s", err)
+		}
+		var d trackedDeal
+		if err := json.Unmarshal(r.Value, &d); err != nil {
+			return nil, fmt.Errorf("unmarshaling tracked deal: %s", err)
+		}
+		deals[id] = d
+	}
+	return deals, nil
+}
+
+func makeKey(dealID uint64) datastore.Key {
+	return datastore.NewKey(strconv.FormatUint(dealID, 10))
+}
@@ -0,0 +1,125 @@
+package dealtracker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/lotus/fakelotus"
+	"github.com/textileio/powergate/tests"
+)
+
+func TestSlashedAndExpired(t *testing.T) {
+	n, err := fakelotus.New(fakelotus.WithBlockTime(0), fakelotus.WithDealSealingEpochs(1))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, n.Close()) })
+
+	ds := tests.NewTxMapDatastore()
+	tr, err := New(ds, n.Builder(), WithUpdateInterval(time.Hour))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, tr.Close()) })
+	ch := tr.Listen()
+
+	c1, id1, end1 := makeActiveDeal(t, n, "data1")
+	c2, id2, end2 := makeActiveDeal(t, n, "data2")
+	require.NoError(t, tr.Track(c1, id1, end1))
+	require.NoError(t, tr.Track(c2, id2, end2))
+
+	// Deals states are checked with hOffset confirmations.
+	require.NoError(t, n.SlashDeal(abi.DealID(id1)))
+	slashEpoch := n.Height()
+	require.NoError(t, tr.update())
+	require.NoError(t, tr.notifyPending())
+	require.Len(t, ch, 0)
+	n.Advance(int(hOffset))
+	require.NoError(t, tr.update())
+	require.NoError(t, tr.notifyPending())
+	e := <-ch
+	require.Equal(t, ffs.DealEvent{
+		Cid:    c1,
+		DealID: id1,
+		State:  ffs.DealSlashed,
+		Reason: fmt.Sprintf("miner was slashed at epoch %d", slashEpoch),
+		Epoch:  int64(slashEpoch),
+	}, e)
+	require.Len(t, ch, 0)
+
+	// Events are notified until they're acknowledged.
+	require.NoError(t, tr.notifyPending())
+	require.Equal(t, e, <-ch)
+	require.NoError(t, tr.Ack(id1))
+	require.NoError(t, tr.notifyPending())
+	require.Len(t, ch, 0)
+
+	// Deals in a terminal state aren't tracked anymore, and the rest
+	// are loaded from the datastore. Unacknowledged events survive
+	// restarts.
+	require.NoError(t, tr.Close())
+	tr, err = New(ds, n.Builder(), WithUpdateInterval(time.Hour))
+	require.NoError(t, err)
+	ch = tr.Listen()
+	n.Advance(int(end2) - int(n.Height()) + int(hOffset))
+	require.NoError(t, tr.update())
+	require.NoError(t, tr.Close())
+	tr, err = New(ds, n.Builder(), WithUpdateInterval(time.Hour))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, tr.Close()) })
+	ch = tr.Listen()
+	require.NoError(t, tr.notifyPending())
+	e = <-ch
+	require.Equal(t, c2, e.Cid)
+	require.Equal(t, id2, e.DealID)
+	require.Equal(t, ffs.DealExpired, e.State)
+	require.Equal(t, end2, e.Epoch)
+	require.NoError(t, tr.Ack(id2))
+	require.NoError(t, tr.update())
+	require.NoError(t, tr.notifyPending())
+	require.Len(t, ch, 0)
+
+	tr.Unregister(ch)
+	_, ok := tr.listeners[ch]
+	require.False(t, ok)
+}
+
+func makeActiveDeal(t *testing.T, n *fakelotus.Node, data string) (cid.Cid, uint64, int64) {
+	ctx := context.Background()
+	c, cls, err := n.Builder()(ctx)
+	require.NoError(t, err)
+	defer cls()
+
+	root, err := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum([]byte(data))
+	require.NoError(t, err)
+	piece, err := c.ClientDealPieceCID(ctx, root)
+	require.NoError(t, err)
+	miner := n.Miners()[0]
+	mi, err := c.StateMinerInfo(ctx, miner, types.EmptyTSK)
+	require.NoError(t, err)
+	ask, err := c.ClientQueryAsk(ctx, *mi.PeerId, miner)
+	require.NoError(t, err)
+	price := big.Div(big.Mul(ask.Price, big.NewInt(int64(piece.PieceSize))), big.NewInt(1<<30))
+	start := n.Height() + 5
+	p, err := c.ClientStartDeal(ctx, &api.StartDealParams{
+		Data:              &storagemarket.DataRef{Root: root, PieceCid: &piece.PieceCID, PieceSize: piece.PieceSize.Unpadded()},
+		Wallet:            n.DefaultAddress(),
+		Miner:             miner,
+		EpochPrice:        price,
+		MinBlocksDuration: 100,
+		DealStartEpoch:    start,
+	})
+	require.NoError(t, err)
+	n.Advance(2)
+	di, err := c.ClientGetDealInfo(ctx, *p)
+	require.NoError(t, err)
+	require.Equal(t, storagemarket.StorageDealActive, di.State)
+	return root, uint64(di.DealID), int64(start) + 100
+}
@@ -0,0 +1,98 @@
+// Package dirtar converts UnixFS directories to and from tar archives, so
+// they can be streamed through the API.
+package dirtar
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	files "github.com/ipfs/go-ipfs-files"
+)
+
+// Write writes the entries of dir as a tar archive to w. Entry names are
+// relative to dir, so the archive doesn't include dir itself.
+func Write(w io.Writer, dir files.Directory) error {
+	tw, err := files.NewTarWriter(w)
+	if err != nil {
+		return fmt.Errorf("creating tar writer: %s", err)
+	}
+	it := dir.Entries()
+	for it.Next() {
+		if err := tw.WriteFile(it.Node(), it.Name()); err != nil {
+			return fmt.Errorf("writing %s: %s", it.Name(), err)
+		}
+	}
+	if it.Err() != nil {
+		return fmt.Errorf("iterating directory: %s", it.Err())
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing tar writer: %s", err)
+	}
+	return nil
+}
+
+// Extract extracts the tar archive read from r into dir. Only directories,
+// regular files and symlinks are supported. Entries can't be extracted
+// outside of dir, neither by their names nor through symlinks.
+func Extract(r io.Reader, dir string) error {
+	links := make(map[string]struct{})
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading tar header: %s", err)
+		}
+		name := path.Clean("/" + h.Name)[1:]
+		if name == "" {
+			continue
+		}
+		for p := name; p != "."; p = path.Dir(p) {
+			if _, ok := links[p]; ok {
+				return fmt.Errorf("entry %s is within symlink %s", h.Name, p)
+			}
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		switch h.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("creating directory %s: %s", name, err)
+			}
+		case tar.TypeReg:
+			if err := extractFile(tr, target); err != nil {
+				return fmt.Errorf("extracting file %s: %s", name, err)
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return fmt.Errorf("creating directory of %s: %s", name, err)
+			}
+			if err := os.Symlink(h.Linkname, target); err != nil {
+				return fmt.Errorf("creating symlink %s: %s", name, err)
+			}
+			links[name] = struct{}{}
+		default:
+			return fmt.Errorf("entry %s has unsupported type %c", h.Name, h.Typeflag)
+		}
+	}
+}
+
+func extractFile(r io.Reader, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("creating directory: %s", err)
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("creating file: %s", err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing file: %s", err)
+	}
+	return f.Close()
+}
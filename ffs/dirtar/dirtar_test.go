@@ -0,0 +1,78 @@
+package dirtar
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	files "github.com/ipfs/go-ipfs-files"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteExtract(t *testing.T) {
+	dir := files.NewMapDirectory(map[string]files.Node{
+		"a.txt": files.NewBytesFile([]byte("a")),
+		"sub": files.NewMapDirectory(map[string]files.Node{
+			"b.txt": files.NewBytesFile([]byte("b")),
+			"link":  files.NewLinkFile("../a.txt", nil),
+		}),
+	})
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, dir))
+
+	out := tempDir(t)
+	require.NoError(t, Extract(&buf, out))
+	requireFile(t, filepath.Join(out, "a.txt"), "a")
+	requireFile(t, filepath.Join(out, "sub", "b.txt"), "b")
+	target, err := os.Readlink(filepath.Join(out, "sub", "link"))
+	require.NoError(t, err)
+	require.Equal(t, "../a.txt", target)
+}
+
+func TestExtractEscapes(t *testing.T) {
+	t.Run("Name", func(t *testing.T) {
+		out := tempDir(t)
+		r := tarArchive(t, &tar.Header{Name: "../../escaped.txt", Typeflag: tar.TypeReg, Size: 1, Mode: 0644})
+		require.NoError(t, Extract(r, out))
+		requireFile(t, filepath.Join(out, "escaped.txt"), "x")
+	})
+	t.Run("Symlink", func(t *testing.T) {
+		out := tempDir(t)
+		r := tarArchive(t,
+			&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: os.TempDir(), Mode: 0777},
+			&tar.Header{Name: "link/escaped.txt", Typeflag: tar.TypeReg, Size: 1, Mode: 0644},
+		)
+		require.Error(t, Extract(r, out))
+	})
+}
+
+// tarArchive returns a tar archive with headers. Regular files contain "x".
+func tarArchive(t *testing.T, headers ...*tar.Header) *bytes.Buffer {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, h := range headers {
+		require.NoError(t, tw.WriteHeader(h))
+		if h.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte("x"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	return &buf
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "dirtar")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+func requireFile(t *testing.T, path, content string) {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, content, string(data))
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/filecoin-project/go-fil-markets/retrievalmarket"
@@ -12,11 +16,15 @@ import (
 	"github.com/ipfs/go-cid"
 	logger "github.com/ipfs/go-log/v2"
 	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipld/go-ipld-prime"
 	"github.com/textileio/powergate/deals"
 	"github.com/textileio/powergate/deals/module"
 	dealsModule "github.com/textileio/powergate/deals/module"
 	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/dagcar"
+	"github.com/textileio/powergate/ffs/piece"
 	"github.com/textileio/powergate/lotus"
+	"github.com/textileio/powergate/wallet"
 )
 
 const (
@@ -32,7 +40,9 @@ var (
 type FilCold struct {
 	ms             ffs.MinerSelector
 	dm             *dealsModule.Module
+	wm             ffs.WalletManager
 	ipfs           iface.CoreAPI
+	pc             *piece.Cache
 	chain          FilChain
 	l              ffs.JobLogger
 	lsm            *lotus.SyncMonitor
@@ -47,12 +57,15 @@ type FilChain interface {
 	GetHeight(context.Context) (uint64, error)
 }
 
-// New returns a new FilCold instance.
-func New(ms ffs.MinerSelector, dm *dealsModule.Module, ipfs iface.CoreAPI, chain FilChain, l ffs.JobLogger, lsm *lotus.SyncMonitor, minPieceSize uint64, maxParallelDealPreparing int) *FilCold {
+// New returns a new FilCold instance. Pieces of data are calculated
+// and cached with pc.
+func New(ms ffs.MinerSelector, dm *dealsModule.Module, wm ffs.WalletManager, ipfs iface.CoreAPI, pc *piece.Cache, chain FilChain, l ffs.JobLogger, lsm *lotus.SyncMonitor, minPieceSize uint64, maxParallelDealPreparing int) *FilCold {
 	return &FilCold{
 		ms:             ms,
 		dm:             dm,
+		wm:             wm,
 		ipfs:           ipfs,
+		pc:             pc,
 		chain:          chain,
 		l:              l,
 		lsm:            lsm,
@@ -64,7 +77,11 @@ func New(ms ffs.MinerSelector, dm *dealsModule.Module, ipfs iface.CoreAPI, chain
 // Fetch fetches the stored Cid data.The data will be considered available
 // to the underlying blockstore.
 func (fc *FilCold) Fetch(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, waddr string, miners []string, maxPrice uint64, selector string) (ffs.FetchInfo, error) {
-	miner, events, err := fc.dm.Fetch(ctx, waddr, pyCid, piCid, miners)
+	waddr, err := fc.clientAddr(ctx, waddr)
+	if err != nil {
+		return ffs.FetchInfo{}, err
+	}
+	miner, events, err := fc.dm.Fetch(dealsContext(ctx), waddr, pyCid, piCid, miners, maxPrice)
 	if err != nil {
 		return ffs.FetchInfo{}, fmt.Errorf("fetching from deal module: %s", err)
 	}
@@ -81,39 +98,146 @@ func (fc *FilCold) Fetch(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, wad
 	return ffs.FetchInfo{RetrievedMiner: miner, FundsSpent: fundsSpent}, nil
 }
 
-func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid) (abi.PaddedPieceSize, cid.Cid, error) {
+// GetCAR retrieves the complete DAG of the stored Cid from miners, and
+// writes the part of it selected by sel as a CAR file of the given version.
+// If sel is nil, the complete DAG is written. It returns the miner the data
+// was retrieved from.
+func (fc *FilCold) GetCAR(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, waddr string, miners []string, sel ipld.Node, version int, w io.Writer) (string, error) {
+	waddr, err := fc.clientAddr(ctx, waddr)
+	if err != nil {
+		return "", err
+	}
+	miner, r, err := fc.dm.Retrieve(dealsContext(ctx), waddr, pyCid, piCid, miners, true)
+	if err != nil {
+		return "", fmt.Errorf("retrieving from deal module: %s", err)
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("closing retrieved car file: %s", err)
+		}
+	}()
+	// The retrieved file is a CARv1 file of the complete DAG, so it's
+	// copied as is unless the selected part or the version differs.
+	if sel == nil && version == dagcar.V1 {
+		if _, err := io.Copy(w, r); err != nil {
+			return "", fmt.Errorf("writing car file: %s", err)
+		}
+		return miner, nil
+	}
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		return "", fmt.Errorf("retrieved car file can't be read at offsets")
+	}
+	store, err := dagcar.FileStore(ra)
+	if err != nil {
+		return "", fmt.Errorf("indexing retrieved car file: %s", err)
+	}
+	if err := dagcar.Write(ctx, store, pyCid, sel, version, w); err != nil {
+		return "", err
+	}
+	return miner, nil
+}
+
+// dealsContext attributes the deals made with the returned context to
+// the job and retrieval of ctx, if any.
+func dealsContext(ctx context.Context) context.Context {
+	if jid, ok := ctx.Value(ffs.CtxKeyJid).(ffs.JobID); ok {
+		ctx = deals.ContextWithJobID(ctx, string(jid))
+	}
+	if rid, ok := ctx.Value(ffs.CtxRetrievalID).(ffs.RetrievalID); ok {
+		ctx = deals.ContextWithRetrievalID(ctx, string(rid))
+	}
+	return ctx
+}
+
+// clientAddr returns the wallet address to be used as client of deals
+// for addr. Multisig actors can't sign deal proposals, so if addr is a
+// multisig its creator address is used. The multisig funds deals through
+// escrow top-ups to its creator address.
+func (fc *FilCold) clientAddr(ctx context.Context, addr string) (string, error) {
+	mi, err := fc.wm.Multisig(ctx, addr)
+	if err == wallet.ErrMultisigNotFound {
+		return addr, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting multisig information: %s", err)
+	}
+	return mi.Creator, nil
+}
+
+// ensureFunds checks that waddr wallet balance plus its available market escrow
+// can pay for the planned deals, and returns an ffs.ErrInsufficientFunds error
+// otherwise.
+func (fc *FilCold) ensureFunds(ctx context.Context, waddr string, pieceSize abi.PaddedPieceSize, cfgs []deals.StorageDealConfig, duration uint64) error {
+	required := big.NewInt(0)
+	for _, cfg := range cfgs {
+		price := new(big.Int).SetUint64(cfg.EpochPrice)
+		price.Mul(price, new(big.Int).SetUint64(uint64(pieceSize)))
+		price.Div(price, big.NewInt(1<<30))
+		price.Mul(price, new(big.Int).SetUint64(duration))
+		required.Add(required, price)
+	}
+	balance, err := fc.wm.Balance(ctx, waddr)
+	if err != nil {
+		return fmt.Errorf("getting balance of %s: %s", waddr, err)
+	}
+	mb, err := fc.wm.MarketBalance(ctx, waddr)
+	if err != nil {
+		return fmt.Errorf("getting market balance of %s: %s", waddr, err)
+	}
+	available := new(big.Int).Add(balance, mb.Available())
+	if available.Cmp(required) < 0 {
+		return fmt.Errorf("%w: planned deals cost %s attoFIL, but %s has %s attoFIL available (balance %s, available escrow %s)", ffs.ErrInsufficientFunds, required, waddr, available, balance, mb.Available())
+	}
+	return nil
+}
+
+// calculateDealPiece returns the piece of the Cid data, calculating it if
+// it isn't cached.
+func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid, params ffs.ColdParams) (abi.PaddedPieceSize, cid.Cid, error) {
+	cached, ok, err := fc.pc.Get(c)
+	if err != nil {
+		log.Errorf("getting cached piece of %s: %s", c, err)
+	}
+	if ok {
+		finishStep := params.StartStep(ffs.StepPieceCalculation, "cached")
+		finishStep(fmt.Sprintf("piece %s with size %d", cached.PieceCid, cached.PieceSize), nil)
+		fc.l.Log(ctx, "Using cached piece %s.", cached.PieceCid)
+		return cached.PieceSize, cached.PieceCid, nil
+	}
+
 	fc.l.Log(ctx, "Entering deal preprocessing queue...")
+	finishStep := params.StartStep(ffs.StepDealPreparationQueue, "")
 	select {
 	case fc.semaphDealPrep <- struct{}{}:
 	case <-ctx.Done():
-		return 0, cid.Undef, fmt.Errorf("canceled by context")
+		err := fmt.Errorf("canceled by context")
+		finishStep("", err)
+		return 0, cid.Undef, err
 	}
 	defer func() { <-fc.semaphDealPrep }()
-	for {
-		if fc.lsm.SyncHeightDiff() < unsyncedThreshold {
-			break
-		}
-		log.Warnf("backpressure from unsynced Lotus node")
-		select {
-		case <-ctx.Done():
-			return 0, cid.Undef, fmt.Errorf("canceled by context")
-		case <-time.After(time.Minute):
-		}
-	}
+	finishStep("", nil)
+
 	fc.l.Log(ctx, "Calculating piece size...")
-	piece, err := fc.dm.CalculateDealPiece(ctx, c)
+	finishStep = params.StartStep(ffs.StepPieceCalculation, "")
+	piece, err := fc.pc.Calculate(ctx, c)
 	if err != nil {
+		finishStep("", err)
 		return 0, cid.Undef, fmt.Errorf("getting cid cummulative size: %s", err)
 	}
-	return piece.PieceSize, piece.PieceCID, nil
+	finishStep(fmt.Sprintf("piece %s with size %d", piece.PieceCid, piece.PieceSize), nil)
+	return piece.PieceSize, piece.PieceCid, nil
 }
 
 // Store stores a Cid in Filecoin considering the configuration provided. The Cid is retrieved using
 // the DAGService registered on instance creation. It returns a slice of ProposalCids that were correctly
 // started, and a slice of with Proposal Cids rejected. Returned proposed deals can be tracked
 // with the WaitForDeal API.
-func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
-	pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c)
+func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig, params ffs.ColdParams) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
+	if err := params.EnsureDealSource(ctx); err != nil {
+		return nil, nil, 0, fmt.Errorf("making data available for deals: %s", err)
+	}
+	pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c, params)
 	if err != nil {
 		return nil, nil, 0, fmt.Errorf("getting cid cummulative size: %s", err)
 	}
@@ -129,12 +253,17 @@ func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]c
 		MaxPrice:       cfg.MaxPrice,
 		PieceSize:      uint64(pieceSize),
 	}
-	cfgs, err := makeDealConfigs(fc.ms, cfg.RepFactor, f, cfg.FastRetrieval, cfg.DealStartOffset)
+	cfgs, err := makeDealConfigs(fc.ms, cfg.RepFactor, f, cfg.FastRetrieval, cfg.DealStartOffset, params)
 	if err != nil {
 		return nil, nil, 0, fmt.Errorf("making deal configs: %s", err)
 	}
+	if params.EscalationRound > 0 {
+		for i := range cfgs {
+			cfgs[i].EpochPrice = cfg.Escalation.EpochPrice(cfgs[i].EpochPrice, cfg.MaxPrice, params.EscalationRound)
+		}
+	}
 
-	okDeals, failedStartingDeals, err := fc.makeDeals(ctx, c, pieceSize, pieceCid, cfgs, cfg)
+	okDeals, failedStartingDeals, err := fc.makeDeals(ctx, c, pieceSize, pieceCid, cfgs, cfg, params)
 	if err != nil {
 		return nil, nil, 0, fmt.Errorf("starting deals: %s", err)
 	}
@@ -153,6 +282,19 @@ func (fc *FilCold) IsFilDealActive(ctx context.Context, proposalCid cid.Cid) (bo
 	return status == storagemarket.StorageDealActive, nil
 }
 
+// GetDealID returns the on-chain id of a deal, or zero if the deal isn't
+// found or wasn't published yet.
+func (fc *FilCold) GetDealID(ctx context.Context, proposalCid cid.Cid) (uint64, error) {
+	id, err := fc.dm.GetDealID(ctx, proposalCid)
+	if err == module.ErrDealNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("getting deal id for %s: %s", proposalCid, err)
+	}
+	return id, nil
+}
+
 // EnsureRenewals analyzes a FilInfo state for a Cid and executes renewals considering the FilConfig desired configuration.
 // Deal status updates are sent on the provided dealUpdates channel.
 // The caller should close the channel once all calls to EnsureRenewals have returned.
@@ -160,7 +302,7 @@ func (fc *FilCold) IsFilDealActive(ctx context.Context, proposalCid cid.Cid) (bo
 // that got renewed with Renewed=true. New deals from renewals are added to the returned FilInfo.
 // Note: Most probably all this code should change in the future, when Filecoin supports telling the miner which deal is about to
 // expire that we're interested in extending the deal duration. Now we should make a new deal from scratch (send data, etc).
-func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInfo, cfg ffs.FilConfig, dealFinalityTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilInfo, []ffs.DealError, error) {
+func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInfo, cfg ffs.FilConfig, dealFinalityTimeout time.Duration, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams) (ffs.FilInfo, []ffs.DealError, error) {
 	height, err := fc.chain.GetHeight(ctx)
 	if err != nil {
 		return ffs.FilInfo{}, nil, fmt.Errorf("get current filecoin height: %s", err)
@@ -215,7 +357,7 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	// Manually imported doesn't provide the piece size.
 	// Re-calculate it if necessary. If present, just re-use that value.
 	if inf.Size == 0 {
-		pieceSize, _, err := fc.calculateDealPiece(ctx, inf.DataCid)
+		pieceSize, _, err := fc.calculateDealPiece(ctx, inf.DataCid, params)
 		if err != nil {
 			return ffs.FilInfo{}, nil, fmt.Errorf("can't recalculate piece size: %s", err)
 		}
@@ -226,12 +368,13 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	var newDealErrors []ffs.DealError
 	for i, p := range toRenew {
 		var dealError ffs.DealError
-		newProposal, err := fc.renewDeal(ctx, c, abi.PaddedPieceSize(inf.Size), p.PieceCid, p, cfg, dealFinalityTimeout, dealUpdates)
+		newProposal, err := fc.renewDeal(ctx, c, abi.PaddedPieceSize(inf.Size), p.PieceCid, p, cfg, dealFinalityTimeout, dealUpdates, params)
 		if err != nil {
 			if errors.As(err, &dealError) {
 				newDealErrors = append(newDealErrors, dealError)
 				continue
 			}
+			fc.l.LogEvent(ctx, ffs.LogError, "", ffs.DealLogFields(p.Miner, p.ProposalCid, 0), "Renewing deal with miner %s failed: %s", p.Miner, err)
 			continue
 		}
 		newInf.Proposals = append(newInf.Proposals, newProposal)
@@ -241,7 +384,10 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	return newInf, newDealErrors, nil
 }
 
-func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, p ffs.FilStorage, fcfg ffs.FilConfig, waitDealTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
+func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, p ffs.FilStorage, fcfg ffs.FilConfig, waitDealTimeout time.Duration, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams) (ffs.FilStorage, error) {
+	if err := params.EnsureDealSource(ctx); err != nil {
+		return ffs.FilStorage{}, fmt.Errorf("making data available for renewal: %s", err)
+	}
 	f := ffs.MinerSelectorFilter{
 		ExcludedMiners: fcfg.ExcludedMiners,
 		CountryCodes:   fcfg.CountryCodes,
@@ -249,12 +395,12 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, pieceSize abi.Padde
 		MaxPrice:       fcfg.MaxPrice,
 		PieceSize:      uint64(pieceSize),
 	}
-	dealConfig, err := makeDealConfigs(fc.ms, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset)
+	dealConfig, err := makeDealConfigs(fc.ms, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset, params)
 	if err != nil {
 		return ffs.FilStorage{}, fmt.Errorf("making new deal config: %s", err)
 	}
 
-	okDeals, failedStartedDeals, err := fc.makeDeals(ctx, c, pieceSize, pieceCid, dealConfig, fcfg)
+	okDeals, failedStartedDeals, err := fc.makeDeals(ctx, c, pieceSize, pieceCid, dealConfig, fcfg, params)
 	if err != nil {
 		return ffs.FilStorage{}, fmt.Errorf("executing renewed deal: %s", err)
 	}
@@ -262,12 +408,12 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, pieceSize abi.Padde
 		if len(failedStartedDeals) != 1 {
 			return ffs.FilStorage{}, fmt.Errorf("failed started deals must be of size 1, this should never happen")
 		}
-		fc.l.Log(ctx, "Starting renewal deal proposal failed: %s", failedStartedDeals[0].Message)
+		fc.l.LogEvent(ctx, ffs.LogWarn, ffs.StepDealProposal, ffs.DealLogFields(failedStartedDeals[0].Miner, failedStartedDeals[0].ProposalCid, 0), "Starting renewal deal proposal failed: %s", failedStartedDeals[0].Message)
 		return ffs.FilStorage{}, failedStartedDeals[0]
 	}
 
 	var dealError ffs.DealError
-	okDeal, err := fc.WaitForDeal(ctx, c, okDeals[0], waitDealTimeout, dealUpdates)
+	okDeal, err := fc.WaitForDeal(ctx, c, okDeals[0], waitDealTimeout, dealUpdates, params)
 	if err != nil && !errors.As(err, &dealError) {
 		return ffs.FilStorage{}, ffs.DealError{ProposalCid: c, Message: fmt.Sprintf("waiting for renew deal: %s", err)}
 	}
@@ -276,7 +422,7 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, pieceSize abi.Padde
 
 // makeDeals starts deals with the specified miners. It returns a slice with all the ProposalCids
 // that were started successfully, and a slice of DealError with deals that failed to be started.
-func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, cfgs []deals.StorageDealConfig, fcfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, error) {
+func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, cfgs []deals.StorageDealConfig, fcfg ffs.FilConfig, params ffs.ColdParams) ([]cid.Cid, []ffs.DealError, error) {
 	for {
 		if fc.lsm.SyncHeightDiff() < unsyncedThreshold {
 			break
@@ -289,19 +435,49 @@ func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, pieceSize abi.Padde
 		}
 	}
 
+	finishSteps := make(map[string]func(string, error), len(cfgs))
 	for _, cfg := range cfgs {
-		fc.l.Log(ctx, "Proposing deal to miner %s with %d attoFIL per epoch...", cfg.Miner, cfg.EpochPrice)
+		fields := ffs.DealLogFields(cfg.Miner, cid.Undef, 0)
+		fields[ffs.LogFieldPrice] = strconv.FormatUint(cfg.EpochPrice, 10)
+		fc.l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealProposal, fields, "Proposing deal to miner %s with %d attoFIL per epoch...", cfg.Miner, cfg.EpochPrice)
+		finishSteps[cfg.Miner] = params.StartStep(ffs.StepDealProposal, fmt.Sprintf("miner %s with %d attoFIL per epoch", cfg.Miner, cfg.EpochPrice))
+	}
+	failSteps := func(err error) {
+		for _, finish := range finishSteps {
+			finish("", err)
+		}
 	}
 
-	sres, err := fc.dm.Store(ctx, fcfg.Addr, c, pieceSize, pieceCid, cfgs, uint64(fcfg.DealMinDuration))
+	waddr, err := fc.clientAddr(ctx, fcfg.Addr)
+	if err != nil {
+		failSteps(err)
+		return nil, nil, err
+	}
+	if waddr != fcfg.Addr {
+		fc.l.Log(ctx, "Using %s as client address of deals funded by multisig %s.", waddr, fcfg.Addr)
+	}
+	if err := fc.ensureFunds(ctx, waddr, pieceSize, cfgs, uint64(fcfg.DealMinDuration)); err != nil {
+		fc.l.LogEvent(ctx, ffs.LogError, ffs.StepDealProposal, nil, "Not enough funds for planned deals: %s", err)
+		failSteps(err)
+		return nil, nil, err
+	}
+	sres, err := fc.dm.Store(dealsContext(ctx), waddr, c, pieceSize, pieceCid, cfgs, uint64(fcfg.DealMinDuration))
 	if err != nil {
+		failSteps(err)
 		return nil, nil, fmt.Errorf("storing deals in deal module: %s", err)
 	}
 	var okDeals []cid.Cid
 	var failedDeals []ffs.DealError
 	for _, r := range sres {
+		if finish, ok := finishSteps[r.Config.Miner]; ok {
+			if r.Success {
+				finish(fmt.Sprintf("proposal %s", r.ProposalCid), nil)
+			} else {
+				finish("", errors.New(r.Message))
+			}
+		}
 		if !r.Success {
-			fc.l.Log(ctx, "Proposal with miner %s failed: %s", r.Config.Miner, r.Message)
+			fc.l.LogEvent(ctx, ffs.LogWarn, ffs.StepDealProposal, ffs.DealLogFields(r.Config.Miner, r.ProposalCid, 0), "Proposal with miner %s failed: %s", r.Config.Miner, r.Message)
 			log.Warnf("failed store result: %s", r.Message)
 			de := ffs.DealError{
 				ProposalCid: r.ProposalCid,
@@ -322,7 +498,7 @@ func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, pieceSize abi.Padde
 // If the deal finishes successfully it returns a FilStorage result.
 // If the deal finished with error, it returns a ffs.DealError error
 // result, so it should be considered in error handling.
-func (fc *FilCold) WaitForDeal(ctx context.Context, c cid.Cid, proposal cid.Cid, timeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
+func (fc *FilCold) WaitForDeal(ctx context.Context, c cid.Cid, proposal cid.Cid, timeout time.Duration, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams) (ffs.FilStorage, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	chDi, err := fc.dm.Watch(ctx, []cid.Cid{proposal})
@@ -331,17 +507,24 @@ func (fc *FilCold) WaitForDeal(ctx context.Context, c cid.Cid, proposal cid.Cid,
 	}
 
 	var last deals.StorageDealInfo
+	finishStep := func(string, error) {}
+	defer func() { finishStep("", fmt.Errorf("stopped watching deal")) }()
 Loop:
 	for {
 		select {
 		case <-time.After(timeout):
 			msg := fmt.Sprintf("DealID %d with miner %s tracking timed out after waiting for %.0f hours.", last.DealID, last.Miner, timeout.Hours())
-			fc.l.Log(ctx, msg)
+			fc.l.LogEvent(ctx, ffs.LogError, ffs.StepDealState, ffs.DealLogFields(last.Miner, proposal, last.DealID), msg)
+			finishStep("", errors.New(msg))
 			return ffs.FilStorage{}, ffs.DealError{ProposalCid: proposal, Miner: last.Miner, Message: msg}
 		case di, ok := <-chDi:
 			if !ok {
 				break Loop
 			}
+			if di.StateID != last.StateID || last.ProposalCid == cid.Undef {
+				finishStep(storagemarket.DealStates[di.StateID], nil)
+				finishStep = params.StartStep(ffs.StepDealState, fmt.Sprintf("proposal %s with miner %s in %s", proposal, di.Miner, storagemarket.DealStates[di.StateID]))
+			}
 			last = di
 			select {
 			case dealUpdates <- di:
@@ -358,20 +541,23 @@ Loop:
 					ActivationEpoch: di.ActivationEpoch,
 					StartEpoch:      di.StartEpoch,
 					EpochPrice:      di.PricePerEpoch,
+					DealID:          di.DealID,
 				}
-				fc.l.Log(ctx, "Deal %d with miner %s is active on-chain", di.DealID, di.Miner)
+				fc.l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, di.DealID), "Deal %d with miner %s is active on-chain", di.DealID, di.Miner)
+				finishStep(fmt.Sprintf("deal %d active", di.DealID), nil)
 
 				return activeProposal, nil
 			case storagemarket.StorageDealError, storagemarket.StorageDealFailing:
 				log.Errorf("deal %d & proposal %s failed with state %s: %s", di.DealID, proposal, storagemarket.DealStates[di.StateID], di.Message)
-				fc.l.Log(ctx, "DealID %d with miner %s failed and won't be active on-chain: %s", di.DealID, di.Miner, di.Message)
+				fc.l.LogEvent(ctx, ffs.LogError, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, di.DealID), "DealID %d with miner %s failed and won't be active on-chain: %s", di.DealID, di.Miner, di.Message)
+				finishStep("", errors.New(di.Message))
 
 				return ffs.FilStorage{}, ffs.DealError{ProposalCid: di.ProposalCid, Miner: di.Miner, Message: di.Message}
 			default:
 				if di.DealID != 0 {
-					fc.l.Log(ctx, "Deal %d with miner %s changed state to %s", di.DealID, di.Miner, storagemarket.DealStates[di.StateID])
+					fc.l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, di.DealID), "Deal %d with miner %s changed state to %s", di.DealID, di.Miner, storagemarket.DealStates[di.StateID])
 				} else {
-					fc.l.Log(ctx, "Deal with miner %s changed state to %s", di.Miner, storagemarket.DealStates[di.StateID])
+					fc.l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, 0), "Deal with miner %s changed state to %s", di.Miner, storagemarket.DealStates[di.StateID])
 				}
 			}
 		}
@@ -379,11 +565,18 @@ Loop:
 	return ffs.FilStorage{}, fmt.Errorf("aborted due to cancellation")
 }
 
-func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64) ([]deals.StorageDealConfig, error) {
+func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64, params ffs.ColdParams) ([]deals.StorageDealConfig, error) {
+	finishStep := params.StartStep(ffs.StepMinerSelection, fmt.Sprintf("%d miners", cntMiners))
 	mps, err := ms.GetMiners(cntMiners, f)
 	if err != nil {
+		finishStep("", err)
 		return nil, fmt.Errorf("getting miners from minerselector: %s", err)
 	}
+	addrs := make([]string, len(mps))
+	for i, m := range mps {
+		addrs[i] = m.Addr
+	}
+	finishStep(strings.Join(addrs, ", "), nil)
 	res := make([]deals.StorageDealConfig, len(mps))
 	for i, m := range mps {
 		res[i] = deals.StorageDealConfig{
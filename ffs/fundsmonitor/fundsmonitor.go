@@ -0,0 +1,418 @@
+package fundsmonitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/ffs"
+)
+
+var (
+	log = logging.Logger("ffs-fundsmonitor")
+
+	// topUpCooldown is the minimum time between top-ups of the same
+	// address, so pending top-ups are executed before sending new ones.
+	topUpCooldown = time.Minute * 30
+
+	dsBaseEvent = datastore.NewKey("event")
+)
+
+// AddrsLister lists the addresses to be monitored.
+type AddrsLister interface {
+	ManagedAddrs() ([]string, error)
+}
+
+// Config configures a Monitor.
+type Config struct {
+	// Interval is the time between checks of all addresses.
+	Interval time.Duration
+	// Threshold is the amount of funds, considering the wallet balance
+	// and available market escrow, below which an address is low in funds.
+	Threshold *big.Int
+	// TopUpAmount is the amount sent from the master address to addresses
+	// low in funds. Nil or zero disables automatic top-ups.
+	TopUpAmount *big.Int
+	// MaxTopUpPerAddr is the maximum amount topped up to a single address
+	// in the last 24hs. Nil means no limit.
+	MaxTopUpPerAddr *big.Int
+	// MaxTopUpTotal is the maximum amount topped up to all addresses in
+	// the last 24hs. Nil means no limit.
+	MaxTopUpTotal *big.Int
+	// EventRetention is how long events are kept. It should be at least
+	// 24hs, since top-up limits are enforced using recent events. Zero
+	// keeps events forever.
+	EventRetention time.Duration
+	// IsLeader returns false if another instance sharing the datastore
+	// monitors addresses, in which case checks are skipped. Nil means
+	// checks always run.
+	IsLeader func() bool
+}
+
+// EventKind is the kind of an Event.
+type EventKind int
+
+const (
+	// EventLowFunds indicates the address funds fell below the threshold.
+	EventLowFunds EventKind = iota
+	// EventFundsRecovered indicates the address funds are again above the threshold.
+	EventFundsRecovered
+	// EventTopUp indicates funds were sent from the master address.
+	EventTopUp
+	// EventTopUpLimitReached indicates an address low in funds can't be topped up
+	// without exceeding the configured limits.
+	EventTopUpLimitReached
+)
+
+// EventKindStr maps EventKind to describing string.
+var EventKindStr = map[EventKind]string{
+	EventLowFunds:          "LowFunds",
+	EventFundsRecovered:    "FundsRecovered",
+	EventTopUp:             "TopUp",
+	EventTopUpLimitReached: "TopUpLimitReached",
+}
+
+// Event is a change in the funds of a monitored address.
+type Event struct {
+	Addr string
+	Kind EventKind
+	// Funds is the wallet balance plus available escrow at the time of the event.
+	Funds *big.Int
+	// Amount and MessageCid are only set for EventTopUp.
+	Amount     *big.Int
+	MessageCid cid.Cid
+	Time       int64
+}
+
+// AddrStatus is the last known funds status of a monitored address.
+type AddrStatus struct {
+	Addr            string
+	Balance         *big.Int
+	EscrowAvailable *big.Int
+	EscrowLocked    *big.Int
+	Low             bool
+	LastTopUp       int64
+	LastChecked     int64
+}
+
+// Monitor periodically checks the wallet balance and market escrow of
+// managed addresses, records events when they're low in funds, and
+// optionally tops them up from the master address.
+type Monitor struct {
+	ds   datastore.Datastore
+	wm   ffs.WalletManager
+	al   AddrsLister
+	conf Config
+
+	lock     sync.Mutex
+	status   map[string]AddrStatus
+	limitHit map[string]bool
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+// New returns a new Monitor, which starts checking addresses in the background.
+func New(ds datastore.Datastore, wm ffs.WalletManager, al AddrsLister, conf Config) (*Monitor, error) {
+	if conf.Interval <= 0 {
+		return nil, fmt.Errorf("interval should be positive")
+	}
+	if conf.Threshold == nil || conf.Threshold.Sign() <= 0 {
+		return nil, fmt.Errorf("threshold should be positive")
+	}
+	topUp := conf.TopUpAmount != nil && conf.TopUpAmount.Sign() > 0
+	if topUp && wm.MasterAddr() == address.Undef {
+		return nil, fmt.Errorf("automatic top-ups require a master address")
+	}
+	if conf.EventRetention != 0 && conf.EventRetention < time.Hour*24 {
+		return nil, fmt.Errorf("event retention should be at least 24hs")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Monitor{
+		ds:       ds,
+		wm:       wm,
+		al:       al,
+		conf:     conf,
+		status:   make(map[string]AddrStatus),
+		limitHit: make(map[string]bool),
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	go m.run()
+	return m, nil
+}
+
+// Status returns the last known funds status of monitored addresses.
+func (m *Monitor) Status() []AddrStatus {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := make([]AddrStatus, 0, len(m.status))
+	for _, s := range m.status {
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Addr < res[j].Addr })
+	return res
+}
+
+// Events returns recorded events since t, most recent first.
+func (m *Monitor) Events(since time.Time) ([]Event, error) {
+	q := query.Query{
+		Prefix: dsBaseEvent.String(),
+		Orders: []query.Order{query.OrderByKeyDescending{}},
+	}
+	res, err := m.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying events: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var ret []Event
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iterating events: %s", r.Error)
+		}
+		var e Event
+		if err := json.Unmarshal(r.Value, &e); err != nil {
+			return nil, fmt.Errorf("unmarshaling event: %s", err)
+		}
+		if e.Time < since.Unix() {
+			break
+		}
+		ret = append(ret, e)
+	}
+	return ret, nil
+}
+
+// Close closes the Monitor.
+func (m *Monitor) Close() error {
+	m.cancel()
+	<-m.finished
+	return nil
+}
+
+func (m *Monitor) run() {
+	defer close(m.finished)
+	for {
+		if m.conf.IsLeader == nil || m.conf.IsLeader() {
+			if err := m.checkAll(m.ctx); err != nil {
+				log.Errorf("checking funds: %s", err)
+			}
+			if m.conf.EventRetention > 0 {
+				if err := m.pruneEvents(time.Now().Add(-m.conf.EventRetention)); err != nil {
+					log.Errorf("pruning events: %s", err)
+				}
+			}
+		}
+		select {
+		case <-m.ctx.Done():
+			log.Info("graceful shutdown of funds monitor")
+			return
+		case <-time.After(m.conf.Interval):
+		}
+	}
+}
+
+func (m *Monitor) checkAll(ctx context.Context) error {
+	addrs, err := m.al.ManagedAddrs()
+	if err != nil {
+		return fmt.Errorf("listing managed addresses: %s", err)
+	}
+	for _, addr := range addrs {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := m.check(ctx, addr); err != nil {
+			log.Errorf("checking funds of %s: %s", addr, err)
+		}
+	}
+	return nil
+}
+
+func (m *Monitor) check(ctx context.Context, addr string) error {
+	balance, err := m.wm.Balance(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("getting balance: %s", err)
+	}
+	mb, err := m.wm.MarketBalance(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("getting market balance: %s", err)
+	}
+	funds := new(big.Int).Add(balance, mb.Available())
+	now := time.Now()
+
+	m.lock.Lock()
+	prev, known := m.status[addr]
+	m.lock.Unlock()
+
+	st := AddrStatus{
+		Addr:            addr,
+		Balance:         balance,
+		EscrowAvailable: mb.Available(),
+		EscrowLocked:    mb.Locked,
+		Low:             funds.Cmp(m.conf.Threshold) < 0,
+		LastTopUp:       prev.LastTopUp,
+		LastChecked:     now.Unix(),
+	}
+	switch {
+	case st.Low && (!known || !prev.Low):
+		log.Warnf("address %s is low in funds: %s attoFIL below threshold %s", addr, funds, m.conf.Threshold)
+		if err := m.putEvent(Event{Addr: addr, Kind: EventLowFunds, Funds: funds, Time: now.Unix()}); err != nil {
+			return err
+		}
+	case !st.Low && known && prev.Low:
+		log.Infof("address %s funds recovered: %s attoFIL", addr, funds)
+		m.lock.Lock()
+		delete(m.limitHit, addr)
+		m.lock.Unlock()
+		if err := m.putEvent(Event{Addr: addr, Kind: EventFundsRecovered, Funds: funds, Time: now.Unix()}); err != nil {
+			return err
+		}
+	}
+	if st.Low {
+		toppedUp, err := m.topUp(ctx, addr, funds, now, st.LastTopUp)
+		if err != nil {
+			log.Errorf("topping up %s: %s", addr, err)
+		}
+		if toppedUp {
+			st.LastTopUp = now.Unix()
+		}
+	}
+
+	m.lock.Lock()
+	m.status[addr] = st
+	m.lock.Unlock()
+	return nil
+}
+
+// topUp sends funds from the master address to addr within the configured
+// limits. It returns true if funds were sent.
+func (m *Monitor) topUp(ctx context.Context, addr string, funds *big.Int, now time.Time, lastTopUp int64) (bool, error) {
+	if m.conf.TopUpAmount == nil || m.conf.TopUpAmount.Sign() <= 0 {
+		return false, nil
+	}
+	master := m.wm.MasterAddr()
+	if addr == master.String() {
+		return false, nil
+	}
+	amount, err := m.allowedTopUp(addr, now)
+	if err != nil {
+		return false, err
+	}
+	if amount.Sign() <= 0 {
+		m.lock.Lock()
+		hit := m.limitHit[addr]
+		m.limitHit[addr] = true
+		m.lock.Unlock()
+		if !hit {
+			log.Warnf("address %s can't be topped up without exceeding limits", addr)
+			return false, m.putEvent(Event{Addr: addr, Kind: EventTopUpLimitReached, Funds: funds, Time: now.Unix()})
+		}
+		return false, nil
+	}
+	if now.Sub(time.Unix(lastTopUp, 0)) < topUpCooldown {
+		return false, nil
+	}
+
+	c, err := m.wm.SendFil(ctx, master.String(), addr, amount)
+	if err != nil {
+		return false, fmt.Errorf("sending funds from master address: %s", err)
+	}
+	log.Infof("topped up %s with %s attoFIL in message %s", addr, amount, c)
+	e := Event{Addr: addr, Kind: EventTopUp, Funds: funds, Amount: amount, MessageCid: c, Time: now.Unix()}
+	return true, m.putEvent(e)
+}
+
+// allowedTopUp returns the amount that can be topped up to addr without
+// exceeding the configured limits.
+func (m *Monitor) allowedTopUp(addr string, now time.Time) (*big.Int, error) {
+	events, err := m.Events(now.Add(-time.Hour * 24))
+	if err != nil {
+		return nil, err
+	}
+	addrTotal, total := big.NewInt(0), big.NewInt(0)
+	for _, e := range events {
+		if e.Kind != EventTopUp {
+			continue
+		}
+		total.Add(total, e.Amount)
+		if e.Addr == addr {
+			addrTotal.Add(addrTotal, e.Amount)
+		}
+	}
+	amount := new(big.Int).Set(m.conf.TopUpAmount)
+	if m.conf.MaxTopUpPerAddr != nil {
+		amount = minAmount(amount, new(big.Int).Sub(m.conf.MaxTopUpPerAddr, addrTotal))
+	}
+	if m.conf.MaxTopUpTotal != nil {
+		amount = minAmount(amount, new(big.Int).Sub(m.conf.MaxTopUpTotal, total))
+	}
+	return amount, nil
+}
+
+// pruneEvents deletes events recorded before t.
+func (m *Monitor) pruneEvents(t time.Time) error {
+	q := query.Query{
+		Prefix:   dsBaseEvent.String(),
+		Orders:   []query.Order{query.OrderByKey{}},
+		KeysOnly: true,
+	}
+	res, err := m.ds.Query(q)
+	if err != nil {
+		return fmt.Errorf("querying events: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var expired []datastore.Key
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iterating events: %s", r.Error)
+		}
+		key := datastore.NewKey(r.Key)
+		nanos, err := strconv.ParseInt(key.List()[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("parsing time of event %s: %s", key, err)
+		}
+		if nanos >= t.UnixNano() {
+			break
+		}
+		expired = append(expired, key)
+	}
+	for _, k := range expired {
+		if err := m.ds.Delete(k); err != nil {
+			return fmt.Errorf("deleting event %s: %s", k, err)
+		}
+	}
+	if len(expired) > 0 {
+		log.Infof("pruned %d events", len(expired))
+	}
+	return nil
+}
+
+func (m *Monitor) putEvent(e Event) error {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshaling event: %s", err)
+	}
+	key := dsBaseEvent.ChildString(fmt.Sprintf("%020d", time.Now().UnixNano())).ChildString(e.Addr).ChildString(EventKindStr[e.Kind])
+	if err := m.ds.Put(key, buf); err != nil {
+		return fmt.Errorf("putting event: %s", err)
+	}
+	return nil
+}
+
+func minAmount(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,211 @@
+package fundsmonitor
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
+)
+
+func TestLowFundsEvents(t *testing.T) {
+	t.Parallel()
+	wm := newWalletManagerMock(t)
+	wm.setBalance("t1a", 50)
+	wm.setBalance("t1b", 500)
+
+	m, err := New(tests.NewTxMapDatastore(), wm, addrs{"t1a", "t1b"}, Config{
+		Interval:  time.Millisecond * 10,
+		Threshold: big.NewInt(100),
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+
+	require.Eventually(t, func() bool {
+		st := m.Status()
+		return len(st) == 2 && st[0].Low && !st[1].Low
+	}, time.Second, time.Millisecond*10)
+
+	// Escrow available funds are considered.
+	wm.setEscrow("t1a", 100, 40)
+	require.Eventually(t, func() bool { return !m.Status()[0].Low }, time.Second, time.Millisecond*10)
+
+	events, err := m.Events(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, EventFundsRecovered, events[0].Kind)
+	require.Equal(t, big.NewInt(110), events[0].Funds)
+	require.Equal(t, EventLowFunds, events[1].Kind)
+	require.Equal(t, "t1a", events[1].Addr)
+	require.Empty(t, wm.sent())
+}
+
+func TestTopUpLimits(t *testing.T) {
+	t.Parallel()
+	wm := newWalletManagerMock(t)
+	wm.setBalance("t1a", 0)
+	wm.setBalance("t1b", 0)
+
+	m, err := New(tests.NewTxMapDatastore(), wm, addrs{"t1a", "t1b"}, Config{
+		Interval:        time.Millisecond * 10,
+		Threshold:       big.NewInt(100),
+		TopUpAmount:     big.NewInt(80),
+		MaxTopUpPerAddr: big.NewInt(1000),
+		MaxTopUpTotal:   big.NewInt(120),
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+
+	// The first address gets the full amount, and the second one
+	// what's left until the total limit.
+	require.Eventually(t, func() bool { return len(wm.sent()) == 2 }, time.Second, time.Millisecond*10)
+	sent := wm.sent()
+	require.Equal(t, big.NewInt(80), sent["t1a"])
+	require.Equal(t, big.NewInt(40), sent["t1b"])
+
+	require.Eventually(t, func() bool {
+		events, err := m.Events(time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		for _, e := range events {
+			if e.Kind == EventTopUpLimitReached {
+				return true
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond*10)
+	require.Len(t, wm.sent(), 2)
+}
+
+func TestTopUpRequiresMaster(t *testing.T) {
+	t.Parallel()
+	wm := newWalletManagerMock(t)
+	wm.master = address.Undef
+	_, err := New(tests.NewTxMapDatastore(), wm, addrs{}, Config{
+		Interval:    time.Second,
+		Threshold:   big.NewInt(100),
+		TopUpAmount: big.NewInt(10),
+	})
+	require.Error(t, err)
+}
+
+func TestPruneEvents(t *testing.T) {
+	t.Parallel()
+	wm := newWalletManagerMock(t)
+	_, err := New(tests.NewTxMapDatastore(), wm, addrs{}, Config{
+		Interval:       time.Second,
+		Threshold:      big.NewInt(100),
+		EventRetention: time.Hour,
+	})
+	require.Error(t, err)
+
+	m, err := New(tests.NewTxMapDatastore(), wm, addrs{}, Config{
+		Interval:       time.Hour,
+		Threshold:      big.NewInt(100),
+		EventRetention: time.Hour * 24,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+
+	require.NoError(t, m.putEvent(Event{Addr: "t1a", Kind: EventLowFunds, Funds: big.NewInt(0), Time: time.Now().Unix()}))
+	cutoff := time.Now()
+	require.NoError(t, m.putEvent(Event{Addr: "t1b", Kind: EventLowFunds, Funds: big.NewInt(0), Time: time.Now().Unix()}))
+	require.NoError(t, m.pruneEvents(cutoff))
+
+	events, err := m.Events(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "t1b", events[0].Addr)
+}
+
+type addrs []string
+
+func (a addrs) ManagedAddrs() ([]string, error) {
+	return a, nil
+}
+
+type walletManagerMock struct {
+	ffs.WalletManager
+
+	t      *testing.T
+	master address.Address
+
+	lock     sync.Mutex
+	balances map[string]*big.Int
+	escrows  map[string]wallet.MarketBalance
+	topUps   map[string]*big.Int
+}
+
+func newWalletManagerMock(t *testing.T) *walletManagerMock {
+	master, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	return &walletManagerMock{
+		t:        t,
+		master:   master,
+		balances: make(map[string]*big.Int),
+		escrows:  make(map[string]wallet.MarketBalance),
+		topUps:   make(map[string]*big.Int),
+	}
+}
+
+func (wm *walletManagerMock) setBalance(addr string, amt int64) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	wm.balances[addr] = big.NewInt(amt)
+}
+
+func (wm *walletManagerMock) setEscrow(addr string, escrow, locked int64) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	wm.escrows[addr] = wallet.MarketBalance{Escrow: big.NewInt(escrow), Locked: big.NewInt(locked)}
+}
+
+func (wm *walletManagerMock) sent() map[string]*big.Int {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	res := make(map[string]*big.Int, len(wm.topUps))
+	for k, v := range wm.topUps {
+		res[k] = v
+	}
+	return res
+}
+
+func (wm *walletManagerMock) MasterAddr() address.Address {
+	return wm.master
+}
+
+func (wm *walletManagerMock) Balance(ctx context.Context, addr string) (*big.Int, error) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	return new(big.Int).Set(wm.balances[addr]), nil
+}
+
+func (wm *walletManagerMock) MarketBalance(ctx context.Context, addr string) (wallet.MarketBalance, error) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	mb, ok := wm.escrows[addr]
+	if !ok {
+		return wallet.MarketBalance{Escrow: big.NewInt(0), Locked: big.NewInt(0)}, nil
+	}
+	return mb, nil
+}
+
+// SendFil records the top-up but doesn't change the balance, so the
+// address stays low in funds.
+func (wm *walletManagerMock) SendFil(ctx context.Context, from, to string, amount *big.Int) (cid.Cid, error) {
+	require.Equal(wm.t, wm.master.String(), from)
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	if _, ok := wm.topUps[to]; !ok {
+		wm.topUps[to] = big.NewInt(0)
+	}
+	wm.topUps[to].Add(wm.topUps[to], amount)
+	return util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+}
@@ -7,14 +7,18 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/ipfs/go-cid"
+	files "github.com/ipfs/go-ipfs-files"
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/ffs/api"
+	"github.com/textileio/powergate/ffs/dirtar"
 	it "github.com/textileio/powergate/ffs/integrationtest"
 	"github.com/textileio/powergate/tests"
 	"github.com/textileio/powergate/util"
@@ -91,6 +95,49 @@ func TestGet(t *testing.T) {
 	})
 }
 
+func TestGetFolder(t *testing.T) {
+	t.Parallel()
+
+	tests.RunFlaky(t, func(t *tests.FlakyT) {
+		ctx := context.Background()
+		ipfs, _, fapi, cls := it.NewAPI(t, 1)
+		defer cls()
+
+		folder := files.NewMapDirectory(map[string]files.Node{
+			"a.txt": files.NewBytesFile([]byte("a")),
+			"sub": files.NewMapDirectory(map[string]files.Node{
+				"b.txt": files.NewBytesFile([]byte("b")),
+			}),
+		})
+		p, err := ipfs.Unixfs().Add(ctx, folder, options.Unixfs.Pin(false))
+		require.NoError(t, err)
+		cid := p.Cid()
+		jid, err := fapi.PushStorageConfig(cid)
+		require.NoError(t, err)
+		it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+
+		r, isDir, err := fapi.GetPath(ctx, cid, "")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, r.Close()) }()
+		require.True(t, isDir)
+		dir, err := ioutil.TempDir("", "getfolder")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, os.RemoveAll(dir)) }()
+		require.NoError(t, dirtar.Extract(r, dir))
+		fetched, err := ioutil.ReadFile(filepath.Join(dir, "sub", "b.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "b", string(fetched))
+
+		r2, isDir, err := fapi.GetPath(ctx, cid, "sub/b.txt")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, r2.Close()) }()
+		require.False(t, isDir)
+		fetched, err = ioutil.ReadAll(r2)
+		require.NoError(t, err)
+		require.Equal(t, "b", string(fetched))
+	})
+}
+
 func TestDealConsistency(t *testing.T) {
 	tests.RunFlaky(t, func(t *tests.FlakyT) {
 		ipfs, _, fapi, cls := it.NewAPI(t, 1)
@@ -25,6 +25,7 @@ import (
 	"github.com/textileio/powergate/ffs/joblogger"
 	"github.com/textileio/powergate/ffs/manager"
 	"github.com/textileio/powergate/ffs/minerselector/fixed"
+	"github.com/textileio/powergate/ffs/piece"
 	"github.com/textileio/powergate/ffs/scheduler"
 	"github.com/textileio/powergate/filchain"
 	"github.com/textileio/powergate/lotus"
@@ -137,18 +138,19 @@ func NewCustomFFSManager(t require.TestingT, ds datastore.TxnDatastore, cb lotus
 	require.NoError(t, err)
 
 	fchain := filchain.New(cb)
-	l := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger"))
+	l, err := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger"))
+	require.NoError(t, err)
 	lsm, err := lotus.NewSyncMonitor(cb)
 	require.NoError(t, err)
-	cl := filcold.New(ms, dm, ipfsClient, fchain, l, lsm, minimumPieceSize, 1)
+	wm, err := walletModule.New(txndstr.Wrap(ds, "wallet"), cb, masterAddr, *big.NewInt(iWalletBal), false, "")
+	require.NoError(t, err)
+	pc := piece.New(txndstr.Wrap(ds, "ffs/piece"), ipfsClient.Dag(), 1)
+	cl := filcold.New(ms, dm, wm, ipfsClient, pc, fchain, l, lsm, minimumPieceSize, 1)
 	hl, err := coreipfs.New(ipfsClient, l)
 	require.NoError(t, err)
 	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hl, cl, 10, time.Minute*10, nil)
 	require.NoError(t, err)
 
-	wm, err := walletModule.New(cb, masterAddr, *big.NewInt(iWalletBal), false, "")
-	require.NoError(t, err)
-
 	manager, err := manager.New(ds, wm, dm, sched, false, true)
 	require.NoError(t, err)
 	err = manager.SetDefaultStorageConfig(ffs.StorageConfig{
@@ -244,18 +246,18 @@ func RequireStorageConfig(t require.TestingT, fapi *api.API, c cid.Cid, config *
 // RequireStorageDealRecord checks that a storage deal record exist for a cid.
 func RequireStorageDealRecord(t require.TestingT, fapi *api.API, c cid.Cid) {
 	time.Sleep(time.Second)
-	recs, err := fapi.StorageDealRecords(deals.WithIncludeFinal(true))
+	page, err := fapi.StorageDealRecords(deals.WithIncludeFinal(true))
 	require.NoError(t, err)
-	require.Len(t, recs, 1)
-	require.Equal(t, c, recs[0].RootCid)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, c, page.Records[0].RootCid)
 }
 
 // RequireRetrievalDealRecord checks that a retrieval deal record exits for a cid.
 func RequireRetrievalDealRecord(t require.TestingT, fapi *api.API, c cid.Cid) {
-	recs, err := fapi.RetrievalDealRecords()
+	page, err := fapi.RetrievalDealRecords()
 	require.NoError(t, err)
-	require.Len(t, recs, 1)
-	require.Equal(t, c, recs[0].DealInfo.RootCid)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, c, page.Records[0].DealInfo.RootCid)
 }
 
 // RandomBytes returns a slice of random bytes of a desired size.
@@ -2,6 +2,7 @@ package wallet
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"os"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/textileio/powergate/ffs/api"
 	it "github.com/textileio/powergate/ffs/integrationtest"
 	"github.com/textileio/powergate/util"
+	walletTypes "github.com/textileio/powergate/wallet"
 )
 
 const (
@@ -103,10 +105,166 @@ func TestSendFil(t *testing.T) {
 
 	require.Eventually(t, hasInitialBal, time.Second*5, time.Second)
 
-	err = fapi.SendFil(ctx, addr1, addr2, big.NewInt(amt))
+	msgCid, _, err := fapi.SendFil(ctx, addr1, addr2, big.NewInt(amt))
 	require.NoError(t, err)
 
 	require.Eventually(t, hasNewBal, time.Second*5, time.Second)
+
+	txs, err := fapi.Transactions(ctx, addr1)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, msgCid, txs[0].MessageCid)
+	require.Equal(t, addr1, txs[0].From)
+	require.Equal(t, addr2, txs[0].To)
+	require.Equal(t, big.NewInt(amt), txs[0].Amount)
+
+	isConfirmed := func() bool {
+		txs, err := fapi.Transactions(ctx, addr2)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		return txs[0].Status == walletTypes.TxConfirmed
+	}
+	require.Eventually(t, isConfirmed, time.Second*10, time.Second)
+}
+
+func TestSendFilSpendPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, _, fapi, cls := it.NewAPI(t, 1)
+	defer cls()
+
+	addr1 := fapi.Addrs()[0].Addr
+	addr2, err := fapi.NewAddr(ctx, "addr2")
+	require.NoError(t, err)
+	addr3, err := fapi.NewAddr(ctx, "addr3")
+	require.NoError(t, err)
+
+	policy := api.SpendPolicy{
+		MaxPerTx:            big.NewInt(100),
+		MaxPerDay:           big.NewInt(150),
+		AllowedDestinations: []string{addr2},
+		ApprovalThreshold:   big.NewInt(50),
+	}
+	err = fapi.SetSpendPolicy(addr1, policy)
+	require.NoError(t, err)
+
+	// Above max per transaction.
+	_, _, err = fapi.SendFil(ctx, addr1, addr2, big.NewInt(101))
+	require.True(t, errors.Is(err, api.ErrSpendPolicyViolation))
+
+	// Not allowed destination.
+	_, _, err = fapi.SendFil(ctx, addr1, addr3, big.NewInt(1))
+	require.True(t, errors.Is(err, api.ErrSpendPolicyViolation))
+
+	// Below approval threshold is sent immediately.
+	c, srID, err := fapi.SendFil(ctx, addr1, addr2, big.NewInt(40))
+	require.NoError(t, err)
+	require.True(t, c.Defined())
+	require.Empty(t, srID)
+
+	// Above approval threshold waits for approval.
+	c, srID, err = fapi.SendFil(ctx, addr1, addr2, big.NewInt(90))
+	require.NoError(t, err)
+	require.False(t, c.Defined())
+	require.NotEmpty(t, srID)
+	srs, err := fapi.SendRequests()
+	require.NoError(t, err)
+	require.Len(t, srs, 1)
+	require.Equal(t, srID, srs[0].ID)
+
+	c, err = fapi.ApproveSendRequest(ctx, srID)
+	require.NoError(t, err)
+	require.True(t, c.Defined())
+	srs, err = fapi.SendRequests()
+	require.NoError(t, err)
+	require.Empty(t, srs)
+
+	// Above max per day considering previous transfers.
+	_, err = fapi.SendFilApproved(ctx, addr1, addr2, big.NewInt(30))
+	require.True(t, errors.Is(err, api.ErrSpendPolicyViolation))
+
+	// Rejected requests aren't sent.
+	_, srID, err = fapi.SendFil(ctx, addr1, addr2, big.NewInt(60))
+	require.Error(t, err)
+	require.Empty(t, srID)
+	err = fapi.SetSpendPolicy(addr1, api.SpendPolicy{ApprovalThreshold: big.NewInt(50)})
+	require.NoError(t, err)
+	_, srID, err = fapi.SendFil(ctx, addr1, addr2, big.NewInt(60))
+	require.NoError(t, err)
+	err = fapi.RejectSendRequest(srID)
+	require.NoError(t, err)
+	_, err = fapi.ApproveSendRequest(ctx, srID)
+	require.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestMultisig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, _, fapi, cls := it.NewAPI(t, 1)
+	defer cls()
+
+	addr1 := fapi.Addrs()[0].Addr
+	addr2, err := fapi.NewAddr(ctx, "addr2")
+	require.NoError(t, err)
+
+	msig, err := fapi.NewMultisigAddr(ctx, "msig", []string{addr1, addr2}, 2, big.NewInt(1000), api.WithMakeDefault(true))
+	require.NoError(t, err)
+	require.Equal(t, msig, fapi.DefaultStorageConfig().Cold.Filecoin.Addr)
+
+	// Multisig addresses can't send funds directly.
+	_, _, err = fapi.SendFil(ctx, msig, addr2, big.NewInt(1))
+	require.Error(t, err)
+
+	_, err = fapi.MultisigProposeEscrowTopUp(ctx, msig, big.NewInt(100))
+	require.NoError(t, err)
+	var pending []walletTypes.MultisigTx
+	require.Eventually(t, func() bool {
+		pending, err = fapi.MultisigPending(ctx, msig)
+		require.NoError(t, err)
+		return len(pending) == 1
+	}, time.Second*10, time.Second)
+	require.Equal(t, []string{addr1}, pending[0].Approved)
+	require.Equal(t, big.NewInt(100), pending[0].Amount)
+
+	_, err = fapi.MultisigApprove(ctx, msig, addr2, pending[0].ID)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		pending, err = fapi.MultisigPending(ctx, msig)
+		require.NoError(t, err)
+		return len(pending) == 0
+	}, time.Second*10, time.Second)
+}
+
+func TestExportImportKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, _, fapi, cls := it.NewAPI(t, 1)
+	defer cls()
+
+	addr, err := fapi.NewAddr(ctx, "addr2", api.WithAddressType("secp256k1"))
+	require.NoError(t, err)
+
+	keys, err := fapi.ExportKeys(ctx, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	var key api.ExportedKey
+	for _, k := range keys {
+		if k.Addr == addr {
+			key = k
+		}
+	}
+	require.Equal(t, "addr2", key.Name)
+	require.Equal(t, "secp256k1", key.Type)
+	require.NotEmpty(t, key.Key)
+
+	_, err = fapi.ImportKey(ctx, "imported", key.Key, "wrong passphrase")
+	require.True(t, errors.Is(err, walletTypes.ErrInvalidPassphrase))
+
+	// Importing a managed address only restores its key.
+	imported, err := fapi.ImportKey(ctx, "imported", key.Key, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, addr, imported)
+	require.Len(t, fapi.Addrs(), 2)
 }
 
 func TestSignVerifyMessage(t *testing.T) {
@@ -9,7 +9,9 @@ import (
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
 	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/wallet"
 )
 
 // WalletManager provides access to a Lotus wallet for a Lotus node.
@@ -21,18 +23,41 @@ type WalletManager interface {
 	NewAddress(context.Context, string) (string, error)
 	// Balance returns the current balance for an address.
 	Balance(context.Context, string) (*big.Int, error)
-	// SendFil sends fil from one address to another.
-	SendFil(context.Context, string, string, *big.Int) error
+	// MarketBalance returns the storage market escrow balance of an address.
+	MarketBalance(context.Context, string) (wallet.MarketBalance, error)
+	// SendFil sends fil from one address to another, and returns
+	// the message cid.
+	SendFil(context.Context, string, string, *big.Int) (cid.Cid, error)
+	// Transactions returns the recorded transactions of addresses.
+	Transactions(context.Context, []string, ...wallet.TransactionsOption) ([]wallet.Transaction, error)
 	// Sign signs a message using an address.
 	Sign(context.Context, string, []byte) ([]byte, error)
 	// Verify verifies if a message was signed with an address.
 	Verify(context.Context, string, []byte, []byte) (bool, error)
+	// NewMultisig creates a multisig actor with a creator, signers,
+	// threshold and initial balance, and returns its address.
+	NewMultisig(context.Context, string, []string, uint64, *big.Int) (string, error)
+	// Multisig returns information of a multisig created with NewMultisig.
+	// It returns wallet.ErrMultisigNotFound if the address isn't such a multisig.
+	Multisig(context.Context, string) (wallet.MultisigInfo, error)
+	// MultisigPending returns the transactions of a multisig waiting for approvals.
+	MultisigPending(context.Context, string) ([]wallet.MultisigTx, error)
+	// MultisigPropose proposes a transfer from a multisig.
+	MultisigPropose(context.Context, string, string, string, *big.Int) (cid.Cid, error)
+	// MultisigProposeEscrowTopUp proposes a storage market escrow deposit from a multisig.
+	MultisigProposeEscrowTopUp(context.Context, string, string, string, *big.Int) (cid.Cid, error)
+	// MultisigApprove approves a pending multisig transaction.
+	MultisigApprove(context.Context, string, string, int64) (cid.Cid, error)
+	// ExportKey exports the private key of an address encrypted with a passphrase.
+	ExportKey(context.Context, string, string) ([]byte, error)
+	// ImportKey imports a key exported with ExportKey, and returns its address.
+	ImportKey(context.Context, []byte, string) (string, error)
 }
 
 // DealRecordsManager provides access to deal records.
 type DealRecordsManager interface {
-	ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error)
-	ListRetrievalDealRecords(opts ...deals.DealRecordsOption) ([]deals.RetrievalDealRecord, error)
+	ListStorageDealRecords(opts ...deals.DealRecordsOption) (deals.StorageDealRecordsPage, error)
+	ListRetrievalDealRecords(opts ...deals.DealRecordsOption) (deals.RetrievalDealRecordsPage, error)
 }
 
 // HotStorage is a fast storage layer for Cid data.
@@ -40,12 +65,27 @@ type HotStorage interface {
 	// Add adds io.Reader data ephemerally (not pinned).
 	Add(context.Context, io.Reader) (cid.Cid, error)
 
+	// AddTar adds the io.Reader tar archive ephemerally (not pinned)
+	// as a directory.
+	AddTar(context.Context, io.Reader) (cid.Cid, error)
+
+	// AddCAR imports the blocks of the io.Reader CAR file ephemerally
+	// (not pinned), and returns its roots.
+	AddCAR(context.Context, io.Reader) ([]cid.Cid, error)
+
 	// Remove removes a stored Cid.
 	Remove(context.Context, cid.Cid) error
 
-	// Get retrieves a stored Cid data.
+	// Get retrieves a stored Cid data. Directories are retrieved as
+	// tar archives.
 	Get(context.Context, cid.Cid) (io.Reader, error)
 
+	// GetPath retrieves the data of a path within the DAG of a stored
+	// Cid, and returns true if it's a directory retrieved as a tar
+	// archive. An empty path retrieves the Cid data. The returned
+	// io.ReadCloser should be closed to release its resources.
+	GetPath(context.Context, cid.Cid, string) (io.ReadCloser, bool, error)
+
 	// Store stores a Cid. If the data wasn't previously Added,
 	// depending on the implementation it may use internal mechanisms
 	// for pulling the data, e.g: IPFS network
@@ -58,6 +98,11 @@ type HotStorage interface {
 	// IsStore returns true if the Cid is stored, or false
 	// otherwise.
 	IsStored(context.Context, cid.Cid) (bool, error)
+
+	// GetCAR writes the part of the DAG of a Cid selected by an IPLD
+	// selector as a CAR file of the provided version. A nil selector
+	// selects the complete DAG.
+	GetCAR(context.Context, cid.Cid, ipld.Node, int, io.Writer) error
 }
 
 // DealError contains information about a failed deal.
@@ -85,24 +130,53 @@ type ColdStorage interface {
 	// Store stores a Cid using the provided configuration and
 	// account address. It returns a slice of accepted proposed deals,
 	// a slice of rejected proposal deals, and the size of the data.
-	Store(context.Context, cid.Cid, FilConfig) ([]cid.Cid, []DealError, abi.PaddedPieceSize, error)
+	Store(context.Context, cid.Cid, FilConfig, ColdParams) ([]cid.Cid, []DealError, abi.PaddedPieceSize, error)
 
 	// WaitForDeal blocks the provided Deal Proposal reach a
 	// final state. If the deal finishes successfully it returns a FilStorage
 	// result. If the deal finished with error, it returns a ffs.DealError
 	// error result, so it should be considered in error handling.
-	WaitForDeal(context.Context, cid.Cid, cid.Cid, time.Duration, chan deals.StorageDealInfo) (FilStorage, error)
+	WaitForDeal(context.Context, cid.Cid, cid.Cid, time.Duration, chan deals.StorageDealInfo, ColdParams) (FilStorage, error)
 
 	// Fetch fetches the cid data in the underlying storage.
 	Fetch(context.Context, cid.Cid, *cid.Cid, string, []string, uint64, string) (FetchInfo, error)
 
+	// GetCAR retrieves the complete DAG of a Cid from miners, using the
+	// provided piece Cid and account address, and writes the part of it
+	// selected by an IPLD selector as a CAR file of the provided version.
+	// A nil selector selects the complete DAG. It returns the miner the
+	// data was retrieved from.
+	GetCAR(context.Context, cid.Cid, *cid.Cid, string, []string, ipld.Node, int, io.Writer) (string, error)
+
 	// EnsureRenewals executes renewal logic for a Cid under a particular
 	// configuration. It returns a slice of deal errors happened during execution.
-	EnsureRenewals(context.Context, cid.Cid, FilInfo, FilConfig, time.Duration, chan deals.StorageDealInfo) (FilInfo, []DealError, error)
+	EnsureRenewals(context.Context, cid.Cid, FilInfo, FilConfig, time.Duration, chan deals.StorageDealInfo, ColdParams) (FilInfo, []DealError, error)
 
 	// IsFIlDealActive returns true if the proposal Cid is active on chain;
 	// returns false otherwise.
 	IsFilDealActive(context.Context, cid.Cid) (bool, error)
+
+	// GetDealID returns the on-chain id of a deal given its proposal
+	// Cid, or zero if the deal isn't found or wasn't published yet.
+	GetDealID(context.Context, cid.Cid) (uint64, error)
+}
+
+// DealTracker follows active deals on-chain until they reach a terminal
+// state, e.g: slashed or expired.
+type DealTracker interface {
+	// Track starts following a deal which stores a Cid and ends at
+	// endEpoch.
+	Track(c cid.Cid, dealID uint64, endEpoch int64) error
+	// Listen returns a channel which receives state changes of
+	// tracked deals. State changes are sent again until they're
+	// acknowledged, so they might be received more than once.
+	Listen() <-chan DealEvent
+	// Ack acknowledges that the state change of a deal was handled,
+	// so it isn't sent again.
+	Ack(dealID uint64) error
+	// Unregister stops sending state changes to a channel returned
+	// by Listen.
+	Unregister(<-chan DealEvent)
 }
 
 // MinerSelector returns miner addresses and ask storage information using a
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -21,13 +20,27 @@ var (
 	log = logging.Logger("ffs-cidlogger")
 )
 
+const (
+	// deleteBatchSize is the maximum number of log entries deleted in a
+	// single transaction.
+	deleteBatchSize = 500
+	// queryBatchSize is the maximum number of log entries walked in a
+	// single query.
+	queryBatchSize = 500
+)
+
 // Logger is a datastore backed implementation of ffs.Logger.
 type Logger struct {
-	ds datastore.Datastore
+	ds   datastore.TxnDatastore
+	conf Config
 
 	lock     sync.Mutex
 	watchers []chan<- ffs.LogEntry
 	closed   bool
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
 }
 
 type logEntry struct {
@@ -36,21 +49,55 @@ type logEntry struct {
 	Timestamp   int64
 	Jid         ffs.JobID
 	Msg         string
+	Level       ffs.LogLevel
+	Event       string
+	Fields      ffs.LogFields
 }
 
 var _ ffs.JobLogger = (*Logger)(nil)
 
-// New returns a new CidLogger.
-func New(ds datastore.Datastore) *Logger {
-	return &Logger{
-		ds: ds,
+// New returns a new CidLogger. If a retention or compaction is
+// configured, old log entries are deleted periodically.
+func New(ds datastore.TxnDatastore, opts ...Option) (*Logger, error) {
+	conf := defaultConfig
+	for _, o := range opts {
+		if err := o(&conf); err != nil {
+			return nil, fmt.Errorf("applying option: %s", err)
+		}
 	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cl := &Logger{
+		ds:       ds,
+		conf:     conf,
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	go cl.runCompaction()
+	return cl, nil
 }
 
-// Log logs a log entry for a Cid. The ctx can contain an optional ffs.CtxKeyJid to add
-// additional metadata about the log entry being part of a Job execution.
+// Log logs an informational log entry for a Cid. The ctx can contain an
+// optional ffs.CtxKeyJid to add additional metadata about the log entry
+// being part of a Job execution.
 func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
-	log.Infof(format, a...)
+	cl.LogEvent(ctx, ffs.LogInfo, "", nil, format, a...)
+}
+
+// LogEvent logs a log entry for a Cid with a level, an event type and
+// structured fields.
+func (cl *Logger) LogEvent(ctx context.Context, level ffs.LogLevel, event string, fields ffs.LogFields, format string, a ...interface{}) {
+	msg := fmt.Sprintf(format, a...)
+	switch level {
+	case ffs.LogDebug:
+		log.Debug(msg)
+	case ffs.LogWarn:
+		log.Warn(msg)
+	case ffs.LogError:
+		log.Error(msg)
+	default:
+		log.Info(msg)
+	}
 
 	// Retrieve context values.
 	c, _ := ctx.Value(ffs.CtxStorageCid).(cid.Cid)
@@ -64,8 +111,11 @@ func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
 		Cid:         c,
 		RetrievalID: rid,
 		Jid:         jid,
-		Msg:         fmt.Sprintf(format, a...),
+		Msg:         msg,
 		Timestamp:   nowNano,
+		Level:       level,
+		Event:       event,
+		Fields:      fields,
 	}
 	b, err := json.Marshal(le)
 	if err != nil {
@@ -73,16 +123,11 @@ func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
 		return
 	}
 	if err := cl.ds.Put(key, b); err != nil {
-		log.Error("saving to datastore: %s", err)
+		log.Errorf("saving to datastore: %s", err)
 		return
 	}
 
-	entry := ffs.LogEntry{
-		Cid:       le.Cid,
-		Jid:       le.Jid,
-		Timestamp: now,
-		Msg:       fmt.Sprintf(format, a...),
-	}
+	entry := le.toLogEntry()
 	cl.lock.Lock()
 	defer cl.lock.Unlock()
 	for _, c := range cl.watchers {
@@ -96,10 +141,62 @@ func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
 
 // GetByCid returns history logs for a Cid.
 func (cl *Logger) GetByCid(ctx context.Context, c cid.Cid) ([]ffs.LogEntry, error) {
-	q := query.Query{Prefix: makeCidKey(c).String()}
+	return cl.Query(ctx, ffs.LogQuery{Cid: c})
+}
+
+// Query returns history logs of a Cid or a retrieval matching the query,
+// sorted by timestamp.
+func (cl *Logger) Query(ctx context.Context, lq ffs.LogQuery) ([]ffs.LogEntry, error) {
+	var prefix datastore.Key
+	switch {
+	case lq.Cid.Defined():
+		prefix = makeCidKey(lq.Cid)
+	case lq.RetrievalID != ffs.EmptyRetrievalID:
+		prefix = makeRetrievalKey(lq.RetrievalID)
+	default:
+		return nil, fmt.Errorf("cid or retrieval id is mandatory")
+	}
+	var lgs []ffs.LogEntry
+	var before string
+	for {
+		page, last, err := cl.queryPage(prefix, before, lq)
+		if err != nil {
+			return nil, err
+		}
+		lgs = append(lgs, page...)
+		if lq.Limit > 0 && len(lgs) >= lq.Limit {
+			lgs = lgs[:lq.Limit]
+			break
+		}
+		if last == "" {
+			break
+		}
+		before = last
+	}
+	// Entries were walked from the most recent one.
+	for a, b := 0, len(lgs)-1; a < b; a, b = a+1, b-1 {
+		lgs[a], lgs[b] = lgs[b], lgs[a]
+	}
+	return lgs, nil
+}
+
+// queryPage returns the entries matching lq of up to queryBatchSize log
+// entries under prefix, which are before the key before. Entries are
+// walked in descending key order, which is the timestamp order. If older
+// entries may match lq, the last walked key is returned to continue from
+// it.
+func (cl *Logger) queryPage(prefix datastore.Key, before string, lq ffs.LogQuery) ([]ffs.LogEntry, string, error) {
+	q := query.Query{
+		Prefix: prefix.String(),
+		Orders: []query.Order{query.OrderByKeyDescending{}},
+		Limit:  queryBatchSize,
+	}
+	if before != "" {
+		q.Filters = []query.Filter{query.FilterKeyCompare{Op: query.LessThan, Key: before}}
+	}
 	res, err := cl.ds.Query(q)
 	if err != nil {
-		return nil, fmt.Errorf("running query: %s", err)
+		return nil, "", fmt.Errorf("running query: %s", err)
 	}
 	defer func() {
 		if err := res.Close(); err != nil {
@@ -107,25 +204,49 @@ func (cl *Logger) GetByCid(ctx context.Context, c cid.Cid) ([]ffs.LogEntry, erro
 		}
 	}()
 	var lgs []ffs.LogEntry
+	var walked int
+	var last string
 	for r := range res.Next() {
 		if r.Error != nil {
-			return nil, fmt.Errorf("iter next: %s", r.Error)
+			return nil, "", fmt.Errorf("iter next: %s", r.Error)
+		}
+		walked++
+		last = r.Key
+		// Avoid unmarshaling entries out of the time range.
+		if ts, ok := keyTimestamp(r.Key); ok {
+			if !lq.From.IsZero() && ts.Before(lq.From) {
+				return lgs, "", nil
+			}
+			if !inRange(ts, lq.From, lq.To) {
+				continue
+			}
 		}
 		var le logEntry
 		if err := json.Unmarshal(r.Value, &le); err != nil {
-			return nil, fmt.Errorf("unmarshaling log entry: %s", err)
+			return nil, "", fmt.Errorf("unmarshaling log entry: %s", err)
+		}
+		e := le.toLogEntry()
+		if lq.Matches(e) {
+			lgs = append(lgs, e)
 		}
-		lgs = append(lgs, ffs.LogEntry{
-			Cid:       le.Cid,
-			Jid:       le.Jid,
-			Msg:       le.Msg,
-			Timestamp: time.Unix(0, le.Timestamp),
-		})
 	}
-	sort.Slice(lgs, func(a, b int) bool {
-		return lgs[a].Timestamp.Before(lgs[b].Timestamp)
-	})
-	return lgs, nil
+	if walked < queryBatchSize {
+		return lgs, "", nil
+	}
+	return lgs, last, nil
+}
+
+// Purge deletes log entries older than before. If c is defined, only log
+// entries of c are deleted. It returns the number of deleted entries.
+func (cl *Logger) Purge(ctx context.Context, c cid.Cid, before time.Time) (int, error) {
+	if c.Defined() {
+		return cl.deleteEntries(ctx, []datastore.Key{makeCidKey(c)}, before, nil)
+	}
+	prefixes, err := cl.prefixes()
+	if err != nil {
+		return 0, err
+	}
+	return cl.deleteEntries(ctx, prefixes, before, nil)
 }
 
 // Watch is a blocking function that writes to the channel all new created log entries.
@@ -170,19 +291,227 @@ func (cl *Logger) Close() error {
 		return nil
 	}
 	cl.closed = true
+	cl.cancel()
+	<-cl.finished
 	for _, w := range cl.watchers {
 		close(w)
 	}
 	return nil
 }
 
+func (cl *Logger) runCompaction() {
+	defer close(cl.finished)
+	if cl.conf.Retention == 0 && cl.conf.CompactAge == 0 {
+		return
+	}
+	for {
+		cl.compact()
+		select {
+		case <-cl.ctx.Done():
+			return
+		case <-time.After(cl.conf.CompactInterval):
+		}
+	}
+}
+
+// compact deletes log entries older than the retention, and entries with
+// a level lower than the compaction level older than the compaction age.
+// Only the leader compacts log entries.
+func (cl *Logger) compact() {
+	if cl.conf.IsLeader != nil && !cl.conf.IsLeader() {
+		return
+	}
+	prefixes, err := cl.prefixes()
+	if err != nil {
+		log.Errorf("compacting log entries: %s", err)
+		return
+	}
+	now := time.Now()
+	if cl.conf.Retention > 0 {
+		n, err := cl.deleteEntries(cl.ctx, prefixes, now.Add(-cl.conf.Retention), nil)
+		if err != nil {
+			log.Errorf("deleting log entries older than retention: %s", err)
+		} else if n > 0 {
+			log.Infof("deleted %d log entries older than %s", n, cl.conf.Retention)
+		}
+	}
+	if cl.conf.CompactAge > 0 {
+		n, err := cl.deleteEntries(cl.ctx, prefixes, now.Add(-cl.conf.CompactAge), func(le logEntry) bool {
+			return le.level() < cl.conf.CompactMinLevel
+		})
+		if err != nil {
+			log.Errorf("compacting log entries: %s", err)
+		} else if n > 0 {
+			log.Infof("compacted %d log entries older than %s", n, cl.conf.CompactAge)
+		}
+	}
+}
+
+// prefixes returns the prefixes of the Cids and retrievals with log
+// entries.
+func (cl *Logger) prefixes() ([]datastore.Key, error) {
+	res, err := cl.ds.Query(query.Query{KeysOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("running query: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	seen := make(map[string]struct{})
+	var prefixes []datastore.Key
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		ns := datastore.RawKey(r.Key).List()
+		if len(ns) == 0 {
+			continue
+		}
+		if _, ok := seen[ns[0]]; ok {
+			continue
+		}
+		seen[ns[0]] = struct{}{}
+		prefixes = append(prefixes, datastore.NewKey(ns[0]))
+	}
+	return prefixes, nil
+}
+
+// deleteEntries deletes log entries under prefixes older than before. If
+// del isn't nil, only entries for which it returns true are deleted. It
+// returns the number of deleted entries.
+func (cl *Logger) deleteEntries(ctx context.Context, prefixes []datastore.Key, before time.Time, del func(logEntry) bool) (int, error) {
+	var total int
+	for _, prefix := range prefixes {
+		var after string
+		for {
+			if ctx.Err() != nil {
+				return total, ctx.Err()
+			}
+			keys, last, err := cl.oldEntries(prefix, after, before, del)
+			if err != nil {
+				return total, fmt.Errorf("getting log entries of %s: %s", prefix, err)
+			}
+			if err := cl.deleteKeys(keys); err != nil {
+				return total, fmt.Errorf("deleting log entries of %s: %s", prefix, err)
+			}
+			total += len(keys)
+			if last == "" {
+				break
+			}
+			after = last
+		}
+	}
+	return total, nil
+}
+
+// oldEntries returns up to deleteBatchSize keys of log entries under
+// prefix older than before, which are after the key after. Entries are
+// walked in key order, which is the timestamp order. If there may be more
+// entries to delete, the last walked key is returned to continue from it.
+func (cl *Logger) oldEntries(prefix datastore.Key, after string, before time.Time, del func(logEntry) bool) ([]datastore.Key, string, error) {
+	q := query.Query{
+		Prefix:   prefix.String(),
+		KeysOnly: del == nil,
+		Orders:   []query.Order{query.OrderByKey{}},
+	}
+	if after != "" {
+		q.Filters = []query.Filter{query.FilterKeyCompare{Op: query.GreaterThan, Key: after}}
+	}
+	res, err := cl.ds.Query(q)
+	if err != nil {
+		return nil, "", fmt.Errorf("running query: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var keys []datastore.Key
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, "", fmt.Errorf("iter next: %s", r.Error)
+		}
+		ts, ok := keyTimestamp(r.Key)
+		if !ok {
+			continue
+		}
+		if !inRange(ts, time.Time{}, before) {
+			return keys, "", nil
+		}
+		if del != nil {
+			var le logEntry
+			if err := json.Unmarshal(r.Value, &le); err != nil {
+				return nil, "", fmt.Errorf("unmarshaling log entry: %s", err)
+			}
+			if !del(le) {
+				continue
+			}
+		}
+		keys = append(keys, datastore.NewKey(r.Key))
+		if len(keys) == deleteBatchSize {
+			return keys, r.Key, nil
+		}
+	}
+	return keys, "", nil
+}
+
+// deleteKeys deletes keys in a single transaction.
+func (cl *Logger) deleteKeys(keys []datastore.Key) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	txn, err := cl.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	for _, k := range keys {
+		if err := txn.Delete(k); err != nil {
+			return fmt.Errorf("deleting log entry: %s", err)
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	return nil
+}
+
+func (le logEntry) toLogEntry() ffs.LogEntry {
+	return ffs.LogEntry{
+		Cid:         le.Cid,
+		RetrievalID: le.RetrievalID,
+		Jid:         le.Jid,
+		Msg:         le.Msg,
+		Timestamp:   time.Unix(0, le.Timestamp),
+		Level:       le.level(),
+		Event:       le.Event,
+		Fields:      le.Fields,
+	}
+}
+
+// level returns the level of the entry. Entries saved before having
+// levels are informational.
+func (le logEntry) level() ffs.LogLevel {
+	if le.Level == ffs.LogUnspecified {
+		return ffs.LogInfo
+	}
+	return le.Level
+}
+
+// keyTimestamp returns the timestamp of a log entry key.
+func keyTimestamp(key string) (time.Time, bool) {
+	ts, err := strconv.ParseInt(datastore.RawKey(key).BaseNamespace(), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, ts), true
+}
+
+func inRange(ts, from, to time.Time) bool {
+	return (from.IsZero() || !ts.Before(from)) && (to.IsZero() || ts.Before(to))
+}
+
 func makeKey(c cid.Cid, rid ffs.RetrievalID, timestamp int64) datastore.Key {
 	strt := strconv.FormatInt(timestamp, 10)
 	if c != cid.Undef {
 		return makeCidKey(c).ChildString(strt)
 	}
 	if rid != ffs.EmptyRetrievalID {
-		return makeRetrievalKey(c).ChildString(strt)
+		return makeRetrievalKey(rid).ChildString(strt)
 	}
 	panic("log should be from stored cid or retrieval request")
 }
@@ -191,6 +520,6 @@ func makeCidKey(c cid.Cid) datastore.Key {
 	return datastore.NewKey(util.CidToString(c))
 }
 
-func makeRetrievalKey(rid cid.Cid) datastore.Key {
+func makeRetrievalKey(rid ffs.RetrievalID) datastore.Key {
 	return datastore.NewKey(rid.String())
 }
@@ -0,0 +1,206 @@
+package joblogger
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+)
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+	l := newLogger(t)
+	c1, c2 := cid1(t), cid2(t)
+	ctx1 := logCtx(c1, "j1")
+	ctx2 := logCtx(c2, "j2")
+
+	l.Log(ctx1, "info %d", 1)
+	l.LogEvent(ctx1, ffs.LogWarn, ffs.StepDealProposal, ffs.DealLogFields("f01000", cid.Undef, 0), "proposal rejected")
+	mid := time.Now()
+	l.LogEvent(logCtx(c1, "j3"), ffs.LogError, ffs.StepDealState, nil, "deal failed")
+	l.Log(ctx2, "other cid")
+
+	lgs, err := l.GetByCid(context.Background(), c1)
+	require.NoError(t, err)
+	require.Len(t, lgs, 3)
+	require.Equal(t, "info 1", lgs[0].Msg)
+	require.Equal(t, ffs.LogInfo, lgs[0].Level)
+	require.Equal(t, ffs.StepDealProposal, lgs[1].Event)
+	require.Equal(t, "f01000", lgs[1].Fields[ffs.LogFieldMiner])
+
+	query := func(q ffs.LogQuery) []ffs.LogEntry {
+		q.Cid = c1
+		lgs, err := l.Query(context.Background(), q)
+		require.NoError(t, err)
+		return lgs
+	}
+	require.Len(t, query(ffs.LogQuery{MinLevel: ffs.LogWarn}), 2)
+	require.Len(t, query(ffs.LogQuery{JobID: "j3"}), 1)
+	require.Len(t, query(ffs.LogQuery{From: mid}), 1)
+	require.Len(t, query(ffs.LogQuery{To: mid}), 2)
+	// Text matches messages and field values ignoring case.
+	require.Len(t, query(ffs.LogQuery{Text: "REJECTED"}), 1)
+	require.Len(t, query(ffs.LogQuery{Text: "f0100"}), 1)
+	lgs = query(ffs.LogQuery{Limit: 1})
+	require.Len(t, lgs, 1)
+	require.Equal(t, "deal failed", lgs[0].Msg)
+
+	_, err = l.Query(context.Background(), ffs.LogQuery{})
+	require.Error(t, err)
+}
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+	l := newLogger(t)
+	c1, c2 := cid1(t), cid2(t)
+	l.Log(logCtx(c1, "j1"), "old")
+	l.Log(logCtx(c2, "j2"), "old")
+	before := time.Now()
+	l.Log(logCtx(c1, "j1"), "new")
+
+	n, err := l.Purge(context.Background(), c1, before)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	lgs, err := l.GetByCid(context.Background(), c1)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+	require.Equal(t, "new", lgs[0].Msg)
+
+	n, err = l.Purge(context.Background(), cid.Undef, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	lgs, err = l.GetByCid(context.Background(), c2)
+	require.NoError(t, err)
+	require.Empty(t, lgs)
+}
+
+func TestRetrievalQuery(t *testing.T) {
+	t.Parallel()
+	l := newLogger(t)
+	rid := ffs.NewRetrievalID()
+	ctx := context.WithValue(context.Background(), ffs.CtxRetrievalID, rid)
+	l.Log(context.WithValue(ctx, ffs.CtxKeyJid, ffs.JobID("j1")), "retrieving")
+	l.Log(logCtx(cid1(t), "j2"), "storing")
+
+	lgs, err := l.Query(context.Background(), ffs.LogQuery{RetrievalID: rid})
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+	require.Equal(t, "retrieving", lgs[0].Msg)
+	require.Equal(t, rid, lgs[0].RetrievalID)
+	require.Equal(t, ffs.JobID("j1"), lgs[0].Jid)
+}
+
+func TestPurgeBatches(t *testing.T) {
+	t.Parallel()
+	l := newLogger(t)
+	c := cid1(t)
+	ctx := logCtx(c, "j1")
+	for i := 0; i < deleteBatchSize*2+10; i++ {
+		l.Log(ctx, "entry %d", i)
+	}
+	before := time.Now()
+	l.Log(ctx, "new")
+
+	n, err := l.Purge(context.Background(), cid.Undef, before)
+	require.NoError(t, err)
+	require.Equal(t, deleteBatchSize*2+10, n)
+	lgs, err := l.GetByCid(context.Background(), c)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+	require.Equal(t, "new", lgs[0].Msg)
+}
+
+func TestQueryBatches(t *testing.T) {
+	t.Parallel()
+	l := newLogger(t)
+	c := cid1(t)
+	ctx := logCtx(c, "j1")
+	total := queryBatchSize*2 + 10
+	for i := 0; i < total; i++ {
+		l.Log(ctx, "entry %d", i)
+	}
+
+	lgs, err := l.GetByCid(context.Background(), c)
+	require.NoError(t, err)
+	require.Len(t, lgs, total)
+	require.Equal(t, "entry 0", lgs[0].Msg)
+	require.Equal(t, fmt.Sprintf("entry %d", total-1), lgs[total-1].Msg)
+
+	// The limit keeps the most recent entries, even if they're matched
+	// in different batches.
+	lgs, err = l.Query(context.Background(), ffs.LogQuery{Cid: c, Limit: queryBatchSize + 1})
+	require.NoError(t, err)
+	require.Len(t, lgs, queryBatchSize+1)
+	require.Equal(t, fmt.Sprintf("entry %d", total-queryBatchSize-1), lgs[0].Msg)
+	require.Equal(t, fmt.Sprintf("entry %d", total-1), lgs[queryBatchSize].Msg)
+
+	from := lgs[1].Timestamp
+	lgs, err = l.Query(context.Background(), ffs.LogQuery{Cid: c, From: from})
+	require.NoError(t, err)
+	require.Len(t, lgs, queryBatchSize)
+	require.Equal(t, from, lgs[0].Timestamp)
+}
+
+func TestCompactionFollower(t *testing.T) {
+	t.Parallel()
+	l, err := New(tests.NewTxMapDatastore(),
+		WithRetention(time.Millisecond),
+		WithCompactInterval(time.Millisecond*10),
+		WithIsLeader(func() bool { return false }))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, l.Close()) })
+	c := cid1(t)
+	l.Log(logCtx(c, "j1"), "progress")
+
+	time.Sleep(time.Millisecond * 100)
+	lgs, err := l.GetByCid(context.Background(), c)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+}
+
+func TestCompaction(t *testing.T) {
+	t.Parallel()
+	l, err := New(tests.NewTxMapDatastore(), WithCompaction(time.Millisecond*100, ffs.LogWarn), WithCompactInterval(time.Millisecond*50))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, l.Close()) })
+	c := cid1(t)
+	ctx := logCtx(c, "j1")
+	l.Log(ctx, "progress")
+	l.LogEvent(ctx, ffs.LogWarn, "", nil, "problem")
+
+	require.Eventually(t, func() bool {
+		lgs, err := l.GetByCid(context.Background(), c)
+		require.NoError(t, err)
+		return len(lgs) == 1 && lgs[0].Msg == "problem"
+	}, time.Second*2, time.Millisecond*50)
+}
+
+func newLogger(t *testing.T) *Logger {
+	l, err := New(tests.NewTxMapDatastore())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, l.Close()) })
+	return l
+}
+
+func logCtx(c cid.Cid, jid ffs.JobID) context.Context {
+	ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, c)
+	return context.WithValue(ctx, ffs.CtxKeyJid, jid)
+}
+
+func cid1(t *testing.T) cid.Cid {
+	c, err := util.CidFromString("QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG")
+	require.NoError(t, err)
+	return c
+}
+
+func cid2(t *testing.T) cid.Cid {
+	c, err := util.CidFromString("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	require.NoError(t, err)
+	return c
+}
@@ -0,0 +1,84 @@
+package joblogger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/textileio/powergate/ffs"
+)
+
+var defaultConfig = Config{
+	CompactMinLevel: ffs.LogWarn,
+	CompactInterval: time.Hour,
+}
+
+// Config contains configuration of the retention of log entries.
+type Config struct {
+	// Retention is the age after which log entries are deleted. Zero
+	// keeps log entries forever.
+	Retention time.Duration
+	// CompactAge is the age after which log entries with a level
+	// lower than CompactMinLevel are deleted. Zero disables compaction.
+	CompactAge time.Duration
+	// CompactMinLevel is the minimum level of log entries kept after
+	// CompactAge.
+	CompactMinLevel ffs.LogLevel
+	// CompactInterval is the frequency in which retention and
+	// compaction are enforced.
+	CompactInterval time.Duration
+	// IsLeader returns true if the instance enforces retention and
+	// compaction. If nil, the instance always does.
+	IsLeader func() bool
+}
+
+// Option configures the Logger.
+type Option func(*Config) error
+
+// WithRetention sets the age after which log entries are deleted. Zero
+// keeps log entries forever.
+func WithRetention(d time.Duration) Option {
+	return func(c *Config) error {
+		if d < 0 {
+			return fmt.Errorf("retention can't be negative")
+		}
+		c.Retention = d
+		return nil
+	}
+}
+
+// WithCompaction deletes log entries with a level lower than minLevel
+// after they're older than age. Zero age disables compaction.
+func WithCompaction(age time.Duration, minLevel ffs.LogLevel) Option {
+	return func(c *Config) error {
+		if age < 0 {
+			return fmt.Errorf("compaction age can't be negative")
+		}
+		if _, ok := ffs.LogLevelStr[minLevel]; !ok {
+			return fmt.Errorf("unknown log level %d", minLevel)
+		}
+		c.CompactAge = age
+		c.CompactMinLevel = minLevel
+		return nil
+	}
+}
+
+// WithCompactInterval sets the frequency in which retention and
+// compaction are enforced.
+func WithCompactInterval(d time.Duration) Option {
+	return func(c *Config) error {
+		if d <= 0 {
+			return fmt.Errorf("compact interval should be positive")
+		}
+		c.CompactInterval = d
+		return nil
+	}
+}
+
+// WithIsLeader enforces retention and compaction only while isLeader
+// returns true, so only one of the instances sharing a datastore does.
+func WithIsLeader(isLeader func() bool) Option {
+	return func(c *Config) error {
+		c.IsLeader = isLeader
+		return nil
+	}
+}
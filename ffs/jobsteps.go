@@ -0,0 +1,17 @@
+package ffs
+
+import "sync"
+
+// StartJobStep starts a step of a Job recorded in r, and returns a
+// function to finish it. Only the first call of the returned function
+// finishes the step. If r is nil, steps aren't recorded.
+func StartJobStep(r JobStepRecorder, name, detail string) func(result string, err error) {
+	if r == nil {
+		return func(string, error) {}
+	}
+	i := r.StartStep(name, detail)
+	var once sync.Once
+	return func(result string, err error) {
+		once.Do(func() { r.FinishStep(i, result, err) })
+	}
+}
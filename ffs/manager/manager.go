@@ -21,6 +21,8 @@ import (
 var (
 	// ErrAuthTokenNotFound returns when an auth-token doesn't exist.
 	ErrAuthTokenNotFound = errors.New("auth token not found")
+	// ErrInstanceNotFound returns when an instance doesn't exist.
+	ErrInstanceNotFound = errors.New("instance not found")
 
 	log = logging.Logger("ffs-manager")
 
@@ -39,10 +41,11 @@ var (
 		Cold: ffs.ColdConfig{
 			Enabled: true,
 			Filecoin: ffs.FilConfig{
-				RepFactor:       1,
-				DealMinDuration: util.MinDealDuration,
-				FastRetrieval:   true,
-				DealStartOffset: 72 * 60 * 60 / util.EpochDurationSeconds, // 72hs
+				RepFactor:         1,
+				DealMinDuration:   util.MinDealDuration,
+				FastRetrieval:     true,
+				DealStartOffset:   72 * 60 * 60 / util.EpochDurationSeconds, // 72hs
+				MaxRetrievalPrice: 100000000000000000,                       // 0.1 FIL
 			},
 		},
 	}
@@ -189,9 +192,86 @@ func (m *Manager) GetByAuthToken(token string) (*api.API, error) {
 		return nil, ErrAuthTokenNotFound
 	}
 
+	return m.getInstance(iid)
+}
+
+// GetByID loads an existing instance by its id. If the instance doesn't exist,
+// it returns ErrInstanceNotFound.
+func (m *Manager) GetByID(iid ffs.APIID) (*api.API, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entries, err := m.auth.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing existing instances: %s", err)
+	}
+	for _, e := range entries {
+		if e.APIID == iid {
+			return m.getInstance(iid)
+		}
+	}
+	return nil, ErrInstanceNotFound
+}
+
+// GetByAddr loads the existing instance which manages a wallet address. If no
+// instance manages the address, it returns ErrInstanceNotFound.
+func (m *Manager) GetByAddr(addr string) (*api.API, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entries, err := m.auth.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing existing instances: %s", err)
+	}
+	for _, e := range entries {
+		i, err := m.getInstance(e.APIID)
+		if err != nil {
+			return nil, err
+		}
+		for _, ai := range i.Addrs() {
+			if ai.Addr == addr {
+				return i, nil
+			}
+		}
+	}
+	return nil, ErrInstanceNotFound
+}
+
+// ManagedAddrs returns the wallet addresses managed by all instances,
+// without duplicates.
+func (m *Manager) ManagedAddrs() ([]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entries, err := m.auth.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing existing instances: %s", err)
+	}
+	var res []string
+	seen := make(map[string]struct{})
+	for _, e := range entries {
+		i, err := m.getInstance(e.APIID)
+		if err != nil {
+			return nil, err
+		}
+		for _, ai := range i.Addrs() {
+			if _, ok := seen[ai.Addr]; ok {
+				continue
+			}
+			seen[ai.Addr] = struct{}{}
+			res = append(res, ai.Addr)
+		}
+	}
+	return res, nil
+}
+
+// getInstance returns a cached instance, or loads it from the datastore.
+// This method must be guarded.
+func (m *Manager) getInstance(iid ffs.APIID) (*api.API, error) {
 	i, ok := m.instances[iid]
 	if !ok {
 		log.Debugf("loading uncached instance %s", iid)
+		var err error
 		i, err = api.Load(namespace.Wrap(m.ds, datastore.NewKey("api/"+iid.String())), iid, m.sched, m.wm, m.drm)
 		if err != nil {
 			return nil, fmt.Errorf("loading instance %s: %s", iid, err)
@@ -0,0 +1,95 @@
+package memstorage
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time of in-memory storages. It drives latencies,
+// timeouts, and the simulated chain epochs.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel which receives the current time after d.
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+// SystemClock returns a Clock backed by the system time.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// ManualClock is a deterministic Clock which only moves forward when
+// calling Advance.
+type ManualClock struct {
+	lock    sync.Mutex
+	now     time.Time
+	waiters []waiter
+}
+
+type waiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+var _ Clock = (*ManualClock)(nil)
+
+// NewManualClock returns a new ManualClock set to start.
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now returns the current time of the clock.
+func (mc *ManualClock) Now() time.Time {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	return mc.now
+}
+
+// After returns a channel which receives the clock time once the clock
+// is advanced d or more.
+func (mc *ManualClock) After(d time.Duration) <-chan time.Time {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- mc.now
+		return ch
+	}
+	mc.waiters = append(mc.waiters, waiter{deadline: mc.now.Add(d), ch: ch})
+	return ch
+}
+
+// Advance moves the clock forward d, firing due After channels.
+func (mc *ManualClock) Advance(d time.Duration) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	mc.now = mc.now.Add(d)
+	pending := mc.waiters[:0]
+	for _, w := range mc.waiters {
+		if w.deadline.After(mc.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.ch <- mc.now
+	}
+	mc.waiters = pending
+}
+
+// Waiters returns the number of pending After channels. It's useful to
+// know when goroutines are blocked on the clock before advancing it.
+func (mc *ManualClock) Waiters() int {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	return len(mc.waiters)
+}
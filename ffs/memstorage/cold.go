@@ -0,0 +1,536 @@
+package memstorage
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	commcid "github.com/filecoin-project/go-fil-commcid"
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/ffs"
+)
+
+// ColdStorage is an in-memory ffs.ColdStorage which simulates Filecoin
+// deals with a set of miners. Deal states are a function of the simulated
+// epoch, which is derived from the configured Clock, so with a ManualClock
+// simulations are deterministic.
+type ColdStorage struct {
+	sim     *sim
+	hs      *HotStorage
+	l       ffs.JobLogger
+	genesis time.Time
+
+	lock       sync.Mutex
+	miners     []string
+	rejecting  map[string]struct{}
+	data       map[cid.Cid][]byte
+	deals      map[cid.Cid]*simDeal
+	lastDealID uint64
+}
+
+var _ ffs.ColdStorage = (*ColdStorage)(nil)
+
+// simDeal is a simulated deal. Its failure and slashing epochs are decided
+// when proposed.
+type simDeal struct {
+	proposalCid     cid.Cid
+	dataCid         cid.Cid
+	pieceCid        cid.Cid
+	miner           string
+	size            abi.PaddedPieceSize
+	epochPrice      uint64
+	duration        int64
+	dealID          uint64
+	proposedEpoch   int64
+	activationEpoch int64
+	startEpoch      int64
+	failEpoch       int64
+	slashEpoch      int64
+}
+
+// NewColdStorage returns a new ColdStorage. Data is taken from, and fetched
+// to, hs.
+func NewColdStorage(hs *HotStorage, l ffs.JobLogger, opts ...Option) (*ColdStorage, error) {
+	conf, err := newConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("applying options: %s", err)
+	}
+	miners := make([]string, conf.Miners)
+	for i := range miners {
+		addr, err := address.NewIDAddress(uint64(1000 + i))
+		if err != nil {
+			return nil, fmt.Errorf("creating miner address: %s", err)
+		}
+		miners[i] = addr.String()
+	}
+	return &ColdStorage{
+		sim:       newSim(conf),
+		hs:        hs,
+		l:         l,
+		genesis:   conf.Clock.Now(),
+		miners:    miners,
+		rejecting: make(map[string]struct{}),
+		data:      make(map[cid.Cid][]byte),
+		deals:     make(map[cid.Cid]*simDeal),
+	}, nil
+}
+
+// Miners returns the addresses of the simulated miners.
+func (cs *ColdStorage) Miners() []string {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	res := make([]string, len(cs.miners))
+	copy(res, cs.miners)
+	return res
+}
+
+// Epoch returns the current simulated epoch.
+func (cs *ColdStorage) Epoch() int64 {
+	return int64(cs.sim.conf.Clock.Now().Sub(cs.genesis) / cs.sim.conf.EpochDuration)
+}
+
+// GetHeight returns the current simulated epoch. It allows using the
+// ColdStorage as a filcold.FilChain.
+func (cs *ColdStorage) GetHeight(ctx context.Context) (uint64, error) {
+	return uint64(cs.Epoch()), nil
+}
+
+// SetMinerRejecting sets if a miner rejects all new deal proposals.
+func (cs *ColdStorage) SetMinerRejecting(miner string, rejecting bool) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	if rejecting {
+		cs.rejecting[miner] = struct{}{}
+		return
+	}
+	delete(cs.rejecting, miner)
+}
+
+// SlashDeal slashes an active deal in the current epoch.
+func (cs *ColdStorage) SlashDeal(proposal cid.Cid) error {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	d, ok := cs.deals[proposal]
+	if !ok {
+		return fmt.Errorf("deal %s not found", proposal)
+	}
+	epoch := cs.Epoch()
+	if d.state(epoch) != storagemarket.StorageDealActive {
+		return fmt.Errorf("deal %s isn't active", proposal)
+	}
+	d.slashEpoch = epoch
+	return nil
+}
+
+// Store proposes deals for the Cid data, which should be available in the
+// HotStorage, to RepFactor miners considering the configuration filters.
+func (cs *ColdStorage) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig, params ffs.ColdParams) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
+	if err := cs.sim.delay(ctx); err != nil {
+		return nil, nil, 0, err
+	}
+	if err := params.EnsureDealSource(ctx); err != nil {
+		return nil, nil, 0, fmt.Errorf("making data available for deals: %s", err)
+	}
+	data, ok := cs.hs.get(c)
+	if !ok {
+		return nil, nil, 0, fmt.Errorf("cid %s data isn't available in hot storage", c)
+	}
+	finishStep := params.StartStep(ffs.StepPieceCalculation, "")
+	pieceSize := paddedSize(int64(len(data)))
+	finishStep(fmt.Sprintf("piece size %d", pieceSize), nil)
+	cs.l.Log(ctx, "Calculated piece size is %d MiB.", pieceSize/1024/1024)
+	finishStep = params.StartStep(ffs.StepMinerSelection, fmt.Sprintf("%d miners", cfg.RepFactor))
+	miners, err := cs.selectMiners(cfg.RepFactor, cfg.ExcludedMiners, cfg.TrustedMiners, cfg.MaxPrice)
+	if err != nil {
+		finishStep("", err)
+		return nil, nil, 0, fmt.Errorf("selecting miners: %s", err)
+	}
+	finishStep(strings.Join(miners, ", "), nil)
+	cs.lock.Lock()
+	cs.data[c] = data
+	cs.lock.Unlock()
+
+	price := cfg.Escalation.EpochPrice(cs.sim.conf.MinerEpochPrice, cfg.MaxPrice, params.EscalationRound)
+	var okDeals []cid.Cid
+	var failedDeals []ffs.DealError
+	for _, m := range miners {
+		fields := ffs.DealLogFields(m, cid.Undef, 0)
+		fields[ffs.LogFieldPrice] = strconv.FormatUint(price, 10)
+		cs.l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealProposal, fields, "Proposing deal to miner %s with %d attoFIL per epoch...", m, price)
+		finishStep := params.StartStep(ffs.StepDealProposal, fmt.Sprintf("miner %s with %d attoFIL per epoch", m, price))
+		d, err := cs.propose(c, pieceSize, m, price, cfg)
+		if err != nil {
+			finishStep("", err)
+			cs.l.LogEvent(ctx, ffs.LogWarn, ffs.StepDealProposal, ffs.DealLogFields(m, cid.Undef, 0), "Proposal with miner %s failed: %s", m, err)
+			failedDeals = append(failedDeals, ffs.DealError{Miner: m, Message: err.Error()})
+			continue
+		}
+		finishStep(fmt.Sprintf("proposal %s", d.proposalCid), nil)
+		okDeals = append(okDeals, d.proposalCid)
+	}
+	return okDeals, failedDeals, pieceSize, nil
+}
+
+// WaitForDeal blocks until the deal reaches a final state, sending deal status
+// updates on dealUpdates. If the deal finishes successfully it returns a
+// FilStorage result, and a ffs.DealError otherwise.
+func (cs *ColdStorage) WaitForDeal(ctx context.Context, c cid.Cid, proposal cid.Cid, timeout time.Duration, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams) (ffs.FilStorage, error) {
+	cs.lock.Lock()
+	d, ok := cs.deals[proposal]
+	cs.lock.Unlock()
+	if !ok {
+		return ffs.FilStorage{}, fmt.Errorf("deal %s not found", proposal)
+	}
+
+	timeoutCh := cs.sim.conf.Clock.After(timeout)
+	lastState := storagemarket.StorageDealUnknown
+	finishStep := func(string, error) {}
+	defer func() { finishStep("", fmt.Errorf("stopped watching deal")) }()
+	for {
+		epoch := cs.Epoch()
+		cs.lock.Lock()
+		di := d.info(epoch)
+		cs.lock.Unlock()
+		if di.StateID != lastState {
+			lastState = di.StateID
+			finishStep(di.StateName, nil)
+			finishStep = params.StartStep(ffs.StepDealState, fmt.Sprintf("proposal %s with miner %s in %s", proposal, di.Miner, di.StateName))
+			select {
+			case dealUpdates <- di:
+			default:
+				log.Warnf("slow receiver for deal updates for %s", c)
+			}
+			switch di.StateID {
+			case storagemarket.StorageDealActive:
+				cs.l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, di.DealID), "Deal %d with miner %s is active on-chain", di.DealID, di.Miner)
+				finishStep(fmt.Sprintf("deal %d active", di.DealID), nil)
+				return d.filStorage(), nil
+			case storagemarket.StorageDealError:
+				cs.l.LogEvent(ctx, ffs.LogError, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, di.DealID), "DealID %d with miner %s failed and won't be active on-chain: %s", di.DealID, di.Miner, di.Message)
+				finishStep("", errors.New(di.Message))
+				return ffs.FilStorage{}, ffs.DealError{ProposalCid: proposal, Miner: di.Miner, Message: di.Message}
+			default:
+				cs.l.LogEvent(ctx, ffs.LogInfo, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, di.DealID), "Deal with miner %s changed state to %s", di.Miner, di.StateName)
+			}
+		}
+
+		nextEpoch := cs.genesis.Add(time.Duration(epoch+1) * cs.sim.conf.EpochDuration)
+		select {
+		case <-ctx.Done():
+			return ffs.FilStorage{}, fmt.Errorf("aborted due to cancellation")
+		case <-timeoutCh:
+			msg := fmt.Sprintf("DealID %d with miner %s tracking timed out after waiting for %.0f hours.", di.DealID, di.Miner, timeout.Hours())
+			cs.l.LogEvent(ctx, ffs.LogError, ffs.StepDealState, ffs.DealLogFields(di.Miner, proposal, di.DealID), msg)
+			finishStep("", errors.New(msg))
+			return ffs.FilStorage{}, ffs.DealError{ProposalCid: proposal, Miner: di.Miner, Message: msg}
+		case <-cs.sim.conf.Clock.After(nextEpoch.Sub(cs.sim.conf.Clock.Now())):
+		}
+	}
+}
+
+// Fetch makes the Cid data available in the HotStorage, if any of miners
+// (or any miner if empty) has an active deal for it.
+func (cs *ColdStorage) Fetch(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, waddr string, miners []string, maxPrice uint64, selector string) (ffs.FetchInfo, error) {
+	if err := cs.sim.delay(ctx); err != nil {
+		return ffs.FetchInfo{}, err
+	}
+	miner, data, err := cs.retrieve(pyCid, piCid, miners)
+	if err != nil {
+		return ffs.FetchInfo{}, err
+	}
+	cs.hs.put(pyCid, data)
+	cs.l.Log(ctx, "Fetched data from miner %s", miner)
+	return ffs.FetchInfo{RetrievedMiner: miner}, nil
+}
+
+// GetCAR writes the Cid data as a CAR file, if any of miners (or any miner
+// if empty) has an active deal for it.
+func (cs *ColdStorage) GetCAR(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, waddr string, miners []string, sel ipld.Node, version int, w io.Writer) (string, error) {
+	if err := cs.sim.delay(ctx); err != nil {
+		return "", err
+	}
+	miner, data, err := cs.retrieve(pyCid, piCid, miners)
+	if err != nil {
+		return "", err
+	}
+	if err := writeCAR(ctx, pyCid, data, sel, version, w); err != nil {
+		return "", err
+	}
+	return miner, nil
+}
+
+// retrieve returns the data of pyCid and the miner of an active deal
+// for it, considering only miners if not empty.
+func (cs *ColdStorage) retrieve(pyCid cid.Cid, piCid *cid.Cid, miners []string) (string, []byte, error) {
+	allowed := make(map[string]struct{}, len(miners))
+	for _, m := range miners {
+		allowed[m] = struct{}{}
+	}
+	epoch := cs.Epoch()
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	for _, d := range cs.deals {
+		if !d.dataCid.Equals(pyCid) || d.state(epoch) != storagemarket.StorageDealActive {
+			continue
+		}
+		if piCid != nil && !d.pieceCid.Equals(*piCid) {
+			continue
+		}
+		if _, ok := allowed[d.miner]; len(allowed) > 0 && !ok {
+			continue
+		}
+		return d.miner, cs.data[pyCid], nil
+	}
+	return "", nil, fmt.Errorf("no active deals for %s", pyCid)
+}
+
+// EnsureRenewals renews deals which are about to expire, so the Cid keeps
+// RepFactor active deals. Renewed deals are made with the same miner.
+func (cs *ColdStorage) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInfo, cfg ffs.FilConfig, dealFinalityTimeout time.Duration, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams) (ffs.FilInfo, []ffs.DealError, error) {
+	height := cs.Epoch()
+	var renewable []int
+	for i, p := range inf.Proposals {
+		if p.Renewed || p.StartEpoch == 0 || p.Duration == 0 {
+			continue
+		}
+		if int64(p.StartEpoch)+p.Duration-int64(cfg.Renew.Threshold) <= height {
+			renewable = append(renewable, i)
+		}
+	}
+	numToBeRenewed := cfg.RepFactor - (len(inf.Proposals) - len(renewable))
+	if numToBeRenewed <= 0 {
+		return inf, nil, nil
+	}
+	if numToBeRenewed > len(renewable) {
+		numToBeRenewed = len(renewable)
+	}
+
+	newInf := ffs.FilInfo{
+		DataCid:   inf.DataCid,
+		Size:      inf.Size,
+		Proposals: make([]ffs.FilStorage, len(inf.Proposals)),
+	}
+	copy(newInf.Proposals, inf.Proposals)
+
+	var dealErrors []ffs.DealError
+	for _, i := range renewable[:numToBeRenewed] {
+		p := inf.Proposals[i]
+		rcfg := cfg
+		rcfg.RepFactor = 1
+		rcfg.TrustedMiners = []string{p.Miner}
+		okDeals, failedDeals, _, err := cs.Store(ctx, c, rcfg, params)
+		if err != nil {
+			return ffs.FilInfo{}, nil, fmt.Errorf("making renewal deal: %s", err)
+		}
+		if len(okDeals) == 0 {
+			dealErrors = append(dealErrors, failedDeals...)
+			continue
+		}
+		fs, err := cs.WaitForDeal(ctx, c, okDeals[0], dealFinalityTimeout, dealUpdates, params)
+		var dealError ffs.DealError
+		if errors.As(err, &dealError) {
+			dealErrors = append(dealErrors, dealError)
+			continue
+		}
+		if err != nil {
+			return ffs.FilInfo{}, nil, fmt.Errorf("waiting for renewal deal: %s", err)
+		}
+		newInf.Proposals = append(newInf.Proposals, fs)
+		newInf.Proposals[i].Renewed = true
+	}
+	return newInf, dealErrors, nil
+}
+
+// IsFilDealActive returns true if the deal is active in the current epoch.
+func (cs *ColdStorage) IsFilDealActive(ctx context.Context, proposal cid.Cid) (bool, error) {
+	epoch := cs.Epoch()
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	d, ok := cs.deals[proposal]
+	if !ok {
+		return false, nil
+	}
+	return d.state(epoch) == storagemarket.StorageDealActive, nil
+}
+
+// GetDealID returns the id of a deal, or zero if the deal isn't found.
+func (cs *ColdStorage) GetDealID(ctx context.Context, proposal cid.Cid) (uint64, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	d, ok := cs.deals[proposal]
+	if !ok {
+		return 0, nil
+	}
+	return d.dealID, nil
+}
+
+// selectMiners returns count miners, preferring trusted miners and skipping
+// excluded ones.
+func (cs *ColdStorage) selectMiners(count int, excluded, trusted []string, maxPrice uint64) ([]string, error) {
+	if maxPrice > 0 && cs.sim.conf.MinerEpochPrice > maxPrice {
+		return nil, fmt.Errorf("miners ask price %d is above max price %d", cs.sim.conf.MinerEpochPrice, maxPrice)
+	}
+	skip := make(map[string]struct{}, len(excluded))
+	for _, m := range excluded {
+		skip[m] = struct{}{}
+	}
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	known := make(map[string]struct{}, len(cs.miners))
+	for _, m := range cs.miners {
+		known[m] = struct{}{}
+	}
+	var res []string
+	for _, m := range append(append([]string{}, trusted...), cs.miners...) {
+		if len(res) == count {
+			break
+		}
+		if _, ok := known[m]; !ok {
+			continue
+		}
+		if _, ok := skip[m]; ok {
+			continue
+		}
+		skip[m] = struct{}{}
+		res = append(res, m)
+	}
+	if len(res) < count {
+		return nil, fmt.Errorf("not enough miners satisfy the filters, want %d, got %d", count, len(res))
+	}
+	return res, nil
+}
+
+// propose creates a deal with miner at price, deciding if it's rejected,
+// fails or gets slashed in the future.
+func (cs *ColdStorage) propose(c cid.Cid, pieceSize abi.PaddedPieceSize, miner string, price uint64, cfg ffs.FilConfig) (*simDeal, error) {
+	conf := cs.sim.conf
+	cs.lock.Lock()
+	_, rejecting := cs.rejecting[miner]
+	cs.lock.Unlock()
+	if rejecting || cs.sim.chance(conf.RejectRate) {
+		return nil, fmt.Errorf("deal rejected by miner %s", miner)
+	}
+
+	epoch := cs.Epoch()
+	pieceCid, err := pieceCid(c)
+	if err != nil {
+		return nil, err
+	}
+	d := &simDeal{
+		dataCid:         c,
+		pieceCid:        pieceCid,
+		miner:           miner,
+		size:            pieceSize,
+		epochPrice:      price,
+		duration:        cfg.DealMinDuration,
+		proposedEpoch:   epoch,
+		activationEpoch: epoch + conf.ActivationEpochs,
+	}
+	d.startEpoch = d.activationEpoch
+	if epoch+cfg.DealStartOffset > d.startEpoch {
+		d.startEpoch = epoch + cfg.DealStartOffset
+	}
+	if cs.sim.chance(conf.FailureRate) {
+		d.failEpoch = epoch + 1 + cs.sim.int63n(conf.ActivationEpochs)
+	}
+	if cs.sim.chance(conf.SlashRate) {
+		d.slashEpoch = d.activationEpoch + cs.sim.int63n(d.startEpoch+d.duration-d.activationEpoch)
+	}
+
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.lastDealID++
+	d.dealID = cs.lastDealID
+	d.proposalCid, err = dataCid([]byte(fmt.Sprintf("proposal-%d-%s-%s", d.dealID, c, miner)))
+	if err != nil {
+		return nil, err
+	}
+	cs.deals[d.proposalCid] = d
+	return d, nil
+}
+
+// state returns the deal state at epoch.
+func (d *simDeal) state(epoch int64) storagemarket.StorageDealStatus {
+	switch {
+	case d.failEpoch > 0 && epoch >= d.failEpoch:
+		return storagemarket.StorageDealError
+	case epoch == d.proposedEpoch:
+		return storagemarket.StorageDealCheckForAcceptance
+	case epoch < d.activationEpoch:
+		return storagemarket.StorageDealSealing
+	case d.slashEpoch > 0 && epoch >= d.slashEpoch:
+		return storagemarket.StorageDealSlashed
+	case epoch >= d.startEpoch+d.duration:
+		return storagemarket.StorageDealExpired
+	default:
+		return storagemarket.StorageDealActive
+	}
+}
+
+func (d *simDeal) info(epoch int64) deals.StorageDealInfo {
+	state := d.state(epoch)
+	di := deals.StorageDealInfo{
+		ProposalCid:   d.proposalCid,
+		StateID:       state,
+		StateName:     storagemarket.DealStates[state],
+		Miner:         d.miner,
+		PieceCID:      d.pieceCid,
+		Size:          uint64(d.size),
+		PricePerEpoch: d.epochPrice,
+		StartEpoch:    uint64(d.startEpoch),
+		Duration:      uint64(d.duration),
+	}
+	if epoch > d.proposedEpoch {
+		di.DealID = d.dealID
+	}
+	switch state {
+	case storagemarket.StorageDealError:
+		di.Message = "simulated deal failure"
+	case storagemarket.StorageDealActive, storagemarket.StorageDealSlashed, storagemarket.StorageDealExpired:
+		di.ActivationEpoch = d.activationEpoch
+	}
+	return di
+}
+
+func (d *simDeal) filStorage() ffs.FilStorage {
+	return ffs.FilStorage{
+		ProposalCid:     d.proposalCid,
+		PieceCid:        d.pieceCid,
+		Duration:        d.duration,
+		Miner:           d.miner,
+		ActivationEpoch: d.activationEpoch,
+		StartEpoch:      uint64(d.startEpoch),
+		EpochPrice:      d.epochPrice,
+		DealID:          d.dealID,
+	}
+}
+
+func pieceCid(c cid.Cid) (cid.Cid, error) {
+	h := sha256.Sum256(c.Bytes())
+	pc, err := commcid.DataCommitmentV1ToCID(h[:])
+	if err != nil {
+		return cid.Undef, fmt.Errorf("creating piece cid: %s", err)
+	}
+	return pc, nil
+}
+
+// paddedSize returns the padded piece size of a payload size.
+func paddedSize(size int64) abi.PaddedPieceSize {
+	padded := uint64(size) * 128 / 127
+	if padded < 256 {
+		return 256
+	}
+	return abi.PaddedPieceSize(1 << bits.Len64(padded-1))
+}
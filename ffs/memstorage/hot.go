@@ -0,0 +1,248 @@
+// Package memstorage provides in-memory implementations of ffs.HotStorage
+// and ffs.ColdStorage. They simulate storage with configurable latency,
+// failure injection and a pluggable clock, so the scheduler state machine
+// can run without IPFS or Filecoin nodes, e.g: for load testing.
+package memstorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/multiformats/go-multihash"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/dagcar"
+)
+
+var (
+	log = logging.Logger("ffs-memstorage")
+
+	// ErrInjectedFailure is returned by operations which failed due to
+	// failure injection.
+	ErrInjectedFailure = errors.New("injected failure")
+)
+
+// HotStorage is an in-memory ffs.HotStorage. Added data is kept until
+// it's removed, and only added data can be stored since there's no
+// network to pull it from.
+type HotStorage struct {
+	sim *sim
+	l   ffs.JobLogger
+
+	lock   sync.Mutex
+	data   map[cid.Cid][]byte
+	pinset map[cid.Cid]struct{}
+}
+
+var _ ffs.HotStorage = (*HotStorage)(nil)
+
+// NewHotStorage returns a new HotStorage.
+func NewHotStorage(l ffs.JobLogger, opts ...Option) (*HotStorage, error) {
+	conf, err := newConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("applying options: %s", err)
+	}
+	return &HotStorage{
+		sim:    newSim(conf),
+		l:      l,
+		data:   make(map[cid.Cid][]byte),
+		pinset: make(map[cid.Cid]struct{}),
+	}, nil
+}
+
+// Add adds data without storing it. The returned cid is a raw cid of
+// the data.
+func (hs *HotStorage) Add(ctx context.Context, r io.Reader) (cid.Cid, error) {
+	if err := hs.simulate(ctx); err != nil {
+		return cid.Undef, fmt.Errorf("adding data: %w", err)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("reading data: %s", err)
+	}
+	c, err := dataCid(data)
+	if err != nil {
+		return cid.Undef, err
+	}
+	hs.put(c, data)
+	return c, nil
+}
+
+// AddTar isn't supported since the HotStorage only holds raw data
+// without links.
+func (hs *HotStorage) AddTar(ctx context.Context, r io.Reader) (cid.Cid, error) {
+	return cid.Undef, fmt.Errorf("directories aren't supported")
+}
+
+// AddCAR adds the data of each block of a CAR file without storing it,
+// and returns the roots of the CAR file.
+func (hs *HotStorage) AddCAR(ctx context.Context, r io.Reader) ([]cid.Cid, error) {
+	if err := hs.simulate(ctx); err != nil {
+		return nil, fmt.Errorf("adding car file: %w", err)
+	}
+	roots, err := dagcar.Import(r, func(b blocks.Block) error {
+		hs.put(b.Cid(), b.RawData())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("importing car file: %s", err)
+	}
+	return roots, nil
+}
+
+// Remove removes a stored Cid.
+func (hs *HotStorage) Remove(ctx context.Context, c cid.Cid) error {
+	if err := hs.simulate(ctx); err != nil {
+		return fmt.Errorf("removing cid %s: %w", c, err)
+	}
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	delete(hs.pinset, c)
+	delete(hs.data, c)
+	hs.l.Log(ctx, "Cid data was removed from hot storage.")
+	return nil
+}
+
+// Get returns the data of an added or stored Cid.
+func (hs *HotStorage) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
+	if err := hs.simulate(ctx); err != nil {
+		return nil, fmt.Errorf("getting cid %s: %w", c, err)
+	}
+	data, ok := hs.get(c)
+	if !ok {
+		return nil, fmt.Errorf("cid %s not found", c)
+	}
+	return bytes.NewReader(data), nil
+}
+
+// GetPath returns the data of an added or stored Cid. Only the empty path
+// is supported since the data has no links.
+func (hs *HotStorage) GetPath(ctx context.Context, c cid.Cid, p string) (io.ReadCloser, bool, error) {
+	if p != "" {
+		return nil, false, fmt.Errorf("path %s not found in cid %s", p, c)
+	}
+	r, err := hs.Get(ctx, c)
+	if err != nil {
+		return nil, false, err
+	}
+	return ioutil.NopCloser(r), false, nil
+}
+
+// Store stores a previously added Cid, returning its size.
+func (hs *HotStorage) Store(ctx context.Context, c cid.Cid) (int, error) {
+	if err := hs.simulate(ctx); err != nil {
+		return 0, fmt.Errorf("storing cid %s: %w", c, err)
+	}
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	data, ok := hs.data[c]
+	if !ok {
+		return 0, fmt.Errorf("cid %s data isn't available", c)
+	}
+	hs.pinset[c] = struct{}{}
+	return len(data), nil
+}
+
+// Replace replaces a stored Cid with a previously added Cid.
+func (hs *HotStorage) Replace(ctx context.Context, c1 cid.Cid, c2 cid.Cid) (int, error) {
+	if err := hs.simulate(ctx); err != nil {
+		return 0, fmt.Errorf("replacing cid %s with %s: %w", c1, c2, err)
+	}
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	if _, ok := hs.pinset[c1]; !ok {
+		return 0, fmt.Errorf("cid %s isn't stored", c1)
+	}
+	data, ok := hs.data[c2]
+	if !ok {
+		return 0, fmt.Errorf("cid %s data isn't available", c2)
+	}
+	delete(hs.pinset, c1)
+	delete(hs.data, c1)
+	hs.pinset[c2] = struct{}{}
+	return len(data), nil
+}
+
+// IsStored returns true if the Cid is stored.
+func (hs *HotStorage) IsStored(ctx context.Context, c cid.Cid) (bool, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	_, ok := hs.pinset[c]
+	return ok, nil
+}
+
+// GetCAR writes the data of an added or stored Cid as a CAR file with a
+// single raw block.
+func (hs *HotStorage) GetCAR(ctx context.Context, c cid.Cid, sel ipld.Node, version int, w io.Writer) error {
+	if err := hs.simulate(ctx); err != nil {
+		return fmt.Errorf("getting cid %s: %w", c, err)
+	}
+	data, ok := hs.get(c)
+	if !ok {
+		return fmt.Errorf("cid %s not found", c)
+	}
+	return writeCAR(ctx, c, data, sel, version, w)
+}
+
+func (hs *HotStorage) simulate(ctx context.Context) error {
+	if err := hs.sim.delay(ctx); err != nil {
+		return err
+	}
+	if hs.sim.chance(hs.sim.conf.HotFailureRate) {
+		log.Debugf("injecting hot storage failure")
+		return ErrInjectedFailure
+	}
+	return nil
+}
+
+func (hs *HotStorage) put(c cid.Cid, data []byte) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	hs.data[c] = data
+}
+
+func (hs *HotStorage) get(c cid.Cid) ([]byte, bool) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	data, ok := hs.data[c]
+	return data, ok
+}
+
+// writeCAR writes data as a CAR file with a single raw block with Cid c.
+func writeCAR(ctx context.Context, c cid.Cid, data []byte, sel ipld.Node, version int, w io.Writer) error {
+	b, err := blocks.NewBlockWithCid(data, c)
+	if err != nil {
+		return fmt.Errorf("creating block: %s", err)
+	}
+	return dagcar.Write(ctx, blockStore{b}, c, sel, version, w)
+}
+
+// blockStore is a car.ReadStore of a single block.
+type blockStore struct {
+	b blocks.Block
+}
+
+func (bs blockStore) Get(c cid.Cid) (blocks.Block, error) {
+	if !c.Equals(bs.b.Cid()) {
+		return nil, fmt.Errorf("block %s not found", c)
+	}
+	return bs.b, nil
+}
+
+func dataCid(data []byte) (cid.Cid, error) {
+	h := sha256.Sum256(data)
+	mh, err := multihash.Encode(h[:], multihash.SHA2_256)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("encoding multihash: %s", err)
+	}
+	return cid.NewCidV1(cid.Raw, mh), nil
+}
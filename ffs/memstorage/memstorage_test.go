@@ -0,0 +1,614 @@
+package memstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/dagcar"
+	"github.com/textileio/powergate/ffs/joblogger"
+	"github.com/textileio/powergate/ffs/scheduler"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+)
+
+const epochDuration = time.Second * 30
+
+func TestDealLifecycle(t *testing.T) {
+	t.Parallel()
+	clock, hs, cs := newStorages(t)
+	c := addData(t, hs)
+	ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, c)
+
+	cfg := filConfig(2)
+	proposals, failed, size, err := cs.Store(ctx, c, cfg, ffs.ColdParams{})
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Len(t, proposals, 2)
+	require.Equal(t, 256, int(size))
+
+	fs := waitForDeal(ctx, t, clock, cs, c, proposals[0])
+	require.Equal(t, cs.Miners()[0], fs.Miner)
+	require.Equal(t, int64(2), fs.ActivationEpoch)
+	active, err := cs.IsFilDealActive(ctx, proposals[1])
+	require.NoError(t, err)
+	require.True(t, active)
+
+	require.NoError(t, hs.Remove(ctx, c))
+	_, err = hs.Get(ctx, c)
+	require.Error(t, err)
+	fi, err := cs.Fetch(ctx, c, &fs.PieceCid, "", []string{cs.Miners()[1]}, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, cs.Miners()[1], fi.RetrievedMiner)
+	_, err = hs.Get(ctx, c)
+	require.NoError(t, err)
+
+	clock.Advance(epochDuration * time.Duration(cfg.DealMinDuration))
+	active, err = cs.IsFilDealActive(ctx, proposals[0])
+	require.NoError(t, err)
+	require.False(t, active)
+	_, err = cs.Fetch(ctx, c, nil, "", nil, 0, "")
+	require.Error(t, err)
+}
+
+func TestGetCAR(t *testing.T) {
+	t.Parallel()
+	clock, hs, cs := newStorages(t)
+	c := addData(t, hs)
+	ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, c)
+
+	var buf bytes.Buffer
+	require.NoError(t, hs.GetCAR(ctx, c, nil, dagcar.V1, &buf))
+	requireCAR(t, &buf, c, "hello world")
+
+	_, err := cs.GetCAR(ctx, c, nil, "", nil, nil, dagcar.V1, &buf)
+	require.Error(t, err)
+	proposals, _, _, err := cs.Store(ctx, c, filConfig(1), ffs.ColdParams{})
+	require.NoError(t, err)
+	waitForDeal(ctx, t, clock, cs, c, proposals[0])
+	require.NoError(t, hs.Remove(ctx, c))
+	buf.Reset()
+	miner, err := cs.GetCAR(ctx, c, nil, "", nil, nil, dagcar.V1, &buf)
+	require.NoError(t, err)
+	require.Equal(t, cs.Miners()[0], miner)
+	requireCAR(t, &buf, c, "hello world")
+}
+
+func TestAddCAR(t *testing.T) {
+	t.Parallel()
+	_, hs, _ := newStorages(t)
+	c := addData(t, hs)
+	ctx := context.Background()
+
+	for _, version := range []int{dagcar.V1, dagcar.V2} {
+		var buf bytes.Buffer
+		require.NoError(t, hs.GetCAR(ctx, c, nil, version, &buf))
+		_, hs2, _ := newStorages(t)
+		roots, err := hs2.AddCAR(ctx, &buf)
+		require.NoError(t, err)
+		require.Equal(t, []cid.Cid{c}, roots)
+		r, err := hs2.Get(ctx, c)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(data))
+	}
+}
+
+func TestMinerSelection(t *testing.T) {
+	t.Parallel()
+	_, hs, cs := newStorages(t)
+	c := addData(t, hs)
+	ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, c)
+	miners := cs.Miners()
+
+	cfg := filConfig(1)
+	cfg.TrustedMiners = []string{miners[2]}
+	proposals, _, _, err := cs.Store(ctx, c, cfg, ffs.ColdParams{})
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	require.Equal(t, miners[2], cs.deals[proposals[0]].miner)
+
+	cfg = filConfig(3)
+	cfg.ExcludedMiners = []string{miners[0]}
+	_, _, _, err = cs.Store(ctx, c, cfg, ffs.ColdParams{})
+	require.Error(t, err)
+
+	cfg = filConfig(1)
+	cfg.MaxPrice = 1
+	_, _, _, err = cs.Store(ctx, c, cfg, ffs.ColdParams{})
+	require.Error(t, err)
+}
+
+func TestRejectAndSlash(t *testing.T) {
+	t.Parallel()
+	clock, hs, cs := newStorages(t)
+	c := addData(t, hs)
+	ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, c)
+	miners := cs.Miners()
+
+	cs.SetMinerRejecting(miners[0], true)
+	proposals, failed, _, err := cs.Store(ctx, c, filConfig(2), ffs.ColdParams{})
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	require.Len(t, failed, 1)
+	require.Equal(t, miners[0], failed[0].Miner)
+
+	require.Error(t, cs.SlashDeal(proposals[0]))
+	waitForDeal(ctx, t, clock, cs, c, proposals[0])
+	require.NoError(t, cs.SlashDeal(proposals[0]))
+	active, err := cs.IsFilDealActive(ctx, proposals[0])
+	require.NoError(t, err)
+	require.False(t, active)
+}
+
+func TestFailureInjection(t *testing.T) {
+	t.Parallel()
+	clock, hs, cs := newStorages(t, WithFailureRate(1))
+	c := addData(t, hs)
+	ctx := context.WithValue(context.Background(), ffs.CtxStorageCid, c)
+
+	proposals, _, _, err := cs.Store(ctx, c, filConfig(1), ffs.ColdParams{})
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	ch := make(chan deals.StorageDealInfo, 100)
+	res := make(chan error)
+	go func() {
+		_, err := cs.WaitForDeal(ctx, c, proposals[0], time.Hour, ch, ffs.ColdParams{})
+		res <- err
+	}()
+	err = advanceUntil(clock, res)
+	var dealError ffs.DealError
+	require.True(t, errors.As(err, &dealError))
+	require.Equal(t, proposals[0], dealError.ProposalCid)
+
+	hs, err = NewHotStorage(newLogger(t), WithHotFailureRate(1))
+	require.NoError(t, err)
+	_, err = hs.Add(ctx, bytes.NewReader([]byte("data")))
+	require.True(t, errors.Is(err, ErrInjectedFailure))
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+	f := newSchedulerFixture(t, nil)
+	cfg := ffs.StorageConfig{
+		Hot:  ffs.HotConfig{Enabled: true, Ipfs: ffs.IpfsConfig{AddTimeout: 1}},
+		Cold: ffs.ColdConfig{Enabled: true, Filecoin: filConfig(2)},
+	}
+	cfg.Cold.Filecoin.Addr = "f0100"
+	job := f.push(t, cfg)
+
+	si, err := f.sched.GetStorageInfo(f.c)
+	require.NoError(t, err)
+	require.True(t, si.Hot.Enabled)
+	require.Len(t, si.Cold.Filecoin.Proposals, 2)
+
+	steps := map[string]int{}
+	for _, s := range job.Steps {
+		require.NotZero(t, s.FinishedAt, s.Name)
+		require.Empty(t, s.Error, s.Name)
+		steps[s.Name]++
+	}
+	require.Equal(t, 1, steps[ffs.StepHotStorage])
+	require.Equal(t, 1, steps[ffs.StepMinerSelection])
+	require.Equal(t, 2, steps[ffs.StepDealProposal])
+	require.Equal(t, 1, steps[ffs.StepFinalization])
+}
+
+func TestSchedulerColdOnlyRenewal(t *testing.T) {
+	t.Parallel()
+	f := newSchedulerFixture(t, nil)
+	cfg := ffs.StorageConfig{
+		Cold: ffs.ColdConfig{Enabled: true, Filecoin: filConfig(1)},
+	}.WithColdMaxRetrievalPrice(1000)
+	cfg.Cold.Filecoin.Addr = "f0100"
+	cfg.Cold.Filecoin.Renew = ffs.FilRenew{Enabled: true, Threshold: util.MinDealDuration}
+	require.NoError(t, cfg.Validate())
+	f.push(t, cfg)
+
+	// Simulate the staged data being garbage collected, so renewals
+	// should retrieve it from the existing deal.
+	require.NoError(t, f.hs.Remove(context.WithValue(context.Background(), ffs.CtxStorageCid, f.c), f.c))
+	job := f.push(t, cfg)
+
+	si, err := f.sched.GetStorageInfo(f.c)
+	require.NoError(t, err)
+	require.False(t, si.Hot.Enabled)
+	require.Len(t, si.Cold.Filecoin.Proposals, 2)
+	require.True(t, si.Cold.Filecoin.Proposals[0].Renewed)
+	var retrievals int
+	for _, s := range job.Steps {
+		if s.Name == ffs.StepColdRetrieval {
+			require.Empty(t, s.Error)
+			retrievals++
+		}
+	}
+	require.Equal(t, 1, retrievals)
+	// The data was only pinned temporarily to make the renewal deal.
+	stored, err := f.hs.IsStored(context.Background(), f.c)
+	require.NoError(t, err)
+	require.False(t, stored)
+}
+
+func TestSchedulerEscalation(t *testing.T) {
+	t.Parallel()
+	f := newSchedulerFixture(t, nil)
+	miners := f.cs.Miners()
+	f.cs.SetMinerRejecting(miners[0], true)
+	askPrice := uint64(500000000)
+	cfg := ffs.StorageConfig{
+		Hot:  ffs.HotConfig{Enabled: true, Ipfs: ffs.IpfsConfig{AddTimeout: 1}},
+		Cold: ffs.ColdConfig{Enabled: true, Filecoin: filConfig(2)},
+	}.WithColdMaxPrice(askPrice*2).WithColdFilEscalation(true, 2, 50, 0)
+	cfg.Cold.Filecoin.Addr = "f0100"
+	require.NoError(t, cfg.Validate())
+	// A price increase requires a max price only if escalation is enabled.
+	require.Error(t, cfg.WithColdMaxPrice(0).Validate())
+	require.NoError(t, cfg.WithColdMaxPrice(0).WithColdFilEscalation(false, 2, 50, 0).Validate())
+	job := f.push(t, cfg)
+	require.Len(t, job.DealErrors, 1)
+	require.Equal(t, miners[0], job.DealErrors[0].Miner)
+
+	// The rejected deal was re-proposed to a new miner with a higher price.
+	si, err := f.sched.GetStorageInfo(f.c)
+	require.NoError(t, err)
+	require.Len(t, si.Cold.Filecoin.Proposals, 2)
+	prices := map[string]uint64{}
+	for _, p := range si.Cold.Filecoin.Proposals {
+		prices[p.Miner] = p.EpochPrice
+	}
+	require.Equal(t, askPrice, prices[miners[1]])
+	require.Equal(t, askPrice*3/2, prices[miners[2]])
+	var rounds int
+	for _, s := range job.Steps {
+		if s.Name == ffs.StepDealEscalation {
+			require.Empty(t, s.Error)
+			rounds++
+		}
+	}
+	require.Equal(t, 1, rounds)
+}
+
+func TestSchedulerEscalationDeadline(t *testing.T) {
+	t.Parallel()
+	// Deals don't finish before the escalation deadline.
+	f := newSchedulerFixture(t, []Option{WithActivationEpochs(1000)})
+	cfg := ffs.StorageConfig{
+		Hot:  ffs.HotConfig{Enabled: true, Ipfs: ffs.IpfsConfig{AddTimeout: 1}},
+		Cold: ffs.ColdConfig{Enabled: true, Filecoin: filConfig(1)},
+	}.WithColdFilEscalation(true, 2, 0, 1)
+	cfg.Cold.Filecoin.Addr = "f0100"
+	require.NoError(t, cfg.Validate())
+	jid, err := f.sched.PushConfig(ffs.NewAPIID(), f.c, cfg)
+	require.NoError(t, err)
+	var job ffs.StorageJob
+	require.Eventually(t, func() bool {
+		job, err = f.sched.StorageJob(jid)
+		require.NoError(t, err)
+		return job.Status == ffs.Failed
+	}, time.Second*5, time.Millisecond*50)
+	// The unfinished deal isn't a deal error, and is resumed by the next Job.
+	require.Empty(t, job.DealErrors)
+	for _, s := range job.Steps {
+		require.NotEqual(t, ffs.StepDealEscalation, s.Name)
+	}
+}
+
+func TestSchedulerDealTracker(t *testing.T) {
+	t.Parallel()
+	dt := newFakeDealTracker()
+	f := newSchedulerFixture(t, nil, scheduler.WithDealTracker(dt))
+	c := f.c
+	cfg := ffs.StorageConfig{
+		Hot:  ffs.HotConfig{Enabled: true, Ipfs: ffs.IpfsConfig{AddTimeout: 1}},
+		Cold: ffs.ColdConfig{Enabled: true, Filecoin: filConfig(1)},
+	}.WithRepairable(true)
+	cfg.Cold.Filecoin.Addr = "f0100"
+	f.push(t, cfg)
+
+	si, err := f.sched.GetStorageInfo(c)
+	require.NoError(t, err)
+	require.Len(t, si.Cold.Filecoin.Proposals, 1)
+	slashed := si.Cold.Filecoin.Proposals[0]
+	require.NotZero(t, slashed.DealID)
+	require.Equal(t, c, dt.get(slashed.DealID))
+
+	// A slashed deal is saved in the StorageInfo, and repaired with a
+	// new deal.
+	require.NoError(t, f.cs.SlashDeal(slashed.ProposalCid))
+	e := ffs.DealEvent{Cid: c, DealID: slashed.DealID, State: ffs.DealSlashed, Reason: "slashed"}
+	dt.events <- e
+	require.Eventually(t, func() bool {
+		si, err = f.sched.GetStorageInfo(c)
+		require.NoError(t, err)
+		return len(si.Cold.Filecoin.Proposals) == 1 && si.Cold.Filecoin.Proposals[0].DealID != slashed.DealID
+	}, time.Second*5, time.Millisecond*50)
+	repaired := si.Cold.Filecoin.Proposals[0]
+	require.Equal(t, ffs.DealActive, repaired.State)
+	require.Equal(t, c, dt.get(repaired.DealID))
+	require.Eventually(t, func() bool { return dt.acks(slashed.DealID) == 1 }, time.Second*5, time.Millisecond*50)
+
+	// Events sent again are acknowledged without repairing twice.
+	dt.events <- e
+	require.Eventually(t, func() bool { return dt.acks(slashed.DealID) == 2 }, time.Second*5, time.Millisecond*50)
+	si, err = f.sched.GetStorageInfo(c)
+	require.NoError(t, err)
+	require.Equal(t, repaired, si.Cold.Filecoin.Proposals[0])
+
+	entries, err := f.l.Query(context.Background(), ffs.LogQuery{Cid: c, MinLevel: ffs.LogWarn})
+	require.NoError(t, err)
+	var found bool
+	for _, e := range entries {
+		if e.Event == ffs.EventDealLifecycle {
+			require.Contains(t, e.Msg, "slashed")
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestSchedulerDealTrackerBackfill(t *testing.T) {
+	t.Parallel()
+	f := newSchedulerFixture(t, nil)
+	cfg := ffs.StorageConfig{
+		Hot:  ffs.HotConfig{Enabled: true, Ipfs: ffs.IpfsConfig{AddTimeout: 1}},
+		Cold: ffs.ColdConfig{Enabled: true, Filecoin: filConfig(1)},
+	}
+	cfg.Cold.Filecoin.Addr = "f0100"
+	f.push(t, cfg)
+	si, err := f.sched.GetStorageInfo(f.c)
+	require.NoError(t, err)
+	require.Len(t, si.Cold.Filecoin.Proposals, 1)
+	dealID := si.Cold.Filecoin.Proposals[0].DealID
+	require.NotZero(t, dealID)
+
+	// Deals stored without a deal tracker, and without a deal id as
+	// before deal ids were saved, are tracked when the scheduler
+	// starts with one.
+	si.Cold.Filecoin.Proposals[0].DealID = 0
+	buf, err := json.Marshal(si)
+	require.NoError(t, err)
+	require.NoError(t, f.ds.Put(datastore.NewKey("cistore").ChildString(util.CidToString(f.c)), buf))
+	dt := newFakeDealTracker()
+	sched, err := scheduler.New(f.ds, f.l, f.hs, f.cs, 10, time.Minute, nil, scheduler.WithDealTracker(dt))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, sched.Close()) })
+	require.Eventually(t, func() bool { return dt.get(dealID) == f.c }, time.Second*5, time.Millisecond*50)
+	si, err = sched.GetStorageInfo(f.c)
+	require.NoError(t, err)
+	require.Equal(t, dealID, si.Cold.Filecoin.Proposals[0].DealID)
+}
+
+func TestSchedulerGetCARFromCold(t *testing.T) {
+	t.Parallel()
+	f := newSchedulerFixture(t, nil)
+	cfg := ffs.StorageConfig{
+		Cold: ffs.ColdConfig{Enabled: true, Filecoin: filConfig(2)},
+	}
+	cfg.Cold.Filecoin.Addr = "f0100"
+	f.push(t, cfg)
+	si, err := f.sched.GetStorageInfo(f.c)
+	require.NoError(t, err)
+	require.Len(t, si.Cold.Filecoin.Proposals, 2)
+
+	// The first miner is asked for a piece it doesn't have, so the data
+	// is retrieved from the second miner with the piece of its deal.
+	si.Cold.Filecoin.Proposals[0].PieceCid = f.c
+	buf, err := json.Marshal(si)
+	require.NoError(t, err)
+	require.NoError(t, f.ds.Put(datastore.NewKey("cistore").ChildString(util.CidToString(f.c)), buf))
+
+	ctx := context.Background()
+	sel, err := dagcar.ParseSelector(`{".":{}}`)
+	require.NoError(t, err)
+	var car bytes.Buffer
+	miner, err := f.sched.GetCARFromCold(ctx, f.c, "f0100", sel, dagcar.V1, &car)
+	require.NoError(t, err)
+	require.Equal(t, si.Cold.Filecoin.Proposals[1].Miner, miner)
+	requireCAR(t, &car, f.c, "hello world")
+
+	car.Reset()
+	_, err = f.sched.GetCARFromCold(ctx, f.c, "f0100", nil, dagcar.V2, &car)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(car.Bytes(), []byte{0x0a, 0xa1, 0x67, 'v', 'e', 'r', 's', 'i', 'o', 'n', 0x02}))
+}
+
+func TestManualClock(t *testing.T) {
+	t.Parallel()
+	clock := NewManualClock(time.Unix(0, 0))
+	ch := clock.After(time.Second)
+	require.Equal(t, 1, clock.Waiters())
+	clock.Advance(time.Millisecond * 500)
+	select {
+	case <-ch:
+		t.Fatal("clock fired early")
+	default:
+	}
+	clock.Advance(time.Millisecond * 500)
+	require.Equal(t, time.Unix(1, 0), <-ch)
+	require.Equal(t, 0, clock.Waiters())
+}
+
+func newStorages(t *testing.T, opts ...Option) (*ManualClock, *HotStorage, *ColdStorage) {
+	clock := NewManualClock(time.Unix(0, 0))
+	opts = append([]Option{
+		WithClock(clock),
+		WithEpochDuration(epochDuration),
+		WithActivationEpochs(2),
+	}, opts...)
+	l := newLogger(t)
+	hs, err := NewHotStorage(l, opts...)
+	require.NoError(t, err)
+	cs, err := NewColdStorage(hs, l, opts...)
+	require.NoError(t, err)
+	return clock, hs, cs
+}
+
+func newLogger(t *testing.T) ffs.JobLogger {
+	l, err := joblogger.New(tests.NewTxMapDatastore())
+	require.NoError(t, err)
+	return l
+}
+
+func addData(t *testing.T, hs *HotStorage) cid.Cid {
+	ctx := context.Background()
+	c, err := hs.Add(ctx, bytes.NewReader([]byte("hello world")))
+	require.NoError(t, err)
+	_, err = hs.Store(ctx, c)
+	require.NoError(t, err)
+	r, err := hs.Get(ctx, c)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+	return c
+}
+
+func requireCAR(t *testing.T, r io.Reader, c cid.Cid, data string) {
+	cr, err := car.NewCarReader(r)
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{c}, cr.Header.Roots)
+	b, err := cr.Next()
+	require.NoError(t, err)
+	require.Equal(t, c, b.Cid())
+	require.Equal(t, data, string(b.RawData()))
+	_, err = cr.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func filConfig(repFactor int) ffs.FilConfig {
+	return ffs.FilConfig{
+		RepFactor:       repFactor,
+		DealMinDuration: util.MinDealDuration,
+	}
+}
+
+func waitForDeal(ctx context.Context, t *testing.T, clock *ManualClock, cs *ColdStorage, c, proposal cid.Cid) ffs.FilStorage {
+	ch := make(chan deals.StorageDealInfo, 100)
+	res := make(chan error)
+	var fs ffs.FilStorage
+	go func() {
+		var err error
+		fs, err = cs.WaitForDeal(ctx, c, proposal, time.Hour, ch, ffs.ColdParams{})
+		res <- err
+	}()
+	require.NoError(t, advanceUntil(clock, res))
+	return fs
+}
+
+// advanceUntil advances the clock one epoch at a time, whenever
+// WaitForDeal is blocked on it, until a result is received.
+func advanceUntil(clock *ManualClock, res chan error) error {
+	for {
+		select {
+		case err := <-res:
+			return err
+		case <-time.After(time.Millisecond * 10):
+		}
+		// Timeout and next epoch waiters.
+		if clock.Waiters() >= 2 {
+			clock.Advance(epochDuration)
+		}
+	}
+}
+
+// schedulerFixture is a scheduler running on in-memory storages with
+// fast deals, and the Cid of data added to the Hot Storage.
+type schedulerFixture struct {
+	ds    datastore.TxnDatastore
+	l     ffs.JobLogger
+	hs    *HotStorage
+	cs    *ColdStorage
+	sched *scheduler.Scheduler
+	c     cid.Cid
+}
+
+func newSchedulerFixture(t *testing.T, sopts []Option, opts ...scheduler.Option) schedulerFixture {
+	l := newLogger(t)
+	sopts = append([]Option{WithEpochDuration(time.Millisecond * 10), WithActivationEpochs(2)}, sopts...)
+	hs, err := NewHotStorage(l, sopts...)
+	require.NoError(t, err)
+	cs, err := NewColdStorage(hs, l, sopts...)
+	require.NoError(t, err)
+	ds := tests.NewTxMapDatastore()
+	sched, err := scheduler.New(ds, l, hs, cs, 10, time.Minute, nil, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, sched.Close()) })
+	c, err := hs.Add(context.Background(), bytes.NewReader([]byte("hello world")))
+	require.NoError(t, err)
+	return schedulerFixture{ds: ds, l: l, hs: hs, cs: cs, sched: sched, c: c}
+}
+
+// push pushes cfg for the Cid of the fixture, and returns the Job once it
+// succeeds.
+func (f schedulerFixture) push(t *testing.T, cfg ffs.StorageConfig) ffs.StorageJob {
+	jid, err := f.sched.PushConfig(ffs.NewAPIID(), f.c, cfg)
+	require.NoError(t, err)
+	var job ffs.StorageJob
+	require.Eventually(t, func() bool {
+		job, err = f.sched.StorageJob(jid)
+		require.NoError(t, err)
+		require.NotEqual(t, ffs.Failed, job.Status, job.ErrCause)
+		return job.Status == ffs.Success
+	}, time.Second*5, time.Millisecond*50)
+	return job
+}
+
+type fakeDealTracker struct {
+	lock    sync.Mutex
+	tracked map[uint64]cid.Cid
+	acked   map[uint64]int
+	events  chan ffs.DealEvent
+}
+
+func newFakeDealTracker() *fakeDealTracker {
+	return &fakeDealTracker{
+		tracked: map[uint64]cid.Cid{},
+		acked:   map[uint64]int{},
+		events:  make(chan ffs.DealEvent),
+	}
+}
+
+func (dt *fakeDealTracker) Track(c cid.Cid, dealID uint64, endEpoch int64) error {
+	dt.lock.Lock()
+	defer dt.lock.Unlock()
+	dt.tracked[dealID] = c
+	return nil
+}
+
+func (dt *fakeDealTracker) Listen() <-chan ffs.DealEvent {
+	return dt.events
+}
+
+func (dt *fakeDealTracker) Ack(dealID uint64) error {
+	dt.lock.Lock()
+	defer dt.lock.Unlock()
+	dt.acked[dealID]++
+	return nil
+}
+
+func (dt *fakeDealTracker) Unregister(<-chan ffs.DealEvent) {}
+
+func (dt *fakeDealTracker) acks(dealID uint64) int {
+	dt.lock.Lock()
+	defer dt.lock.Unlock()
+	return dt.acked[dealID]
+}
+
+func (dt *fakeDealTracker) get(dealID uint64) cid.Cid {
+	dt.lock.Lock()
+	defer dt.lock.Unlock()
+	return dt.tracked[dealID]
+}
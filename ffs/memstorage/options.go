@@ -0,0 +1,228 @@
+package memstorage
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Config contains the configuration of in-memory storages.
+type Config struct {
+	// Clock drives latencies, timeouts and simulated epochs.
+	Clock Clock
+	// Latency is the delay of every storage operation.
+	Latency time.Duration
+	// Seed is the seed of the random source used for failure injection,
+	// so simulations can be replayed.
+	Seed int64
+
+	// HotFailureRate is the probability of a hot storage operation failing.
+	HotFailureRate float64
+
+	// EpochDuration is the duration of a simulated chain epoch.
+	EpochDuration time.Duration
+	// Miners is the number of simulated miners.
+	Miners int
+	// MinerEpochPrice is the ask price of miners in attoFIL per GiB per epoch.
+	MinerEpochPrice uint64
+	// ActivationEpochs is the number of epochs between a deal proposal and
+	// its activation.
+	ActivationEpochs int64
+	// RejectRate is the probability of a deal proposal being rejected.
+	RejectRate float64
+	// FailureRate is the probability of an accepted deal failing before
+	// being active.
+	FailureRate float64
+	// SlashRate is the probability of an active deal being slashed before
+	// expiring.
+	SlashRate float64
+}
+
+// Option sets values on a Config.
+type Option func(*Config) error
+
+func defaultConfig() Config {
+	return Config{
+		Clock:            SystemClock(),
+		EpochDuration:    time.Second * 30,
+		Miners:           3,
+		MinerEpochPrice:  500000000,
+		ActivationEpochs: 10,
+	}
+}
+
+func newConfig(opts []Option) (Config, error) {
+	conf := defaultConfig()
+	for _, o := range opts {
+		if err := o(&conf); err != nil {
+			return Config{}, err
+		}
+	}
+	return conf, nil
+}
+
+// WithClock sets the clock of the storage. By default, the system
+// clock is used.
+func WithClock(c Clock) Option {
+	return func(conf *Config) error {
+		conf.Clock = c
+		return nil
+	}
+}
+
+// WithLatency sets the delay of every storage operation.
+func WithLatency(d time.Duration) Option {
+	return func(conf *Config) error {
+		if d < 0 {
+			return fmt.Errorf("latency can't be negative")
+		}
+		conf.Latency = d
+		return nil
+	}
+}
+
+// WithSeed sets the seed of the random source used for failure injection.
+func WithSeed(seed int64) Option {
+	return func(conf *Config) error {
+		conf.Seed = seed
+		return nil
+	}
+}
+
+// WithHotFailureRate sets the probability of hot storage operations failing.
+func WithHotFailureRate(rate float64) Option {
+	return func(conf *Config) error {
+		if err := checkRate(rate); err != nil {
+			return err
+		}
+		conf.HotFailureRate = rate
+		return nil
+	}
+}
+
+// WithEpochDuration sets the duration of simulated epochs.
+func WithEpochDuration(d time.Duration) Option {
+	return func(conf *Config) error {
+		if d <= 0 {
+			return fmt.Errorf("epoch duration should be positive")
+		}
+		conf.EpochDuration = d
+		return nil
+	}
+}
+
+// WithMiners sets the number of simulated miners and their ask price.
+func WithMiners(count int, epochPrice uint64) Option {
+	return func(conf *Config) error {
+		if count <= 0 {
+			return fmt.Errorf("miners count should be positive")
+		}
+		conf.Miners = count
+		conf.MinerEpochPrice = epochPrice
+		return nil
+	}
+}
+
+// WithActivationEpochs sets the number of epochs it takes for a deal
+// to be active after being proposed.
+func WithActivationEpochs(epochs int64) Option {
+	return func(conf *Config) error {
+		if epochs <= 0 {
+			return fmt.Errorf("activation epochs should be positive")
+		}
+		conf.ActivationEpochs = epochs
+		return nil
+	}
+}
+
+// WithRejectRate sets the probability of deal proposals being rejected.
+func WithRejectRate(rate float64) Option {
+	return func(conf *Config) error {
+		if err := checkRate(rate); err != nil {
+			return err
+		}
+		conf.RejectRate = rate
+		return nil
+	}
+}
+
+// WithFailureRate sets the probability of accepted deals failing before
+// being active.
+func WithFailureRate(rate float64) Option {
+	return func(conf *Config) error {
+		if err := checkRate(rate); err != nil {
+			return err
+		}
+		conf.FailureRate = rate
+		return nil
+	}
+}
+
+// WithSlashRate sets the probability of active deals being slashed
+// before expiring.
+func WithSlashRate(rate float64) Option {
+	return func(conf *Config) error {
+		if err := checkRate(rate); err != nil {
+			return err
+		}
+		conf.SlashRate = rate
+		return nil
+	}
+}
+
+func checkRate(rate float64) error {
+	if rate < 0 || rate > 1 {
+		return fmt.Errorf("rate %f should be between 0 and 1", rate)
+	}
+	return nil
+}
+
+// sim contains the shared simulation machinery of in-memory storages.
+type sim struct {
+	conf Config
+
+	rndLock sync.Mutex
+	rnd     *rand.Rand
+}
+
+func newSim(conf Config) *sim {
+	return &sim{
+		conf: conf,
+		rnd:  rand.New(rand.NewSource(conf.Seed)),
+	}
+}
+
+// delay waits the configured latency.
+func (s *sim) delay(ctx context.Context) error {
+	if s.conf.Latency == 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("canceled by context")
+	case <-s.conf.Clock.After(s.conf.Latency):
+		return nil
+	}
+}
+
+// chance returns true with probability rate.
+func (s *sim) chance(rate float64) bool {
+	if rate == 0 {
+		return false
+	}
+	s.rndLock.Lock()
+	defer s.rndLock.Unlock()
+	return s.rnd.Float64() < rate
+}
+
+// int63n returns a random number in [0, n).
+func (s *sim) int63n(n int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+	s.rndLock.Lock()
+	defer s.rndLock.Unlock()
+	return s.rnd.Int63n(n)
+}
@@ -0,0 +1,168 @@
+package piece
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	format "github.com/ipfs/go-ipld-format"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/ipld/go-car"
+	"github.com/textileio/powergate/util"
+)
+
+var (
+	log = logging.Logger("ffs-piece")
+)
+
+// Info is the piece of the data of a payload Cid.
+type Info struct {
+	PieceCid  cid.Cid
+	PieceSize abi.PaddedPieceSize
+}
+
+// Cache is a persisted cache of the pieces of payload Cids. Pieces are
+// calculated locally from the CAR serialization of the payload DAG.
+type Cache struct {
+	ds  datastore.Datastore
+	dag format.NodeGetter
+
+	semaph chan struct{}
+
+	lock     sync.Mutex
+	inflight map[cid.Cid]*calculation
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type calculation struct {
+	done chan struct{}
+	info Info
+	err  error
+}
+
+// New returns a new Cache which calculates pieces with the DAG available
+// in dag, running at most maxParallel background calculations at once.
+func New(ds datastore.Datastore, dag format.NodeGetter, maxParallel int) *Cache {
+	if maxParallel <= 0 {
+		maxParallel = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Cache{
+		ds:       ds,
+		dag:      dag,
+		semaph:   make(chan struct{}, maxParallel),
+		inflight: make(map[cid.Cid]*calculation),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Get returns the cached piece of a payload Cid, and false if it wasn't
+// calculated yet.
+func (c *Cache) Get(payload cid.Cid) (Info, bool, error) {
+	buf, err := c.ds.Get(makeKey(payload))
+	if err == datastore.ErrNotFound {
+		return Info{}, false, nil
+	}
+	if err != nil {
+		return Info{}, false, fmt.Errorf("getting piece from datastore: %s", err)
+	}
+	var i Info
+	if err := json.Unmarshal(buf, &i); err != nil {
+		return Info{}, false, fmt.Errorf("unmarshaling piece: %s", err)
+	}
+	return i, true, nil
+}
+
+// Calculate returns the piece of a payload Cid, calculating and caching
+// it if needed. Concurrent calls for the same Cid share the calculation.
+func (c *Cache) Calculate(ctx context.Context, payload cid.Cid) (Info, error) {
+	i, ok, err := c.Get(payload)
+	if err != nil {
+		return Info{}, err
+	}
+	if ok {
+		return i, nil
+	}
+
+	c.lock.Lock()
+	calc, ok := c.inflight[payload]
+	if !ok {
+		calc = &calculation{done: make(chan struct{})}
+		c.inflight[payload] = calc
+		c.lock.Unlock()
+		calc.info, calc.err = c.calculate(ctx, payload)
+		c.lock.Lock()
+		delete(c.inflight, payload)
+		close(calc.done)
+		c.lock.Unlock()
+		return calc.info, calc.err
+	}
+	c.lock.Unlock()
+
+	select {
+	case <-calc.done:
+		return calc.info, calc.err
+	case <-ctx.Done():
+		return Info{}, fmt.Errorf("waiting for piece calculation: %s", ctx.Err())
+	}
+}
+
+// Precompute calculates and caches the piece of a payload Cid in the
+// background, e.g: when data is staged, so new deals don't wait for it.
+func (c *Cache) Precompute(payload cid.Cid) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		select {
+		case c.semaph <- struct{}{}:
+		case <-c.ctx.Done():
+			return
+		}
+		defer func() { <-c.semaph }()
+		i, err := c.Calculate(c.ctx, payload)
+		if err != nil {
+			log.Errorf("precomputing piece of %s: %s", payload, err)
+			return
+		}
+		log.Debugf("precomputed piece %s of %s", i.PieceCid, payload)
+	}()
+}
+
+// Close cancels background calculations.
+func (c *Cache) Close() error {
+	c.cancel()
+	c.wg.Wait()
+	return nil
+}
+
+func (c *Cache) calculate(ctx context.Context, payload cid.Cid) (Info, error) {
+	w := NewWriter()
+	if err := car.WriteCar(ctx, c.dag, []cid.Cid{payload}, w); err != nil {
+		return Info{}, fmt.Errorf("serializing dag to car: %s", err)
+	}
+	pieceCid, pieceSize, err := w.Sum()
+	if err != nil {
+		return Info{}, fmt.Errorf("calculating piece commitment: %s", err)
+	}
+	i := Info{PieceCid: pieceCid, PieceSize: pieceSize}
+	buf, err := json.Marshal(i)
+	if err != nil {
+		return Info{}, fmt.Errorf("marshaling piece: %s", err)
+	}
+	if err := c.ds.Put(makeKey(payload), buf); err != nil {
+		return Info{}, fmt.Errorf("saving piece in datastore: %s", err)
+	}
+	return i, nil
+}
+
+func makeKey(c cid.Cid) datastore.Key {
+	return datastore.NewKey(util.CidToString(c))
+}
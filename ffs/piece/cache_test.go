@@ -0,0 +1,107 @@
+package piece
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	ipfsfiles "github.com/ipfs/go-ipfs-files"
+	httpapi "github.com/ipfs/go-ipfs-http-client"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+)
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dag := dstest.Mock()
+	c := addDag(t, dag)
+	cache := New(tests.NewTxMapDatastore(), dag, 1)
+	t.Cleanup(func() { require.NoError(t, cache.Close()) })
+
+	_, ok, err := cache.Get(c)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	i, err := cache.Calculate(ctx, c)
+	require.NoError(t, err)
+	w := NewWriter()
+	require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{c}, w))
+	pieceCid, pieceSize, err := w.Sum()
+	require.NoError(t, err)
+	require.Equal(t, pieceCid, i.PieceCid)
+	require.Equal(t, pieceSize, i.PieceSize)
+
+	cached, ok, err := cache.Get(c)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, i, cached)
+}
+
+func TestPrecompute(t *testing.T) {
+	t.Parallel()
+	dag := dstest.Mock()
+	c := addDag(t, dag)
+	cache := New(tests.NewTxMapDatastore(), dag, 1)
+	t.Cleanup(func() { require.NoError(t, cache.Close()) })
+
+	cache.Precompute(c)
+	require.Eventually(t, func() bool {
+		_, ok, err := cache.Get(c)
+		require.NoError(t, err)
+		return ok
+	}, time.Second*5, time.Millisecond*50)
+}
+
+// TestLotusPiece checks the piece of a multi-block UnixFS DAG against the
+// one calculated by Lotus, since deals are proposed with the local piece.
+func TestLotusPiece(t *testing.T) {
+	ctx := context.Background()
+	ipfsDocker, cls := tests.LaunchIPFSDocker(t)
+	t.Cleanup(cls)
+	ipfs, err := httpapi.NewApi(util.MustParseAddr("/ip4/127.0.0.1/tcp/" + ipfsDocker.GetPort("5001/tcp")))
+	require.NoError(t, err)
+	bridgeIP := ipfsDocker.Container.NetworkSettings.Networks["bridge"].IPAddress
+	cb, _, _ := tests.CreateLocalDevnetWithIPFS(t, 1, fmt.Sprintf("/ip4/%s/tcp/5001", bridgeIP), false)
+	lapi, lcls, err := cb(ctx)
+	require.NoError(t, err)
+	t.Cleanup(lcls)
+
+	// 3MiB of random data are chunked in many blocks, with a piece
+	// bigger than a single padded CAR block.
+	data := make([]byte, 3<<20)
+	r := rand.New(rand.NewSource(22))
+	_, _ = r.Read(data)
+	p, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(bytes.NewReader(data)), options.Unixfs.Pin(true))
+	require.NoError(t, err)
+
+	cache := New(tests.NewTxMapDatastore(), ipfs.Dag(), 1)
+	t.Cleanup(func() { require.NoError(t, cache.Close()) })
+	i, err := cache.Calculate(ctx, p.Cid())
+	require.NoError(t, err)
+
+	expected, err := lapi.ClientDealPieceCID(ctx, p.Cid())
+	require.NoError(t, err)
+	require.Equal(t, expected.PieceCID, i.PieceCid)
+	require.Equal(t, expected.PieceSize, i.PieceSize)
+}
+
+func addDag(t *testing.T, dag format.DAGService) cid.Cid {
+	ctx := context.Background()
+	leaf := merkledag.NodeWithData([]byte("hello world"))
+	require.NoError(t, dag.Add(ctx, leaf))
+	root := merkledag.NodeWithData([]byte("root"))
+	require.NoError(t, root.AddNodeLink("leaf", leaf))
+	require.NoError(t, dag.Add(ctx, root))
+	return root.Cid()
+}
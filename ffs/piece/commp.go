@@ -0,0 +1,139 @@
+package piece
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+
+	commcid "github.com/filecoin-project/go-fil-commcid"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/extern/sector-storage/fr32"
+	"github.com/ipfs/go-cid"
+)
+
+const (
+	nodeSize = 32
+	// bufChunks is the number of 127 bytes chunks padded at once.
+	bufChunks = 1024
+)
+
+// zeroNodes contains the merkle nodes of zeroed subtrees for every level
+// of the tree of the largest sector size.
+var zeroNodes = func() [][nodeSize]byte {
+	res := make([][nodeSize]byte, 64)
+	for i := 1; i < len(res); i++ {
+		res[i] = hashNodes(res[i-1], res[i-1])
+	}
+	return res
+}()
+
+// Writer calculates the piece commitment (CommP) of the written bytes, as
+// Filecoin does for the CAR serialization of the data of a deal.
+type Writer struct {
+	len    uint64
+	buf    []byte
+	padded []byte
+	// stack contains the roots of completed subtrees, from bigger to
+	// smaller, at their level.
+	stack  [][nodeSize]byte
+	levels []int
+}
+
+// NewWriter returns a new Writer.
+func NewWriter() *Writer {
+	return &Writer{
+		buf:    make([]byte, 0, 127*bufChunks),
+		padded: make([]byte, 128*bufChunks),
+	}
+}
+
+// Write writes data to calculate its piece commitment.
+func (w *Writer) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		copied := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+copied]
+		p = p[copied:]
+		if len(w.buf) == cap(w.buf) {
+			w.flush()
+		}
+	}
+	w.len += uint64(n)
+	return n, nil
+}
+
+// Sum returns the piece Cid and padded size of the written bytes. The
+// Writer shouldn't be used after calling Sum.
+func (w *Writer) Sum() (cid.Cid, abi.PaddedPieceSize, error) {
+	size := PaddedSize(w.len)
+	if len(w.buf) > 0 {
+		// Complete the last chunk with zeros.
+		chunkLen := (len(w.buf) + 126) / 127 * 127
+		for len(w.buf) < chunkLen {
+			w.buf = append(w.buf, 0)
+		}
+		w.flush()
+	}
+	// Fill the tree up to the padded size with zeroed subtrees.
+	root := bits.TrailingZeros64(uint64(size) / nodeSize)
+	for len(w.stack) == 0 || len(w.stack) > 1 || w.levels[0] < root {
+		level := 0
+		if len(w.levels) > 0 {
+			level = w.levels[len(w.levels)-1]
+		}
+		w.push(zeroNodes[level], level)
+	}
+	c, err := commcid.DataCommitmentV1ToCID(w.stack[0][:])
+	if err != nil {
+		return cid.Undef, 0, fmt.Errorf("creating piece cid: %s", err)
+	}
+	return c, size, nil
+}
+
+// flush pads the buffered chunks and adds them as leaves of the tree.
+func (w *Writer) flush() {
+	padded := w.padded[:len(w.buf)/127*128]
+	fr32.Pad(w.buf, padded)
+	for i := 0; i < len(padded); i += nodeSize {
+		var n [nodeSize]byte
+		copy(n[:], padded[i:i+nodeSize])
+		w.push(n, 0)
+	}
+	w.buf = w.buf[:0]
+}
+
+// push adds a subtree root at level, merging completed subtrees.
+func (w *Writer) push(n [nodeSize]byte, level int) {
+	for len(w.stack) > 0 && w.levels[len(w.levels)-1] == level {
+		n = hashNodes(w.stack[len(w.stack)-1], n)
+		w.stack = w.stack[:len(w.stack)-1]
+		w.levels = w.levels[:len(w.levels)-1]
+		level++
+	}
+	w.stack = append(w.stack, n)
+	w.levels = append(w.levels, level)
+}
+
+// PaddedSize returns the padded piece size of size bytes of data, which
+// is the smallest power of two fitting the fr32 padded data.
+func PaddedSize(size uint64) abi.PaddedPieceSize {
+	padded := (size + 126) / 127 * 128
+	if padded < 128 {
+		return 128
+	}
+	if bits.OnesCount64(padded) != 1 {
+		padded = 1 << uint(64-bits.LeadingZeros64(padded))
+	}
+	return abi.PaddedPieceSize(padded)
+}
+
+func hashNodes(a, b [nodeSize]byte) [nodeSize]byte {
+	h := sha256.New()
+	_, _ = h.Write(a[:])
+	_, _ = h.Write(b[:])
+	var res [nodeSize]byte
+	copy(res[:], h.Sum(nil))
+	// Truncate to 254 bits to fit in the field.
+	res[nodeSize-1] &= 0x3f
+	return res
+}
@@ -0,0 +1,107 @@
+package piece
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/rand"
+	"testing"
+
+	commcid "github.com/filecoin-project/go-fil-commcid"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/extern/sector-storage/fr32"
+	"github.com/filecoin-project/lotus/extern/sector-storage/zerocomm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaddedSize(t *testing.T) {
+	t.Parallel()
+	cases := map[uint64]abi.PaddedPieceSize{
+		0:         128,
+		1:         128,
+		127:       128,
+		128:       256,
+		254:       256,
+		255:       512,
+		127 << 20: 128 << 20,
+		9 << 20:   16 << 20,
+	}
+	for size, padded := range cases {
+		require.Equal(t, padded, PaddedSize(size), size)
+	}
+}
+
+func TestZeroData(t *testing.T) {
+	t.Parallel()
+	for _, size := range []int{1, 127, 128, 1000, 127*bufChunks + 5, 9 << 20} {
+		w := NewWriter()
+		_, err := w.Write(make([]byte, size))
+		require.NoError(t, err)
+		c, padded, err := w.Sum()
+		require.NoError(t, err)
+		require.Equal(t, PaddedSize(uint64(size)), padded)
+		require.Equal(t, zerocomm.ZeroPieceCommitment(padded.Unpadded()), c, size)
+	}
+}
+
+func TestWrites(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(22))
+	for _, size := range []int{100, 127 * 3, 127*bufChunks*2 + 300, 1 << 20} {
+		data := make([]byte, size)
+		_, _ = r.Read(data)
+		expected := naiveCommP(t, data)
+		for _, writeSize := range []int{1, 127, 4096, size} {
+			if writeSize == 1 && size > 1<<16 {
+				continue
+			}
+			w := NewWriter()
+			rd := bytes.NewReader(data)
+			buf := make([]byte, writeSize)
+			for {
+				n, _ := rd.Read(buf)
+				if n == 0 {
+					break
+				}
+				_, err := w.Write(buf[:n])
+				require.NoError(t, err)
+			}
+			c, _, err := w.Sum()
+			require.NoError(t, err)
+			require.Equal(t, expected, c.String(), "size %d write size %d", size, writeSize)
+		}
+	}
+}
+
+// naiveCommP calculates the piece commitment padding all the data at once
+// and building the whole merkle tree. It shares no code with Writer, so a
+// bug in the streaming implementation can't be hidden by the reference.
+func naiveCommP(t *testing.T, data []byte) string {
+	padded := abi.PaddedPieceSize(128)
+	for uint64(padded.Unpadded()) < uint64(len(data)) {
+		padded *= 2
+	}
+	in := make([]byte, padded.Unpadded())
+	copy(in, data)
+	out := make([]byte, padded)
+	fr32.Pad(in, out)
+	var level [][]byte
+	for i := 0; i < len(out); i += 32 {
+		level = append(level, out[i:i+32])
+	}
+	for len(level) > 1 {
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			h := sha256.New()
+			_, _ = h.Write(level[2*i])
+			_, _ = h.Write(level[2*i+1])
+			sum := h.Sum(nil)
+			// Nodes are truncated to 254 bits to fit in the field.
+			sum[31] &= 0x3f
+			next[i] = sum
+		}
+		level = next
+	}
+	c, err := commcid.DataCommitmentV1ToCID(level[0])
+	require.NoError(t, err)
+	return c.String()
+}
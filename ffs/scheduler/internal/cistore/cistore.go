@@ -7,6 +7,7 @@ import (
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/util"
 )
@@ -60,6 +61,27 @@ func (s *Store) Put(ci ffs.StorageInfo) error {
 	return nil
 }
 
+// GetAll returns the stored state of every Cid.
+func (s *Store) GetAll() ([]ffs.StorageInfo, error) {
+	res, err := s.ds.Query(query.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("querying cid infos: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var cis []ffs.StorageInfo
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iterating cid infos: %s", r.Error)
+		}
+		var ci ffs.StorageInfo
+		if err := json.Unmarshal(r.Value, &ci); err != nil {
+			return nil, fmt.Errorf("unmarshaling cid info from datastore: %s", err)
+		}
+		cis = append(cis, ci)
+	}
+	return cis, nil
+}
+
 func makeKey(c cid.Cid) datastore.Key {
 	return datastore.NewKey(util.CidToString(c))
 }
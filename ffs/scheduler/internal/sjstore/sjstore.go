@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
@@ -59,13 +60,8 @@ type watcher struct {
 // New returns a new JobStore backed by the Datastore.
 func New(ds datastore.Datastore) (*Store, error) {
 	s := &Store{
-		ds:                 ds,
-		executingCids:      make(map[cid.Cid]ffs.JobID),
-		jobStatusCache:     make(map[ffs.APIID]map[cid.Cid]map[cid.Cid]deals.StorageDealInfo),
-		queuedJobs:         make(map[ffs.APIID]map[cid.Cid][]*ffs.StorageJob),
-		executingJobs:      make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob),
-		lastFinalJobs:      make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob),
-		lastSuccessfulJobs: make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob),
+		ds:             ds,
+		jobStatusCache: make(map[ffs.APIID]map[cid.Cid]map[cid.Cid]deals.StorageDealInfo),
 	}
 	if err := s.loadCaches(); err != nil {
 		return nil, fmt.Errorf("reloading caches: %s", err)
@@ -73,6 +69,18 @@ func New(ds datastore.Datastore) (*Store, error) {
 	return s, nil
 }
 
+// Reload discards cached jobs and loads them again from the datastore.
+// It's used when the datastore might have been modified by other
+// Store instances, e.g: by other Powergate instances.
+func (s *Store) Reload() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.loadCaches(); err != nil {
+		return fmt.Errorf("reloading caches: %s", err)
+	}
+	return nil
+}
+
 // MonitorJob returns a channel that can be passed into the deal monitoring process.
 func (s *Store) MonitorJob(j ffs.StorageJob) chan deals.StorageDealInfo {
 	dealUpdates := make(chan deals.StorageDealInfo, 1000)
@@ -142,6 +150,52 @@ func (s *Store) Finalize(jid ffs.JobID, st ffs.JobStatus, jobError error, dealEr
 	return nil
 }
 
+// StartStep appends a started step to an executing Job, and returns its
+// index.
+func (s *Store) StartStep(jid ffs.JobID, name, detail string) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	j, err := s.get(jid)
+	if err != nil {
+		return 0, err
+	}
+	if j.Status == ffs.Queued {
+		return 0, fmt.Errorf("can't add steps to a queued job")
+	}
+	j.Steps = append(j.Steps, ffs.JobStep{
+		Name:      name,
+		Detail:    detail,
+		StartedAt: time.Now().UnixNano(),
+	})
+	if err := s.put(j); err != nil {
+		return 0, fmt.Errorf("saving in datastore: %s", err)
+	}
+	return len(j.Steps) - 1, nil
+}
+
+// FinishStep sets the finish time and outcome of the step of a Job with
+// index i.
+func (s *Store) FinishStep(jid ffs.JobID, i int, result string, stepErr error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	j, err := s.get(jid)
+	if err != nil {
+		return err
+	}
+	if i < 0 || i >= len(j.Steps) {
+		return fmt.Errorf("job %s has no step %d", jid, i)
+	}
+	j.Steps[i].FinishedAt = time.Now().UnixNano()
+	j.Steps[i].Result = result
+	if stepErr != nil {
+		j.Steps[i].Error = stepErr.Error()
+	}
+	if err := s.put(j); err != nil {
+		return fmt.Errorf("saving in datastore: %s", err)
+	}
+	return nil
+}
+
 // Dequeue dequeues a Job which doesn't have have another Executing Job
 // for the same Cid. Saying it differently, it's safe to execute. The returned
 // job Status is automatically changed to Executing. If no jobs are available to dequeue
@@ -550,9 +604,15 @@ func (s *Store) notifyWatchers(j ffs.StorageJob) {
 	}
 }
 
+// loadCaches loads cached jobs from the datastore. This method must be
+// guarded.
 func (s *Store) loadCaches() error {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.queued = nil
+	s.executingCids = make(map[cid.Cid]ffs.JobID)
+	s.queuedJobs = make(map[ffs.APIID]map[cid.Cid][]*ffs.StorageJob)
+	s.executingJobs = make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob)
+	s.lastFinalJobs = make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob)
+	s.lastSuccessfulJobs = make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob)
 
 	q := query.Query{Prefix: dsBaseJob.String()}
 	res, err := s.ds.Query(q)
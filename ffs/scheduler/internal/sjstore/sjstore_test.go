@@ -132,6 +132,39 @@ func TestStartedDeals(t *testing.T) {
 	require.Equal(t, 0, len(fds))
 }
 
+func TestSteps(t *testing.T) {
+	t.Parallel()
+	s := create(t)
+	j := createJob(t)
+	err := s.Enqueue(j)
+	require.NoError(t, err)
+	_, err = s.StartStep(j.ID, ffs.StepHotStorage, "")
+	require.Error(t, err)
+
+	_, err = s.Dequeue()
+	require.NoError(t, err)
+	i, err := s.StartStep(j.ID, ffs.StepHotStorage, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, i)
+	i, err = s.StartStep(j.ID, ffs.StepDealProposal, "miner t0100")
+	require.NoError(t, err)
+	require.Equal(t, 1, i)
+	err = s.FinishStep(j.ID, 1, "", errors.New("rejected"))
+	require.NoError(t, err)
+	err = s.FinishStep(j.ID, 2, "", nil)
+	require.Error(t, err)
+
+	j2, err := s.Get(j.ID)
+	require.NoError(t, err)
+	require.Len(t, j2.Steps, 2)
+	require.Equal(t, ffs.StepHotStorage, j2.Steps[0].Name)
+	require.NotZero(t, j2.Steps[0].StartedAt)
+	require.Zero(t, j2.Steps[0].FinishedAt)
+	require.Equal(t, "miner t0100", j2.Steps[1].Detail)
+	require.GreaterOrEqual(t, j2.Steps[1].FinishedAt, j2.Steps[1].StartedAt)
+	require.Equal(t, "rejected", j2.Steps[1].Error)
+}
+
 func TestQueryJobs(t *testing.T) {
 	t.Run("ExecutingAndFailed", func(t *testing.T) {
 		t.Parallel()
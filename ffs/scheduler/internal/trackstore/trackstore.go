@@ -32,9 +32,7 @@ type trackedStorageConfig struct {
 // New retruns a new Store.
 func New(ds datastore.Datastore) (*Store, error) {
 	s := &Store{
-		ds:          ds,
-		repairables: map[cid.Cid]struct{}{},
-		renewables:  map[cid.Cid]struct{}{},
+		ds: ds,
 	}
 	if err := s.loadCaches(); err != nil {
 		return nil, fmt.Errorf("loading renewable/repairable caches: %s", err)
@@ -42,6 +40,17 @@ func New(ds datastore.Datastore) (*Store, error) {
 	return s, nil
 }
 
+// Reload discards cached renewable and repairable Cids, and loads them
+// again from the datastore.
+func (s *Store) Reload() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.loadCaches(); err != nil {
+		return fmt.Errorf("loading renewable/repairable caches: %s", err)
+	}
+	return nil
+}
+
 // Get returns the storage config of a repairable/renewable stored Cid.
 func (s *Store) Get(c cid.Cid) (ffs.StorageConfig, ffs.APIID, error) {
 	v, err := s.ds.Get(datastore.NewKey(c.String()))
@@ -144,7 +153,11 @@ func (s *Store) GetRenewables() ([]cid.Cid, error) {
 	return res, nil
 }
 
+// loadCaches loads renewable and repairable Cids from the datastore. This
+// method must be guarded.
 func (s *Store) loadCaches() error {
+	s.repairables = map[cid.Cid]struct{}{}
+	s.renewables = map[cid.Cid]struct{}{}
 	q := query.Query{}
 	r, err := s.ds.Query(q)
 	if err != nil {
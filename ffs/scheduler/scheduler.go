@@ -11,6 +11,7 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/ipld/go-ipld-prime"
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/ffs/scheduler/internal/astore"
 	"github.com/textileio/powergate/ffs/scheduler/internal/cistore"
@@ -18,6 +19,7 @@ import (
 	"github.com/textileio/powergate/ffs/scheduler/internal/rjstore"
 	"github.com/textileio/powergate/ffs/scheduler/internal/sjstore"
 	"github.com/textileio/powergate/ffs/scheduler/internal/trackstore"
+	"github.com/textileio/powergate/leader"
 	txndstr "github.com/textileio/powergate/txndstransform"
 )
 
@@ -53,11 +55,16 @@ type Scheduler struct {
 
 	sr2RepFactor        func() (int, error)
 	dealFinalityTimeout time.Duration
+	elector             *leader.Elector
+	dt                  ffs.DealTracker
 
 	sd          storageDaemon
 	rd          retrievalDaemon
 	cancelLock  sync.Mutex
 	cancelChans map[ffs.JobID]chan struct{}
+	// jobs tracks executing jobs, which are canceled when the context
+	// of the daemons is canceled.
+	jobs sync.WaitGroup
 
 	ctx      context.Context
 	cancel   context.CancelFunc
@@ -78,9 +85,23 @@ type retrievalDaemon struct {
 	evaluateQueue chan struct{}
 }
 
+// Option configures a Scheduler.
+type Option func(*Scheduler) error
+
+// WithElector makes the Scheduler execute jobs, renewals and repairs only
+// while the instance is the elected leader. Every time the instance is
+// elected, cached state is reloaded from the datastore, since it might
+// have been modified by the previous leader.
+func WithElector(e *leader.Elector) Option {
+	return func(s *Scheduler) error {
+		s.elector = e
+		return nil
+	}
+}
+
 // New returns a new instance of Scheduler which uses JobStore as its backing repository for state,
 // HotStorage for the hot layer, and ColdStorage for the cold layer.
-func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, maxParallel int, dealFinalityTimeout time.Duration, sr2rf func() (int, error)) (*Scheduler, error) {
+func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, maxParallel int, dealFinalityTimeout time.Duration, sr2rf func() (int, error), opts ...Option) (*Scheduler, error) {
 	sjs, err := sjstore.New(txndstr.Wrap(ds, "sjstore"))
 	if err != nil {
 		return nil, fmt.Errorf("loading stroage jobstore: %s", err)
@@ -131,17 +152,90 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 		sr2RepFactor:        sr2rf,
 		dealFinalityTimeout: dealFinalityTimeout,
 	}
+	for _, o := range opts {
+		if err := o(sch); err != nil {
+			cancel()
+			return nil, fmt.Errorf("applying option: %s", err)
+		}
+	}
 	go sch.run()
 	return sch, nil
 }
 
-// GetCidFromHot returns an io.Reader of the data from the hot layer.
-func (s *Scheduler) GetCidFromHot(ctx context.Context, c cid.Cid) (io.Reader, error) {
-	r, err := s.hs.Get(ctx, c)
+// GetCidFromHot returns an io.ReadCloser of the data of a path within the
+// DAG of a Cid from the hot layer, and true if it's a directory read as a
+// tar archive. An empty path reads the Cid data.
+func (s *Scheduler) GetCidFromHot(ctx context.Context, c cid.Cid, path string) (io.ReadCloser, bool, error) {
+	r, isDir, err := s.hs.GetPath(ctx, c, path)
 	if err != nil {
-		return nil, fmt.Errorf("getting %s from hot layer: %s", c, err)
+		return nil, false, fmt.Errorf("getting %s from hot layer: %s", c, err)
+	}
+	return r, isDir, nil
+}
+
+// GetCARFromHot writes the part of the DAG of a Cid selected by sel from
+// the hot layer as a CAR file of the given version. If sel is nil, the
+// complete DAG is written.
+func (s *Scheduler) GetCARFromHot(ctx context.Context, c cid.Cid, sel ipld.Node, version int, w io.Writer) error {
+	if err := s.hs.GetCAR(ctx, c, sel, version, w); err != nil {
+		return fmt.Errorf("getting %s car from hot layer: %s", c, err)
 	}
-	return r, nil
+	return nil
+}
+
+// GetCARFromCold retrieves the DAG of a Cid from the miners of its
+// Filecoin deals using waddr, and writes the part of it selected by sel as
+// a CAR file of the given version. If sel is nil, the complete DAG is
+// written. It returns the miner the data was retrieved from, or
+// ErrNotFound if the Cid isn't stored.
+func (s *Scheduler) GetCARFromCold(ctx context.Context, c cid.Cid, waddr string, sel ipld.Node, version int, w io.Writer) (string, error) {
+	info, err := s.GetStorageInfo(c)
+	if err != nil {
+		return "", err
+	}
+	if len(info.Cold.Filecoin.Proposals) == 0 {
+		return "", fmt.Errorf("no active deals to make retrieval possible")
+	}
+	// Deals might have different pieces of the data, so miners are asked
+	// for the piece of their deals.
+	var pieceCids []cid.Cid
+	miners := make(map[cid.Cid][]string)
+	for _, p := range info.Cold.Filecoin.Proposals {
+		if _, ok := miners[p.PieceCid]; !ok {
+			pieceCids = append(pieceCids, p.PieceCid)
+		}
+		miners[p.PieceCid] = append(miners[p.PieceCid], p.Miner)
+	}
+	cw := &countingWriter{w: w}
+	for _, pieceCid := range pieceCids {
+		var piCid *cid.Cid
+		if pieceCid.Defined() {
+			piCid = &pieceCid
+		}
+		var miner string
+		miner, err = s.cs.GetCAR(ctx, info.Cold.Filecoin.DataCid, piCid, waddr, miners[pieceCid], sel, version, cw)
+		if err == nil {
+			return miner, nil
+		}
+		// Other miners can be tried only if nothing was written yet.
+		if cw.n > 0 {
+			break
+		}
+		log.Infof("getting %s car with piece %s: %s", c, pieceCid, err)
+	}
+	return "", fmt.Errorf("getting %s car from cold layer: %s", c, err)
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
 }
 
 // Cancel cancels an executing Job.
@@ -175,11 +269,61 @@ func (s *Scheduler) Close() error {
 	return nil
 }
 
-// run spins the long-running goroutines that will execute
-// queued storage and retrieval jobs, renewals and repairs.
+// run runs the daemons, or if there's a leader elector, runs them in
+// every term in which the instance is the leader.
 func (s *Scheduler) run() {
 	defer close(s.finished)
-	if err := s.resumeStartedDeals(); err != nil {
+	if s.elector == nil {
+		s.runDaemons(s.ctx)
+		return
+	}
+	for {
+		term, err := s.elector.Campaign(s.ctx)
+		if err != nil {
+			return
+		}
+		log.Infof("starting daemons for leadership term %d", term.Token)
+		ctx, cancel := context.WithCancel(term.Context())
+		go func() {
+			select {
+			case <-s.ctx.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		if err := s.reloadCaches(); err != nil {
+			log.Errorf("reloading caches: %s", err)
+		} else {
+			s.runDaemons(ctx)
+		}
+		<-ctx.Done()
+		cancel()
+		if s.ctx.Err() != nil {
+			return
+		}
+		log.Infof("daemons stopped since leadership term %d ended", term.Token)
+	}
+}
+
+// reloadCaches reloads cached state which might have been modified by
+// other instances.
+func (s *Scheduler) reloadCaches() error {
+	if err := s.sjs.Reload(); err != nil {
+		return fmt.Errorf("reloading storage jobstore: %s", err)
+	}
+	if err := s.ts.Reload(); err != nil {
+		return fmt.Errorf("reloading trackstore: %s", err)
+	}
+	return nil
+}
+
+// runDaemons spins the long-running goroutines that will execute
+// queued storage and retrieval jobs, renewals and repairs, until ctx
+// is canceled. Executing jobs are canceled with ctx, and runDaemons
+// returns after they finish.
+func (s *Scheduler) runDaemons(ctx context.Context) {
+	defer s.jobs.Wait()
+	if err := s.resumeStartedDeals(ctx); err != nil {
 		log.Errorf("resuming started deals: %s", err)
 		return
 	}
@@ -191,11 +335,11 @@ func (s *Scheduler) run() {
 		defer wg.Done()
 		for {
 			select {
-			case <-s.ctx.Done():
+			case <-ctx.Done():
 				return
 			case <-time.After(RenewalEvalFrequency):
 				log.Debug("running renewal checks...")
-				s.execRenewCron(s.ctx)
+				s.execRenewCron(ctx)
 				log.Debug("renewal cron done")
 			}
 		}
@@ -207,27 +351,36 @@ func (s *Scheduler) run() {
 		defer wg.Done()
 		for {
 			select {
-			case <-s.ctx.Done():
+			case <-ctx.Done():
 				return
 			case <-time.After(RepairEvalFrequency):
 				log.Debug("running repair checks...")
-				s.execRepairCron(s.ctx)
+				s.execRepairCron(ctx)
 				log.Debug("repair cron done")
 			}
 		}
 	}()
 
+	// Loop for state changes of tracked deals.
+	if s.dt != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runDealTracking(ctx)
+		}()
+	}
+
 	// Loop for retrievals jobs.
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		for {
 			select {
-			case <-s.ctx.Done():
+			case <-ctx.Done():
 				return
 			case <-s.rd.evaluateQueue:
 				log.Debug("evaluating retrieval job queue...")
-				s.execQueuedRetrievals(s.ctx)
+				s.execQueuedRetrievals(ctx)
 				log.Debug("retrieval job queue evaluated")
 			}
 		}
@@ -239,14 +392,14 @@ func (s *Scheduler) run() {
 	// Loop for new pushed storage configs.
 	for {
 		select {
-		case <-s.ctx.Done():
+		case <-ctx.Done():
 			log.Infof("terminating scheduler daemon")
 			wg.Wait()
 			log.Infof("scheduler daemon terminated")
 			return
 		case <-s.sd.evaluateQueue:
 			s.printStats()
-			s.execQueuedStorages(s.ctx)
+			s.execQueuedStorages(ctx)
 			s.printStats()
 		}
 	}
@@ -258,12 +411,12 @@ func (s *Scheduler) printStats() {
 	log.Infof("storage job total queued: %d, total executing: %d", stats.TotalQueued, stats.TotalExecuting)
 }
 
-func (s *Scheduler) resumeStartedDeals() error {
+func (s *Scheduler) resumeStartedDeals(ctx context.Context) error {
 	ejids := s.sjs.GetExecutingJobIDs()
 	// No need for rate limit since the number of "Executing"
 	// jobs is always rate-limited on creation.
 	for _, jid := range ejids {
-		if s.ctx.Err() != nil {
+		if ctx.Err() != nil {
 			break
 		}
 		j, err := s.sjs.Get(jid)
@@ -271,12 +424,18 @@ func (s *Scheduler) resumeStartedDeals() error {
 			return fmt.Errorf("getting resumed queued job: %s", err)
 		}
 		log.Infof("storage job resume rate limit: %d/%d", len(s.sd.rateLim), cap(s.sd.rateLim))
-		s.sd.rateLim <- struct{}{}
+		select {
+		case <-ctx.Done():
+			return nil
+		case s.sd.rateLim <- struct{}{}:
+		}
+		s.jobs.Add(1)
 		go func(j ffs.StorageJob) {
+			defer s.jobs.Done()
 			log.Infof("resuming job %s with cid %s", j.ID, j.Cid)
 			// We re-execute the pipeline as if was dequeued.
 			// Both hot and cold storage can detect resumed job execution.
-			s.executeQueuedStorage(j)
+			s.executeQueuedStorage(ctx, j)
 
 			log.Infof("storage job resume rate limit: %d/%d", len(s.sd.rateLim), cap(s.sd.rateLim))
 			<-s.sd.rateLim
@@ -305,7 +464,7 @@ func (s *Scheduler) execRepairCron(ctx context.Context) {
 		s.l.Log(lCtx, "Scheduling deal repair evaluation...")
 		jid, err := s.scheduleRenewRepairJob(c)
 		if err != nil {
-			s.l.Log(lCtx, "Scheduling deal repair errored: %s", err)
+			s.l.LogEvent(lCtx, ffs.LogError, "", nil, "Scheduling deal repair errored: %s", err)
 		} else {
 			s.l.Log(lCtx, "Job %s was queued for repair evaluation.", jid)
 		}
@@ -329,7 +488,7 @@ func (s *Scheduler) execRenewCron(ctx context.Context) {
 		s.l.Log(lCtx, "Scheduling deal renew evaluation...")
 		jid, err := s.scheduleRenewRepairJob(c)
 		if err != nil {
-			s.l.Log(lCtx, "Scheduling deal renewal errored: %s", err)
+			s.l.LogEvent(lCtx, ffs.LogError, "", nil, "Scheduling deal renewal errored: %s", err)
 		} else {
 			s.l.Log(lCtx, "Job %s was queued for renew evaluation.", jid)
 		}
@@ -376,8 +535,10 @@ forLoop:
 			<-s.sd.rateLim
 			break
 		}
+		s.jobs.Add(1)
 		go func(j ffs.StorageJob) {
-			s.executeQueuedStorage(j)
+			defer s.jobs.Done()
+			s.executeQueuedStorage(ctx, j)
 			log.Infof("storage job push rate limit: %d/%d", len(s.sd.rateLim), cap(s.sd.rateLim))
 			<-s.sd.rateLim
 
@@ -392,7 +553,10 @@ forLoop:
 	}
 }
 
-func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
+// executeQueuedStorage executes a storage job until it finishes or
+// termCtx is canceled. If termCtx is canceled, the job isn't finalized,
+// so it's resumed by the next execution of the daemons.
+func (s *Scheduler) executeQueuedStorage(termCtx context.Context, j ffs.StorageJob) {
 	cancelChan := make(chan struct{}, 1)
 	// Create chan to allow Job cancellation.
 	s.cancelLock.Lock()
@@ -404,7 +568,7 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 		s.cancelLock.Unlock()
 	}()
 
-	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ffs.CtxKeyJid, j.ID))
+	ctx, cancel := context.WithCancel(context.WithValue(termCtx, ffs.CtxKeyJid, j.ID))
 	defer cancel()
 	ctx = context.WithValue(ctx, ffs.CtxStorageCid, j.Cid)
 
@@ -427,30 +591,41 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 		if err := s.sjs.Finalize(j.ID, ffs.Failed, err, nil); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s couldn't start: %s.", j.ID, err)
+		s.l.LogEvent(ctx, ffs.LogError, "", nil, "Job %s couldn't start: %s.", j.ID, err)
 		return
 	}
 
 	// Execute
 	s.l.Log(ctx, "Executing job %s...", j.ID)
 	dealUpdates := s.sjs.MonitorJob(j)
-	info, dealErrors, err := s.executeStorage(ctx, a, j, dealUpdates)
+	steps := jobSteps{sjs: s.sjs, jid: j.ID}
+	info, dealErrors, err := s.executeStorage(ctx, a, j, dealUpdates, steps)
 	close(dealUpdates)
+	if termCtx.Err() != nil {
+		log.Infof("job %s was interrupted since the scheduler daemons stopped, it will be resumed", j.ID)
+		return
+	}
 	// Something bad-enough happened to make Job
 	// execution fail.
 	if err != nil {
 		log.Errorf("executing job %s: %s", j.ID, err)
+		// The finalization step finishes before the job does, so the
+		// final job has a complete timeline.
+		finishStep := ffs.StartJobStep(steps, ffs.StepFinalization, "")
+		finishStep(ffs.JobStatusStr[ffs.Failed], nil)
 		if err := s.sjs.Finalize(j.ID, ffs.Failed, err, dealErrors); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s execution failed: %s", j.ID, err)
+		s.l.LogEvent(ctx, ffs.LogError, ffs.StepFinalization, nil, "Job %s execution failed: %s", j.ID, err)
 		return
 	}
 	// Save whatever stored information was completely/partially
 	// done in execution.
+	finishStep := ffs.StartJobStep(steps, ffs.StepFinalization, "")
 	if err := s.cis.Put(info); err != nil {
 		log.Errorf("saving cid info to store: %s", err)
 	}
+	s.trackDeals(info)
 
 	finalStatus := ffs.Success
 	// Detect if user-cancelation was triggered
@@ -461,10 +636,39 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 	cancelLock.Unlock()
 
 	// Finalize Job, saving any deals errors happened during execution.
+	finishStep(ffs.JobStatusStr[finalStatus], nil)
 	if err := s.sjs.Finalize(j.ID, finalStatus, nil, dealErrors); err != nil {
 		log.Errorf("changing job to success: %s", err)
 	}
-	s.l.Log(ctx, "Job %s execution finished with status %s.", j.ID, ffs.JobStatusStr[finalStatus])
+	s.l.LogEvent(ctx, ffs.LogInfo, ffs.StepFinalization, nil, "Job %s execution finished with status %s.", j.ID, ffs.JobStatusStr[finalStatus])
+}
+
+// jobSteps records the steps of a storage job execution in the job store.
+type jobSteps struct {
+	sjs *sjstore.Store
+	jid ffs.JobID
+}
+
+var _ ffs.JobStepRecorder = jobSteps{}
+
+// StartStep records a started step of the job.
+func (js jobSteps) StartStep(name, detail string) int {
+	i, err := js.sjs.StartStep(js.jid, name, detail)
+	if err != nil {
+		log.Errorf("starting step %s of job %s: %s", name, js.jid, err)
+		return -1
+	}
+	return i
+}
+
+// FinishStep records the outcome of a step of the job.
+func (js jobSteps) FinishStep(i int, result string, err error) {
+	if i < 0 {
+		return
+	}
+	if err := js.sjs.FinishStep(js.jid, i, result, err); err != nil {
+		log.Errorf("finishing step %d of job %s: %s", i, js.jid, err)
+	}
 }
 
 func (s *Scheduler) execQueuedRetrievals(ctx context.Context) {
@@ -495,8 +699,10 @@ forLoop:
 			<-s.rd.rateLim
 			break
 		}
+		s.jobs.Add(1)
 		go func(j ffs.RetrievalJob) {
-			s.executeQueuedRetrievals(j)
+			defer s.jobs.Done()
+			s.executeQueuedRetrievals(ctx, j)
 
 			<-s.rd.rateLim
 
@@ -511,7 +717,9 @@ forLoop:
 	}
 }
 
-func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
+// executeQueuedRetrievals executes a retrieval job until it finishes or
+// termCtx is canceled.
+func (s *Scheduler) executeQueuedRetrievals(termCtx context.Context, j ffs.RetrievalJob) {
 	cancelChan := make(chan struct{})
 	// Create chan to allow Job cancellation.
 	s.cancelLock.Lock()
@@ -523,7 +731,7 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 		s.cancelLock.Unlock()
 	}()
 
-	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ffs.CtxKeyJid, j.ID))
+	ctx, cancel := context.WithCancel(context.WithValue(termCtx, ffs.CtxKeyJid, j.ID))
 	defer cancel()
 	ctx = context.WithValue(ctx, ffs.CtxRetrievalID, j.RetrievalID)
 	go func() {
@@ -540,7 +748,7 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 		if err := s.rjs.Finalize(j.ID, ffs.Failed, err); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s couldn't start: %s.", j.ID, err)
+		s.l.LogEvent(ctx, ffs.LogError, "", nil, "Job %s couldn't start: %s.", j.ID, err)
 		return
 	}
 
@@ -555,7 +763,7 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 		if err := s.rjs.Finalize(j.ID, ffs.Failed, err); err != nil {
 			log.Errorf("changing retrieval job status to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s execution failed: %s", j.ID, err)
+		s.l.LogEvent(ctx, ffs.LogError, ffs.StepFinalization, nil, "Job %s execution failed: %s", j.ID, err)
 		return
 	}
 	// Save whatever stored information was completely/partially
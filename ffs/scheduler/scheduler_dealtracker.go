@@ -0,0 +1,161 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/scheduler/internal/cistore"
+)
+
+// WithDealTracker makes the Scheduler follow active deals on-chain with
+// a DealTracker. When a deal is slashed or expires, its state is updated
+// in the StorageInfo, and the Cid is repaired if its config is repairable.
+func WithDealTracker(dt ffs.DealTracker) Option {
+	return func(s *Scheduler) error {
+		s.dt = dt
+		return nil
+	}
+}
+
+// trackDeals starts tracking the active deals of a StorageInfo.
+func (s *Scheduler) trackDeals(info ffs.StorageInfo) {
+	if s.dt == nil {
+		return
+	}
+	for _, p := range info.Cold.Filecoin.Proposals {
+		if p.DealID == 0 || p.State != ffs.DealActive {
+			continue
+		}
+		endEpoch := int64(p.StartEpoch) + p.Duration
+		if err := s.dt.Track(info.Cid, p.DealID, endEpoch); err != nil {
+			log.Errorf("tracking deal %d of %s: %s", p.DealID, info.Cid, err)
+		}
+	}
+}
+
+// backfillTrackedDeals tracks the active deals of every stored Cid, so
+// deals stored before tracking was enabled, or whose tracking failed, are
+// followed too. Deals made before deal ids were saved in the StorageInfo
+// get their id from the cold storage.
+func (s *Scheduler) backfillTrackedDeals(ctx context.Context) error {
+	infos, err := s.cis.GetAll()
+	if err != nil {
+		return fmt.Errorf("getting cid infos: %s", err)
+	}
+	for _, info := range infos {
+		if ctx.Err() != nil {
+			return nil
+		}
+		var changed bool
+		for i := range info.Cold.Filecoin.Proposals {
+			p := &info.Cold.Filecoin.Proposals[i]
+			if p.DealID != 0 || p.State != ffs.DealActive {
+				continue
+			}
+			id, err := s.cs.GetDealID(ctx, p.ProposalCid)
+			if err != nil {
+				log.Warnf("getting deal id of proposal %s: %s", p.ProposalCid, err)
+				continue
+			}
+			if id == 0 {
+				continue
+			}
+			p.DealID = id
+			changed = true
+		}
+		if changed {
+			if err := s.cis.Put(info); err != nil {
+				return fmt.Errorf("saving cid info to store: %s", err)
+			}
+		}
+		s.trackDeals(info)
+	}
+	return nil
+}
+
+// runDealTracking handles state changes of tracked deals until ctx is
+// canceled. Handled state changes are acknowledged, so they're sent
+// again if handling them fails.
+func (s *Scheduler) runDealTracking(ctx context.Context) {
+	events := s.dt.Listen()
+	defer s.dt.Unregister(events)
+	if err := s.backfillTrackedDeals(ctx); err != nil {
+		log.Errorf("backfilling tracked deals: %s", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-events:
+			if err := s.handleDealEvent(ctx, e); err != nil {
+				log.Errorf("handling event of deal %d: %s", e.DealID, err)
+				continue
+			}
+			if err := s.dt.Ack(e.DealID); err != nil {
+				log.Errorf("acknowledging event of deal %d: %s", e.DealID, err)
+			}
+		}
+	}
+}
+
+// handleDealEvent saves the new state of a deal in the StorageInfo of its
+// Cid, and schedules a repair job if the Cid is repairable and the deal
+// wasn't expected to end. Events which were already handled are ignored.
+func (s *Scheduler) handleDealEvent(ctx context.Context, e ffs.DealEvent) error {
+	info, err := s.cis.Get(e.Cid)
+	if err == cistore.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting cid info: %s", err)
+	}
+	idx := -1
+	for i, p := range info.Cold.Filecoin.Proposals {
+		if p.DealID == e.DealID {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil
+	}
+	p := &info.Cold.Filecoin.Proposals[idx]
+	if p.State == e.State {
+		return nil
+	}
+	p.State = e.State
+	p.StateReason = e.Reason
+	if err := s.cis.Put(info); err != nil {
+		return fmt.Errorf("saving cid info to store: %s", err)
+	}
+
+	lCtx := context.WithValue(ctx, ffs.CtxStorageCid, e.Cid)
+	// Renewed deals are replaced before they expire.
+	if e.State == ffs.DealExpired && p.Renewed {
+		s.l.LogEvent(lCtx, ffs.LogInfo, ffs.EventDealLifecycle, ffs.DealLogFields(p.Miner, p.ProposalCid, e.DealID), "Renewed deal %d with miner %s is %s: %s", e.DealID, p.Miner, ffs.DealStateStr[e.State], e.Reason)
+		return nil
+	}
+	s.l.LogEvent(lCtx, ffs.LogWarn, ffs.EventDealLifecycle, ffs.DealLogFields(p.Miner, p.ProposalCid, e.DealID), "Deal %d with miner %s is %s: %s", e.DealID, p.Miner, ffs.DealStateStr[e.State], e.Reason)
+
+	// The new state is already saved, so failing to schedule the repair
+	// is left to the repair cron.
+	repairables, err := s.ts.GetRepairables()
+	if err != nil {
+		log.Errorf("getting repairable cid configs from store: %s", err)
+		return nil
+	}
+	for _, c := range repairables {
+		if !c.Equals(e.Cid) {
+			continue
+		}
+		jid, err := s.scheduleRenewRepairJob(c)
+		if err != nil {
+			s.l.LogEvent(lCtx, ffs.LogError, ffs.EventDealLifecycle, nil, "Scheduling deal repair errored: %s", err)
+		} else {
+			s.l.Log(lCtx, "Job %s was queued for repair evaluation.", jid)
+		}
+		return nil
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/ffs/scheduler/internal/astore"
 	"github.com/textileio/powergate/ffs/scheduler/internal/ristore"
+	"github.com/textileio/powergate/ffs/scheduler/internal/rjstore"
 )
 
 // StartRetrieval schedules a new RetrievalJob to execute a Filecoin retrieval.
@@ -82,6 +83,18 @@ func (s *Scheduler) GetRetrievalInfo(rid ffs.RetrievalID) (ffs.RetrievalInfo, er
 	return info, nil
 }
 
+// RetrievalJob returns the current state of a retrieval Job.
+func (s *Scheduler) RetrievalJob(jid ffs.JobID) (ffs.RetrievalJob, error) {
+	j, err := s.rjs.Get(jid)
+	if err == rjstore.ErrNotFound {
+		return ffs.RetrievalJob{}, ErrNotFound
+	}
+	if err != nil {
+		return ffs.RetrievalJob{}, fmt.Errorf("getting retrieval job from store: %s", err)
+	}
+	return j, nil
+}
+
 func (s *Scheduler) executeRetrieval(ctx context.Context, a astore.RetrievalAction, j ffs.RetrievalJob) (ffs.RetrievalInfo, error) {
 	// ToDo: WIP.
 	_ = j
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/deals"
 	"github.com/textileio/powergate/ffs"
@@ -19,6 +20,10 @@ var (
 	// HardcodedHotTimeout is a temporary override of storage configs
 	// value for AddTimeout.
 	HardcodedHotTimeout = time.Second * 300
+
+	// dealSourceStoreTimeout is the timeout of pinning data in the Hot
+	// Storage to make new deals of a Cid with disabled Hot Storage.
+	dealSourceStoreTimeout = time.Minute
 )
 
 // PushConfig queues the specified StorageConfig to be executed as a new Job. It returns
@@ -123,6 +128,7 @@ func (s *Scheduler) ImportStorageInfo(ci ffs.StorageInfo) error {
 	if err := s.cis.Put(ci); err != nil {
 		return fmt.Errorf("importing cid information: %s", err)
 	}
+	s.trackDeals(ci)
 	return nil
 }
 
@@ -159,6 +165,15 @@ func (s *Scheduler) GetLogsByCid(ctx context.Context, c cid.Cid) ([]ffs.LogEntry
 	return lgs, nil
 }
 
+// QueryLogs returns history logs of a Cid matching a query.
+func (s *Scheduler) QueryLogs(ctx context.Context, q ffs.LogQuery) ([]ffs.LogEntry, error) {
+	lgs, err := s.l.Query(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("querying logs: %s", err)
+	}
+	return lgs, nil
+}
+
 // QueuedStorageJobs returns queued jobs for the specified instance id and cids.
 // If the instance id is ffs.EmptyInstanceID, data for all instances is returned.
 // If no cids are provided, data for all data cids is returned.
@@ -202,7 +217,7 @@ func (s *Scheduler) StorageConfig(jid ffs.JobID) (ffs.StorageConfig, error) {
 // executeStorage executes a Job. If an error is returned, it means that the Job
 // should be considered failed. If error is nil, it still can return []ffs.DealError
 // since some deals failing isn't necessarily a fatal Job config execution.
-func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction, job ffs.StorageJob, dealUpdates chan deals.StorageDealInfo) (ffs.StorageInfo, []ffs.DealError, error) {
+func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction, job ffs.StorageJob, dealUpdates chan deals.StorageDealInfo, steps ffs.JobStepRecorder) (ffs.StorageInfo, []ffs.DealError, error) {
 	ci, err := s.getRefreshedInfo(ctx, a.Cid)
 	if err != nil {
 		return ffs.StorageInfo{}, nil, fmt.Errorf("getting current cid info from store: %s", err)
@@ -215,17 +230,30 @@ func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction,
 	}
 
 	s.l.Log(ctx, "Ensuring Hot-Storage satisfies the configuration...")
+	finishStep := ffs.StartJobStep(steps, ffs.StepHotStorage, "")
 	hot, err := s.executeHotStorage(ctx, ci, a.Cfg.Hot, a.Cfg.Cold.Filecoin.Addr, a.ReplacedCid)
 	if err != nil {
-		s.l.Log(ctx, "Hot-Storage excution failed.")
+		finishStep("", err)
+		s.l.LogEvent(ctx, ffs.LogError, ffs.StepHotStorage, nil, "Hot-Storage excution failed.")
 		return ffs.StorageInfo{}, nil, fmt.Errorf("executing hot-storage config: %s", err)
 	}
+	if hot.Enabled {
+		finishStep(fmt.Sprintf("stored %d bytes", hot.Size), nil)
+	} else {
+		finishStep("not stored", nil)
+	}
 	s.l.Log(ctx, "Hot-Storage execution ran successfully.")
 
 	s.l.Log(ctx, "Ensuring Cold-Storage satisfies the configuration...")
-	cold, errors, err := s.executeColdStorage(ctx, ci, a.Cfg.Cold, dealUpdates)
+	params := ffs.ColdParams{Steps: steps}
+	if !hot.Enabled {
+		src := &dealSource{s: s, curr: ci, cfg: a.Cfg.Cold.Filecoin, steps: steps}
+		params.DealSource = src.ensure
+		defer src.release(ctx)
+	}
+	cold, errors, err := s.executeColdStorage(ctx, ci, a.Cfg.Cold, dealUpdates, params)
 	if err != nil {
-		s.l.Log(ctx, "Cold-Storage execution failed.")
+		s.l.LogEvent(ctx, ffs.LogError, "", nil, "Cold-Storage execution failed.")
 		return ffs.StorageInfo{}, errors, fmt.Errorf("executing cold-storage config: %s", err)
 	}
 	s.l.Log(ctx, "Cold-Storage execution ran successfully.")
@@ -267,9 +295,9 @@ func (s *Scheduler) executeHotStorage(ctx context.Context, curr ffs.StorageInfo,
 		size, err = s.hs.Replace(sctx, replaceCid, curr.Cid)
 	}
 	if err != nil {
-		s.l.Log(ctx, "Direct fetching from IPFS wasn't possible.")
+		s.l.LogEvent(ctx, ffs.LogWarn, ffs.StepHotStorage, nil, "Direct fetching from IPFS wasn't possible.")
 		if !cfg.AllowUnfreeze || len(curr.Cold.Filecoin.Proposals) == 0 {
-			s.l.Log(ctx, "Unfreeze is disabled or active Filecoin deals are unavailable.")
+			s.l.LogEvent(ctx, ffs.LogError, ffs.StepHotStorage, nil, "Unfreeze is disabled or active Filecoin deals are unavailable.")
 			return ffs.HotInfo{}, fmt.Errorf("pinning cid in hot storage: %s", err)
 		}
 		s.l.Log(ctx, "Unfreezing from Filecoin...")
@@ -340,6 +368,11 @@ func (s *Scheduler) getRefreshedColdInfo(ctx context.Context, curr ffs.ColdInfo)
 	var err error
 	activeDeals := make([]ffs.FilStorage, 0, len(curr.Filecoin.Proposals))
 	for _, fp := range curr.Filecoin.Proposals {
+		// Deals which were slashed or expired aren't
+		// active anymore.
+		if fp.State != ffs.DealActive {
+			continue
+		}
 		active := true
 		// Consider the border-case of imported deals which
 		// didn't provide the ProposalCid of the deal.
@@ -357,9 +390,9 @@ func (s *Scheduler) getRefreshedColdInfo(ctx context.Context, curr ffs.ColdInfo)
 	return curr, nil
 }
 
-func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo, cfg ffs.ColdConfig, dealUpdates chan deals.StorageDealInfo) (ffs.ColdInfo, []ffs.DealError, error) {
+func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo, cfg ffs.ColdConfig, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams) (ffs.ColdInfo, []ffs.DealError, error) {
 	if !cfg.Enabled {
-		s.l.Log(ctx, "Cold-Storage was disabled, Filecoin deals will eventually expire.")
+		s.l.LogEvent(ctx, ffs.LogWarn, "", nil, "Cold-Storage was disabled, Filecoin deals will eventually expire.")
 		return curr.Cold, nil, nil
 	}
 
@@ -373,7 +406,12 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	var allErrors []ffs.DealError
 	if len(sds) > 0 {
 		s.l.Log(ctx, "Resuming %d dettached executing deals...", len(sds))
-		okResumedDeals, failedResumedDeals := s.waitForDeals(ctx, curr.Cid, sds, dealUpdates)
+		okResumedDeals, failedResumedDeals, _ := s.waitForDeals(ctx, curr.Cid, sds, dealUpdates, params)
+		if ctx.Err() == nil {
+			if err := s.sjs.RemoveStartedDeals(curr.Cid); err != nil {
+				return ffs.ColdInfo{}, nil, fmt.Errorf("removing resumed started deals: %s", err)
+			}
+		}
 		s.l.Log(ctx, "A total of %d resumed deals finished successfully", len(okResumedDeals))
 		allErrors = append(allErrors, failedResumedDeals...)
 		// Append the resumed and confirmed deals to the current active proposals
@@ -383,43 +421,30 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	// 2. If this Storage Config is renewable, then let's check if any of the existing deals
 	// should be renewed, and do it.
 	if cfg.Filecoin.Renew.Enabled {
-		if curr.Hot.Enabled {
-			s.l.Log(ctx, "Checking deal renewals...")
-			newFilInfo, errors, err := s.cs.EnsureRenewals(ctx, curr.Cid, curr.Cold.Filecoin, cfg.Filecoin, s.dealFinalityTimeout, dealUpdates)
-			if err != nil {
-				s.l.Log(ctx, "Deal renewal process couldn't be executed: %s", err)
-			} else {
-				for _, e := range errors {
-					s.l.Log(ctx, "Deal deal renewal errored. ProposalCid: %s, Miner: %s, Cause: %s", e.ProposalCid, e.Miner, e.Message)
-				}
-				numDeals := len(newFilInfo.Proposals) - len(curr.Cold.Filecoin.Proposals)
-				if numDeals > 0 {
-					// If renew process created deals, we eagerly save this information in the datastore.
-					// Further work about the new storage config could decide the Job failed and we'd lose
-					// this information if not saved.
-					if err := s.cis.Put(curr); err != nil {
-						return ffs.ColdInfo{}, nil, fmt.Errorf("eager saving of new info: %s", err)
-					}
-					s.l.Log(ctx, "A total of %d new deals were created in the renewal process", numDeals)
-				}
-				s.l.Log(ctx, "Deal renewal evaluated successfully")
-				curr.Cold.Filecoin = newFilInfo
-
+		s.l.Log(ctx, "Checking deal renewals...")
+		newFilInfo, errors, err := s.cs.EnsureRenewals(ctx, curr.Cid, curr.Cold.Filecoin, cfg.Filecoin, s.dealFinalityTimeout, dealUpdates, params)
+		if err != nil {
+			s.l.LogEvent(ctx, ffs.LogError, "", nil, "Deal renewal process couldn't be executed: %s", err)
+		} else {
+			for _, e := range errors {
+				s.l.LogEvent(ctx, ffs.LogWarn, "", ffs.DealLogFields(e.Miner, e.ProposalCid, 0), "Deal deal renewal errored. ProposalCid: %s, Miner: %s, Cause: %s", e.ProposalCid, e.Miner, e.Message)
+			}
+			numDeals := len(newFilInfo.Proposals) - len(curr.Cold.Filecoin.Proposals)
+			if numDeals > 0 {
+				// If renew process created deals, we eagerly save this information in the datastore.
+				// Further work about the new storage config could decide the Job failed and we'd lose
+				// this information if not saved.
 				if err := s.cis.Put(curr); err != nil {
-					log.Errorf("saving cid info to store: %s", err)
+					return ffs.ColdInfo{}, nil, fmt.Errorf("eager saving of new info: %s", err)
 				}
+				s.l.Log(ctx, "A total of %d new deals were created in the renewal process", numDeals)
+			}
+			s.l.Log(ctx, "Deal renewal evaluated successfully")
+			curr.Cold.Filecoin = newFilInfo
+
+			if err := s.cis.Put(curr); err != nil {
+				log.Errorf("saving cid info to store: %s", err)
 			}
-		} else {
-			// (**) Renewable note:
-			// This shouldn't happen since it would be an invalid Storage Config.
-			// We can only accept "Repair" if Hot Storage is enabled.
-			// We can the feature to retrieve the data from a miner,
-			// put it in Hot Storage, and then try the renewal. Looks to me
-			// we should be sure about doing that since it would be paying
-			// for retrieval to later discard the data. Sounds like Filecoin
-			// should allow renewing a deal without the need of sending the data
-			// again. Still not clear.
-			return ffs.ColdInfo{}, nil, fmt.Errorf("invalid storage configuration, can't be renewable with disabled hot storage")
 		}
 	}
 
@@ -441,36 +466,81 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	}
 
 	// The answer is yes, calculate how many extra deals we need and create them.
+	// If escalation is enabled, deals that were rejected or failed are re-proposed
+	// to new miners in further rounds.
 	deltaFilConfig := createDeltaFilConfig(cfg, curr.Cold.Filecoin)
-	s.l.Log(ctx, "Current replication factor is lower than desired, making %d new deals...", deltaFilConfig.RepFactor)
-	startedProposals, rejectedProposals, size, err := s.cs.Store(ctx, curr.Cid, deltaFilConfig)
-	if err != nil {
-		s.l.Log(ctx, "Starting deals failed, with cause: %s", err)
-		return ffs.ColdInfo{}, rejectedProposals, err
-	}
-	allErrors = append(allErrors, rejectedProposals...)
-
-	// If *none* of the tried proposals succeeded, then the Job fails.
-	if len(startedProposals) == 0 {
-		return ffs.ColdInfo{}, allErrors, fmt.Errorf("all proposals were rejected")
+	esc := deltaFilConfig.Escalation
+	var deadline time.Time
+	if esc.Enabled && esc.Deadline > 0 {
+		deadline = time.Now().Add(time.Duration(esc.Deadline) * time.Second)
 	}
-
-	// Track all deals that weren't rejected, just in case Powergate crashes/closes before
-	// we see them finalize, so they can be detected and resumed on starting Powergate again (point 1. above)
-	if err := s.sjs.AddStartedDeals(curr.Cid, startedProposals); err != nil {
-		return ffs.ColdInfo{}, rejectedProposals, err
-	}
-
-	// Wait for started deals.
-	okDeals, failedDeals := s.waitForDeals(ctx, curr.Cid, startedProposals, dealUpdates)
-	allErrors = append(allErrors, failedDeals...)
-	if err := s.sjs.RemoveStartedDeals(curr.Cid); err != nil {
-		return ffs.ColdInfo{}, allErrors, fmt.Errorf("removing temporal started deals storage: %s", err)
+	var okDeals []ffs.FilStorage
+	var unfinished []cid.Cid
+	var size abi.PaddedPieceSize
+	var numStarted int
+	for round := 0; ; round++ {
+		rparams := params
+		rparams.EscalationRound = round
+		finishStep := func(string, error) {}
+		if round == 0 {
+			s.l.Log(ctx, "Current replication factor is lower than desired, making %d new deals...", deltaFilConfig.RepFactor)
+		} else {
+			s.l.LogEvent(ctx, ffs.LogWarn, ffs.StepDealEscalation, nil, "Escalation round %d, re-proposing %d deals to new miners...", round, deltaFilConfig.RepFactor)
+			finishStep = params.StartStep(ffs.StepDealEscalation, fmt.Sprintf("round %d with %d deals", round, deltaFilConfig.RepFactor))
+		}
+		roundOkDeals, roundErrors, roundUnfinished, roundStarted, roundSize, err := s.makeDeals(ctx, curr.Cid, deltaFilConfig, dealUpdates, rparams, deadline)
+		allErrors = append(allErrors, roundErrors...)
+		if err != nil {
+			finishStep("", err)
+			// Errors of further rounds don't invalidate deals of previous rounds.
+			if round == 0 {
+				return ffs.ColdInfo{}, allErrors, err
+			}
+			s.l.LogEvent(ctx, ffs.LogError, ffs.StepDealEscalation, nil, "Escalation round %d failed, with cause: %s", round, err)
+			break
+		}
+		finishStep(fmt.Sprintf("%d of %d deals succeeded", len(roundOkDeals), deltaFilConfig.RepFactor), nil)
+		okDeals = append(okDeals, roundOkDeals...)
+		unfinished = append(unfinished, roundUnfinished...)
+		numStarted += roundStarted
+		size = roundSize
+
+		// Unfinished deals are resumed by the next Job of the Cid, so
+		// they aren't re-proposed.
+		missing := deltaFilConfig.RepFactor - len(roundOkDeals) - len(roundUnfinished)
+		if missing <= 0 || ctx.Err() != nil || !esc.Enabled {
+			break
+		}
+		if round >= esc.MaxRounds {
+			s.l.LogEvent(ctx, ffs.LogWarn, ffs.StepDealEscalation, nil, "Reached the maximum of %d escalation rounds with %d missing deals.", esc.MaxRounds, missing)
+			break
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			s.l.LogEvent(ctx, ffs.LogWarn, ffs.StepDealEscalation, nil, "Escalation deadline passed with %d missing deals.", missing)
+			break
+		}
+		// Miners that were already tried aren't considered in the next round.
+		deltaFilConfig.RepFactor = missing
+		for _, d := range roundOkDeals {
+			deltaFilConfig.ExcludedMiners = append(deltaFilConfig.ExcludedMiners, d.Miner)
+		}
+		for _, e := range roundErrors {
+			if e.Miner != "" {
+				deltaFilConfig.ExcludedMiners = append(deltaFilConfig.ExcludedMiners, e.Miner)
+			}
+		}
 	}
 
 	// If the Job wasn't canceled, and not even one deal finished succcessfully,
 	// consider this Job execution a failure.
-	if ctx.Err() == nil && len(failedDeals) == len(startedProposals) {
+	if ctx.Err() == nil && len(okDeals) == 0 {
+		// If *none* of the tried proposals succeeded, then the Job fails.
+		if numStarted == 0 {
+			return ffs.ColdInfo{}, allErrors, fmt.Errorf("all proposals were rejected")
+		}
+		if len(unfinished) > 0 {
+			return ffs.ColdInfo{}, allErrors, fmt.Errorf("no deal finished before the escalation deadline, %d unfinished deals will be resumed in the next execution", len(unfinished))
+		}
 		return ffs.ColdInfo{}, allErrors, fmt.Errorf("all started deals failed")
 	}
 
@@ -485,11 +555,64 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	}, allErrors, nil
 }
 
-func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposals []cid.Cid, dealUpdates chan deals.StorageDealInfo) ([]ffs.FilStorage, []ffs.DealError) {
+// makeDeals starts new deals for a Cid and waits for them to finish. It
+// returns the deals that finished successfully, errors of rejected or
+// failed deals, the proposals of unfinished deals, and the number of
+// started deals. If deadline isn't zero, deals which didn't finish by then
+// are unfinished, and are resumed by the next Job of the Cid.
+func (s *Scheduler) makeDeals(ctx context.Context, c cid.Cid, cfg ffs.FilConfig, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams, deadline time.Time) ([]ffs.FilStorage, []ffs.DealError, []cid.Cid, int, abi.PaddedPieceSize, error) {
+	startedProposals, rejectedProposals, size, err := s.cs.Store(ctx, c, cfg, params)
+	if err != nil {
+		s.l.LogEvent(ctx, ffs.LogError, "", nil, "Starting deals failed, with cause: %s", err)
+		return nil, rejectedProposals, nil, 0, 0, err
+	}
+	if len(startedProposals) == 0 {
+		return nil, rejectedProposals, nil, 0, size, nil
+	}
+
+	// Track all deals that weren't rejected, just in case Powergate crashes/closes before
+	// we see them finalize, so they can be detected and resumed on starting Powergate again (point 1. above)
+	if err := s.sjs.AddStartedDeals(c, startedProposals); err != nil {
+		return nil, rejectedProposals, nil, 0, 0, err
+	}
+
+	// Wait for started deals.
+	wctx := ctx
+	if !deadline.IsZero() {
+		var cancel context.CancelFunc
+		wctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+	okDeals, failedDeals, unfinished := s.waitForDeals(wctx, c, startedProposals, dealUpdates, params)
+	dealErrors := make([]ffs.DealError, 0, len(rejectedProposals)+len(failedDeals))
+	dealErrors = append(dealErrors, rejectedProposals...)
+	dealErrors = append(dealErrors, failedDeals...)
+	if ctx.Err() != nil {
+		// The Job was canceled, so started deals are kept to be resumed.
+		return okDeals, dealErrors, unfinished, len(startedProposals), size, nil
+	}
+	if len(unfinished) > 0 {
+		s.l.LogEvent(ctx, ffs.LogWarn, ffs.StepDealEscalation, nil, "Escalation deadline passed with %d unfinished deals, which will be resumed in the next execution.", len(unfinished))
+		if err := s.sjs.AddStartedDeals(c, unfinished); err != nil {
+			return nil, dealErrors, nil, 0, 0, fmt.Errorf("saving unfinished started deals: %s", err)
+		}
+		return okDeals, dealErrors, unfinished, len(startedProposals), size, nil
+	}
+	if err := s.sjs.RemoveStartedDeals(c); err != nil {
+		return nil, dealErrors, nil, 0, 0, fmt.Errorf("removing temporal started deals storage: %s", err)
+	}
+	return okDeals, dealErrors, nil, len(startedProposals), size, nil
+}
+
+// waitForDeals waits for started deals to finish. It returns the deals
+// that finished successfully, errors of failed deals, and the proposals of
+// deals which didn't finish before ctx was done.
+func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposals []cid.Cid, dealUpdates chan deals.StorageDealInfo, params ffs.ColdParams) ([]ffs.FilStorage, []ffs.DealError, []cid.Cid) {
 	s.l.Log(ctx, "Watching deals unfold...")
 
 	var failedDeals []ffs.DealError
 	var okDeals []ffs.FilStorage
+	var unfinished []cid.Cid
 	var wg sync.WaitGroup
 	var lock sync.Mutex
 	wg.Add(len(startedProposals))
@@ -498,17 +621,22 @@ func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposal
 		go func() {
 			defer wg.Done()
 
-			res, err := s.cs.WaitForDeal(ctx, c, pc, s.dealFinalityTimeout, dealUpdates)
+			res, err := s.cs.WaitForDeal(ctx, c, pc, s.dealFinalityTimeout, dealUpdates, params)
 			var dealError ffs.DealError
 			if err != nil {
-				if !errors.As(err, &dealError) {
+				isDealError := errors.As(err, &dealError)
+				if !isDealError {
 					dealError = ffs.DealError{
 						ProposalCid: pc,
 						Message:     fmt.Sprintf("waiting for deal finality: %s", err),
 					}
 				}
 				lock.Lock()
-				failedDeals = append(failedDeals, dealError)
+				if !isDealError && ctx.Err() != nil {
+					unfinished = append(unfinished, pc)
+				} else {
+					failedDeals = append(failedDeals, dealError)
+				}
 				lock.Unlock()
 				return
 			}
@@ -518,7 +646,7 @@ func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposal
 		}()
 	}
 	wg.Wait()
-	return okDeals, failedDeals
+	return okDeals, failedDeals, unfinished
 }
 
 func createDeltaFilConfig(cfg ffs.ColdConfig, curr ffs.FilInfo) ffs.FilConfig {
@@ -529,3 +657,97 @@ func createDeltaFilConfig(cfg ffs.ColdConfig, curr ffs.FilInfo) ffs.FilConfig {
 	}
 	return res
 }
+
+// dealSource makes the data of a Cid with disabled Hot Storage available
+// to make new deals, retrieving it from an existing deal if needed. The
+// data is pinned in the Hot Storage until release is called.
+type dealSource struct {
+	s     *Scheduler
+	curr  ffs.StorageInfo
+	cfg   ffs.FilConfig
+	steps ffs.JobStepRecorder
+
+	once   sync.Once
+	err    error
+	pinned bool
+}
+
+// ensure makes the data available. The data is made available once, so
+// further calls return the result of the first one.
+func (ds *dealSource) ensure(ctx context.Context) error {
+	ds.once.Do(func() {
+		ds.err = ds.makeAvailable(ctx)
+	})
+	return ds.err
+}
+
+func (ds *dealSource) makeAvailable(ctx context.Context) error {
+	c := ds.curr.Cid
+	proposals := ds.curr.Cold.Filecoin.Proposals
+	// Without existing deals, the data should have been staged to
+	// make the first deals.
+	if len(proposals) == 0 {
+		return nil
+	}
+	stored, err := ds.s.hs.IsStored(ctx, c)
+	if err != nil {
+		return fmt.Errorf("checking if cid is stored in hot storage: %s", err)
+	}
+	if stored {
+		return nil
+	}
+
+	sctx, cancel := context.WithTimeout(ctx, dealSourceStoreTimeout)
+	_, err = ds.s.hs.Store(sctx, c)
+	cancel()
+	if err == nil {
+		ds.pinned = true
+		ds.s.l.Log(ctx, "Data for new deals was pinned temporarily in Hot-Storage.")
+		return nil
+	}
+
+	if ds.cfg.MaxRetrievalPrice == 0 {
+		return fmt.Errorf("data isn't available in hot-storage and retrievals are disabled without a max retrieval price")
+	}
+	ds.s.l.Log(ctx, "Data for new deals isn't available in Hot-Storage, retrieving it from an existing deal...")
+	finishStep := ffs.StartJobStep(ds.steps, ffs.StepColdRetrieval, fmt.Sprintf("max price %d attoFIL", ds.cfg.MaxRetrievalPrice))
+	var pieceCid *cid.Cid
+	var miners []string
+	for _, p := range proposals {
+		if p.PieceCid != cid.Undef {
+			pieceCid = &p.PieceCid
+		}
+		miners = append(miners, p.Miner)
+	}
+	fi, err := ds.s.cs.Fetch(ctx, c, pieceCid, ds.cfg.Addr, miners, ds.cfg.MaxRetrievalPrice, "")
+	if err != nil {
+		finishStep("", err)
+		return fmt.Errorf("retrieving data from existing deals: %s", err)
+	}
+	finishStep(fmt.Sprintf("retrieved from %s for %d attoFIL", fi.RetrievedMiner, fi.FundsSpent), nil)
+	ds.s.l.LogEvent(ctx, ffs.LogInfo, ffs.StepColdRetrieval, ffs.DealLogFields(fi.RetrievedMiner, cid.Undef, 0), "Retrieved data from %s with cost %d attoFil, pinning temporarily in Hot-Storage...", fi.RetrievedMiner, fi.FundsSpent)
+	if _, err := ds.s.hs.Store(ctx, c); err != nil {
+		return fmt.Errorf("pinning retrieved data: %s", err)
+	}
+	ds.pinned = true
+	return nil
+}
+
+// release removes the data from the Hot Storage if it was pinned to make
+// new deals.
+func (ds *dealSource) release(ctx context.Context) {
+	if !ds.pinned {
+		return
+	}
+	// The job context might be canceled, but the temporary pin should
+	// be removed anyway.
+	rctx := context.WithValue(context.Background(), ffs.CtxStorageCid, ds.curr.Cid)
+	rctx = context.WithValue(rctx, ffs.CtxKeyJid, ctx.Value(ffs.CtxKeyJid))
+	rctx, cancel := context.WithTimeout(rctx, dealSourceStoreTimeout)
+	defer cancel()
+	if err := ds.s.hs.Remove(rctx, ds.curr.Cid); err != nil {
+		ds.s.l.LogEvent(ctx, ffs.LogError, "", nil, "Removing temporary pin of data for new deals failed: %s", err)
+		return
+	}
+	ds.s.l.Log(ctx, "Temporary pin of data for new deals was removed from Hot-Storage.")
+}
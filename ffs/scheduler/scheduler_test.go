@@ -0,0 +1,145 @@
+package scheduler_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/joblogger"
+	"github.com/textileio/powergate/ffs/memstorage"
+	"github.com/textileio/powergate/ffs/scheduler"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+)
+
+const askPrice = uint64(500000000)
+
+func TestEscalationRounds(t *testing.T) {
+	t.Parallel()
+	sched, cs, c := newScheduler(t, memstorage.WithMiners(5, askPrice))
+	miners := cs.Miners()
+	cs.SetMinerRejecting(miners[0], true)
+	cs.SetMinerRejecting(miners[1], true)
+	cs.SetMinerRejecting(miners[2], true)
+	cfg := storageConfig(2).WithColdMaxPrice(askPrice*3).WithColdFilEscalation(true, 3, 50, 0)
+	job := waitJob(t, sched, c, cfg, ffs.Success)
+
+	// Round 0 is rejected by miners 0 and 1, round 1 by miner 2, and
+	// round 2 only proposes the missing deal.
+	require.Len(t, job.DealErrors, 3)
+	for i, e := range job.DealErrors {
+		require.Equal(t, miners[i], e.Miner)
+	}
+	require.Equal(t, 2, escalationRounds(job))
+	si, err := sched.GetStorageInfo(c)
+	require.NoError(t, err)
+	require.Len(t, si.Cold.Filecoin.Proposals, 2)
+	prices := map[string]uint64{}
+	for _, p := range si.Cold.Filecoin.Proposals {
+		prices[p.Miner] = p.EpochPrice
+	}
+	require.Equal(t, askPrice*3/2, prices[miners[3]])
+	require.Equal(t, askPrice*2, prices[miners[4]])
+}
+
+func TestEscalationMaxRounds(t *testing.T) {
+	t.Parallel()
+	sched, cs, c := newScheduler(t, memstorage.WithMiners(5, askPrice))
+	miners := cs.Miners()
+	for _, m := range miners[:3] {
+		cs.SetMinerRejecting(m, true)
+	}
+	cfg := storageConfig(1).WithColdMaxPrice(askPrice*3).WithColdFilEscalation(true, 2, 50, 0)
+	job := waitJob(t, sched, c, cfg, ffs.Failed)
+
+	// Miners 3 and 4 aren't tried after the last round.
+	require.Len(t, job.DealErrors, 3)
+	for i, e := range job.DealErrors {
+		require.Equal(t, miners[i], e.Miner)
+	}
+	require.Equal(t, 2, escalationRounds(job))
+}
+
+func TestEscalationDeadline(t *testing.T) {
+	t.Parallel()
+	// Deals are active 1.5 seconds after being proposed, after the
+	// escalation deadline.
+	sched, cs, c := newScheduler(t, memstorage.WithActivationEpochs(150))
+	cfg := storageConfig(1).WithColdFilEscalation(true, 2, 0, 1)
+	job := waitJob(t, sched, c, cfg, ffs.Failed)
+
+	// Unfinished deals aren't failed nor re-proposed.
+	require.Empty(t, job.DealErrors)
+	require.Equal(t, 0, escalationRounds(job))
+
+	// The next Job resumes the unfinished deal instead of making a
+	// new one.
+	job = waitJob(t, sched, c, cfg, ffs.Success)
+	for _, s := range job.Steps {
+		require.NotEqual(t, ffs.StepDealProposal, s.Name)
+	}
+	si, err := sched.GetStorageInfo(c)
+	require.NoError(t, err)
+	require.Len(t, si.Cold.Filecoin.Proposals, 1)
+	require.Equal(t, cs.Miners()[0], si.Cold.Filecoin.Proposals[0].Miner)
+}
+
+func newScheduler(t *testing.T, opts ...memstorage.Option) (*scheduler.Scheduler, *memstorage.ColdStorage, cid.Cid) {
+	l, err := joblogger.New(tests.NewTxMapDatastore())
+	require.NoError(t, err)
+	opts = append([]memstorage.Option{memstorage.WithEpochDuration(time.Millisecond * 10), memstorage.WithActivationEpochs(2)}, opts...)
+	hs, err := memstorage.NewHotStorage(l, opts...)
+	require.NoError(t, err)
+	cs, err := memstorage.NewColdStorage(hs, l, opts...)
+	require.NoError(t, err)
+	sched, err := scheduler.New(tests.NewTxMapDatastore(), l, hs, cs, 10, time.Minute, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, sched.Close()) })
+	c, err := hs.Add(context.Background(), bytes.NewReader([]byte("hello world")))
+	require.NoError(t, err)
+	return sched, cs, c
+}
+
+func storageConfig(repFactor int) ffs.StorageConfig {
+	cfg := ffs.StorageConfig{
+		Hot: ffs.HotConfig{Enabled: true, Ipfs: ffs.IpfsConfig{AddTimeout: 1}},
+		Cold: ffs.ColdConfig{
+			Enabled: true,
+			Filecoin: ffs.FilConfig{
+				RepFactor:       repFactor,
+				DealMinDuration: util.MinDealDuration,
+				Addr:            "f0100",
+			},
+		},
+	}
+	return cfg
+}
+
+// waitJob pushes cfg for c, and returns the Job once it has status.
+func waitJob(t *testing.T, sched *scheduler.Scheduler, c cid.Cid, cfg ffs.StorageConfig, status ffs.JobStatus) ffs.StorageJob {
+	require.NoError(t, cfg.Validate())
+	jid, err := sched.PushConfig(ffs.NewAPIID(), c, cfg)
+	require.NoError(t, err)
+	var job ffs.StorageJob
+	require.Eventually(t, func() bool {
+		job, err = sched.StorageJob(jid)
+		require.NoError(t, err)
+		return job.Status == ffs.Success || job.Status == ffs.Failed
+	}, time.Second*5, time.Millisecond*50)
+	require.Equal(t, status, job.Status, job.ErrCause)
+	return job
+}
+
+func escalationRounds(job ffs.StorageJob) int {
+	var rounds int
+	for _, s := range job.Steps {
+		if s.Name == ffs.StepDealEscalation {
+			rounds++
+		}
+	}
+	return rounds
+}
@@ -2,7 +2,11 @@ package ffs
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +15,12 @@ import (
 	"github.com/textileio/powergate/util"
 )
 
+var (
+	// ErrInsufficientFunds is returned when an address doesn't have enough
+	// funds to pay for planned deals.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
 var (
 	// EmptyRetrievalID is an undef retrieval id.
 	EmptyRetrievalID = RetrievalID("")
@@ -119,6 +129,50 @@ type StorageJob struct {
 	DealInfo   []deals.StorageDealInfo
 	DealErrors []DealError
 	CreatedAt  int64
+	Steps      []JobStep
+}
+
+// Names of the steps of a StorageJob execution.
+const (
+	// StepHotStorage is the step of ensuring the Hot Storage satisfies
+	// the configuration, e.g: adding and pinning data in IPFS.
+	StepHotStorage = "hot-storage"
+	// StepColdRetrieval is the retrieval of data from an existing deal
+	// to make new deals when the data isn't in the Hot Storage.
+	StepColdRetrieval = "cold-retrieval"
+	// StepDealPreparationQueue is the wait in the queue of data being
+	// prepared for deals.
+	StepDealPreparationQueue = "deal-preparation-queue"
+	// StepPieceCalculation is the calculation of the piece of the data.
+	StepPieceCalculation = "piece-calculation"
+	// StepMinerSelection is the selection of miners for new deals.
+	StepMinerSelection = "miner-selection"
+	// StepDealProposal is the proposal of a deal to a miner.
+	StepDealProposal = "deal-proposal"
+	// StepDealState is the time a deal spent in a state.
+	StepDealState = "deal-state"
+	// StepFinalization is saving the result of the execution.
+	StepFinalization = "finalization"
+	// StepDealEscalation is a round of re-proposing rejected or failed
+	// deals to new miners.
+	StepDealEscalation = "deal-escalation"
+)
+
+// JobStep is a step of a Job execution.
+type JobStep struct {
+	// Name is the kind of step, e.g: StepPieceCalculation.
+	Name string
+	// Detail describes the step, e.g: the miner of a deal proposal.
+	Detail string
+	// StartedAt is the unix time in nanoseconds when the step started.
+	StartedAt int64
+	// FinishedAt is the unix time in nanoseconds when the step finished,
+	// or zero if it's still running.
+	FinishedAt int64
+	// Result describes the outcome of a successful step.
+	Result string
+	// Error is the error of a failed step.
+	Error string
 }
 
 // RetrievalJob is a retrieval task executed by the Scheduler.
@@ -162,6 +216,14 @@ func (s StorageConfig) WithColdStartDealOffset(offset int64) StorageConfig {
 	return s
 }
 
+// WithColdMaxRetrievalPrice sets the maximum price in attoFil to
+// retrieve the data from an existing deal to make new deals, if the Hot
+// Storage is disabled. Zero disables retrieving the data.
+func (s StorageConfig) WithColdMaxRetrievalPrice(maxPrice uint64) StorageConfig {
+	s.Cold.Filecoin.MaxRetrievalPrice = maxPrice
+	return s
+}
+
 // WithColdFilCountryCodes defines a list of allowed country codes to select miners
 // for deals.
 func (s StorageConfig) WithColdFilCountryCodes(countryCodes []string) StorageConfig {
@@ -207,6 +269,19 @@ func (s StorageConfig) WithColdFilRenew(enabled bool, threshold int) StorageConf
 	return s
 }
 
+// WithColdFilEscalation specifies if rejected or failed deals should be
+// re-proposed to new miners in up to maxRounds rounds, increasing the
+// offered price by priceIncrease percent of the ask price per round.
+func (s StorageConfig) WithColdFilEscalation(enabled bool, maxRounds, priceIncrease, deadline int) StorageConfig {
+	s.Cold.Filecoin.Escalation = FilEscalation{
+		Enabled:       enabled,
+		MaxRounds:     maxRounds,
+		PriceIncrease: priceIncrease,
+		Deadline:      deadline,
+	}
+	return s
+}
+
 // WithColdMaxPrice specifies the max price that should be considered for
 // deal asks even when all other filers match.
 func (s StorageConfig) WithColdMaxPrice(maxPrice uint64) StorageConfig {
@@ -261,11 +336,6 @@ func (s StorageConfig) Validate() error {
 	if err := s.Cold.Validate(); err != nil {
 		return fmt.Errorf("cold-filecoin config is invalid: %s", err)
 	}
-	// We can't accept being renewable without the hot storage enabled.
-	// See the (**) note in scheduler.go
-	if s.Cold.Enabled && s.Cold.Filecoin.Renew.Enabled && !s.Hot.Enabled {
-		return fmt.Errorf("hot storage should be enabled to enable renewals")
-	}
 	return nil
 }
 
@@ -366,6 +436,15 @@ type FilConfig struct {
 	// if miners accept deals, since they should seal fast enough to satisfy
 	// this constraint.
 	DealStartOffset int64
+	// MaxRetrievalPrice is the maximum amount of attoFil to pay for
+	// retrieving the data from an existing deal, when new deals are
+	// needed for renewals or repairs and the Hot Storage is disabled.
+	// Zero disables retrieving the data, so new deals can't be made if
+	// the data isn't available in the Hot Storage.
+	MaxRetrievalPrice uint64
+	// Escalation indicates the re-proposal configuration of rejected or
+	// failed deals.
+	Escalation FilEscalation
 }
 
 // Validate returns a non-nil error if the configuration is invalid.
@@ -379,6 +458,12 @@ func (fc *FilConfig) Validate() error {
 	if err := fc.Renew.Validate(); err != nil {
 		return fmt.Errorf("invalid renew config: %s", err)
 	}
+	if err := fc.Escalation.Validate(); err != nil {
+		return fmt.Errorf("invalid escalation config: %s", err)
+	}
+	if fc.Escalation.Enabled && fc.Escalation.PriceIncrease > 0 && fc.MaxPrice == 0 {
+		return fmt.Errorf("escalation price increase requires a max price")
+	}
 	return nil
 }
 
@@ -399,6 +484,58 @@ func (fr *FilRenew) Validate() error {
 	return nil
 }
 
+// FilEscalation contains configuration to re-propose deals that were
+// rejected by miners or failed before being active on-chain.
+type FilEscalation struct {
+	// Enabled indicates that rejected or failed deals are re-proposed to
+	// new miners until RepFactor is met.
+	Enabled bool
+	// MaxRounds is the maximum number of re-proposal rounds.
+	MaxRounds int
+	// PriceIncrease is the percentage of the miner ask price added to
+	// the offered price in each round. The offered price never exceeds
+	// MaxPrice.
+	PriceIncrease int
+	// Deadline is the maximum number of seconds since the first proposals
+	// to start a new round. Zero means no deadline.
+	Deadline int
+}
+
+// Validate returns a non-nil error if the configuration is invalid.
+func (fe *FilEscalation) Validate() error {
+	if !fe.Enabled {
+		return nil
+	}
+	if fe.MaxRounds <= 0 {
+		return fmt.Errorf("max rounds should be positive: %d", fe.MaxRounds)
+	}
+	if fe.PriceIncrease < 0 {
+		return fmt.Errorf("price increase can't be negative: %d", fe.PriceIncrease)
+	}
+	if fe.Deadline < 0 {
+		return fmt.Errorf("deadline can't be negative: %d", fe.Deadline)
+	}
+	return nil
+}
+
+// EpochPrice returns the price offered in a re-proposal round for a miner
+// asking askPrice, capped by maxPrice if it isn't zero.
+func (fe *FilEscalation) EpochPrice(askPrice, maxPrice uint64, round int) uint64 {
+	if !fe.Enabled || round <= 0 || fe.PriceIncrease <= 0 {
+		return askPrice
+	}
+	price := new(big.Int).SetUint64(askPrice)
+	price.Mul(price, big.NewInt(int64(100+fe.PriceIncrease*round)))
+	price.Div(price, big.NewInt(100))
+	if maxPrice > 0 && price.Cmp(new(big.Int).SetUint64(maxPrice)) > 0 {
+		return maxPrice
+	}
+	if !price.IsUint64() {
+		return askPrice
+	}
+	return price.Uint64()
+}
+
 // RetrievalInfo has data about an executed Filecoin retrieval.
 type RetrievalInfo struct {
 	ID        RetrievalID
@@ -488,6 +625,47 @@ type FilStorage struct {
 	// EpochPrice is the price of attoFil per GiB
 	// per epoch paid in this deal.
 	EpochPrice uint64
+	// DealID is the on-chain id of the deal.
+	DealID uint64
+	// State is the on-chain state of the deal.
+	State DealState
+	// StateReason describes why the deal reached
+	// a terminal state.
+	StateReason string
+}
+
+// DealState is the on-chain state of an active deal.
+type DealState int
+
+const (
+	// DealActive indicates the deal is active on-chain.
+	DealActive DealState = iota
+	// DealSlashed indicates the miner was slashed for the deal, so the
+	// data isn't stored anymore.
+	DealSlashed
+	// DealExpired indicates the deal reached its end epoch.
+	DealExpired
+)
+
+// DealStateStr maps DealState to describing string.
+var DealStateStr = map[DealState]string{
+	DealActive:  "active",
+	DealSlashed: "slashed",
+	DealExpired: "expired",
+}
+
+// DealEvent is a change of the on-chain state of a tracked deal.
+type DealEvent struct {
+	// Cid is the data Cid stored in the deal.
+	Cid cid.Cid
+	// DealID is the on-chain id of the deal.
+	DealID uint64
+	// State is the new state of the deal.
+	State DealState
+	// Reason describes the state change.
+	Reason string
+	// Epoch is the epoch in which the change was detected.
+	Epoch int64
 }
 
 // JobLoggerCtxKey is a type to use in ctx values for CidLogger.
@@ -504,19 +682,158 @@ const (
 	CtxRetrievalID
 )
 
+// JobStepRecorder records the steps of a Job execution.
+type JobStepRecorder interface {
+	// StartStep records a started step, and returns its index.
+	StartStep(name, detail string) int
+	// FinishStep records the outcome of the step with index i.
+	FinishStep(i int, result string, err error)
+}
+
 // JobLogger saves log information about a storage and retrieval tasks.
 type JobLogger interface {
+	// Log logs an informational message.
 	Log(context.Context, string, ...interface{})
+	// LogEvent logs a message with a level, an event type and
+	// structured fields. event and fields are optional.
+	LogEvent(ctx context.Context, level LogLevel, event string, fields LogFields, format string, a ...interface{})
 	Watch(context.Context, chan<- LogEntry) error
 	GetByCid(context.Context, cid.Cid) ([]LogEntry, error)
+	// Query returns the logs of a Cid matching a query.
+	Query(context.Context, LogQuery) ([]LogEntry, error)
+}
+
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+const (
+	// LogUnspecified is an undefined level, which matches all levels in
+	// queries.
+	LogUnspecified LogLevel = iota
+	// LogDebug is the level of detailed information for debugging.
+	LogDebug
+	// LogInfo is the level of the normal progress of an execution.
+	LogInfo
+	// LogWarn is the level of failures which the execution recovers from,
+	// e.g: a rejected deal proposal.
+	LogWarn
+	// LogError is the level of failures of an execution.
+	LogError
+)
+
+// LogLevelStr maps LogLevel to describing string.
+var LogLevelStr = map[LogLevel]string{
+	LogUnspecified: "unspecified",
+	LogDebug:       "debug",
+	LogInfo:        "info",
+	LogWarn:        "warn",
+	LogError:       "error",
+}
+
+// Keys of commonly used LogFields.
+const (
+	// LogFieldMiner is the address of a miner.
+	LogFieldMiner = "miner"
+	// LogFieldProposalCid is the proposal cid of a deal.
+	LogFieldProposalCid = "proposal-cid"
+	// LogFieldDealID is the on-chain id of a deal.
+	LogFieldDealID = "deal-id"
+	// LogFieldPrice is a price in attoFIL per epoch.
+	LogFieldPrice = "attofil"
+)
+
+// EventDealLifecycle is the event of log entries about on-chain state
+// changes of active deals.
+const EventDealLifecycle = "deal-lifecycle"
+
+// LogFields are structured fields of a log entry.
+type LogFields map[string]string
+
+// DealLogFields returns the fields of a log entry about a deal. Zero
+// values are omitted.
+func DealLogFields(miner string, proposal cid.Cid, dealID uint64) LogFields {
+	f := LogFields{}
+	if miner != "" {
+		f[LogFieldMiner] = miner
+	}
+	if proposal.Defined() {
+		f[LogFieldProposalCid] = proposal.String()
+	}
+	if dealID != 0 {
+		f[LogFieldDealID] = strconv.FormatUint(dealID, 10)
+	}
+	return f
 }
 
 // LogEntry is a log entry from a Cid execution.
 type LogEntry struct {
-	Cid       cid.Cid
-	Timestamp time.Time
-	Jid       JobID
-	Msg       string
+	Cid         cid.Cid
+	RetrievalID RetrievalID
+	Timestamp   time.Time
+	Jid         JobID
+	Msg         string
+	Level       LogLevel
+	Event       string
+	Fields      LogFields
+}
+
+// LogQuery filters log entries of a Cid or a retrieval. Zero values of
+// fields don't filter entries.
+type LogQuery struct {
+	// Cid is the Cid of the entries. Either Cid or RetrievalID is
+	// mandatory.
+	Cid cid.Cid
+	// RetrievalID is the retrieval of the entries.
+	RetrievalID RetrievalID
+	// JobID is the Job of the entries.
+	JobID JobID
+	// MinLevel is the minimum level of the entries.
+	MinLevel LogLevel
+	// From is the inclusive start of the time range of the entries.
+	From time.Time
+	// To is the exclusive end of the time range of the entries.
+	To time.Time
+	// Text is a case insensitive text contained in the message or
+	// field values of the entries.
+	Text string
+	// Limit is the maximum number of entries, keeping the most recent.
+	Limit int
+}
+
+// Matches returns true if the entry satisfies the query filters, except
+// Limit.
+func (q LogQuery) Matches(e LogEntry) bool {
+	if q.Cid.Defined() && !q.Cid.Equals(e.Cid) {
+		return false
+	}
+	if q.RetrievalID != EmptyRetrievalID && q.RetrievalID != e.RetrievalID {
+		return false
+	}
+	if q.JobID != EmptyJobID && q.JobID != e.Jid {
+		return false
+	}
+	if e.Level < q.MinLevel {
+		return false
+	}
+	if !q.From.IsZero() && e.Timestamp.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && !e.Timestamp.Before(q.To) {
+		return false
+	}
+	if q.Text == "" {
+		return true
+	}
+	text := strings.ToLower(q.Text)
+	if strings.Contains(strings.ToLower(e.Msg), text) {
+		return true
+	}
+	for _, v := range e.Fields {
+		if strings.Contains(strings.ToLower(v), text) {
+			return true
+		}
+	}
+	return false
 }
 
 // PaychDir specifies the direction of a payment channel.
@@ -50,6 +50,10 @@ type Config struct {
 	MaxParallel     int
 	RefreshInterval time.Duration
 	RefreshOnStart  bool
+	// IsLeader returns false if another instance sharing the datastore
+	// updates the index. In that case, the index is reloaded from the
+	// datastore instead of updated. If nil, the index is always updated.
+	IsLeader func() bool
 }
 
 // New returns a new ask index runner. It load a persisted ask index, and immediately starts building a new fresh one.
@@ -154,7 +158,7 @@ func (ai *Runner) Close() error {
 // start is a long running job that updates asks information in the market.
 func (ai *Runner) start(refreshOnStart bool, disable bool) {
 	defer close(ai.finished)
-	if refreshOnStart {
+	if refreshOnStart && ai.isLeader() {
 		if err := ai.update(); err != nil {
 			log.Errorf("updating miners asks: %s", err)
 		}
@@ -169,6 +173,12 @@ func (ai *Runner) start(refreshOnStart bool, disable bool) {
 				log.Infof("skipping update since disabled")
 				continue
 			}
+			if !ai.isLeader() {
+				if err := ai.reload(); err != nil {
+					log.Errorf("reloading ask index: %s", err)
+				}
+				continue
+			}
 			if err := ai.update(); err != nil {
 				log.Errorf("updating miners asks: %s", err)
 			}
@@ -212,6 +222,24 @@ func (ai *Runner) update() error {
 	return nil
 }
 
+// reload loads the index persisted by the leader instance.
+func (ai *Runner) reload() error {
+	idx, err := ai.store.Get()
+	if err != nil {
+		return fmt.Errorf("loading from store: %s", err)
+	}
+	ai.lock.Lock()
+	ai.index = idx
+	ai.orderedAsks = generateOrderedAsks(idx.Storage)
+	ai.lock.Unlock()
+	ai.signaler.Signal()
+	return nil
+}
+
+func (ai *Runner) isLeader() bool {
+	return ai.config.IsLeader == nil || ai.config.IsLeader()
+}
+
 // generateIndex returns a fresh index.
 func generateIndex(ctx context.Context, api *apistruct.FullNodeStruct, maxParallel int, askTimeout time.Duration) (ask.Index, []*ask.StorageAsk, error) {
 	addrs, err := api.StateListMiners(ctx, types.EmptyTSK)
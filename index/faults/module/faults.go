@@ -43,6 +43,7 @@ type Index struct {
 	clientBuilder lotus.ClientBuilder
 	store         *chainstore.Store
 	signaler      *signaler.Signaler
+	isLeader      func() bool
 
 	lock  sync.Mutex
 	index faults.IndexSnapshot
@@ -52,9 +53,22 @@ type Index struct {
 	finished chan struct{}
 }
 
+// Option configures the Index.
+type Option func(*Index) error
+
+// WithIsLeader sets a function which returns false if another instance
+// sharing the datastore updates the index. In that case, the index is
+// reloaded from the datastore instead of updated.
+func WithIsLeader(f func() bool) Option {
+	return func(s *Index) error {
+		s.isLeader = f
+		return nil
+	}
+}
+
 // New returns a new FaultIndex. It will load previous state from ds, and
 // immediately start getting in sync with new on-chain.
-func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, disable bool) (*Index, error) {
+func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, disable bool, opts ...Option) (*Index, error) {
 	cs := chainsync.New(clientBuilder)
 	store, err := chainstore.New(txndstr.Wrap(ds, "chainstore"), cs)
 	if err != nil {
@@ -66,6 +80,7 @@ func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, disable b
 		clientBuilder: clientBuilder,
 		store:         store,
 		signaler:      signaler.New(),
+		isLeader:      func() bool { return true },
 		index: faults.IndexSnapshot{
 			Miners: make(map[string]faults.Faults),
 		},
@@ -73,6 +88,12 @@ func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, disable b
 		cancel:   cancel,
 		finished: make(chan struct{}),
 	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, fmt.Errorf("applying option: %s", err)
+		}
+	}
 	if err := s.loadFromDS(); err != nil {
 		return nil, err
 	}
@@ -133,6 +154,12 @@ func (s *Index) start(disabled bool) {
 				log.Infof("skipping updating since it's disabled")
 				continue
 			}
+			if !s.isLeader() {
+				if err := s.reload(); err != nil {
+					log.Errorf("reloading faults index: %s", err)
+				}
+				continue
+			}
 			if err := s.updateIndex(); err != nil {
 				log.Errorf("updating faults history: %s", err)
 				continue
@@ -239,8 +266,20 @@ func (s *Index) updateIndex() error {
 	return nil
 }
 
-// loadFromDS loads persisted indexes to memory datastructures. No locks needed
-// since its only called from New().
+// reload loads the index persisted by the leader instance.
+func (s *Index) reload() error {
+	s.lock.Lock()
+	err := s.loadFromDS()
+	s.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	s.signaler.Signal()
+	return nil
+}
+
+// loadFromDS loads persisted indexes to memory datastructures. This method
+// must be guarded, unless called from New().
 func (s *Index) loadFromDS() error {
 	var index faults.IndexSnapshot
 	if _, err := s.store.GetLastCheckpoint(&index); err != nil {
@@ -42,6 +42,9 @@ func (mi *Index) startMetaWorker(disabled bool) {
 				log.Info("graceful shutdown of meta updater")
 				return
 			case <-time.After(metaRefreshInterval):
+				if !mi.isLeader() {
+					continue
+				}
 				log.Info("updating meta index...")
 				// ToDo: coud have smarter ways of electing which addrs to refresh, and then
 				// doing a merge. Will depend if this too slow, but might not be the case
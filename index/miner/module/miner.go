@@ -44,8 +44,11 @@ type Index struct {
 	lr            iplocation.LocationResolver
 	signaler      *signaler.Signaler
 
-	lock  sync.Mutex
-	index miner.IndexSnapshot
+	isLeader func() bool
+
+	lock             sync.Mutex
+	index            miner.IndexSnapshot
+	countryOverrides map[string]string
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -53,9 +56,22 @@ type Index struct {
 	closed bool
 }
 
+// Option configures the Index.
+type Option func(*Index) error
+
+// WithIsLeader sets a function which returns false if another instance
+// sharing the datastore updates the index. In that case, the index is
+// reloaded from the datastore instead of updated.
+func WithIsLeader(f func() bool) Option {
+	return func(mi *Index) error {
+		mi.isLeader = f
+		return nil
+	}
+}
+
 // New returns a new MinerIndex. It loads from ds any previous state and starts
 // immediately making the index up to date.
-func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, h P2PHost, lr iplocation.LocationResolver, disable bool) (*Index, error) {
+func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, h P2PHost, lr iplocation.LocationResolver, disable bool, opts ...Option) (*Index, error) {
 	cs := chainsync.New(clientBuilder)
 	store, err := chainstore.New(txndstr.Wrap(ds, "chainstore"), cs)
 	if err != nil {
@@ -70,10 +86,17 @@ func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, h P2PHost
 		signaler:      signaler.New(),
 		h:             h,
 		lr:            lr,
+		isLeader:      func() bool { return true },
 
 		ctx:    ctx,
 		cancel: cancel,
 	}
+	for _, o := range opts {
+		if err := o(mi); err != nil {
+			cancel()
+			return nil, fmt.Errorf("applying option: %s", err)
+		}
+	}
 	if err := mi.loadFromDS(); err != nil {
 		return nil, err
 	}
@@ -101,6 +124,7 @@ func (mi *Index) Get() miner.IndexSnapshot {
 	for addr, v := range mi.index.Meta.Info {
 		ii.Meta.Info[addr] = v
 	}
+	mi.applyCountryOverrides(ii.Meta)
 	for addr, v := range mi.index.OnChain.Miners {
 		ii.OnChain.Miners[addr] = v
 	}
@@ -143,8 +167,10 @@ func (mi *Index) startMinerWorker(disabled bool) {
 			log.Infof("miners index worker disabled")
 			return
 		}
-		if err := mi.updateOnChainIndex(); err != nil {
-			log.Errorf("initial updating miner index: %s", err)
+		if mi.isLeader() {
+			if err := mi.updateOnChainIndex(); err != nil {
+				log.Errorf("initial updating miner index: %s", err)
+			}
 		}
 		for {
 			select {
@@ -152,6 +178,12 @@ func (mi *Index) startMinerWorker(disabled bool) {
 				log.Info("graceful shutdown of background miner index")
 				return
 			case <-time.After(minersRefreshInterval):
+				if !mi.isLeader() {
+					if err := mi.reload(); err != nil {
+						log.Errorf("reloading miner index: %s", err)
+					}
+					continue
+				}
 				if err := mi.updateOnChainIndex(); err != nil {
 					log.Errorf("updating miner index: %s", err)
 				}
@@ -160,8 +192,20 @@ func (mi *Index) startMinerWorker(disabled bool) {
 	}()
 }
 
-// loadFromDS loads persisted indexes to memory datastructures. No locks needed
-// since its only called from New().
+// reload loads the index persisted by the leader instance.
+func (mi *Index) reload() error {
+	mi.lock.Lock()
+	err := mi.loadFromDS()
+	mi.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	mi.signaler.Signal()
+	return nil
+}
+
+// loadFromDS loads persisted indexes to memory datastructures. This method
+// must be guarded, unless called from New().
 func (mi *Index) loadFromDS() error {
 	mi.index = miner.IndexSnapshot{
 		Meta:    miner.MetaIndex{Info: make(map[string]miner.Meta)},
@@ -186,5 +230,9 @@ func (mi *Index) loadFromDS() error {
 	}
 	mi.index.OnChain = chainIndex
 
+	if err := mi.loadCountryOverrides(); err != nil {
+		return fmt.Errorf("loading country overrides: %s", err)
+	}
+
 	return nil
 }
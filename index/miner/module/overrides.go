@@ -0,0 +1,94 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/textileio/powergate/index/miner"
+)
+
+var (
+	dsKeyCountryOverrides = dsBase.ChildString("countryoverrides")
+)
+
+// SetCountryOverride pins the country of a miner, taking precedence over
+// the country resolved from its multiaddresses. This is useful for miners
+// whose IPs are wrongly geolocated, e.g: behind hosting providers.
+func (mi *Index) SetCountryOverride(addr string, country string) error {
+	if _, err := address.NewFromString(addr); err != nil {
+		return fmt.Errorf("invalid miner address: %s", err)
+	}
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if len(country) != 2 {
+		return fmt.Errorf("country should be an ISO 3166-1 alpha-2 code")
+	}
+	mi.lock.Lock()
+	defer mi.lock.Unlock()
+	if err := mi.ds.Put(dsKeyCountryOverrides.ChildString(addr), []byte(country)); err != nil {
+		return fmt.Errorf("persisting country override: %s", err)
+	}
+	mi.countryOverrides[addr] = country
+	mi.signaler.Signal()
+	return nil
+}
+
+// RemoveCountryOverride removes a country override of a miner, if exists.
+func (mi *Index) RemoveCountryOverride(addr string) error {
+	mi.lock.Lock()
+	defer mi.lock.Unlock()
+	if err := mi.ds.Delete(dsKeyCountryOverrides.ChildString(addr)); err != nil {
+		return fmt.Errorf("deleting country override: %s", err)
+	}
+	delete(mi.countryOverrides, addr)
+	mi.signaler.Signal()
+	return nil
+}
+
+// CountryOverrides returns the current country overrides keyed
+// by miner address.
+func (mi *Index) CountryOverrides() map[string]string {
+	mi.lock.Lock()
+	defer mi.lock.Unlock()
+	res := make(map[string]string, len(mi.countryOverrides))
+	for addr, country := range mi.countryOverrides {
+		res[addr] = country
+	}
+	return res
+}
+
+// applyCountryOverrides overrides the country of miners in the meta index
+// with the current country overrides. Overrides of miners that aren't in the
+// index are skipped. It should be called with the lock held.
+func (mi *Index) applyCountryOverrides(meta miner.MetaIndex) {
+	for addr, country := range mi.countryOverrides {
+		m, ok := meta.Info[addr]
+		if !ok {
+			continue
+		}
+		m.Location.Country = country
+		meta.Info[addr] = m
+	}
+}
+
+// loadCountryOverrides loads persisted country overrides. No locks needed
+// since its only called from New().
+func (mi *Index) loadCountryOverrides() error {
+	mi.countryOverrides = make(map[string]string)
+	q := query.Query{Prefix: dsKeyCountryOverrides.String()}
+	res, err := mi.ds.Query(q)
+	if err != nil {
+		return fmt.Errorf("querying country overrides: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iterating country overrides: %s", r.Error)
+		}
+		addr := datastore.RawKey(r.Key).Name()
+		mi.countryOverrides[addr] = string(r.Value)
+	}
+	return nil
+}
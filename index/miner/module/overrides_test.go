@@ -0,0 +1,45 @@
+package module
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/index/miner"
+	"github.com/textileio/powergate/tests"
+)
+
+func TestCountryOverrides(t *testing.T) {
+	ds := tests.NewTxMapDatastore()
+	mi, err := New(ds, nil, &p2pHostMock{}, &lrMock{}, true)
+	require.NoError(t, err)
+	mi.lock.Lock()
+	mi.index.Meta.Info["t01000"] = miner.Meta{Location: miner.Location{Country: "US"}}
+	mi.lock.Unlock()
+
+	err = mi.SetCountryOverride("invalid", "US")
+	require.Error(t, err)
+	err = mi.SetCountryOverride("t01000", "USA")
+	require.Error(t, err)
+
+	err = mi.SetCountryOverride("t01000", "uy")
+	require.NoError(t, err)
+	err = mi.SetCountryOverride("t01001", "AR")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"t01000": "UY", "t01001": "AR"}, mi.CountryOverrides())
+	require.Equal(t, "UY", mi.Get().Meta.Info["t01000"].Location.Country)
+
+	// Overrides of miners unknown to the index are skipped.
+	_, ok := mi.Get().Meta.Info["t01001"]
+	require.False(t, ok)
+
+	err = mi.RemoveCountryOverride("t01001")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"t01000": "UY"}, mi.CountryOverrides())
+	require.NoError(t, mi.Close())
+
+	// Overrides are loaded from the datastore.
+	mi, err = New(ds, nil, &p2pHostMock{}, &lrMock{}, true)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, mi.Close()) }()
+	require.Equal(t, map[string]string{"t01000": "UY"}, mi.CountryOverrides())
+}
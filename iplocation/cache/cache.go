@@ -0,0 +1,205 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logger "github.com/ipfs/go-log/v2"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/textileio/powergate/iplocation"
+)
+
+var (
+	log = logger.Logger("iplocation-cache")
+)
+
+// Cache is a LocationResolver that persists resolutions of another
+// LocationResolver keyed by multiaddr. Successful resolutions and definitive
+// misses (ErrCantResolve) are considered valid for a configured TTL, while
+// transient failures aren't cached. Expired entries are pruned periodically.
+type Cache struct {
+	ds  datastore.Datastore
+	lr  iplocation.LocationResolver
+	ttl time.Duration
+	now func() time.Time
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+var _ iplocation.LocationResolver = (*Cache)(nil)
+
+type entry struct {
+	Resolved  bool
+	Location  iplocation.Location
+	CreatedAt int64
+}
+
+// New returns a new Cache of lr resolutions which are valid for ttl.
+func New(ds datastore.Datastore, lr iplocation.LocationResolver, ttl time.Duration) *Cache {
+	return newCache(ds, lr, ttl, time.Now)
+}
+
+func newCache(ds datastore.Datastore, lr iplocation.LocationResolver, ttl time.Duration, now func() time.Time) *Cache {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Cache{
+		ds:       ds,
+		lr:       lr,
+		ttl:      ttl,
+		now:      now,
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	c.pruneExpired()
+	go c.pruneDaemon()
+	return c
+}
+
+// Close closes the cache.
+func (c *Cache) Close() error {
+	c.cancel()
+	<-c.finished
+	return nil
+}
+
+// Resolve returns Location information from multiaddrs. Multiaddrs with a
+// valid cached resolution are served from the cache, and only the rest are
+// resolved with the underlying resolver. If none can be resolved and the
+// underlying resolver failed for transient reasons, that error is returned.
+func (c *Cache) Resolve(mas []multiaddr.Multiaddr) (iplocation.Location, error) {
+	var misses []multiaddr.Multiaddr
+	for _, ma := range mas {
+		e, ok, err := c.get(ma)
+		if err != nil {
+			log.Errorf("getting cached location for %s: %s", ma, err)
+		}
+		if !ok {
+			misses = append(misses, ma)
+			continue
+		}
+		if e.Resolved {
+			return e.Location, nil
+		}
+	}
+
+	var transientErr error
+	for _, ma := range misses {
+		l, err := c.lr.Resolve([]multiaddr.Multiaddr{ma})
+		if err != nil && err != iplocation.ErrCantResolve {
+			log.Debugf("resolving location for %s: %s", ma, err)
+			transientErr = err
+			continue
+		}
+		e := entry{
+			Resolved:  err == nil,
+			Location:  l,
+			CreatedAt: c.now().Unix(),
+		}
+		if err := c.put(ma, e); err != nil {
+			log.Errorf("caching location for %s: %s", ma, err)
+		}
+		if e.Resolved {
+			return l, nil
+		}
+	}
+	if transientErr != nil {
+		return iplocation.Location{}, transientErr
+	}
+	return iplocation.Location{}, iplocation.ErrCantResolve
+}
+
+// get returns the cached entry for ma, if exists and isn't expired.
+func (c *Cache) get(ma multiaddr.Multiaddr) (entry, bool, error) {
+	buf, err := c.ds.Get(makeKey(ma))
+	if err == datastore.ErrNotFound {
+		return entry{}, false, nil
+	}
+	if err != nil {
+		return entry{}, false, fmt.Errorf("getting from datastore: %s", err)
+	}
+	var e entry
+	if err := json.Unmarshal(buf, &e); err != nil {
+		return entry{}, false, fmt.Errorf("unmarshaling cache entry: %s", err)
+	}
+	if c.expired(e) {
+		return entry{}, false, nil
+	}
+	return e, true, nil
+}
+
+// pruneDaemon prunes expired entries once every ttl.
+func (c *Cache) pruneDaemon() {
+	defer close(c.finished)
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(c.ttl):
+			c.pruneExpired()
+		}
+	}
+}
+
+func (c *Cache) pruneExpired() {
+	n, err := c.prune()
+	if err != nil {
+		log.Errorf("pruning expired entries: %s", err)
+		return
+	}
+	if n > 0 {
+		log.Infof("pruned %d expired entries", n)
+	}
+}
+
+// prune deletes expired entries, and returns how many were deleted.
+func (c *Cache) prune() (int, error) {
+	res, err := c.ds.Query(query.Query{})
+	if err != nil {
+		return 0, fmt.Errorf("querying entries: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var expired []datastore.Key
+	for r := range res.Next() {
+		if r.Error != nil {
+			return 0, fmt.Errorf("iterating entries: %s", r.Error)
+		}
+		var e entry
+		if err := json.Unmarshal(r.Value, &e); err != nil {
+			log.Warnf("unmarshaling cache entry %s, deleting: %s", r.Key, err)
+		} else if !c.expired(e) {
+			continue
+		}
+		expired = append(expired, datastore.NewKey(r.Key))
+	}
+	for _, k := range expired {
+		if err := c.ds.Delete(k); err != nil {
+			return 0, fmt.Errorf("deleting entry %s: %s", k, err)
+		}
+	}
+	return len(expired), nil
+}
+
+func (c *Cache) expired(e entry) bool {
+	return c.now().Sub(time.Unix(e.CreatedAt, 0)) > c.ttl
+}
+
+func (c *Cache) put(ma multiaddr.Multiaddr, e entry) error {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshaling cache entry: %s", err)
+	}
+	if err := c.ds.Put(makeKey(ma), buf); err != nil {
+		return fmt.Errorf("putting in datastore: %s", err)
+	}
+	return nil
+}
+
+func makeKey(ma multiaddr.Multiaddr) datastore.Key {
+	return datastore.NewKey(ma.String())
+}
@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/iplocation"
+	"github.com/textileio/powergate/tests"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+	ma1, err := multiaddr.NewMultiaddr("/ip4/1.1.1.1/tcp/1234")
+	require.NoError(t, err)
+	ma2, err := multiaddr.NewMultiaddr("/ip4/2.2.2.2/tcp/1234")
+	require.NoError(t, err)
+	ma3, err := multiaddr.NewMultiaddr("/ip4/3.3.3.3/tcp/1234")
+	require.NoError(t, err)
+
+	r := &countingResolver{locs: map[string]iplocation.Location{
+		ma2.String(): {Country: "AR"},
+	}}
+	now := time.Now()
+	c := newCache(tests.NewTxMapDatastore(), r, time.Hour, func() time.Time { return now })
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	// First resolution goes to the underlying resolver for both addrs.
+	l, err := c.Resolve([]multiaddr.Multiaddr{ma1, ma2})
+	require.NoError(t, err)
+	require.Equal(t, "AR", l.Country)
+	require.Equal(t, 2, r.calls)
+
+	// Both positive and negative resolutions are cached.
+	l, err = c.Resolve([]multiaddr.Multiaddr{ma1, ma2})
+	require.NoError(t, err)
+	require.Equal(t, "AR", l.Country)
+	require.Equal(t, 2, r.calls)
+	_, err = c.Resolve([]multiaddr.Multiaddr{ma1})
+	require.Equal(t, iplocation.ErrCantResolve, err)
+	require.Equal(t, 2, r.calls)
+
+	// Expired entries are resolved again.
+	r.locs[ma2.String()] = iplocation.Location{Country: "UY"}
+	now = now.Add(time.Hour + time.Second)
+	l, err = c.Resolve([]multiaddr.Multiaddr{ma2})
+	require.NoError(t, err)
+	require.Equal(t, "UY", l.Country)
+	require.Equal(t, 3, r.calls)
+
+	// Transient failures aren't cached.
+	r.err = fmt.Errorf("db unavailable")
+	_, err = c.Resolve([]multiaddr.Multiaddr{ma3})
+	require.Error(t, err)
+	require.NotEqual(t, iplocation.ErrCantResolve, err)
+	r.err = nil
+	r.locs[ma3.String()] = iplocation.Location{Country: "BR"}
+	l, err = c.Resolve([]multiaddr.Multiaddr{ma3})
+	require.NoError(t, err)
+	require.Equal(t, "BR", l.Country)
+	require.Equal(t, 5, r.calls)
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+	ma1, err := multiaddr.NewMultiaddr("/ip4/1.1.1.1/tcp/1234")
+	require.NoError(t, err)
+	ma2, err := multiaddr.NewMultiaddr("/ip4/2.2.2.2/tcp/1234")
+	require.NoError(t, err)
+
+	ds := tests.NewTxMapDatastore()
+	now := time.Now()
+	c := newCache(ds, &countingResolver{}, time.Hour, func() time.Time { return now })
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+	require.NoError(t, c.put(ma1, entry{CreatedAt: now.Add(-2 * time.Hour).Unix()}))
+	require.NoError(t, c.put(ma2, entry{Resolved: true, CreatedAt: now.Unix()}))
+
+	n, err := c.prune()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	_, ok, err := c.get(ma1)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, ok, err = c.get(ma2)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+type countingResolver struct {
+	calls int
+	locs  map[string]iplocation.Location
+	err   error
+}
+
+func (cr *countingResolver) Resolve(mas []multiaddr.Multiaddr) (iplocation.Location, error) {
+	cr.calls++
+	if cr.err != nil {
+		return iplocation.Location{}, cr.err
+	}
+	for _, ma := range mas {
+		if l, ok := cr.locs[ma.String()]; ok {
+			return l, nil
+		}
+	}
+	return iplocation.Location{}, iplocation.ErrCantResolve
+}
@@ -0,0 +1,44 @@
+package iplocation
+
+import (
+	"fmt"
+
+	"github.com/multiformats/go-multiaddr"
+)
+
+// Chain is a LocationResolver that delegates resolution to a list
+// of resolvers. Resolvers are tried in order, and the first successful
+// resolution is returned.
+type Chain struct {
+	resolvers []LocationResolver
+}
+
+var _ LocationResolver = (*Chain)(nil)
+
+// NewChain returns a new Chain of the provided resolvers.
+func NewChain(resolvers ...LocationResolver) *Chain {
+	return &Chain{resolvers: resolvers}
+}
+
+// Resolve returns Location information from multiaddrs using the first
+// resolver in the chain that can resolve them. If no resolver can,
+// it returns ErrCantResolve, unless some resolver failed for transient
+// reasons, in which case that error is returned.
+func (c *Chain) Resolve(mas []multiaddr.Multiaddr) (Location, error) {
+	var transientErr error
+	for _, r := range c.resolvers {
+		l, err := r.Resolve(mas)
+		if err != nil {
+			if err != ErrCantResolve {
+				log.Debugf("resolving location: %s", err)
+				transientErr = err
+			}
+			continue
+		}
+		return l, nil
+	}
+	if transientErr != nil {
+		return Location{}, fmt.Errorf("resolving location: %s", transientErr)
+	}
+	return Location{}, ErrCantResolve
+}
@@ -0,0 +1,45 @@
+package iplocation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+	failing := resolverFunc(func([]multiaddr.Multiaddr) (Location, error) {
+		return Location{}, fmt.Errorf("db unavailable")
+	})
+	unresolved := resolverFunc(func([]multiaddr.Multiaddr) (Location, error) {
+		return Location{}, ErrCantResolve
+	})
+	ar := resolverFunc(func([]multiaddr.Multiaddr) (Location, error) {
+		return Location{Country: "AR"}, nil
+	})
+	uy := resolverFunc(func([]multiaddr.Multiaddr) (Location, error) {
+		return Location{Country: "UY"}, nil
+	})
+
+	l, err := NewChain(failing, unresolved, ar, uy).Resolve(nil)
+	require.NoError(t, err)
+	require.Equal(t, "AR", l.Country)
+
+	_, err = NewChain(failing, unresolved).Resolve(nil)
+	require.Error(t, err)
+	require.NotEqual(t, ErrCantResolve, err)
+
+	_, err = NewChain(unresolved, unresolved).Resolve(nil)
+	require.Equal(t, ErrCantResolve, err)
+
+	_, err = NewChain().Resolve(nil)
+	require.Equal(t, ErrCantResolve, err)
+}
+
+type resolverFunc func([]multiaddr.Multiaddr) (Location, error)
+
+func (rf resolverFunc) Resolve(mas []multiaddr.Multiaddr) (Location, error) {
+	return rf(mas)
+}
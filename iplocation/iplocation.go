@@ -3,11 +3,16 @@ package iplocation
 import (
 	"errors"
 
+	logger "github.com/ipfs/go-log/v2"
 	"github.com/multiformats/go-multiaddr"
 )
 
 var (
+	log = logger.Logger("iplocation")
+
 	// ErrCantResolve indicates that geoinformation couldn't be resolved for a host.
+	// Resolvers return other errors for transient failures, such as unavailable
+	// DNS resolution or databases, which shouldn't be considered definitive.
 	ErrCantResolve = errors.New("can't resolve multiaddr location information")
 )
 
@@ -31,12 +31,18 @@ func New(db string) (*MaxMind, error) {
 	return &MaxMind{db: r}, nil
 }
 
-// Resolve returns Location information from multiaddrs.
+// Resolve returns Location information from multiaddrs. If none of them
+// can be resolved, it returns ErrCantResolve, unless resolving some of them
+// failed for transient reasons, in which case that error is returned.
 func (mm *MaxMind) Resolve(mas []multiaddr.Multiaddr) (iplocation.Location, error) {
+	var transientErr error
 	for _, ma := range mas {
 		ipport, err := util.TCPAddrFromMultiAddr(ma)
 		if err != nil {
 			log.Debugf("transforming %s to tcp addr: %s", ma, err)
+			if _, derr := ma.ValueForProtocol(multiaddr.P_DNS4); derr == nil {
+				transientErr = fmt.Errorf("transforming %s to tcp addr: %s", ma, err)
+			}
 			continue
 		}
 		strIP, _, err := net.SplitHostPort(ipport)
@@ -48,6 +54,7 @@ func (mm *MaxMind) Resolve(mas []multiaddr.Multiaddr) (iplocation.Location, erro
 		city, err := mm.db.City(ip)
 		if err != nil {
 			log.Debugf("querying maxmind db for %s: %s", ipport, err)
+			transientErr = fmt.Errorf("querying maxmind db for %s: %s", ipport, err)
 			continue
 		}
 		if city.Country.IsoCode != "" || (city.Location.Latitude != 0 && city.Location.Longitude != 0) {
@@ -59,6 +66,9 @@ func (mm *MaxMind) Resolve(mas []multiaddr.Multiaddr) (iplocation.Location, erro
 		}
 		log.Debugf("no info for addr %s", ip)
 	}
+	if transientErr != nil {
+		return iplocation.Location{}, transientErr
+	}
 	return iplocation.Location{}, iplocation.ErrCantResolve
 }
 
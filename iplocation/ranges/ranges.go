@@ -0,0 +1,168 @@
+package ranges
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	logger "github.com/ipfs/go-log/v2"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/textileio/powergate/iplocation"
+	"github.com/textileio/powergate/util"
+)
+
+var (
+	log = logger.Logger("iplocation-ranges")
+)
+
+// Ranges is an offline iplocation resolver backed by a CSV file
+// of IP ranges. Each record has the form:
+//    start_ip,end_ip,country[,latitude,longitude]
+// Empty lines and lines starting with # are ignored. Both IPv4
+// and IPv6 ranges are supported.
+type Ranges struct {
+	entries []entry
+}
+
+type entry struct {
+	start net.IP
+	end   net.IP
+	loc   iplocation.Location
+}
+
+// New returns a new Ranges resolver loading ranges from the CSV
+// file at path.
+func New(path string) (*Ranges, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening ranges file: %s", err)
+	}
+	defer func() { _ = f.Close() }()
+	return NewFromReader(f)
+}
+
+// NewFromReader returns a new Ranges resolver loading ranges in
+// CSV format from r.
+func NewFromReader(r io.Reader) (*Ranges, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var entries []entry
+	for i := 1; ; i++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading csv record: %s", err)
+		}
+		e, err := parseRecord(rec)
+		if err != nil {
+			return nil, fmt.Errorf("parsing record %d: %s", i, err)
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].start, entries[j].start) < 0
+	})
+	return &Ranges{entries: entries}, nil
+}
+
+// Resolve returns Location information from multiaddrs. If none of them
+// can be resolved, it returns ErrCantResolve, unless resolving some of them
+// failed for transient reasons, in which case that error is returned.
+func (r *Ranges) Resolve(mas []multiaddr.Multiaddr) (iplocation.Location, error) {
+	var transientErr error
+	for _, ma := range mas {
+		ipport, err := util.TCPAddrFromMultiAddr(ma)
+		if err != nil {
+			log.Debugf("transforming %s to tcp addr: %s", ma, err)
+			if _, derr := ma.ValueForProtocol(multiaddr.P_DNS4); derr == nil {
+				transientErr = fmt.Errorf("transforming %s to tcp addr: %s", ma, err)
+			}
+			continue
+		}
+		strIP, _, err := net.SplitHostPort(ipport)
+		if err != nil {
+			log.Debugf("parsing ip/port from %s: %s", ipport, err)
+			continue
+		}
+		ip := net.ParseIP(strIP)
+		if ip == nil {
+			log.Debugf("parsing ip %s", strIP)
+			continue
+		}
+		if loc, ok := r.lookup(ip.To16()); ok {
+			return loc, nil
+		}
+		log.Debugf("no range for addr %s", ip)
+	}
+	if transientErr != nil {
+		return iplocation.Location{}, transientErr
+	}
+	return iplocation.Location{}, iplocation.ErrCantResolve
+}
+
+// lookup returns the location of the range containing ip, if any.
+// Ranges are expected to be non-overlapping; if they overlap, the
+// range with the greatest start address that contains ip wins.
+func (r *Ranges) lookup(ip net.IP) (iplocation.Location, bool) {
+	i := sort.Search(len(r.entries), func(i int) bool {
+		return bytes.Compare(r.entries[i].start, ip) > 0
+	})
+	if i == 0 {
+		return iplocation.Location{}, false
+	}
+	e := r.entries[i-1]
+	if bytes.Compare(ip, e.end) > 0 {
+		return iplocation.Location{}, false
+	}
+	return e.loc, true
+}
+
+func parseRecord(rec []string) (entry, error) {
+	if len(rec) != 3 && len(rec) != 5 {
+		return entry{}, fmt.Errorf("expected 3 or 5 fields, got %d", len(rec))
+	}
+	start := net.ParseIP(strings.TrimSpace(rec[0]))
+	if start == nil {
+		return entry{}, fmt.Errorf("invalid start ip %s", rec[0])
+	}
+	end := net.ParseIP(strings.TrimSpace(rec[1]))
+	if end == nil {
+		return entry{}, fmt.Errorf("invalid end ip %s", rec[1])
+	}
+	if (start.To4() == nil) != (end.To4() == nil) {
+		return entry{}, fmt.Errorf("start and end ips should be of the same family")
+	}
+	start, end = start.To16(), end.To16()
+	if bytes.Compare(start, end) > 0 {
+		return entry{}, fmt.Errorf("start ip %s is greater than end ip %s", start, end)
+	}
+	e := entry{
+		start: start,
+		end:   end,
+		loc:   iplocation.Location{Country: strings.ToUpper(strings.TrimSpace(rec[2]))},
+	}
+	if len(rec) == 5 {
+		lat, err := strconv.ParseFloat(strings.TrimSpace(rec[3]), 64)
+		if err != nil {
+			return entry{}, fmt.Errorf("parsing latitude: %s", err)
+		}
+		lon, err := strconv.ParseFloat(strings.TrimSpace(rec[4]), 64)
+		if err != nil {
+			return entry{}, fmt.Errorf("parsing longitude: %s", err)
+		}
+		e.loc.Latitude = lat
+		e.loc.Longitude = lon
+	}
+	return e, nil
+}
@@ -0,0 +1,69 @@
+package ranges
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/iplocation"
+)
+
+const testRanges = `
+# start,end,country,latitude,longitude
+1.0.0.0,1.0.0.255,AU
+8.8.4.0,8.8.8.255,us,37.751,-97.822
+2001:4860::,2001:4860:ffff:ffff:ffff:ffff:ffff:ffff,US
+`
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	r, err := NewFromReader(strings.NewReader(testRanges))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		addr string
+		loc  iplocation.Location
+		err  error
+	}{
+		{name: "Range start", addr: "/ip4/1.0.0.0/tcp/1234", loc: iplocation.Location{Country: "AU"}},
+		{name: "Range end", addr: "/ip4/1.0.0.255/tcp/1234", loc: iplocation.Location{Country: "AU"}},
+		{name: "With coordinates", addr: "/ip4/8.8.8.8/tcp/1234", loc: iplocation.Location{Country: "US", Latitude: 37.751, Longitude: -97.822}},
+		{name: "IPv6", addr: "/ip6/2001:4860:4860::8888/tcp/1234", loc: iplocation.Location{Country: "US"}},
+		{name: "Gap between ranges", addr: "/ip4/1.0.1.0/tcp/1234", err: iplocation.ErrCantResolve},
+		{name: "Before first range", addr: "/ip4/0.0.0.1/tcp/1234", err: iplocation.ErrCantResolve},
+		{name: "After last range", addr: "/ip4/9.9.9.9/tcp/1234", err: iplocation.ErrCantResolve},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ma, err := multiaddr.NewMultiaddr(tt.addr)
+			require.NoError(t, err)
+			loc, err := r.Resolve([]multiaddr.Multiaddr{ma})
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.loc, loc)
+		})
+	}
+}
+
+func TestInvalidRecords(t *testing.T) {
+	t.Parallel()
+	invalid := []string{
+		"1.0.0.0,1.0.0.255",
+		"1.0.0.0,1.0.0.255,AU,1",
+		"1.0.0.x,1.0.0.255,AU",
+		"1.0.0.255,1.0.0.0,AU",
+		"1.0.0.0,2001:4860::,AU",
+		"1.0.0.0,1.0.0.255,AU,lat,lon",
+	}
+	for _, rec := range invalid {
+		_, err := NewFromReader(strings.NewReader(rec))
+		require.Error(t, err, rec)
+	}
+}
@@ -0,0 +1,109 @@
+package leader
+
+import (
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	dsextensions "github.com/textileio/go-datastore-extensions"
+	txndstr "github.com/textileio/powergate/txndstransform"
+)
+
+// fencedWriteAttempts is the number of attempts of fenced writes which
+// fail to commit, usually because of conflicts with concurrent writes.
+const fencedWriteAttempts = 5
+
+// Fence returns a datastore which fails writes with ErrFenced unless the
+// instance is the leader, and the lease in the datastore has the token of
+// its current term. The lease is only read by fenced transactions, so they
+// don't conflict with each other. Since the Elector ends a term a renew
+// interval before its lease expires, a leader stops committing before
+// another instance can start a new term. ds should be the datastore used
+// by the Elector, not a namespace of it.
+func (e *Elector) Fence(ds datastore.TxnDatastore) datastore.TxnDatastore {
+	return &fenced{TxnDatastore: ds, e: e}
+}
+
+type fenced struct {
+	datastore.TxnDatastore
+	e *Elector
+}
+
+var _ datastore.TxnDatastore = (*fenced)(nil)
+var _ dsextensions.ExtendedDatastore = (*fenced)(nil)
+
+// Put stores a value in a fenced transaction.
+func (f *fenced) Put(key datastore.Key, value []byte) error {
+	return f.write(func(txn datastore.Txn) error {
+		return txn.Put(key, value)
+	})
+}
+
+// Delete deletes a value in a fenced transaction.
+func (f *fenced) Delete(key datastore.Key) error {
+	return f.write(func(txn datastore.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+// QueryExtended runs an extended query in the underlying datastore.
+// Reads aren't fenced.
+func (f *fenced) QueryExtended(q dsextensions.QueryExt) (query.Results, error) {
+	return txndstr.QueryExtended(f.TxnDatastore, q)
+}
+
+// NewTransaction returns a transaction which is fenced on commit.
+func (f *fenced) NewTransaction(readOnly bool) (datastore.Txn, error) {
+	txn, err := f.TxnDatastore.NewTransaction(readOnly)
+	if err != nil {
+		return nil, err
+	}
+	if readOnly {
+		return txn, nil
+	}
+	return &fencedTxn{Txn: txn, e: f.e}, nil
+}
+
+// write runs op in a fenced transaction. The transaction is retried if
+// committing fails for reasons other than fencing.
+func (f *fenced) write(op func(datastore.Txn) error) error {
+	var err error
+	for i := 0; i < fencedWriteAttempts; i++ {
+		var txn datastore.Txn
+		txn, err = f.NewTransaction(false)
+		if err != nil {
+			return err
+		}
+		if err := op(txn); err != nil {
+			txn.Discard()
+			return err
+		}
+		err = txn.Commit()
+		txn.Discard()
+		if err == nil || err == ErrFenced {
+			return err
+		}
+		log.Debugf("retrying fenced write: %s", err)
+	}
+	return err
+}
+
+type fencedTxn struct {
+	datastore.Txn
+	e *Elector
+}
+
+// Commit commits the transaction if the instance holds the lease of the
+// current term.
+func (t *fencedTxn) Commit() error {
+	term := t.e.Term()
+	if term == nil {
+		return ErrFenced
+	}
+	l, ok, err := getLease(t.Txn)
+	if err != nil {
+		return err
+	}
+	if !ok || l.Holder != t.e.id || l.Token != term.Token {
+		return ErrFenced
+	}
+	return t.Txn.Commit()
+}
@@ -0,0 +1,327 @@
+// Package leader elects a single leader among Powergate instances sharing
+// a datastore. Leadership is a lease saved in the datastore which the
+// leader renews periodically. Every new term gets a bigger fencing token,
+// so writes of a leader which lost its lease can be rejected.
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var (
+	log = logging.Logger("leader")
+
+	// ErrNoLeader is returned when no instance holds a valid lease.
+	ErrNoLeader = errors.New("no leader elected")
+
+	// ErrFenced is returned when writing to a fenced datastore without
+	// holding the lease of the current term.
+	ErrFenced = errors.New("not the leader of the current term")
+
+	// ErrClosed is returned when campaigning with a closed Elector.
+	ErrClosed = errors.New("elector closed")
+
+	keyLease = datastore.NewKey("/leader/lease")
+)
+
+// Lease is the leadership lease saved in the datastore.
+type Lease struct {
+	// Holder is the ID of the instance holding the lease.
+	Holder string
+	// Addr is the gRPC address where the holder serves requests.
+	Addr string
+	// Token is the fencing token of the term, which increases with
+	// every new term.
+	Token uint64
+	// Expires is the unix time in nanoseconds when the lease expires if
+	// it isn't renewed.
+	Expires int64
+}
+
+// Term is a period of time in which an instance is the leader.
+type Term struct {
+	// Token is the fencing token of the term.
+	Token uint64
+
+	deadline time.Time
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// Done returns a channel which is closed when the term ends.
+func (t *Term) Done() <-chan struct{} {
+	return t.ctx.Done()
+}
+
+// Context returns a context which is canceled when the term ends.
+func (t *Term) Context() context.Context {
+	return t.ctx
+}
+
+// Elector campaigns to hold the leadership lease for an instance.
+type Elector struct {
+	ds   datastore.TxnDatastore
+	id   string
+	addr string
+	conf Config
+
+	lock    sync.Mutex
+	term    *Term
+	elected chan struct{}
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+	clsLock  sync.Mutex
+	closed   bool
+}
+
+// New returns a new Elector for the instance id, which serves gRPC requests
+// at addr. It immediately starts campaigning for the lease in ds. Instance
+// ids should be unique among instances sharing ds.
+func New(ds datastore.TxnDatastore, id, addr string, opts ...Option) (*Elector, error) {
+	if id == "" {
+		return nil, fmt.Errorf("instance id is empty")
+	}
+	conf := defaultConfig
+	for _, o := range opts {
+		if err := o(&conf); err != nil {
+			return nil, fmt.Errorf("applying option: %s", err)
+		}
+	}
+	if conf.RenewInterval >= conf.TTL {
+		return nil, fmt.Errorf("renew interval %s should be smaller than ttl %s", conf.RenewInterval, conf.TTL)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &Elector{
+		ds:       ds,
+		id:       id,
+		addr:     addr,
+		conf:     conf,
+		elected:  make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	go e.run()
+	return e, nil
+}
+
+// ID returns the instance id.
+func (e *Elector) ID() string {
+	return e.id
+}
+
+// IsLeader returns true if the instance holds the lease.
+func (e *Elector) IsLeader() bool {
+	return e.Term() != nil
+}
+
+// Term returns the current term, or nil if the instance isn't the leader.
+func (e *Elector) Term() *Term {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.term != nil && time.Now().After(e.term.deadline) {
+		e.endTerm("lease renewal deadline exceeded")
+	}
+	return e.term
+}
+
+// Campaign blocks until the instance is the leader, and returns the
+// current term.
+func (e *Elector) Campaign(ctx context.Context) (*Term, error) {
+	for {
+		e.lock.Lock()
+		t, elected := e.term, e.elected
+		e.lock.Unlock()
+		if t != nil && t.ctx.Err() == nil {
+			return t, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-e.ctx.Done():
+			return nil, ErrClosed
+		case <-elected:
+		}
+	}
+}
+
+// Leader returns the current valid lease, or ErrNoLeader if no instance
+// holds one.
+func (e *Elector) Leader() (Lease, error) {
+	l, ok, err := getLease(e.ds)
+	if err != nil {
+		return Lease{}, err
+	}
+	if !ok || time.Now().UnixNano() > l.Expires {
+		return Lease{}, ErrNoLeader
+	}
+	return l, nil
+}
+
+// Close stops campaigning, and releases the lease if the instance is the
+// leader, so other instances can take over without waiting for the lease
+// to expire.
+func (e *Elector) Close() error {
+	log.Info("closing...")
+	defer log.Info("closed")
+	e.clsLock.Lock()
+	defer e.clsLock.Unlock()
+	if e.closed {
+		return nil
+	}
+	e.cancel()
+	<-e.finished
+	e.closed = true
+	return e.resign()
+}
+
+func (e *Elector) run() {
+	defer close(e.finished)
+	for {
+		wait := e.conf.RenewInterval
+		if err := e.campaign(); err != nil {
+			// Lease updates can conflict with fenced writes, so
+			// retry sooner to renew the lease before it expires.
+			log.Errorf("campaigning for leadership: %s", err)
+			wait = e.conf.RenewInterval / 10
+		}
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// campaign acquires or renews the lease. A new term starts if the
+// lease is expired, and the current term ends if another instance holds
+// the lease.
+func (e *Elector) campaign() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	start := time.Now()
+	txn, err := e.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	curr, ok, err := getLease(txn)
+	if err != nil {
+		return err
+	}
+	renewing := ok && e.term != nil && curr.Holder == e.id && curr.Token == e.term.Token
+	if ok && !renewing && start.UnixNano() <= curr.Expires {
+		if e.term != nil {
+			e.endTerm(fmt.Sprintf("lease taken by %s", curr.Holder))
+		}
+		return nil
+	}
+	next := Lease{
+		Holder:  e.id,
+		Addr:    e.addr,
+		Token:   curr.Token,
+		Expires: start.Add(e.conf.TTL).UnixNano(),
+	}
+	if !renewing {
+		next.Token++
+	}
+	buf, err := json.Marshal(next)
+	if err != nil {
+		return fmt.Errorf("marshaling lease: %s", err)
+	}
+	if err := txn.Put(keyLease, buf); err != nil {
+		return fmt.Errorf("putting lease: %s", err)
+	}
+	if err := txn.Commit(); err != nil {
+		if e.term != nil && time.Now().After(e.term.deadline) {
+			e.endTerm("lease renewal deadline exceeded")
+		}
+		return fmt.Errorf("committing lease: %s", err)
+	}
+
+	// The lease is considered lost a renew interval before it expires,
+	// as a margin for clock drift between instances.
+	deadline := start.Add(e.conf.TTL - e.conf.RenewInterval)
+	if renewing {
+		e.term.deadline = deadline
+		return nil
+	}
+	if e.term != nil {
+		e.endTerm("lease expired")
+	}
+	ctx, cancel := context.WithCancel(e.ctx)
+	e.term = &Term{Token: next.Token, deadline: deadline, ctx: ctx, cancel: cancel}
+	close(e.elected)
+	e.elected = make(chan struct{})
+	log.Infof("elected as leader with token %d", next.Token)
+	return nil
+}
+
+// endTerm ends the current term. This method must be guarded.
+func (e *Elector) endTerm(reason string) {
+	log.Warnf("leadership of term %d lost: %s", e.term.Token, reason)
+	e.term.cancel()
+	e.term = nil
+}
+
+// resign deletes the lease if the instance still holds it.
+func (e *Elector) resign() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.term == nil {
+		return nil
+	}
+	token := e.term.Token
+	e.endTerm("resigned")
+	txn, err := e.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	curr, ok, err := getLease(txn)
+	if err != nil {
+		return err
+	}
+	if !ok || curr.Holder != e.id || curr.Token != token {
+		return nil
+	}
+	// Keep the token so the next term gets a bigger one.
+	curr.Holder, curr.Addr, curr.Expires = "", "", 0
+	buf, err := json.Marshal(curr)
+	if err != nil {
+		return fmt.Errorf("marshaling lease: %s", err)
+	}
+	if err := txn.Put(keyLease, buf); err != nil {
+		return fmt.Errorf("putting lease: %s", err)
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing lease: %s", err)
+	}
+	return nil
+}
+
+func getLease(r datastore.Read) (Lease, bool, error) {
+	buf, err := r.Get(keyLease)
+	if err == datastore.ErrNotFound {
+		return Lease{}, false, nil
+	}
+	if err != nil {
+		return Lease{}, false, fmt.Errorf("getting lease: %s", err)
+	}
+	var l Lease
+	if err := json.Unmarshal(buf, &l); err != nil {
+		return Lease{}, false, fmt.Errorf("unmarshaling lease: %s", err)
+	}
+	return l, true, nil
+}
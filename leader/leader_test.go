@@ -0,0 +1,164 @@
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	mongods "github.com/textileio/go-ds-mongo"
+	"github.com/textileio/powergate/tests"
+)
+
+const (
+	ttl           = time.Millisecond * 300
+	renewInterval = time.Millisecond * 50
+)
+
+func TestElection(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	e1 := newElector(t, ds, "e1")
+	t1, err := campaign(e1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), t1.Token)
+
+	e2 := newElector(t, ds, "e2")
+	time.Sleep(ttl)
+	require.True(t, e1.IsLeader())
+	require.False(t, e2.IsLeader())
+	l, err := e2.Leader()
+	require.NoError(t, err)
+	require.Equal(t, "e1", l.Holder)
+	require.Equal(t, "e1-addr", l.Addr)
+
+	// Closing the leader releases the lease, so e2 takes over
+	// without waiting for the lease to expire.
+	require.NoError(t, e1.Close())
+	select {
+	case <-t1.Done():
+	default:
+		t.Fatal("term should be done")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ttl/2)
+	defer cancel()
+	t2, err := e2.Campaign(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), t2.Token)
+	l, err = e1.Leader()
+	require.NoError(t, err)
+	require.Equal(t, "e2", l.Holder)
+}
+
+func TestExpiredLease(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	e1 := newElector(t, ds, "e1")
+	t1, err := campaign(e1)
+	require.NoError(t, err)
+
+	// Stop renewing without releasing the lease, as if e1 died.
+	e1.cancel()
+	<-e1.finished
+	e2 := newElector(t, ds, "e2")
+	t2, err := campaign(e2)
+	require.NoError(t, err)
+	require.Greater(t, t2.Token, t1.Token)
+	require.False(t, e1.IsLeader())
+}
+
+func TestFence(t *testing.T) {
+	t.Parallel()
+	testFence(t, tests.NewTxMapDatastore())
+}
+
+func TestFenceMongo(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	ds, err := mongods.New(ctx, tests.LaunchMongoDocker(t), "powergate")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, ds.Close()) })
+	testFence(t, ds)
+}
+
+func testFence(t *testing.T, ds datastore.TxnDatastore) {
+	key := datastore.NewKey("/foo")
+
+	e1 := newElector(t, ds, "e1")
+	_, err := campaign(e1)
+	require.NoError(t, err)
+	fds1 := e1.Fence(ds)
+	require.NoError(t, fds1.Put(key, []byte("e1")))
+
+	e2 := newElector(t, ds, "e2")
+	fds2 := e2.Fence(ds)
+	require.Equal(t, ErrFenced, fds2.Put(key, []byte("e2")))
+	require.Equal(t, ErrFenced, fds2.Delete(key))
+
+	// A transaction started by e1 fails to commit after e2 is elected.
+	txn, err := fds1.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn.Discard()
+	require.NoError(t, txn.Put(key, []byte("stale")))
+	e1.cancel()
+	<-e1.finished
+	_, err = campaign(e2)
+	require.NoError(t, err)
+	require.Equal(t, ErrFenced, txn.Commit())
+	require.NoError(t, fds2.Put(key, []byte("e2")))
+	v, err := ds.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, "e2", string(v))
+
+	// Reads aren't fenced.
+	v, err = fds1.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, "e2", string(v))
+
+	// Fenced transactions don't write the lease, so concurrent
+	// transactions writing different keys don't conflict.
+	lease, err := ds.Get(keyLease)
+	require.NoError(t, err)
+	txn1, err := fds2.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn1.Discard()
+	txn2, err := fds2.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn2.Discard()
+	require.NoError(t, txn1.Put(datastore.NewKey("/bar1"), []byte("1")))
+	require.NoError(t, txn2.Put(datastore.NewKey("/bar2"), []byte("2")))
+	require.NoError(t, txn1.Commit())
+	require.NoError(t, txn2.Commit())
+	curr, err := ds.Get(keyLease)
+	require.NoError(t, err)
+	require.Equal(t, lease, curr)
+
+	// A leader which doesn't renew the lease stops committing when the
+	// renewal deadline of its term is exceeded.
+	txn, err = fds2.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn.Discard()
+	require.NoError(t, txn.Put(key, []byte("paused")))
+	e2.cancel()
+	<-e2.finished
+	time.Sleep(ttl - renewInterval)
+	require.Equal(t, ErrFenced, txn.Commit())
+	v, err = ds.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, "e2", string(v))
+}
+
+func newElector(t *testing.T, ds datastore.TxnDatastore, id string) *Elector {
+	e, err := New(ds, id, id+"-addr", WithTTL(ttl), WithRenewInterval(renewInterval))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, e.Close()) })
+	return e
+}
+
+func campaign(e *Elector) (*Term, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ttl*3)
+	defer cancel()
+	return e.Campaign(ctx)
+}
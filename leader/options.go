@@ -0,0 +1,47 @@
+package leader
+
+import (
+	"fmt"
+	"time"
+)
+
+var defaultConfig = Config{
+	TTL:           time.Second * 15,
+	RenewInterval: time.Second * 5,
+}
+
+// Config contains configuration of the leader election.
+type Config struct {
+	// TTL is the duration of the lease since its last renewal.
+	TTL time.Duration
+	// RenewInterval is the frequency in which the lease is renewed, or
+	// acquired if it's expired.
+	RenewInterval time.Duration
+}
+
+// Option configures the leader election.
+type Option func(*Config) error
+
+// WithTTL sets the duration of the lease. If the leader doesn't renew the
+// lease within ttl, another instance takes over.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Config) error {
+		if ttl <= 0 {
+			return fmt.Errorf("ttl should be positive")
+		}
+		c.TTL = ttl
+		return nil
+	}
+}
+
+// WithRenewInterval sets the frequency in which the lease is renewed. It
+// should be smaller than the lease ttl.
+func WithRenewInterval(d time.Duration) Option {
+	return func(c *Config) error {
+		if d <= 0 {
+			return fmt.Errorf("renew interval should be positive")
+		}
+		c.RenewInterval = d
+		return nil
+	}
+}
@@ -16,6 +16,8 @@ import (
 
 var (
 	log = logging.Logger("lotus-client")
+
+	connRetryInterval = time.Second * 10
 )
 
 // ClientBuilder creates a new Lotus client.
@@ -32,28 +34,37 @@ func NewBuilder(maddr ma.Multiaddr, authToken string, connRetries int) (ClientBu
 	}
 
 	return func(ctx context.Context) (*apistruct.FullNodeStruct, func(), error) {
-		var api apistruct.FullNodeStruct
+		var api *apistruct.FullNodeStruct
 		var closer jsonrpc.ClientCloser
 		var err error
 		for i := 0; i < connRetries; i++ {
 			if ctx.Err() != nil {
 				return nil, nil, fmt.Errorf("canceled by context")
 			}
-			closer, err = jsonrpc.NewMergeClient(context.Background(), "ws://"+addr+"/rpc/v0", "Filecoin",
-				[]interface{}{
-					&api.Internal,
-					&api.CommonStruct.Internal,
-				}, headers)
+			api, closer, err = dial(addr, headers)
 			if err == nil {
 				break
 			}
 			log.Warnf("failed to connect to Lotus client %s, retrying...", err)
-			time.Sleep(time.Second * 10)
+			time.Sleep(connRetryInterval)
 		}
 		if err != nil {
 			return nil, nil, fmt.Errorf("couldn't connect to Lotus API: %s", err)
 		}
 
-		return &api, closer, nil
+		return api, closer, nil
 	}, nil
 }
+
+func dial(addr string, headers http.Header) (*apistruct.FullNodeStruct, jsonrpc.ClientCloser, error) {
+	var api apistruct.FullNodeStruct
+	closer, err := jsonrpc.NewMergeClient(context.Background(), "ws://"+addr+"/rpc/v0", "Filecoin",
+		[]interface{}{
+			&api.Internal,
+			&api.CommonStruct.Internal,
+		}, headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &api, closer, nil
+}
@@ -0,0 +1,271 @@
+package lotus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/lotus/api/apistruct"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/textileio/powergate/util"
+)
+
+var (
+	// healthCheckInterval is the time between health checks of
+	// failover endpoints.
+	healthCheckInterval = time.Second * 30
+	// healthCheckTimeout is the maximum time of a health check.
+	healthCheckTimeout = time.Second * 5
+	// maxSyncedHeightDiff is the maximum sync height difference of an
+	// endpoint to be considered synced.
+	maxSyncedHeightDiff int64 = 10
+)
+
+type ctxKey int
+
+const endpointKey ctxKey = 0
+
+// WithEndpoint returns a context in which a Failover connects to a
+// particular endpoint. If name is empty, the Failover connects to the
+// preferred endpoint and sets name with its name. This allows making
+// calls that depend on node local state, as tracking deals, against
+// the node where the state was created. If name isn't a configured
+// endpoint, it's handled as if it were empty.
+func WithEndpoint(ctx context.Context, name *string) context.Context {
+	return context.WithValue(ctx, endpointKey, name)
+}
+
+// Endpoint is a Lotus API endpoint.
+type Endpoint struct {
+	Addr      ma.Multiaddr
+	AuthToken string
+}
+
+// EndpointStatus is the last known health status of a Failover endpoint.
+type EndpointStatus struct {
+	Name        string
+	Reachable   bool
+	HeightDiff  int64
+	LastError   string
+	LastChecked time.Time
+}
+
+type endpoint struct {
+	addr    string
+	headers http.Header
+	status  EndpointStatus
+}
+
+// Failover connects to multiple Lotus endpoints, preferring the healthiest
+// synced one, and failing over to the next ones on connection errors.
+type Failover struct {
+	connRetries int
+
+	lock      sync.Mutex
+	endpoints []*endpoint
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+// NewFailover creates a new Failover for endpoints, which are checked
+// periodically in the background. Endpoints are preferred in the provided
+// order when they're equally healthy.
+func NewFailover(endpoints []Endpoint, connRetries int) (*Failover, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("at least one endpoint is required")
+	}
+	eps := make([]*endpoint, len(endpoints))
+	for i, e := range endpoints {
+		addr, err := util.TCPAddrFromMultiAddr(e.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing endpoint address %s: %s", e.Addr, err)
+		}
+		eps[i] = &endpoint{
+			addr: addr,
+			headers: http.Header{
+				"Authorization": []string{"Bearer " + e.AuthToken},
+			},
+			status: EndpointStatus{Name: e.Addr.String(), Reachable: true},
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &Failover{
+		connRetries: connRetries,
+		endpoints:   eps,
+		ctx:         ctx,
+		cancel:      cancel,
+		finished:    make(chan struct{}),
+	}
+	go f.run()
+	return f, nil
+}
+
+// Build connects to the preferred endpoint, or the endpoint set in
+// ctx with WithEndpoint. It has the signature of a ClientBuilder.
+func (f *Failover) Build(ctx context.Context) (*apistruct.FullNodeStruct, func(), error) {
+	name, _ := ctx.Value(endpointKey).(*string)
+	var lastErr error
+	for i := 0; i < f.connRetries; i++ {
+		if ctx.Err() != nil {
+			return nil, nil, fmt.Errorf("canceled by context")
+		}
+		candidates := f.candidates(name)
+		for _, e := range candidates {
+			api, closer, err := dial(e.addr, e.headers)
+			if err != nil {
+				log.Warnf("failed to connect to Lotus endpoint %s: %s", e.status.Name, err)
+				f.markUnreachable(e, err)
+				lastErr = err
+				continue
+			}
+			if name != nil && *name == "" {
+				*name = e.status.Name
+			}
+			return api, closer, nil
+		}
+		if i < f.connRetries-1 {
+			log.Warnf("failed to connect to all Lotus endpoints, retrying...")
+			time.Sleep(connRetryInterval)
+		}
+	}
+	return nil, nil, fmt.Errorf("couldn't connect to Lotus API: %s", lastErr)
+}
+
+// Status returns the last known status of endpoints, in preference order.
+func (f *Failover) Status() []EndpointStatus {
+	eps := f.preferred()
+	res := make([]EndpointStatus, len(eps))
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for i, e := range eps {
+		res[i] = e.status
+	}
+	return res
+}
+
+// Close stops the endpoint health checks.
+func (f *Failover) Close() error {
+	f.cancel()
+	<-f.finished
+	return nil
+}
+
+// candidates returns the endpoints to connect to in order. If name points
+// to a non-empty endpoint name, only that endpoint is returned. If the
+// endpoint is unknown, e.g: it was removed from the configuration, name is
+// cleared and all endpoints are returned in preference order, so the
+// connected endpoint is set in name instead.
+func (f *Failover) candidates(name *string) []*endpoint {
+	if name == nil || *name == "" {
+		return f.preferred()
+	}
+	f.lock.Lock()
+	for _, e := range f.endpoints {
+		if e.status.Name == *name {
+			f.lock.Unlock()
+			return []*endpoint{e}
+		}
+	}
+	f.lock.Unlock()
+	log.Warnf("unknown Lotus endpoint %s, falling back to the preferred endpoint", *name)
+	*name = ""
+	return f.preferred()
+}
+
+// preferred returns endpoints sorted by preference: reachable before
+// unreachable, synced before unsynced, lower height difference first,
+// and finally the configured order.
+func (f *Failover) preferred() []*endpoint {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	res := make([]*endpoint, len(f.endpoints))
+	copy(res, f.endpoints)
+	sort.SliceStable(res, func(i, j int) bool {
+		a, b := res[i].status, res[j].status
+		if a.Reachable != b.Reachable {
+			return a.Reachable
+		}
+		aSynced, bSynced := a.HeightDiff <= maxSyncedHeightDiff, b.HeightDiff <= maxSyncedHeightDiff
+		if aSynced != bSynced {
+			return aSynced
+		}
+		if !aSynced {
+			return a.HeightDiff < b.HeightDiff
+		}
+		return false
+	})
+	return res
+}
+
+func (f *Failover) markUnreachable(e *endpoint, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	e.status.Reachable = false
+	e.status.LastError = err.Error()
+}
+
+func (f *Failover) run() {
+	defer close(f.finished)
+	for {
+		f.checkAll()
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-time.After(healthCheckInterval):
+		}
+	}
+}
+
+func (f *Failover) checkAll() {
+	f.lock.Lock()
+	eps := make([]*endpoint, len(f.endpoints))
+	copy(eps, f.endpoints)
+	f.lock.Unlock()
+
+	var wg sync.WaitGroup
+	for _, e := range eps {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			heightDiff, err := f.check(e)
+			f.lock.Lock()
+			defer f.lock.Unlock()
+			e.status.LastChecked = time.Now()
+			if err != nil {
+				if e.status.Reachable {
+					log.Warnf("Lotus endpoint %s is unhealthy: %s", e.status.Name, err)
+				}
+				e.status.Reachable = false
+				e.status.LastError = err.Error()
+				return
+			}
+			if !e.status.Reachable {
+				log.Infof("Lotus endpoint %s is healthy again", e.status.Name)
+			}
+			e.status.Reachable = true
+			e.status.HeightDiff = heightDiff
+			e.status.LastError = ""
+		}(e)
+	}
+	wg.Wait()
+}
+
+func (f *Failover) check(e *endpoint) (int64, error) {
+	ctx, cancel := context.WithTimeout(f.ctx, healthCheckTimeout)
+	defer cancel()
+	c, closer, err := dial(e.addr, e.headers)
+	if err != nil {
+		return 0, fmt.Errorf("connecting: %s", err)
+	}
+	defer closer()
+	heightDiff, _, err := syncHeightDiff(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	return heightDiff, nil
+}
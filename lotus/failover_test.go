@@ -0,0 +1,58 @@
+package lotus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/util"
+)
+
+func TestFailoverPreferred(t *testing.T) {
+	t.Parallel()
+	f := &Failover{
+		endpoints: []*endpoint{
+			{status: EndpointStatus{Name: "unreachable", Reachable: false}},
+			{status: EndpointStatus{Name: "unsynced-far", Reachable: true, HeightDiff: 500}},
+			{status: EndpointStatus{Name: "synced-1", Reachable: true, HeightDiff: 5}},
+			{status: EndpointStatus{Name: "unsynced-near", Reachable: true, HeightDiff: 50}},
+			{status: EndpointStatus{Name: "synced-2", Reachable: true, HeightDiff: 0}},
+		},
+	}
+	var names []string
+	for _, e := range f.preferred() {
+		names = append(names, e.status.Name)
+	}
+	require.Equal(t, []string{"synced-1", "synced-2", "unsynced-near", "unsynced-far", "unreachable"}, names)
+
+	name := "synced-2"
+	cs := f.candidates(&name)
+	require.Len(t, cs, 1)
+	require.Equal(t, "synced-2", cs[0].status.Name)
+
+	// Unknown endpoints fall back to the preferred ones.
+	name = "unknown"
+	cs = f.candidates(&name)
+	require.Len(t, cs, 5)
+	require.Equal(t, "synced-1", cs[0].status.Name)
+	require.Empty(t, name)
+}
+
+func TestFailoverUnreachable(t *testing.T) {
+	t.Parallel()
+	f, err := NewFailover([]Endpoint{
+		{Addr: util.MustParseAddr("/ip4/127.0.0.1/tcp/1")},
+		{Addr: util.MustParseAddr("/ip4/127.0.0.1/tcp/2")},
+	}, 1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, f.Close()) }()
+
+	var name string
+	_, _, err = f.Build(WithEndpoint(context.Background(), &name))
+	require.Error(t, err)
+	require.Empty(t, name)
+	for _, s := range f.Status() {
+		require.False(t, s.Reachable)
+		require.NotEmpty(t, s.LastError)
+	}
+}
@@ -0,0 +1,135 @@
+package fakelotus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/build"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/ipfs/go-cid"
+)
+
+var (
+	builtinMinerAddr, _ = address.NewIDAddress(1000)
+)
+
+const networkName = "fakenet"
+
+func (n *Node) registerChain(c *apistruct.FullNodeStruct) {
+	c.CommonStruct.Internal.Version = n.version
+	c.Internal.ChainHead = n.chainHead
+	c.Internal.ChainGetGenesis = n.chainGetGenesis
+	c.Internal.ChainGetTipSet = n.chainGetTipSet
+	c.Internal.ChainGetTipSetByHeight = n.chainGetTipSetByHeight
+	c.Internal.ChainGetPath = n.chainGetPath
+	c.Internal.SyncState = n.syncState
+	c.Internal.StateNetworkName = n.stateNetworkName
+	c.Internal.StateChangedActors = n.stateChangedActors
+}
+
+func (n *Node) version(ctx context.Context) (api.Version, error) {
+	return api.Version{
+		Version:    "fakelotus",
+		APIVersion: build.FullAPIVersion,
+		BlockDelay: uint64(n.conf.blockTime.Seconds()),
+	}, nil
+}
+
+func (n *Node) chainHead(ctx context.Context) (*types.TipSet, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.head(), nil
+}
+
+func (n *Node) chainGetGenesis(ctx context.Context) (*types.TipSet, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.tipsets[0], nil
+}
+
+func (n *Node) chainGetTipSet(ctx context.Context, tsk types.TipSetKey) (*types.TipSet, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.tipSetByKey(tsk)
+}
+
+func (n *Node) chainGetTipSetByHeight(ctx context.Context, h abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	ts, err := n.tipSetByKey(tsk)
+	if err != nil {
+		return nil, err
+	}
+	if h < 0 || h > ts.Height() {
+		return nil, fmt.Errorf("looking for tipset with height greater than start point")
+	}
+	return n.tipsets[h], nil
+}
+
+func (n *Node) chainGetPath(ctx context.Context, from, to types.TipSetKey) ([]*api.HeadChange, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	fts, err := n.tipSetByKey(from)
+	if err != nil {
+		return nil, fmt.Errorf("loading from tipset: %s", err)
+	}
+	tts, err := n.tipSetByKey(to)
+	if err != nil {
+		return nil, fmt.Errorf("loading to tipset: %s", err)
+	}
+	var res []*api.HeadChange
+	for h := fts.Height(); h > tts.Height(); h-- {
+		res = append(res, &api.HeadChange{Type: "revert", Val: n.tipsets[h]})
+	}
+	for h := fts.Height() + 1; h <= tts.Height(); h++ {
+		res = append(res, &api.HeadChange{Type: "apply", Val: n.tipsets[h]})
+	}
+	return res, nil
+}
+
+// syncState reports the node as fully synced.
+func (n *Node) syncState(ctx context.Context) (*api.SyncState, error) {
+	return &api.SyncState{}, nil
+}
+
+func (n *Node) stateNetworkName(ctx context.Context) (dtypes.NetworkName, error) {
+	return dtypes.NetworkName(networkName), nil
+}
+
+// stateChangedActors reports all miners as changed, since miner state
+// isn't tracked in state roots.
+func (n *Node) stateChangedActors(ctx context.Context, old, new cid.Cid) (map[string]types.Actor, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	res := make(map[string]types.Actor)
+	if old.Equals(new) {
+		return res, nil
+	}
+	for _, m := range n.miners {
+		res[m.addr.String()] = types.Actor{
+			Code:    minerActorCode,
+			Head:    new,
+			Balance: types.NewInt(0),
+		}
+	}
+	return res, nil
+}
+
+// tipSetByKey returns the tipset with key tsk, or the head if
+// tsk is empty.
+func (n *Node) tipSetByKey(tsk types.TipSetKey) (*types.TipSet, error) {
+	if tsk == types.EmptyTSK {
+		return n.head(), nil
+	}
+	for i := len(n.tipsets) - 1; i >= 0; i-- {
+		if n.tipsets[i].Key() == tsk {
+			return n.tipsets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("tipset %s not found", tsk)
+}
@@ -0,0 +1,283 @@
+// Package fakelotus provides an in-process fake Lotus node, which can be
+// used through a lotus.ClientBuilder to run Powergate flows in tests
+// without a real Lotus devnet.
+//
+// The fake node simulates the subset of the Lotus API used by Powergate:
+// chain height progression, wallet addresses and balances, messages, miners
+// with asks, storage deal state machines and retrievals. It's not a Filecoin implementation: keys,
+// signatures, piece commitments and state roots are placeholders which
+// are only meaningful to the fake node itself.
+package fakelotus
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/lotus"
+)
+
+var (
+	log = logging.Logger("fakelotus")
+
+	// initialBalance is the balance in attoFIL of the default address.
+	initialBalance = new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil)
+)
+
+// Node is an in-process fake Lotus node.
+type Node struct {
+	conf config
+
+	lock        sync.Mutex
+	tipsets     []*types.TipSet
+	newHead     chan struct{}
+	wallet      map[address.Address]types.KeyInfo
+	walletOrder []address.Address
+	defaultAddr address.Address
+	balances    map[address.Address]types.BigInt
+	escrows     map[address.Address]*api.MarketBalance
+	nonces      map[address.Address]uint64
+	msgs        map[cid.Cid]*message
+	pendingMsgs []cid.Cid
+	miners      []*miner
+	deals       map[cid.Cid]*deal
+	dealIDs     map[abi.DealID]*deal
+	lastDealID  abi.DealID
+	imports     map[cid.Cid][]byte
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+// New creates a new fake Lotus node. The chain starts at height 0, with
+// a funded default wallet address.
+func New(opts ...Option) (*Node, error) {
+	conf := defaultConfig()
+	for _, o := range opts {
+		o(&conf)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &Node{
+		conf:     conf,
+		wallet:   make(map[address.Address]types.KeyInfo),
+		balances: make(map[address.Address]types.BigInt),
+		escrows:  make(map[address.Address]*api.MarketBalance),
+		nonces:   make(map[address.Address]uint64),
+		msgs:     make(map[cid.Cid]*message),
+		deals:    make(map[cid.Cid]*deal),
+		dealIDs:  make(map[abi.DealID]*deal),
+		imports:  make(map[cid.Cid][]byte),
+		newHead:  make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	if err := n.mineGenesis(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("creating genesis: %s", err)
+	}
+	def, err := n.newAddress(types.KTBLS)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("creating default address: %s", err)
+	}
+	n.defaultAddr = def
+	n.balances[def] = types.BigInt{Int: initialBalance}
+	for i := 0; i < conf.miners; i++ {
+		m, err := newMiner(i, DefaultMinerConfig())
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("creating miner %d: %s", i, err)
+		}
+		n.miners = append(n.miners, m)
+	}
+	go n.run()
+	return n, nil
+}
+
+// Builder returns a lotus.ClientBuilder connected to the node.
+func (n *Node) Builder() lotus.ClientBuilder {
+	return func(ctx context.Context) (*apistruct.FullNodeStruct, func(), error) {
+		if n.ctx.Err() != nil {
+			return nil, nil, fmt.Errorf("fake lotus node is closed")
+		}
+		return n.client(), func() {}, nil
+	}
+}
+
+// DefaultAddress returns the default wallet address of the node.
+func (n *Node) DefaultAddress() address.Address {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.defaultAddr
+}
+
+// Miners returns the addresses of the simulated miners.
+func (n *Node) Miners() []address.Address {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	res := make([]address.Address, len(n.miners))
+	for i, m := range n.miners {
+		res[i] = m.addr
+	}
+	return res
+}
+
+// Height returns the current chain height.
+func (n *Node) Height() abi.ChainEpoch {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.head().Height()
+}
+
+// Advance mines count new tipsets, executing pending messages and
+// moving forward storage deals.
+func (n *Node) Advance(count int) {
+	for i := 0; i < count; i++ {
+		n.mine()
+	}
+}
+
+// SlashDeal slashes an active deal at the current height.
+func (n *Node) SlashDeal(dealID abi.DealID) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	d, ok := n.dealIDs[dealID]
+	if !ok || d.info.State != storagemarket.StorageDealActive {
+		return fmt.Errorf("active deal %d not found", dealID)
+	}
+	d.info.State = storagemarket.StorageDealSlashed
+	d.slashEpoch = n.head().Height()
+	return nil
+}
+
+// Close stops the chain progression of the node.
+func (n *Node) Close() error {
+	n.cancel()
+	<-n.finished
+	return nil
+}
+
+func (n *Node) run() {
+	defer close(n.finished)
+	if n.conf.blockTime <= 0 {
+		<-n.ctx.Done()
+		return
+	}
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-time.After(n.conf.blockTime):
+			n.mine()
+		}
+	}
+}
+
+// mine creates a new tipset on top of the current head, and applies
+// its effects.
+func (n *Node) mine() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	parent := n.head()
+	height := parent.Height() + 1
+	ts, err := n.newTipSet(height, parent.Cids())
+	if err != nil {
+		log.Errorf("creating tipset at height %d: %s", height, err)
+		return
+	}
+	n.tipsets = append(n.tipsets, ts)
+	n.executeMessages(ts)
+	n.advanceDeals(height)
+	n.notifyHead(ts)
+}
+
+func (n *Node) mineGenesis() error {
+	ts, err := n.newTipSet(0, nil)
+	if err != nil {
+		return err
+	}
+	n.tipsets = append(n.tipsets, ts)
+	return nil
+}
+
+func (n *Node) newTipSet(height abi.ChainEpoch, parents []cid.Cid) (*types.TipSet, error) {
+	bh := &types.BlockHeader{
+		Miner:                 builtinMinerAddr,
+		Parents:               parents,
+		ParentWeight:          types.NewInt(uint64(height)),
+		Height:                height,
+		ParentStateRoot:       fakeCid(fmt.Sprintf("state-root-%d", height)),
+		ParentMessageReceipts: fakeCid(fmt.Sprintf("receipts-%d", height)),
+		Messages:              fakeCid(fmt.Sprintf("messages-%d", height)),
+		Timestamp:             uint64(time.Now().Unix()),
+		ParentBaseFee:         types.NewInt(100),
+	}
+	return types.NewTipSet([]*types.BlockHeader{bh})
+}
+
+func (n *Node) head() *types.TipSet {
+	return n.tipsets[len(n.tipsets)-1]
+}
+
+// notifyHead wakes up calls waiting for a new head.
+func (n *Node) notifyHead(ts *types.TipSet) {
+	close(n.newHead)
+	n.newHead = make(chan struct{})
+}
+
+// client returns a FullNodeStruct whose methods are served by the node.
+// Methods which aren't simulated return an error.
+func (n *Node) client() *apistruct.FullNodeStruct {
+	var c apistruct.FullNodeStruct
+	n.registerChain(&c)
+	n.registerWallet(&c)
+	n.registerMarket(&c)
+	notImplemented(&c.Internal)
+	notImplemented(&c.CommonStruct.Internal)
+	return &c
+}
+
+// notImplemented sets all nil function fields of the struct pointed by
+// internal to functions returning an error.
+func notImplemented(internal interface{}) {
+	v := reflect.ValueOf(internal).Elem()
+	t := v.Type()
+	errType := reflect.TypeOf((*error)(nil)).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Func || !f.IsNil() {
+			continue
+		}
+		name := t.Field(i).Name
+		ft := f.Type()
+		f.Set(reflect.MakeFunc(ft, func([]reflect.Value) []reflect.Value {
+			out := make([]reflect.Value, ft.NumOut())
+			for j := range out {
+				out[j] = reflect.Zero(ft.Out(j))
+			}
+			if last := ft.NumOut() - 1; last >= 0 && ft.Out(last) == errType {
+				err := fmt.Errorf("%s isn't implemented by fake lotus", name)
+				out[last] = reflect.ValueOf(&err).Elem()
+			}
+			return out
+		}))
+	}
+}
+
+// fakeCid returns a placeholder cid derived from s.
+func fakeCid(s string) cid.Cid {
+	return dataCid([]byte(s))
+}
@@ -0,0 +1,146 @@
+package fakelotus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/exitcode"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendFil(t *testing.T) {
+	t.Parallel()
+	n, c := newNode(t)
+	ctx := context.Background()
+
+	from := n.DefaultAddress()
+	to, err := c.WalletNew(ctx, types.KTSecp256k1)
+	require.NoError(t, err)
+	smsg, err := c.MpoolPushMessage(ctx, &types.Message{From: from, To: to, Value: types.NewInt(1000)}, nil)
+	require.NoError(t, err)
+
+	lookup, err := c.StateSearchMsg(ctx, smsg.Cid())
+	require.NoError(t, err)
+	require.Nil(t, lookup)
+
+	n.Advance(1)
+	lookup, err = c.StateWaitMsg(ctx, smsg.Cid(), 0)
+	require.NoError(t, err)
+	require.Equal(t, exitcode.Ok, lookup.Receipt.ExitCode)
+	require.Equal(t, abi.ChainEpoch(1), lookup.Height)
+	balance, err := c.WalletBalance(ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, types.NewInt(1000), balance)
+
+	msgs, err := c.StateListMessages(ctx, &api.MessageMatch{To: to}, types.EmptyTSK, 0)
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{smsg.Cid()}, msgs)
+
+	ki, err := c.WalletExport(ctx, to)
+	require.NoError(t, err)
+	require.NoError(t, c.WalletDelete(ctx, to))
+	imported, err := c.WalletImport(ctx, ki)
+	require.NoError(t, err)
+	require.Equal(t, to, imported)
+}
+
+func TestDealLifecycle(t *testing.T) {
+	t.Parallel()
+	n, c := newNode(t, WithDealSealingEpochs(2))
+	ctx := context.Background()
+	miners := n.Miners()
+
+	root := fakeCid("data")
+	piece, err := c.ClientDealPieceCID(ctx, root)
+	require.NoError(t, err)
+	ask, err := c.ClientQueryAsk(ctx, n.miners[0].peerID, miners[0])
+	require.NoError(t, err)
+	price := big.Div(big.Mul(ask.Price, big.NewInt(int64(piece.PieceSize))), big.NewInt(1<<30))
+
+	p, err := c.ClientStartDeal(ctx, &api.StartDealParams{
+		Data:              &storagemarket.DataRef{Root: root, PieceCid: &piece.PieceCID, PieceSize: piece.PieceSize.Unpadded()},
+		Wallet:            n.DefaultAddress(),
+		Miner:             miners[0],
+		EpochPrice:        price,
+		MinBlocksDuration: 1000,
+		DealStartEpoch:    100,
+	})
+	require.NoError(t, err)
+	requireDealState(t, c, *p, storagemarket.StorageDealCheckForAcceptance)
+
+	n.Advance(1)
+	requireDealState(t, c, *p, storagemarket.StorageDealSealing)
+
+	n.Advance(2)
+	di := requireDealState(t, c, *p, storagemarket.StorageDealActive)
+	md, err := c.StateMarketStorageDeal(ctx, di.DealID, types.EmptyTSK)
+	require.NoError(t, err)
+	require.Equal(t, abi.ChainEpoch(3), md.State.SectorStartEpoch)
+
+	mb, err := c.StateMarketBalance(ctx, n.DefaultAddress(), types.EmptyTSK)
+	require.NoError(t, err)
+	require.Equal(t, big.Mul(price, big.NewInt(1000)).String(), mb.Locked.String())
+
+	offers, err := c.ClientFindData(ctx, root, nil)
+	require.NoError(t, err)
+	require.Len(t, offers, 1)
+	require.Equal(t, miners[0], offers[0].Miner)
+
+	_, err = c.ClientGetDealInfo(ctx, fakeCid("unknown"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "datastore: key not found")
+
+	require.NoError(t, n.SlashDeal(di.DealID))
+	requireDealState(t, c, *p, storagemarket.StorageDealSlashed)
+	md, err = c.StateMarketStorageDeal(ctx, di.DealID, types.EmptyTSK)
+	require.NoError(t, err)
+	require.Equal(t, n.Height(), md.State.SlashEpoch)
+}
+
+func TestDealRejected(t *testing.T) {
+	t.Parallel()
+	n, c := newNode(t)
+	ctx := context.Background()
+
+	root := fakeCid("data")
+	p, err := c.ClientStartDeal(ctx, &api.StartDealParams{
+		Data:              &storagemarket.DataRef{Root: root},
+		Wallet:            n.DefaultAddress(),
+		Miner:             n.Miners()[0],
+		EpochPrice:        abi.NewTokenAmount(1),
+		MinBlocksDuration: 1000,
+		DealStartEpoch:    100,
+	})
+	require.NoError(t, err)
+	n.Advance(1)
+	di := requireDealState(t, c, *p, storagemarket.StorageDealError)
+	require.Contains(t, di.Message, "less than asking price")
+
+	mb, err := c.StateMarketBalance(ctx, n.DefaultAddress(), types.EmptyTSK)
+	require.NoError(t, err)
+	require.Equal(t, "0", mb.Locked.String())
+}
+
+func newNode(t *testing.T, opts ...Option) (*Node, *apistruct.FullNodeStruct) {
+	n, err := New(append([]Option{WithBlockTime(0)}, opts...)...)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, n.Close()) })
+	c, cls, err := n.Builder()(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(cls)
+	return n, c
+}
+
+func requireDealState(t *testing.T, c *apistruct.FullNodeStruct, pcid cid.Cid, state storagemarket.StorageDealStatus) *api.DealInfo {
+	di, err := c.ClientGetDealInfo(context.Background(), pcid)
+	require.NoError(t, err)
+	require.Equal(t, storagemarket.DealStates[state], storagemarket.DealStates[di.State], di.Message)
+	return di
+}
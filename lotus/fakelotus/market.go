@@ -0,0 +1,540 @@
+package fakelotus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"math/bits"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	commcid "github.com/filecoin-project/go-fil-commcid"
+	"github.com/filecoin-project/go-fil-markets/retrievalmarket"
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/go-multistore"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/market"
+	lminer "github.com/filecoin-project/lotus/chain/actors/builtin/miner"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/power"
+	"github.com/filecoin-project/lotus/chain/types"
+	marketevents "github.com/filecoin-project/lotus/markets/loggers"
+	builtin2 "github.com/filecoin-project/specs-actors/v2/actors/builtin"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multihash"
+)
+
+var (
+	minerActorCode = builtin2.StorageMinerActorCodeID
+
+	// defaultPayloadSize is the payload size of data which wasn't imported
+	// in the node, e.g. data available through an IPFS node.
+	defaultPayloadSize = int64(1024)
+
+	// errDealNotFound has the message of a missing deal in Lotus, which
+	// is what clients check for.
+	errDealNotFound = fmt.Errorf("getting deal info: datastore: key not found")
+)
+
+// miner is a simulated storage miner.
+type miner struct {
+	addr   address.Address
+	owner  address.Address
+	peerID peer.ID
+	conf   MinerConfig
+}
+
+func newMiner(i int, conf MinerConfig) (*miner, error) {
+	addr, err := address.NewIDAddress(uint64(1000 + i))
+	if err != nil {
+		return nil, fmt.Errorf("creating miner address: %s", err)
+	}
+	owner, err := address.NewIDAddress(uint64(100 + i))
+	if err != nil {
+		return nil, fmt.Errorf("creating owner address: %s", err)
+	}
+	mh, err := multihash.Sum([]byte(addr.String()), multihash.SHA2_256, -1)
+	if err != nil {
+		return nil, fmt.Errorf("creating peer id: %s", err)
+	}
+	return &miner{
+		addr:   addr,
+		owner:  owner,
+		peerID: peer.ID(mh),
+		conf:   conf,
+	}, nil
+}
+
+// deal is a storage deal proposed to a simulated miner.
+type deal struct {
+	info          api.DealInfo
+	proposal      market.DealProposal
+	acceptedEpoch abi.ChainEpoch
+	activeEpoch   abi.ChainEpoch
+	slashEpoch    abi.ChainEpoch
+}
+
+func (n *Node) registerMarket(c *apistruct.FullNodeStruct) {
+	c.Internal.StateListMiners = n.stateListMiners
+	c.Internal.StateMinerInfo = n.stateMinerInfo
+	c.Internal.StateMinerPower = n.stateMinerPower
+	c.Internal.StateAllMinerFaults = n.stateAllMinerFaults
+	c.Internal.StateMarketStorageDeal = n.stateMarketStorageDeal
+	c.Internal.ClientQueryAsk = n.clientQueryAsk
+	c.Internal.ClientImport = n.clientImport
+	c.Internal.ClientDealPieceCID = n.clientDealPieceCID
+	c.Internal.ClientStartDeal = n.clientStartDeal
+	c.Internal.ClientGetDealInfo = n.clientGetDealInfo
+	c.Internal.ClientFindData = n.clientFindData
+	c.Internal.ClientMinerQueryOffer = n.clientMinerQueryOffer
+	c.Internal.ClientRetrieveWithEvents = n.clientRetrieveWithEvents
+}
+
+func (n *Node) stateListMiners(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	res := make([]address.Address, len(n.miners))
+	for i, m := range n.miners {
+		res[i] = m.addr
+	}
+	return res, nil
+}
+
+func (n *Node) stateMinerInfo(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (lminer.MinerInfo, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.minerByAddr(maddr)
+	if !ok {
+		return lminer.MinerInfo{}, fmt.Errorf("failed to load miner actor: actor not found")
+	}
+	pid := m.peerID
+	return lminer.MinerInfo{
+		Owner:      m.owner,
+		Worker:     m.owner,
+		PeerId:     &pid,
+		SectorSize: abi.SectorSize(32 << 30),
+	}, nil
+}
+
+func (n *Node) stateMinerPower(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (*api.MinerPower, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.minerByAddr(maddr)
+	if !ok {
+		return nil, fmt.Errorf("failed to load miner actor: actor not found")
+	}
+	total := big.Zero()
+	for _, m := range n.miners {
+		total = big.Add(total, m.power())
+	}
+	return &api.MinerPower{
+		MinerPower:  power.Claim{RawBytePower: m.power(), QualityAdjPower: m.power()},
+		TotalPower:  power.Claim{RawBytePower: total, QualityAdjPower: total},
+		HasMinPower: m.power().Sign() > 0,
+	}, nil
+}
+
+// stateAllMinerFaults reports no faults, since simulated miners don't fail.
+func (n *Node) stateAllMinerFaults(ctx context.Context, lookback abi.ChainEpoch, tsk types.TipSetKey) ([]*api.Fault, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if _, err := n.tipSetByKey(tsk); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (n *Node) stateMarketStorageDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*api.MarketDeal, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	d, ok := n.dealIDs[dealID]
+	if !ok {
+		return nil, fmt.Errorf("deal %d not found", dealID)
+	}
+	md := &api.MarketDeal{
+		Proposal: d.proposal,
+		State: market.DealState{
+			SectorStartEpoch: -1,
+			LastUpdatedEpoch: -1,
+			SlashEpoch:       -1,
+		},
+	}
+	switch d.info.State {
+	case storagemarket.StorageDealActive:
+		md.State.SectorStartEpoch = d.activeEpoch
+	case storagemarket.StorageDealSlashed:
+		md.State.SectorStartEpoch = d.activeEpoch
+		md.State.SlashEpoch = d.slashEpoch
+	}
+	return md, nil
+}
+
+func (n *Node) clientQueryAsk(ctx context.Context, p peer.ID, maddr address.Address) (*storagemarket.StorageAsk, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.minerByAddr(maddr)
+	if !ok || m.peerID != p {
+		return nil, fmt.Errorf("failed to open stream to miner: peer %s not found", p)
+	}
+	return m.ask(n.head().Height()), nil
+}
+
+// clientImport keeps the content of a file in memory. The root of CAR files
+// is read from its header, and other files get a placeholder root.
+func (n *Node) clientImport(ctx context.Context, ref api.FileRef) (*api.ImportRes, error) {
+	data, err := ioutil.ReadFile(ref.Path)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %s", err)
+	}
+	root := dataCid(data)
+	if ref.IsCAR {
+		h, _, err := car.ReadHeader(bufio.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			return nil, fmt.Errorf("reading car header: %s", err)
+		}
+		if len(h.Roots) != 1 {
+			return nil, fmt.Errorf("car file must have exactly one root, has %d", len(h.Roots))
+		}
+		root = h.Roots[0]
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.imports[root] = data
+	return &api.ImportRes{Root: root, ImportID: multistore.StoreID(len(n.imports))}, nil
+}
+
+func (n *Node) clientDealPieceCID(ctx context.Context, root cid.Cid) (api.DataCIDSize, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	size := n.payloadSize(root)
+	pcid, err := pieceCid(root)
+	if err != nil {
+		return api.DataCIDSize{}, err
+	}
+	return api.DataCIDSize{
+		PayloadSize: size,
+		PieceSize:   paddedSize(size),
+		PieceCID:    pcid,
+	}, nil
+}
+
+// clientStartDeal proposes a deal to a simulated miner. The deal price is
+// locked in the client escrow, which is topped up from the wallet balance
+// if needed, as Lotus does.
+func (n *Node) clientStartDeal(ctx context.Context, params *api.StartDealParams) (*cid.Cid, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.minerByAddr(params.Miner)
+	if !ok {
+		return nil, fmt.Errorf("failed getting peer ID: miner %s not found", params.Miner)
+	}
+	if _, ok := n.wallet[params.Wallet]; !ok {
+		return nil, fmt.Errorf("wallet %s not found", params.Wallet)
+	}
+	if params.Data == nil {
+		return nil, fmt.Errorf("data reference is required")
+	}
+	height := n.head().Height()
+	if params.DealStartEpoch <= height {
+		return nil, fmt.Errorf("deal start epoch %d is in the past", params.DealStartEpoch)
+	}
+
+	pieceSize := paddedSize(n.payloadSize(params.Data.Root))
+	if params.Data.PieceSize != 0 {
+		pieceSize = params.Data.PieceSize.Padded()
+	}
+	pcid, err := pieceCid(params.Data.Root)
+	if err != nil {
+		return nil, err
+	}
+	if params.Data.PieceCid != nil {
+		pcid = *params.Data.PieceCid
+	}
+
+	total := big.Mul(params.EpochPrice, big.NewIntUnsigned(params.MinBlocksDuration))
+	mb := n.escrow(params.Wallet)
+	available := big.Sub(mb.Escrow, mb.Locked)
+	if available.LessThan(total) {
+		missing := big.Sub(total, available)
+		if n.balance(params.Wallet).LessThan(missing) {
+			return nil, fmt.Errorf("adding market funds failed: not enough funds: %s < %s", n.balance(params.Wallet), missing)
+		}
+		n.balances[params.Wallet] = big.Sub(n.balance(params.Wallet), missing)
+		mb.Escrow = big.Add(mb.Escrow, missing)
+	}
+	mb.Locked = big.Add(mb.Locked, total)
+	n.escrows[params.Wallet] = &mb
+
+	proposal := market.DealProposal{
+		PieceCID:             pcid,
+		PieceSize:            pieceSize,
+		Client:               params.Wallet,
+		Provider:             m.addr,
+		StartEpoch:           params.DealStartEpoch,
+		EndEpoch:             params.DealStartEpoch + abi.ChainEpoch(params.MinBlocksDuration),
+		StoragePricePerEpoch: params.EpochPrice,
+		ProviderCollateral:   big.Zero(),
+		ClientCollateral:     big.Zero(),
+		VerifiedDeal:         params.VerifiedDeal,
+	}
+	propCid := fakeCid(fmt.Sprintf("proposal-%s-%s-%s-%d", params.Data.Root, m.addr, params.Wallet, len(n.deals)))
+	dataRef := *params.Data
+	d := &deal{
+		info: api.DealInfo{
+			ProposalCid:   propCid,
+			State:         storagemarket.StorageDealCheckForAcceptance,
+			Provider:      m.addr,
+			DataRef:       &dataRef,
+			PieceCID:      pcid,
+			Size:          uint64(pieceSize.Unpadded()),
+			PricePerEpoch: params.EpochPrice,
+			Duration:      params.MinBlocksDuration,
+			CreationTime:  time.Now(),
+			Verified:      params.VerifiedDeal,
+		},
+		proposal:      proposal,
+		acceptedEpoch: height + 1,
+	}
+	n.deals[propCid] = d
+	return &propCid, nil
+}
+
+func (n *Node) clientGetDealInfo(ctx context.Context, propCid cid.Cid) (*api.DealInfo, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	d, ok := n.deals[propCid]
+	if !ok {
+		return nil, errDealNotFound
+	}
+	info := d.info
+	return &info, nil
+}
+
+// clientFindData returns retrieval offers from miners with active
+// deals for root.
+func (n *Node) clientFindData(ctx context.Context, root cid.Cid, piece *cid.Cid) ([]api.QueryOffer, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	var res []api.QueryOffer
+	for _, m := range n.miners {
+		if qo, ok := n.queryOffer(m, root, piece); ok {
+			res = append(res, qo)
+		}
+	}
+	return res, nil
+}
+
+func (n *Node) clientMinerQueryOffer(ctx context.Context, maddr address.Address, root cid.Cid, piece *cid.Cid) (api.QueryOffer, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.minerByAddr(maddr)
+	if !ok {
+		return api.QueryOffer{}, fmt.Errorf("miner %s not found", maddr)
+	}
+	qo, ok := n.queryOffer(m, root, piece)
+	if !ok {
+		return api.QueryOffer{}, fmt.Errorf("retrieval query offer errored: miner doesn't have data %s", root)
+	}
+	return qo, nil
+}
+
+// clientRetrieveWithEvents pays the retrieval from the order client, and
+// writes the imported data of the root to ref if provided.
+func (n *Node) clientRetrieveWithEvents(ctx context.Context, order api.RetrievalOrder, ref *api.FileRef) (<-chan marketevents.RetrievalEvent, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.minerByAddr(order.Miner)
+	if !ok {
+		return nil, fmt.Errorf("miner %s not found", order.Miner)
+	}
+	if _, ok := n.queryOffer(m, order.Root, order.Piece); !ok {
+		return nil, fmt.Errorf("miner doesn't have data %s", order.Root)
+	}
+	if n.balance(order.Client).LessThan(order.Total) {
+		return nil, fmt.Errorf("not enough funds for retrieval: %s < %s", n.balance(order.Client), order.Total)
+	}
+	data, imported := n.imports[order.Root]
+	if ref != nil {
+		if !imported {
+			return nil, fmt.Errorf("data %s isn't available in the node", order.Root)
+		}
+		if err := ioutil.WriteFile(ref.Path, data, 0644); err != nil {
+			return nil, fmt.Errorf("writing retrieved data: %s", err)
+		}
+	}
+	n.transfer(order.Client, m.owner, order.Total)
+
+	ch := make(chan marketevents.RetrievalEvent, 2)
+	ch <- marketevents.RetrievalEvent{
+		Event:         retrievalmarket.ClientEventBlocksReceived,
+		Status:        retrievalmarket.DealStatusOngoing,
+		BytesReceived: order.Size,
+		FundsSpent:    big.Zero(),
+	}
+	ch <- marketevents.RetrievalEvent{
+		Event:         retrievalmarket.ClientEventComplete,
+		Status:        retrievalmarket.DealStatusCompleted,
+		BytesReceived: order.Size,
+		FundsSpent:    order.Total,
+	}
+	close(ch)
+	return ch, nil
+}
+
+// advanceDeals moves deal state machines forward to height.
+func (n *Node) advanceDeals(height abi.ChainEpoch) {
+	for _, d := range n.deals {
+		switch d.info.State {
+		case storagemarket.StorageDealCheckForAcceptance:
+			if height < d.acceptedEpoch {
+				continue
+			}
+			m, _ := n.minerByAddr(d.info.Provider)
+			if reason := m.reject(d, n.head().Height()); reason != "" {
+				n.failDeal(d, reason)
+				continue
+			}
+			n.lastDealID++
+			d.info.DealID = n.lastDealID
+			d.info.State = storagemarket.StorageDealSealing
+			d.activeEpoch = height + n.conf.dealSealingEpochs
+			n.dealIDs[d.info.DealID] = d
+		case storagemarket.StorageDealSealing:
+			if height >= d.activeEpoch {
+				d.info.State = storagemarket.StorageDealActive
+			}
+		}
+	}
+}
+
+// failDeal moves a deal to the error state, unlocking its escrow.
+func (n *Node) failDeal(d *deal, reason string) {
+	mb := n.escrow(d.proposal.Client)
+	mb.Locked = big.Sub(mb.Locked, totalStorageFee(d.proposal))
+	n.escrows[d.proposal.Client] = &mb
+	d.info.State = storagemarket.StorageDealError
+	d.info.Message = reason
+}
+
+func (n *Node) minerByAddr(maddr address.Address) (*miner, bool) {
+	for _, m := range n.miners {
+		if m.addr == maddr {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// queryOffer returns the retrieval offer of m for root, if m has an
+// active deal for it.
+func (n *Node) queryOffer(m *miner, root cid.Cid, piece *cid.Cid) (api.QueryOffer, bool) {
+	for _, d := range n.deals {
+		if d.info.Provider != m.addr || d.info.State != storagemarket.StorageDealActive || !d.info.DataRef.Root.Equals(root) {
+			continue
+		}
+		if piece != nil && !d.info.PieceCID.Equals(*piece) {
+			continue
+		}
+		pcid := d.info.PieceCID
+		return api.QueryOffer{
+			Root:                    root,
+			Piece:                   &pcid,
+			Size:                    uint64(n.payloadSize(root)),
+			MinPrice:                big.Zero(),
+			UnsealPrice:             big.Zero(),
+			PaymentInterval:         1 << 20,
+			PaymentIntervalIncrease: 1 << 20,
+			Miner:                   m.addr,
+			MinerPeer: retrievalmarket.RetrievalPeer{
+				Address:  m.addr,
+				ID:       m.peerID,
+				PieceCID: &pcid,
+			},
+		}, true
+	}
+	return api.QueryOffer{}, false
+}
+
+func (n *Node) payloadSize(root cid.Cid) int64 {
+	if data, ok := n.imports[root]; ok {
+		return int64(len(data))
+	}
+	return defaultPayloadSize
+}
+
+func (m *miner) power() abi.StoragePower {
+	if m.conf.Power.Int == nil {
+		return big.Zero()
+	}
+	return m.conf.Power
+}
+
+func (m *miner) ask(height abi.ChainEpoch) *storagemarket.StorageAsk {
+	return &storagemarket.StorageAsk{
+		Price:         m.conf.AskPrice,
+		VerifiedPrice: m.conf.AskPrice,
+		MinPieceSize:  m.conf.MinPieceSize,
+		MaxPieceSize:  m.conf.MaxPieceSize,
+		Miner:         m.addr,
+		Timestamp:     height,
+		Expiry:        height + 10000,
+	}
+}
+
+// reject returns the reason why m rejects d, or an empty string if
+// the deal is accepted.
+func (m *miner) reject(d *deal, height abi.ChainEpoch) string {
+	ask := m.ask(height)
+	pieceSize := d.proposal.PieceSize
+	switch {
+	case pieceSize < ask.MinPieceSize:
+		return fmt.Sprintf("deal rejected: piece size less than minimum required size: %d < %d", pieceSize, ask.MinPieceSize)
+	case pieceSize > ask.MaxPieceSize:
+		return fmt.Sprintf("deal rejected: piece size more than maximum allowed size: %d > %d", pieceSize, ask.MaxPieceSize)
+	}
+	minPrice := big.Div(big.Mul(ask.Price, abi.NewStoragePower(int64(pieceSize))), big.NewInt(1<<30))
+	if d.proposal.StoragePricePerEpoch.LessThan(minPrice) {
+		return fmt.Sprintf("deal rejected: storage price per epoch less than asking price: %s < %s", d.proposal.StoragePricePerEpoch, minPrice)
+	}
+	return ""
+}
+
+func totalStorageFee(p market.DealProposal) abi.TokenAmount {
+	return big.Mul(p.StoragePricePerEpoch, big.NewInt(int64(p.EndEpoch-p.StartEpoch)))
+}
+
+// dataCid returns a placeholder root for data.
+func dataCid(data []byte) cid.Cid {
+	h := sha256.Sum256(data)
+	mh, err := multihash.Encode(h[:], multihash.SHA2_256)
+	if err != nil {
+		panic(err)
+	}
+	return cid.NewCidV1(cid.Raw, mh)
+}
+
+// pieceCid returns a placeholder piece commitment for root.
+func pieceCid(root cid.Cid) (cid.Cid, error) {
+	h := sha256.Sum256(root.Bytes())
+	c, err := commcid.DataCommitmentV1ToCID(h[:])
+	if err != nil {
+		return cid.Undef, fmt.Errorf("creating piece cid: %s", err)
+	}
+	return c, nil
+}
+
+// paddedSize returns the padded piece size of a payload size.
+func paddedSize(size int64) abi.PaddedPieceSize {
+	padded := uint64(size) * 128 / 127
+	if padded < 256 {
+		return 256
+	}
+	return abi.PaddedPieceSize(1 << bits.Len64(padded-1))
+}
@@ -0,0 +1,69 @@
+package fakelotus
+
+import (
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// MinerConfig is the configuration of simulated miners.
+type MinerConfig struct {
+	// AskPrice is the price in attoFIL per GiB per epoch.
+	AskPrice abi.TokenAmount
+	// MinPieceSize and MaxPieceSize bound the piece size of accepted deals.
+	MinPieceSize abi.PaddedPieceSize
+	MaxPieceSize abi.PaddedPieceSize
+	// Power is the raw byte power of the miner.
+	Power abi.StoragePower
+}
+
+// DefaultMinerConfig returns the configuration of simulated miners.
+func DefaultMinerConfig() MinerConfig {
+	return MinerConfig{
+		AskPrice:     types.NewInt(500_000_000),
+		MinPieceSize: 256,
+		MaxPieceSize: 32 << 30,
+		Power:        abi.NewStoragePower(32 << 30),
+	}
+}
+
+type config struct {
+	blockTime         time.Duration
+	miners            int
+	dealSealingEpochs abi.ChainEpoch
+}
+
+func defaultConfig() config {
+	return config{
+		blockTime:         time.Millisecond * 100,
+		miners:            1,
+		dealSealingEpochs: 3,
+	}
+}
+
+// Option configures a Node.
+type Option func(*config)
+
+// WithBlockTime sets the time between tipsets. If zero, the chain
+// only advances when calling Node.Advance.
+func WithBlockTime(d time.Duration) Option {
+	return func(c *config) {
+		c.blockTime = d
+	}
+}
+
+// WithMiners creates count miners configured with DefaultMinerConfig.
+func WithMiners(count int) Option {
+	return func(c *config) {
+		c.miners = count
+	}
+}
+
+// WithDealSealingEpochs sets the number of epochs a deal spends sealing
+// after being accepted, before becoming active.
+func WithDealSealingEpochs(epochs abi.ChainEpoch) Option {
+	return func(c *config) {
+		c.dealSealingEpochs = epochs
+	}
+}
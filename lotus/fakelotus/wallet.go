@@ -0,0 +1,395 @@
+package fakelotus
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/go-state-types/exitcode"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/market"
+	"github.com/filecoin-project/lotus/chain/types"
+	builtin2 "github.com/filecoin-project/specs-actors/v2/actors/builtin"
+	"github.com/ipfs/go-cid"
+)
+
+// message is a pushed message, and its execution result once included
+// in a tipset.
+type message struct {
+	msg      *types.Message
+	executed bool
+	height   abi.ChainEpoch
+	tsk      types.TipSetKey
+	exitCode exitcode.ExitCode
+}
+
+func (n *Node) registerWallet(c *apistruct.FullNodeStruct) {
+	c.Internal.WalletNew = n.walletNew
+	c.Internal.WalletList = n.walletList
+	c.Internal.WalletBalance = n.walletBalance
+	c.Internal.WalletSign = n.walletSign
+	c.Internal.WalletVerify = n.walletVerify
+	c.Internal.WalletDefaultAddress = n.walletDefaultAddress
+	c.Internal.WalletExport = n.walletExport
+	c.Internal.WalletImport = n.walletImport
+	c.Internal.WalletDelete = n.walletDelete
+	c.Internal.MpoolPushMessage = n.mpoolPushMessage
+	c.Internal.ChainGetMessage = n.chainGetMessage
+	c.Internal.StateSearchMsg = n.stateSearchMsg
+	c.Internal.StateWaitMsg = n.stateWaitMsg
+	c.Internal.StateListMessages = n.stateListMessages
+	c.Internal.StateGetActor = n.stateGetActor
+	c.Internal.StateMarketBalance = n.stateMarketBalance
+}
+
+func (n *Node) walletNew(ctx context.Context, kt types.KeyType) (address.Address, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.newAddress(kt)
+}
+
+func (n *Node) walletList(ctx context.Context) ([]address.Address, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	res := make([]address.Address, len(n.walletOrder))
+	copy(res, n.walletOrder)
+	return res, nil
+}
+
+func (n *Node) walletBalance(ctx context.Context, addr address.Address) (types.BigInt, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.balance(addr), nil
+}
+
+func (n *Node) walletSign(ctx context.Context, addr address.Address, msg []byte) (*crypto.Signature, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if _, ok := n.wallet[addr]; !ok {
+		return nil, fmt.Errorf("signing using key '%s': key not found", addr)
+	}
+	return sign(addr, msg), nil
+}
+
+func (n *Node) walletVerify(ctx context.Context, addr address.Address, msg []byte, sig *crypto.Signature) (bool, error) {
+	expected := sign(addr, msg)
+	return sig.Type == expected.Type && bytes.Equal(sig.Data, expected.Data), nil
+}
+
+func (n *Node) walletDefaultAddress(ctx context.Context) (address.Address, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.defaultAddr, nil
+}
+
+func (n *Node) walletExport(ctx context.Context, addr address.Address) (*types.KeyInfo, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	ki, ok := n.wallet[addr]
+	if !ok {
+		return nil, fmt.Errorf("key not found")
+	}
+	return &types.KeyInfo{Type: ki.Type, PrivateKey: append([]byte(nil), ki.PrivateKey...)}, nil
+}
+
+func (n *Node) walletImport(ctx context.Context, ki *types.KeyInfo) (address.Address, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.addKey(types.KeyInfo{Type: ki.Type, PrivateKey: append([]byte(nil), ki.PrivateKey...)})
+}
+
+func (n *Node) walletDelete(ctx context.Context, addr address.Address) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if _, ok := n.wallet[addr]; !ok {
+		return fmt.Errorf("key not found")
+	}
+	delete(n.wallet, addr)
+	for i, a := range n.walletOrder {
+		if a == addr {
+			n.walletOrder = append(n.walletOrder[:i], n.walletOrder[i+1:]...)
+			break
+		}
+	}
+	if n.defaultAddr == addr {
+		n.defaultAddr = address.Undef
+	}
+	return nil
+}
+
+// mpoolPushMessage assigns the nonce, gas and signature of msg, and adds
+// it to the pending messages which are executed in the next tipset.
+func (n *Node) mpoolPushMessage(ctx context.Context, msg *types.Message, spec *api.MessageSendSpec) (*types.SignedMessage, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if _, ok := n.wallet[msg.From]; !ok {
+		return nil, fmt.Errorf("mpool push: getting key: key not found")
+	}
+	m := *msg
+	if m.Value.Int == nil {
+		m.Value = types.NewInt(0)
+	}
+	if m.Value.GreaterThan(n.balance(m.From)) {
+		return nil, fmt.Errorf("mpool push: not enough funds: %s < %s", n.balance(m.From), m.Value)
+	}
+	m.Nonce = n.nonces[m.From]
+	n.nonces[m.From]++
+	m.GasLimit = 1_000_000
+	m.GasFeeCap = types.NewInt(100)
+	m.GasPremium = types.NewInt(100)
+
+	smsg := &types.SignedMessage{
+		Message:   m,
+		Signature: *sign(m.From, m.Cid().Bytes()),
+	}
+	c := smsg.Cid()
+	n.msgs[c] = &message{msg: &m}
+	n.pendingMsgs = append(n.pendingMsgs, c)
+	return smsg, nil
+}
+
+func (n *Node) chainGetMessage(ctx context.Context, c cid.Cid) (*types.Message, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.msgs[c]
+	if !ok {
+		return nil, fmt.Errorf("message %s not found", c)
+	}
+	msg := *m.msg
+	return &msg, nil
+}
+
+// stateSearchMsg returns the execution result of a message, or nil if it
+// wasn't executed yet.
+func (n *Node) stateSearchMsg(ctx context.Context, c cid.Cid) (*api.MsgLookup, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	m, ok := n.msgs[c]
+	if !ok || !m.executed {
+		return nil, nil
+	}
+	return lookup(c, m), nil
+}
+
+// stateWaitMsg waits until a message was executed confidence epochs ago.
+func (n *Node) stateWaitMsg(ctx context.Context, c cid.Cid, confidence uint64) (*api.MsgLookup, error) {
+	for {
+		n.lock.Lock()
+		m, ok := n.msgs[c]
+		if !ok {
+			n.lock.Unlock()
+			return nil, fmt.Errorf("message %s not found", c)
+		}
+		if m.executed && n.head().Height() >= m.height+abi.ChainEpoch(confidence) {
+			res := lookup(c, m)
+			n.lock.Unlock()
+			return res, nil
+		}
+		newHead := n.newHead
+		n.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-n.ctx.Done():
+			return nil, fmt.Errorf("fake lotus node is closed")
+		case <-newHead:
+		}
+	}
+}
+
+// stateListMessages returns executed messages matching the sender or
+// receiver of match, between heights toht and tsk.
+func (n *Node) stateListMessages(ctx context.Context, match *api.MessageMatch, tsk types.TipSetKey, toht abi.ChainEpoch) ([]cid.Cid, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if match.To == address.Undef && match.From == address.Undef {
+		return nil, fmt.Errorf("must specify at least To or From in message filter")
+	}
+	ts, err := n.tipSetByKey(tsk)
+	if err != nil {
+		return nil, fmt.Errorf("loading tipset %s: %s", tsk, err)
+	}
+	var res []cid.Cid
+	for c, m := range n.msgs {
+		if !m.executed || m.height < toht || m.height > ts.Height() {
+			continue
+		}
+		if match.To != address.Undef && match.To != m.msg.To {
+			continue
+		}
+		if match.From != address.Undef && match.From != m.msg.From {
+			continue
+		}
+		res = append(res, c)
+	}
+	return res, nil
+}
+
+func (n *Node) stateGetActor(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.Actor, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if _, ok := n.balances[addr]; !ok {
+		return nil, fmt.Errorf("actor not found")
+	}
+	return &types.Actor{
+		Code:    builtin2.AccountActorCodeID,
+		Head:    fakeCid("actor-" + addr.String()),
+		Nonce:   n.nonces[addr],
+		Balance: n.balance(addr),
+	}, nil
+}
+
+func (n *Node) stateMarketBalance(ctx context.Context, addr address.Address, tsk types.TipSetKey) (api.MarketBalance, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.escrow(addr), nil
+}
+
+// executeMessages executes pending messages, as included in ts.
+func (n *Node) executeMessages(ts *types.TipSet) {
+	for _, c := range n.pendingMsgs {
+		m := n.msgs[c]
+		m.executed = true
+		m.height = ts.Height()
+		m.tsk = ts.Key()
+		m.exitCode = n.applyMessage(m.msg)
+		if m.exitCode != exitcode.Ok {
+			log.Infof("message %s failed with exit code %s", c, m.exitCode)
+		}
+	}
+	n.pendingMsgs = nil
+}
+
+// applyMessage applies the value transfer of msg, and the escrow changes
+// of storage market messages.
+func (n *Node) applyMessage(msg *types.Message) exitcode.ExitCode {
+	if msg.Value.GreaterThan(n.balance(msg.From)) {
+		return exitcode.SysErrInsufficientFunds
+	}
+	if msg.To != market.Address {
+		n.transfer(msg.From, msg.To, msg.Value)
+		return exitcode.Ok
+	}
+	switch msg.Method {
+	case market.Methods.AddBalance:
+		var addr address.Address
+		if err := addr.UnmarshalCBOR(bytes.NewReader(msg.Params)); err != nil {
+			return exitcode.ErrSerialization
+		}
+		n.balances[msg.From] = big.Sub(n.balance(msg.From), msg.Value)
+		mb := n.escrow(addr)
+		mb.Escrow = big.Add(mb.Escrow, msg.Value)
+		n.escrows[addr] = &mb
+	case market.Methods.WithdrawBalance:
+		var params market.WithdrawBalanceParams
+		if err := params.UnmarshalCBOR(bytes.NewReader(msg.Params)); err != nil {
+			return exitcode.ErrSerialization
+		}
+		mb := n.escrow(params.ProviderOrClientAddress)
+		amount := big.Min(params.Amount, big.Sub(mb.Escrow, mb.Locked))
+		mb.Escrow = big.Sub(mb.Escrow, amount)
+		n.escrows[params.ProviderOrClientAddress] = &mb
+		n.balances[msg.From] = big.Add(n.balance(msg.From), amount)
+	default:
+		return exitcode.SysErrInvalidMethod
+	}
+	return exitcode.Ok
+}
+
+func (n *Node) transfer(from, to address.Address, amount abi.TokenAmount) {
+	n.balances[from] = big.Sub(n.balance(from), amount)
+	n.balances[to] = big.Add(n.balance(to), amount)
+}
+
+func (n *Node) balance(addr address.Address) types.BigInt {
+	b, ok := n.balances[addr]
+	if !ok {
+		return types.NewInt(0)
+	}
+	return b
+}
+
+func (n *Node) escrow(addr address.Address) api.MarketBalance {
+	mb, ok := n.escrows[addr]
+	if !ok {
+		return api.MarketBalance{Escrow: big.Zero(), Locked: big.Zero()}
+	}
+	return *mb
+}
+
+// newAddress creates a new key of type kt in the wallet.
+func (n *Node) newAddress(kt types.KeyType) (address.Address, error) {
+	if kt != types.KTBLS && kt != types.KTSecp256k1 {
+		return address.Undef, fmt.Errorf("unsupported key type: %s", kt)
+	}
+	pk := make([]byte, 32)
+	if _, err := rand.Read(pk); err != nil {
+		return address.Undef, fmt.Errorf("generating private key: %s", err)
+	}
+	return n.addKey(types.KeyInfo{Type: kt, PrivateKey: pk})
+}
+
+func (n *Node) addKey(ki types.KeyInfo) (address.Address, error) {
+	addr, err := keyAddress(ki)
+	if err != nil {
+		return address.Undef, err
+	}
+	if _, ok := n.wallet[addr]; !ok {
+		n.walletOrder = append(n.walletOrder, addr)
+	}
+	n.wallet[addr] = ki
+	if n.defaultAddr == address.Undef {
+		n.defaultAddr = addr
+	}
+	return addr, nil
+}
+
+// keyAddress derives the address of a key. Public keys are placeholders
+// derived from the private key hash, with the length of real ones.
+func keyAddress(ki types.KeyInfo) (address.Address, error) {
+	if len(ki.PrivateKey) == 0 {
+		return address.Undef, fmt.Errorf("empty private key")
+	}
+	h := sha512.Sum512(ki.PrivateKey)
+	switch ki.Type {
+	case types.KTBLS:
+		return address.NewBLSAddress(h[:48])
+	case types.KTSecp256k1:
+		return address.NewSecp256k1Address(append([]byte{0x04}, h[:]...))
+	default:
+		return address.Undef, fmt.Errorf("unsupported key type: %s", ki.Type)
+	}
+}
+
+// sign returns a placeholder signature of msg by addr.
+func sign(addr address.Address, msg []byte) *crypto.Signature {
+	h := sha256.New()
+	_, _ = h.Write(addr.Bytes())
+	_, _ = h.Write(msg)
+	sigType := crypto.SigTypeSecp256k1
+	if addr.Protocol() == address.BLS {
+		sigType = crypto.SigTypeBLS
+	}
+	return &crypto.Signature{Type: sigType, Data: h.Sum(nil)}
+}
+
+func lookup(c cid.Cid, m *message) *api.MsgLookup {
+	return &api.MsgLookup{
+		Message: c,
+		Receipt: types.MessageReceipt{
+			ExitCode: m.exitCode,
+			GasUsed:  m.msg.GasLimit,
+		},
+		TipSet: m.tsk,
+		Height: m.height,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 )
@@ -107,9 +108,25 @@ func (lsm *SyncMonitor) refreshSyncDiff() error {
 
 	ctx, cls := context.WithTimeout(context.Background(), time.Second*5)
 	defer cls()
+	maxHeightDiff, remaining, err := syncHeightDiff(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	lsm.lock.Lock()
+	lsm.heightDiff = maxHeightDiff
+	lsm.remaining = remaining
+	lsm.lock.Unlock()
+
+	return nil
+}
+
+// syncHeightDiff returns the maximum height difference of active syncs
+// of a Lotus node, and the remaining height to sync of that active sync.
+func syncHeightDiff(ctx context.Context, c *apistruct.FullNodeStruct) (int64, int64, error) {
 	ss, err := c.SyncState(ctx)
 	if err != nil {
-		return fmt.Errorf("calling sync state: %s", err)
+		return 0, 0, fmt.Errorf("calling sync state: %s", err)
 	}
 
 	var maxHeightDiff, remaining int64
@@ -131,11 +148,5 @@ func (lsm *SyncMonitor) refreshSyncDiff() error {
 			}
 		}
 	}
-
-	lsm.lock.Lock()
-	lsm.heightDiff = maxHeightDiff
-	lsm.remaining = remaining
-	lsm.lock.Unlock()
-
-	return nil
+	return maxHeightDiff, remaining, nil
 }
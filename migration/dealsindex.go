@@ -0,0 +1,116 @@
+package migration
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/util"
+)
+
+var (
+	dealsBase               = datastore.NewKey("/deals")
+	dealsBaseStoragePending = dealsBase.ChildString("storage-pending")
+	dealsBaseStorageFinal   = dealsBase.ChildString("storage-final")
+	dealsBaseRetrieval      = dealsBase.ChildString("retrieval")
+	dealsBaseStorageIndex   = datastore.NewKey("storage-index")
+	dealsBaseRetrievalIndex = datastore.NewKey("retrieval-index")
+	dealsBaseIndexAddrs     = dealsBase.ChildString("index-addrs")
+	dealsBaseSummaries      = dealsBase.ChildString("summaries")
+	dealsKeyIndexVersion    = dealsBase.ChildString("index-version")
+)
+
+// indexDealRecords builds the index of the deal records of the deals
+// module, which lists records of a wallet address ordered by time:
+// - /deals/storage-index/<addr>/<time>/<proposal-cid>
+// - /deals/retrieval-index/<addr>/<time>/<retrieval-id>
+// Index entries are copies of the records. Addresses with records are
+// saved in /deals/index-addrs/<index-base>/<addr>. Records are summarized
+// by address and miner in /deals/summaries/<record-base>/<addr>/<miner>,
+// where <miner> is "-" for records without a miner.
+func indexDealRecords(txn datastore.Txn) error {
+	summaries := make(map[datastore.Key]*deals.DealRecordsSummary)
+	summarize := func(base datastore.Key, addr, miner string, attoFil *big.Int, size uint64) {
+		if miner == "" {
+			miner = "-"
+		}
+		key := dealsBaseSummaries.ChildString(base.Name()).ChildString(addr).ChildString(miner)
+		sum, ok := summaries[key]
+		if !ok {
+			sum = &deals.DealRecordsSummary{TotalAttoFil: big.NewInt(0)}
+			summaries[key] = sum
+		}
+		sum.Count++
+		sum.TotalAttoFil.Add(sum.TotalAttoFil, attoFil)
+		sum.TotalBytes += size
+	}
+
+	// Final records are indexed last, so they override pending records
+	// of the same deal.
+	for _, base := range []datastore.Key{dealsBaseStoragePending, dealsBaseStorageFinal} {
+		es, err := entries(txn, base)
+		if err != nil {
+			return fmt.Errorf("getting %s records: %s", base, err)
+		}
+		for _, e := range es {
+			var dr deals.StorageDealRecord
+			if err := json.Unmarshal(e.value, &dr); err != nil {
+				return fmt.Errorf("unmarshaling storage record %s: %s", e.key, err)
+			}
+			id := util.CidToString(dr.DealInfo.ProposalCid)
+			if err := putIndexEntry(txn, dealsBaseStorageIndex, dr.Addr, dr.Time, id, e.value); err != nil {
+				return err
+			}
+			price := new(big.Int).SetUint64(dr.DealInfo.PricePerEpoch)
+			price.Mul(price, new(big.Int).SetUint64(dr.DealInfo.Duration))
+			summarize(base, dr.Addr, dr.DealInfo.Miner, price, dr.DealInfo.Size)
+		}
+	}
+
+	es, err := entries(txn, dealsBaseRetrieval)
+	if err != nil {
+		return fmt.Errorf("getting retrieval records: %s", err)
+	}
+	for _, e := range es {
+		var rr deals.RetrievalDealRecord
+		if err := json.Unmarshal(e.value, &rr); err != nil {
+			return fmt.Errorf("unmarshaling retrieval record %s: %s", e.key, err)
+		}
+		str := fmt.Sprintf("%v%v%v%v", rr.Time, rr.Addr, rr.DealInfo.Miner, util.CidToString(rr.DealInfo.RootCid))
+		sum := md5.Sum([]byte(str))
+		if err := putIndexEntry(txn, dealsBaseRetrievalIndex, rr.Addr, rr.Time, fmt.Sprintf("%x", sum[:]), e.value); err != nil {
+			return err
+		}
+		summarize(dealsBaseRetrieval, rr.Addr, rr.DealInfo.Miner, new(big.Int).SetUint64(rr.FundsSpent), rr.DealInfo.Size)
+	}
+
+	for key, sum := range summaries {
+		buf, err := json.Marshal(sum)
+		if err != nil {
+			return fmt.Errorf("marshaling summary: %s", err)
+		}
+		if err := txn.Put(key, buf); err != nil {
+			return fmt.Errorf("put summary: %s", err)
+		}
+	}
+
+	// The index is versioned by the datastore version from now on.
+	if err := txn.Delete(dealsKeyIndexVersion); err != nil && err != datastore.ErrNotFound {
+		return fmt.Errorf("deleting index version: %s", err)
+	}
+	return nil
+}
+
+func putIndexEntry(txn datastore.Txn, base datastore.Key, addr string, t int64, id string, buf []byte) error {
+	key := dealsBase.Child(base).ChildString(addr).ChildString(fmt.Sprintf("%019d", t)).ChildString(id)
+	if err := txn.Put(key, buf); err != nil {
+		return fmt.Errorf("put index entry: %s", err)
+	}
+	if err := txn.Put(dealsBaseIndexAddrs.Child(base).ChildString(addr), []byte{}); err != nil {
+		return fmt.Errorf("put index address: %s", err)
+	}
+	return nil
+}
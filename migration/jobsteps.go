@@ -0,0 +1,38 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/textileio/powergate/ffs"
+)
+
+var sjstoreJobBase = datastore.NewKey("/ffs/scheduler/sjstore/job")
+
+// addJobSteps adds an empty list of execution steps to storage jobs
+// created before steps were recorded.
+func addJobSteps(txn datastore.Txn) error {
+	es, err := entries(txn, sjstoreJobBase)
+	if err != nil {
+		return fmt.Errorf("getting storage jobs: %s", err)
+	}
+	for _, e := range es {
+		var j ffs.StorageJob
+		if err := json.Unmarshal(e.value, &j); err != nil {
+			return fmt.Errorf("unmarshaling storage job %s: %s", e.key, err)
+		}
+		if j.Steps != nil {
+			continue
+		}
+		j.Steps = []ffs.JobStep{}
+		buf, err := json.Marshal(j)
+		if err != nil {
+			return fmt.Errorf("marshaling storage job: %s", err)
+		}
+		if err := txn.Put(e.key, buf); err != nil {
+			return fmt.Errorf("put storage job: %s", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+// Package migration keeps track of the schema version of the Powergate
+// datastore, and runs migrations to upgrade datastores created by older
+// versions of Powergate.
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var (
+	log = logging.Logger("migration")
+
+	// ErrUnknownVersion is returned when the datastore has a newer version
+	// than the latest known migration, i.e: it was used by a newer
+	// version of Powergate.
+	ErrUnknownVersion = errors.New("datastore version is newer than latest known version")
+
+	keyVersion = datastore.NewKey("/migration/version")
+)
+
+// Migration is a datastore schema change.
+type Migration struct {
+	// Version is the datastore version after running the migration.
+	Version int
+	// Description is a short description of what the migration does.
+	Description string
+	// Run runs the migration in txn. Keys are absolute to the root of the
+	// datastore. Migrations should be idempotent, since a migration might
+	// be re-run if the process dies between running it and committing txn.
+	Run func(txn datastore.Txn) error
+}
+
+// Migrator runs pending migrations on a datastore.
+type Migrator struct {
+	ds         datastore.TxnDatastore
+	migrations []Migration
+}
+
+// New returns a new Migrator for ds. Migrations should have strictly
+// increasing versions starting at 1.
+func New(ds datastore.TxnDatastore, migrations []Migration) (*Migrator, error) {
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("migration #%d has version %d, want %d", i, m.Version, i+1)
+		}
+		if m.Run == nil {
+			return nil, fmt.Errorf("migration %d has no run function", m.Version)
+		}
+	}
+	return &Migrator{
+		ds:         ds,
+		migrations: migrations,
+	}, nil
+}
+
+// LatestVersion returns the version of the last known migration.
+func (m *Migrator) LatestVersion() int {
+	return len(m.migrations)
+}
+
+// Version returns the current datastore version. A datastore without a
+// version which contains data was created before versioning existed, and
+// has version 0.
+func (m *Migrator) Version() (int, error) {
+	v, ok, err := m.currentVersion()
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return v, nil
+}
+
+// SetVersion sets the datastore version in w without running migrations.
+// It's useful when populating an empty datastore with data of a known
+// version, where w is usually the transaction writing the data.
+func (m *Migrator) SetVersion(w datastore.Write, version int) error {
+	if version > m.LatestVersion() {
+		return fmt.Errorf("version %d, latest known %d: %w", version, m.LatestVersion(), ErrUnknownVersion)
+	}
+	if err := w.Put(keyVersion, []byte(strconv.Itoa(version))); err != nil {
+		return fmt.Errorf("saving version: %s", err)
+	}
+	return nil
+}
+
+// Migrate runs pending migrations in order. Every migration runs in its
+// own transaction together with the version update, so an interrupted
+// upgrade continues from the last successful migration. If dryRun is
+// true, all pending migrations run in a single transaction which is
+// discarded. An empty datastore is set to the latest version without
+// running migrations.
+func (m *Migrator) Migrate(dryRun bool) error {
+	current, ok, err := m.currentVersion()
+	if err != nil {
+		return err
+	}
+	latest := m.LatestVersion()
+	if !ok {
+		empty, err := m.isEmpty()
+		if err != nil {
+			return err
+		}
+		if empty {
+			log.Infof("initializing empty datastore at version %d", latest)
+			if dryRun {
+				return nil
+			}
+			return m.ds.Put(keyVersion, []byte(strconv.Itoa(latest)))
+		}
+	}
+	if current > latest {
+		return fmt.Errorf("datastore version %d, latest known %d: %w", current, latest, ErrUnknownVersion)
+	}
+	if current == latest {
+		log.Infof("datastore is at latest version %d", latest)
+		return nil
+	}
+
+	if dryRun {
+		return m.dryRun(current)
+	}
+	for _, mig := range m.migrations[current:] {
+		log.Infof("running migration %d: %s", mig.Version, mig.Description)
+		if err := m.run(mig); err != nil {
+			return fmt.Errorf("running migration %d: %s", mig.Version, err)
+		}
+	}
+	log.Infof("datastore migrated from version %d to %d", current, latest)
+	return nil
+}
+
+func (m *Migrator) run(mig Migration) error {
+	txn, err := m.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	if err := mig.Run(txn); err != nil {
+		return err
+	}
+	if err := txn.Put(keyVersion, []byte(strconv.Itoa(mig.Version))); err != nil {
+		return fmt.Errorf("saving version: %s", err)
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	return nil
+}
+
+func (m *Migrator) dryRun(current int) error {
+	txn, err := m.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	for _, mig := range m.migrations[current:] {
+		log.Infof("dry-running migration %d: %s", mig.Version, mig.Description)
+		if err := mig.Run(txn); err != nil {
+			return fmt.Errorf("dry-running migration %d: %s", mig.Version, err)
+		}
+	}
+	log.Infof("datastore can be migrated from version %d to %d", current, m.LatestVersion())
+	return nil
+}
+
+// ReadVersion returns the datastore version read from r, e.g: a
+// transaction of a consistent snapshot, with the same semantics as
+// Version.
+func ReadVersion(r datastore.Read) (int, error) {
+	v, _, err := readVersion(r)
+	return v, err
+}
+
+func (m *Migrator) currentVersion() (int, bool, error) {
+	return readVersion(m.ds)
+}
+
+func readVersion(r datastore.Read) (int, bool, error) {
+	buf, err := r.Get(keyVersion)
+	if err == datastore.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("getting version: %s", err)
+	}
+	v, err := strconv.Atoi(string(buf))
+	if err != nil {
+		return 0, false, fmt.Errorf("parsing version %q: %s", buf, err)
+	}
+	return v, true, nil
+}
+
+func (m *Migrator) isEmpty() (bool, error) {
+	res, err := m.ds.Query(query.Query{KeysOnly: true, Limit: 1})
+	if err != nil {
+		return false, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return false, fmt.Errorf("iterating results: %s", r.Error)
+		}
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,144 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/tests"
+)
+
+var (
+	keyA = datastore.NewKey("/a")
+	keyB = datastore.NewKey("/b")
+)
+
+func TestEmptyDatastore(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	m, err := New(ds, testMigrations())
+	require.NoError(t, err)
+
+	require.NoError(t, m.Migrate(false))
+	requireVersion(t, m, 2)
+	_, err = ds.Get(keyB)
+	require.Equal(t, datastore.ErrNotFound, err)
+}
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	require.NoError(t, ds.Put(keyA, []byte("a")))
+	m, err := New(ds, testMigrations())
+	require.NoError(t, err)
+	requireVersion(t, m, 0)
+
+	require.NoError(t, m.Migrate(true))
+	requireVersion(t, m, 0)
+	_, err = ds.Get(keyB)
+	require.Equal(t, datastore.ErrNotFound, err)
+
+	require.NoError(t, m.Migrate(false))
+	requireVersion(t, m, 2)
+	_, err = ds.Get(keyA)
+	require.Equal(t, datastore.ErrNotFound, err)
+	v, err := ds.Get(keyB)
+	require.NoError(t, err)
+	require.Equal(t, "a-migrated", string(v))
+
+	// Re-running is a no-op.
+	require.NoError(t, m.Migrate(false))
+	requireVersion(t, m, 2)
+}
+
+func TestFailedMigration(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	require.NoError(t, ds.Put(keyA, []byte("a")))
+	migs := testMigrations()
+	migs = append(migs, Migration{
+		Version: 3,
+		Run: func(txn datastore.Txn) error {
+			if err := txn.Put(keyA, []byte("broken")); err != nil {
+				return err
+			}
+			return fmt.Errorf("boom")
+		},
+	})
+	m, err := New(ds, migs)
+	require.NoError(t, err)
+
+	require.Error(t, m.Migrate(false))
+	requireVersion(t, m, 2)
+	_, err = ds.Get(keyA)
+	require.Equal(t, datastore.ErrNotFound, err)
+}
+
+func TestUnknownVersion(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	require.NoError(t, ds.Put(keyVersion, []byte("3")))
+	m, err := New(ds, testMigrations())
+	require.NoError(t, err)
+
+	err = m.Migrate(false)
+	require.True(t, errors.Is(err, ErrUnknownVersion))
+}
+
+func TestInvalidMigrations(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	noop := func(datastore.Txn) error { return nil }
+	_, err := New(ds, []Migration{{Version: 2, Run: noop}})
+	require.Error(t, err)
+	_, err = New(ds, []Migration{{Version: 1}})
+	require.Error(t, err)
+	_, err = New(ds, Migrations())
+	require.NoError(t, err)
+}
+
+// testMigrations renames key a to b in version 1, and updates b in
+// version 2.
+func testMigrations() []Migration {
+	return []Migration{
+		{
+			Version:     1,
+			Description: "rename a to b",
+			Run: func(txn datastore.Txn) error {
+				v, err := txn.Get(keyA)
+				if err == datastore.ErrNotFound {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if err := txn.Put(keyB, v); err != nil {
+					return err
+				}
+				return txn.Delete(keyA)
+			},
+		},
+		{
+			Version:     2,
+			Description: "update b",
+			Run: func(txn datastore.Txn) error {
+				v, err := txn.Get(keyB)
+				if err == datastore.ErrNotFound {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				return txn.Put(keyB, append(v, []byte("-migrated")...))
+			},
+		},
+	}
+}
+
+func requireVersion(t *testing.T, m *Migrator, version int) {
+	v, err := m.Version()
+	require.NoError(t, err)
+	require.Equal(t, version, v)
+}
@@ -0,0 +1,60 @@
+package migration
+
+import (
+	"fmt"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+// Migrations returns the known migrations of the Powergate datastore. New
+// migrations should be appended with the next version whenever persisted
+// data changes in an incompatible way.
+func Migrations() []Migration {
+	return []Migration{
+		{
+			Version:     1,
+			Description: "Start versioning the datastore",
+			Run:         func(datastore.Txn) error { return nil },
+		},
+		{
+			Version:     2,
+			Description: "Index deal records by wallet address and time",
+			Run:         indexDealRecords,
+		},
+		{
+			Version:     3,
+			Description: "Track active deals of stored data on-chain",
+			Run:         trackActiveDeals,
+		},
+		{
+			Version:     4,
+			Description: "Add execution steps to storage jobs",
+			Run:         addJobSteps,
+		},
+	}
+}
+
+type entry struct {
+	key   datastore.Key
+	value []byte
+}
+
+// entries returns all the entries of txn under prefix. Entries are loaded
+// before being modified, since iterating and writing in the same
+// transaction isn't supported by every datastore.
+func entries(txn datastore.Txn, prefix datastore.Key) ([]entry, error) {
+	res, err := txn.Query(query.Query{Prefix: prefix.String()})
+	if err != nil {
+		return nil, fmt.Errorf("executing query: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var es []entry
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		es = append(es, entry{key: datastore.NewKey(r.Key), value: r.Value})
+	}
+	return es, nil
+}
@@ -0,0 +1,129 @@
+package migration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+)
+
+func TestIndexDealRecords(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	prop := makeCid(t, "QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	dr := deals.StorageDealRecord{Addr: "a", Time: 1, DealInfo: deals.StorageDealInfo{ProposalCid: prop}}
+	putJSON(t, ds, "/deals/storage-final/"+prop.String(), dr)
+	rr := deals.RetrievalDealRecord{Addr: "b", Time: 2, DealInfo: deals.RetrievalDealInfo{RootCid: prop, Miner: "t01000"}}
+	putJSON(t, ds, "/deals/retrieval/id", rr)
+	require.NoError(t, ds.Put(datastore.NewKey("/deals/index-version"), []byte("1")))
+
+	runMigration(t, ds, indexDealRecords)
+	runMigration(t, ds, indexDealRecords)
+
+	keys := dumpKeys(t, ds, "/deals")
+	require.Len(t, keys, 8)
+	require.Contains(t, keys, "/deals/summaries/storage-final/a/-")
+	require.Contains(t, keys, "/deals/summaries/retrieval/b/t01000")
+	require.Contains(t, keys, "/deals/storage-index/a/0000000000000000001/"+prop.String())
+	require.Contains(t, keys, "/deals/index-addrs/storage-index/a")
+	require.Contains(t, keys, "/deals/index-addrs/retrieval-index/b")
+	buf, err := ds.Get(datastore.NewKey("/deals/storage-index/a/0000000000000000001/" + prop.String()))
+	require.NoError(t, err)
+	var idr deals.StorageDealRecord
+	require.NoError(t, json.Unmarshal(buf, &idr))
+	require.Equal(t, dr, idr)
+}
+
+func TestTrackActiveDeals(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	data := makeCid(t, "QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	prop1 := makeCid(t, "QmbbSsUoGmUs4zSpZMq5WhBNDFX8V4TFHyF5J6rWjsKfwH")
+	prop2 := makeCid(t, "QmZxfp3HkeL8Y3v8sq77gyyjCrDvDLzRKCgTQdFvBBsPbD")
+	prop3 := makeCid(t, "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	putJSON(t, ds, "/deals/storage-final/"+prop1.String(), deals.StorageDealRecord{DealInfo: deals.StorageDealInfo{ProposalCid: prop1, DealID: 10}})
+	putJSON(t, ds, "/deals/storage-final/"+prop3.String(), deals.StorageDealRecord{DealInfo: deals.StorageDealInfo{ProposalCid: prop3, DealID: 30}})
+	info := ffs.StorageInfo{Cid: data}
+	info.Cold.Filecoin.Proposals = []ffs.FilStorage{
+		// Deal made before deal ids were saved.
+		{ProposalCid: prop1, StartEpoch: 100, Duration: 1000},
+		// Deal without a record.
+		{ProposalCid: prop2, StartEpoch: 100, Duration: 1000},
+		// Deal which isn't active anymore.
+		{ProposalCid: prop3, StartEpoch: 100, Duration: 1000, DealID: 30, State: ffs.DealSlashed},
+	}
+	putJSON(t, ds, "/ffs/scheduler/cistore/"+data.String(), info)
+
+	runMigration(t, ds, trackActiveDeals)
+	runMigration(t, ds, trackActiveDeals)
+
+	require.Equal(t, []string{"/ffs/dealtracker/deals/10"}, dumpKeys(t, ds, "/ffs/dealtracker"))
+	buf, err := ds.Get(datastore.NewKey("/ffs/dealtracker/deals/10"))
+	require.NoError(t, err)
+	var td trackedDeal
+	require.NoError(t, json.Unmarshal(buf, &td))
+	require.Equal(t, trackedDeal{Cid: data, EndEpoch: 1100}, td)
+
+	buf, err = ds.Get(datastore.NewKey("/ffs/scheduler/cistore/" + data.String()))
+	require.NoError(t, err)
+	var minfo ffs.StorageInfo
+	require.NoError(t, json.Unmarshal(buf, &minfo))
+	require.Equal(t, uint64(10), minfo.Cold.Filecoin.Proposals[0].DealID)
+	require.Zero(t, minfo.Cold.Filecoin.Proposals[1].DealID)
+}
+
+func TestAddJobSteps(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	jid := ffs.NewJobID()
+	putJSON(t, ds, "/ffs/scheduler/sjstore/job/"+jid.String(), ffs.StorageJob{ID: jid, Status: ffs.Success})
+
+	runMigration(t, ds, addJobSteps)
+
+	buf, err := ds.Get(datastore.NewKey("/ffs/scheduler/sjstore/job/" + jid.String()))
+	require.NoError(t, err)
+	var j ffs.StorageJob
+	require.NoError(t, json.Unmarshal(buf, &j))
+	require.NotNil(t, j.Steps)
+	require.Empty(t, j.Steps)
+	require.Equal(t, ffs.Success, j.Status)
+}
+
+func runMigration(t *testing.T, ds datastore.TxnDatastore, run func(datastore.Txn) error) {
+	txn, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn.Discard()
+	require.NoError(t, run(txn))
+	require.NoError(t, txn.Commit())
+}
+
+func putJSON(t *testing.T, ds datastore.Datastore, key string, v interface{}) {
+	buf, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.NoError(t, ds.Put(datastore.NewKey(key), buf))
+}
+
+func dumpKeys(t *testing.T, ds datastore.Datastore, prefix string) []string {
+	res, err := ds.Query(query.Query{Prefix: prefix, KeysOnly: true})
+	require.NoError(t, err)
+	es, err := res.Rest()
+	require.NoError(t, err)
+	keys := make([]string, len(es))
+	for i, e := range es {
+		keys[i] = e.Key
+	}
+	return keys
+}
+
+func makeCid(t *testing.T, s string) cid.Cid {
+	c, err := util.CidFromString(s)
+	require.NoError(t, err)
+	return c
+}
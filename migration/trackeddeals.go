@@ -0,0 +1,90 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/util"
+)
+
+var (
+	cistoreBase      = datastore.NewKey("/ffs/scheduler/cistore")
+	trackedDealsBase = datastore.NewKey("/ffs/dealtracker/deals")
+)
+
+// trackedDeal is a deal followed on-chain by the deal tracker.
+type trackedDeal struct {
+	Cid      cid.Cid
+	EndEpoch int64
+}
+
+// trackActiveDeals tracks the active deals of every stored Cid in the deal
+// tracker. Deals made before deal ids were saved in the StorageInfo get
+// their id from the final storage deal records of the deals module.
+// Deals without records are left for the scheduler, which gets their id
+// from Lotus and tracks them when it starts.
+func trackActiveDeals(txn datastore.Txn) error {
+	es, err := entries(txn, dealsBaseStorageFinal)
+	if err != nil {
+		return fmt.Errorf("getting final storage records: %s", err)
+	}
+	dealIDs := make(map[string]uint64, len(es))
+	for _, e := range es {
+		var dr deals.StorageDealRecord
+		if err := json.Unmarshal(e.value, &dr); err != nil {
+			return fmt.Errorf("unmarshaling storage record %s: %s", e.key, err)
+		}
+		if dr.DealInfo.DealID != 0 {
+			dealIDs[util.CidToString(dr.DealInfo.ProposalCid)] = dr.DealInfo.DealID
+		}
+	}
+
+	es, err = entries(txn, cistoreBase)
+	if err != nil {
+		return fmt.Errorf("getting storage infos: %s", err)
+	}
+	for _, e := range es {
+		var info ffs.StorageInfo
+		if err := json.Unmarshal(e.value, &info); err != nil {
+			return fmt.Errorf("unmarshaling storage info %s: %s", e.key, err)
+		}
+		var changed bool
+		for i := range info.Cold.Filecoin.Proposals {
+			p := &info.Cold.Filecoin.Proposals[i]
+			if p.DealID == 0 {
+				id, ok := dealIDs[util.CidToString(p.ProposalCid)]
+				if !ok {
+					continue
+				}
+				p.DealID = id
+				changed = true
+			}
+			if p.State != ffs.DealActive {
+				continue
+			}
+			buf, err := json.Marshal(trackedDeal{Cid: info.Cid, EndEpoch: int64(p.StartEpoch) + p.Duration})
+			if err != nil {
+				return fmt.Errorf("marshaling tracked deal: %s", err)
+			}
+			if err := txn.Put(trackedDealsBase.ChildString(strconv.FormatUint(p.DealID, 10)), buf); err != nil {
+				return fmt.Errorf("put tracked deal: %s", err)
+			}
+		}
+		if !changed {
+			continue
+		}
+		buf, err := json.Marshal(info)
+		if err != nil {
+			return fmt.Errorf("marshaling storage info: %s", err)
+		}
+		if err := txn.Put(e.key, buf); err != nil {
+			return fmt.Errorf("put storage info: %s", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package tests
+
+import (
+	"github.com/filecoin-project/go-address"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/lotus"
+	"github.com/textileio/powergate/lotus/fakelotus"
+)
+
+// CreateFakeDevnet returns an API client that targets an in-process fake Lotus
+// node with numMiners number of miners. It's a hermetic alternative to
+// CreateLocalDevnet, which doesn't require docker.
+func CreateFakeDevnet(t TestingTWithCleanup, numMiners int, opts ...fakelotus.Option) (lotus.ClientBuilder, address.Address, []address.Address) {
+	n := CreateFakeLotus(t, append([]fakelotus.Option{fakelotus.WithMiners(numMiners)}, opts...)...)
+	return n.Builder(), n.DefaultAddress(), n.Miners()
+}
+
+// CreateFakeLotus creates an in-process fake Lotus node which is closed
+// when the test finishes. The node allows controlling the simulated chain,
+// e.g: to make miners faulty.
+func CreateFakeLotus(t TestingTWithCleanup, opts ...fakelotus.Option) *fakelotus.Node {
+	n, err := fakelotus.New(opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, n.Close())
+	})
+	return n
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"fmt"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/require"
+)
+
+// LaunchMongoDocker runs a single-node Mongo replica set, which supports
+// transactions, and returns its connection URI.
+func LaunchMongoDocker(t TestingTWithCleanup) string {
+	pool, err := dockertest.NewPool("")
+	require.NoError(t, err)
+	mongo, err := pool.RunWithOptions(&dockertest.RunOptions{Repository: "mongo", Tag: "4.4", Cmd: []string{"--replSet", "rs0"}})
+	require.NoError(t, err)
+	err = mongo.Expire(180)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := pool.Purge(mongo)
+		require.NoError(t, err)
+	})
+
+	// Initiate the replica set and wait until the node is the primary.
+	initiate := `try { rs.initiate({_id: "rs0", members: [{_id: 0, host: "localhost:27017"}]}) } catch (e) {}; quit(db.isMaster().ismaster ? 0 : 1)`
+	err = pool.Retry(func() error {
+		code, err := mongo.Exec([]string{"mongo", "--quiet", "--eval", initiate}, dockertest.ExecOptions{})
+		if err != nil {
+			return err
+		}
+		if code != 0 {
+			return fmt.Errorf("replica set isn't ready")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return "mongodb://127.0.0.1:" + mongo.GetPort("27017/tcp") + "/?connect=direct"
+}
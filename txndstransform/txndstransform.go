@@ -6,6 +6,7 @@ import (
 	ds "github.com/ipfs/go-datastore"
 	kt "github.com/ipfs/go-datastore/keytransform"
 	dsq "github.com/ipfs/go-datastore/query"
+	dsextensions "github.com/textileio/go-datastore-extensions"
 )
 
 // Wrap wraps a TxDatastore with a namespace prefix.
@@ -83,7 +84,7 @@ func (t *txn) GetSize(key ds.Key) (size int, err error) {
 
 // Query implements Query, inverting keys on the way back out.
 func (t *txn) Query(q dsq.Query) (dsq.Results, error) {
-	nq, cq := t.prepareQuery(q)
+	nq, cq := t.ds.prepareQuery(q)
 
 	cqr, err := t.Txn.Query(cq)
 	if err != nil {
@@ -108,20 +109,75 @@ func (t *txn) Query(q dsq.Query) (dsq.Results, error) {
 	return dsq.NaiveQueryApply(nq, qr), nil
 }
 
+// QueryExtended implements dsextensions.ExtendedDatastore, seeking in the
+// child datastore if it supports it, and inverting keys on the way back
+// out. SeekPrefix is relative to the order by key of the query.
+func (d *Datastore) QueryExtended(q dsextensions.QueryExt) (dsq.Results, error) {
+	nq, cq := d.prepareQuery(q.Query)
+	cqe := dsextensions.QueryExt{Query: cq}
+	if q.SeekPrefix != "" {
+		cqe.SeekPrefix = d.ConvertKey(ds.NewKey(q.SeekPrefix)).String()
+	}
+
+	cqr, err := QueryExtended(d.child, cqe)
+	if err != nil {
+		return nil, err
+	}
+
+	qr := dsq.ResultsFromIterator(q.Query, dsq.Iterator{
+		Next: func() (dsq.Result, bool) {
+			r, ok := cqr.NextSync()
+			if !ok {
+				return r, false
+			}
+			if r.Error == nil {
+				r.Entry.Key = d.InvertKey(ds.RawKey(r.Entry.Key)).String()
+			}
+			return r, true
+		},
+		Close: func() error {
+			return cqr.Close()
+		},
+	})
+	return dsq.NaiveQueryApply(nq, qr), nil
+}
+
+// QueryExtended runs q in d. If d doesn't implement
+// dsextensions.ExtendedDatastore, the keys before SeekPrefix in the order
+// by key of q are skipped while iterating.
+func QueryExtended(d ds.Read, q dsextensions.QueryExt) (dsq.Results, error) {
+	if ed, ok := d.(dsextensions.ExtendedDatastore); ok {
+		return ed.QueryExtended(q)
+	}
+	if q.SeekPrefix == "" {
+		return d.Query(q.Query)
+	}
+	op := dsq.GreaterThanOrEqual
+	if len(q.Orders) > 0 {
+		switch q.Orders[0].(type) {
+		case dsq.OrderByKeyDescending, *dsq.OrderByKeyDescending:
+			op = dsq.LessThanOrEqual
+		}
+	}
+	nq := q.Query
+	nq.Filters = append([]dsq.Filter{dsq.FilterKeyCompare{Op: op, Key: ds.NewKey(q.SeekPrefix).String()}}, q.Filters...)
+	return d.Query(nq)
+}
+
 // Split the query into a child query and a naive query. That way, we can make
 // the child datastore do as much work as possible.
-func (t *txn) prepareQuery(q dsq.Query) (naive, child dsq.Query) {
+func (d *Datastore) prepareQuery(q dsq.Query) (naive, child dsq.Query) {
 	// First, put everything in the child query. Then, start taking things
 	// out.
 	child = q
 
 	// Always let the child handle the key prefix.
-	child.Prefix = t.ds.ConvertKey(ds.NewKey(child.Prefix)).String()
+	child.Prefix = d.ConvertKey(ds.NewKey(child.Prefix)).String()
 
 	// Check if the key transform is order-preserving so we can use the
 	// child datastore's built-in ordering.
 	orderPreserving := false
-	switch t.ds.KeyTransform.(type) {
+	switch d.KeyTransform.(type) {
 	case kt.PrefixTransform, *kt.PrefixTransform:
 		orderPreserving = true
 	}
@@ -172,23 +228,23 @@ orders:
 		case dsq.FilterKeyCompare:
 			child.Filters[i] = dsq.FilterKeyCompare{
 				Op:  f.Op,
-				Key: t.ds.ConvertKey(ds.NewKey(f.Key)).String(),
+				Key: d.ConvertKey(ds.NewKey(f.Key)).String(),
 			}
 			continue
 		case *dsq.FilterKeyCompare:
 			child.Filters[i] = &dsq.FilterKeyCompare{
 				Op:  f.Op,
-				Key: t.ds.ConvertKey(ds.NewKey(f.Key)).String(),
+				Key: d.ConvertKey(ds.NewKey(f.Key)).String(),
 			}
 			continue
 		case dsq.FilterKeyPrefix:
 			child.Filters[i] = dsq.FilterKeyPrefix{
-				Prefix: t.ds.ConvertKey(ds.NewKey(f.Prefix)).String(),
+				Prefix: d.ConvertKey(ds.NewKey(f.Prefix)).String(),
 			}
 			continue
 		case *dsq.FilterKeyPrefix:
 			child.Filters[i] = &dsq.FilterKeyPrefix{
-				Prefix: t.ds.ConvertKey(ds.NewKey(f.Prefix)).String(),
+				Prefix: d.ConvertKey(ds.NewKey(f.Prefix)).String(),
 			}
 			continue
 		}
@@ -206,3 +262,4 @@ orders:
 }
 
 var _ ds.TxnDatastore = (*Datastore)(nil)
+var _ dsextensions.ExtendedDatastore = (*Datastore)(nil)
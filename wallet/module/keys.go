@@ -0,0 +1,191 @@
+package module
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/textileio/powergate/wallet"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keystoreVersion = 1
+
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 32
+	maxScryptN   = 1 << 20
+	maxScryptRP  = 16
+)
+
+// encryptedKey is the serialized form of an exported key. The private key
+// is encrypted with AES-GCM using a key derived from a passphrase with scrypt.
+// The header fields are authenticated as additional data, so they can't be
+// modified without failing decryption.
+type encryptedKey struct {
+	Version    int
+	Address    string
+	Type       string
+	Salt       []byte
+	N          int
+	R          int
+	P          int
+	Nonce      []byte
+	Ciphertext []byte `json:",omitempty"`
+}
+
+// ExportKey exports the private key of a wallet address, encrypted with
+// a key derived from passphrase.
+func (m *Module) ExportKey(ctx context.Context, addr string, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase can't be empty")
+	}
+	a, err := address.NewFromString(addr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing address: %s", err)
+	}
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+	ki, err := client.WalletExport(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("exporting key from wallet: %s", err)
+	}
+	return encryptKey(addr, *ki, passphrase)
+}
+
+// ImportKey decrypts a key exported with ExportKey and imports it into the
+// wallet, returning its address. If the address of the imported key doesn't
+// match the exported one, the import is undone and an error is returned.
+func (m *Module) ImportKey(ctx context.Context, key []byte, passphrase string) (string, error) {
+	ki, exported, err := decryptKey(key, passphrase)
+	if err != nil {
+		return "", err
+	}
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return "", fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+	existing, err := client.WalletList(ctx)
+	if err != nil {
+		return "", fmt.Errorf("listing wallet addresses: %s", err)
+	}
+	addr, err := client.WalletImport(ctx, &ki)
+	if err != nil {
+		return "", fmt.Errorf("importing key into wallet: %s", err)
+	}
+	if addr.String() != exported {
+		existed := false
+		for _, a := range existing {
+			if a == addr {
+				existed = true
+				break
+			}
+		}
+		if !existed {
+			if err := client.WalletDelete(ctx, addr); err != nil {
+				log.Errorf("deleting mismatched imported key %s: %s", addr, err)
+			}
+		}
+		return "", fmt.Errorf("imported key address %s doesn't match exported address %s", addr, exported)
+	}
+	return addr.String(), nil
+}
+
+func encryptKey(addr string, ki types.KeyInfo, passphrase string) ([]byte, error) {
+	ek := encryptedKey{
+		Version: keystoreVersion,
+		Address: addr,
+		Type:    string(ki.Type),
+		Salt:    make([]byte, saltLen),
+		N:       scryptN,
+		R:       scryptR,
+		P:       scryptP,
+	}
+	if _, err := rand.Read(ek.Salt); err != nil {
+		return nil, fmt.Errorf("generating salt: %s", err)
+	}
+	aead, err := newAEAD(passphrase, ek)
+	if err != nil {
+		return nil, err
+	}
+	ek.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(ek.Nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %s", err)
+	}
+	ad, err := json.Marshal(ek)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling key header: %s", err)
+	}
+	ek.Ciphertext = aead.Seal(nil, ek.Nonce, ki.PrivateKey, ad)
+	buf, err := json.Marshal(ek)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling encrypted key: %s", err)
+	}
+	return buf, nil
+}
+
+// decryptKey decrypts an exported key, and returns it with its exported address.
+func decryptKey(key []byte, passphrase string) (types.KeyInfo, string, error) {
+	var ek encryptedKey
+	if err := json.Unmarshal(key, &ek); err != nil {
+		return types.KeyInfo{}, "", fmt.Errorf("unmarshaling encrypted key: %s", err)
+	}
+	if ek.Version != keystoreVersion {
+		return types.KeyInfo{}, "", fmt.Errorf("unsupported encrypted key version %d", ek.Version)
+	}
+	if ek.N <= 0 || ek.N > maxScryptN || ek.R <= 0 || ek.R > maxScryptRP || ek.P <= 0 || ek.P > maxScryptRP {
+		return types.KeyInfo{}, "", fmt.Errorf("invalid scrypt parameters N=%d, r=%d, p=%d", ek.N, ek.R, ek.P)
+	}
+	if _, err := address.NewFromString(ek.Address); err != nil {
+		return types.KeyInfo{}, "", fmt.Errorf("invalid address %s: %s", ek.Address, err)
+	}
+	if ek.Type != string(types.KTBLS) && ek.Type != string(types.KTSecp256k1) {
+		return types.KeyInfo{}, "", fmt.Errorf("unknown key type %s", ek.Type)
+	}
+	aead, err := newAEAD(passphrase, ek)
+	if err != nil {
+		return types.KeyInfo{}, "", err
+	}
+	if len(ek.Nonce) != aead.NonceSize() {
+		return types.KeyInfo{}, "", fmt.Errorf("invalid nonce size %d", len(ek.Nonce))
+	}
+	ciphertext := ek.Ciphertext
+	ek.Ciphertext = nil
+	ad, err := json.Marshal(ek)
+	if err != nil {
+		return types.KeyInfo{}, "", fmt.Errorf("marshaling key header: %s", err)
+	}
+	pk, err := aead.Open(nil, ek.Nonce, ciphertext, ad)
+	if err != nil {
+		return types.KeyInfo{}, "", wallet.ErrInvalidPassphrase
+	}
+	return types.KeyInfo{Type: types.KeyType(ek.Type), PrivateKey: pk}, ek.Address, nil
+}
+
+func newAEAD(passphrase string, ek encryptedKey) (cipher.AEAD, error) {
+	k, err := scrypt.Key([]byte(passphrase), ek.Salt, ek.N, ek.R, ek.P, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key from passphrase: %s", err)
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %s", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating gcm cipher: %s", err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,88 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/wallet"
+)
+
+func TestEncryptKey(t *testing.T) {
+	t.Parallel()
+	ki := types.KeyInfo{Type: types.KTSecp256k1, PrivateKey: []byte("private key")}
+	key, err := encryptKey("t01000", ki, "passphrase")
+	require.NoError(t, err)
+	require.NotContains(t, string(key), "private key")
+
+	addr, err := wallet.ExportedKeyAddr(key)
+	require.NoError(t, err)
+	require.Equal(t, "t01000", addr)
+
+	res, addr, err := decryptKey(key, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, ki, res)
+	require.Equal(t, "t01000", addr)
+
+	_, _, err = decryptKey(key, "wrong passphrase")
+	require.Equal(t, wallet.ErrInvalidPassphrase, err)
+
+	// Tampering the header fails decryption.
+	var ek encryptedKey
+	require.NoError(t, json.Unmarshal(key, &ek))
+	ek.Type = string(types.KTBLS)
+	tampered, err := json.Marshal(ek)
+	require.NoError(t, err)
+	_, _, err = decryptKey(tampered, "passphrase")
+	require.Equal(t, wallet.ErrInvalidPassphrase, err)
+	require.NoError(t, json.Unmarshal(key, &ek))
+	ek.Address = "t01001"
+	tampered, err = json.Marshal(ek)
+	require.NoError(t, err)
+	_, _, err = decryptKey(tampered, "passphrase")
+	require.Equal(t, wallet.ErrInvalidPassphrase, err)
+}
+
+func TestImportKeyMismatch(t *testing.T) {
+	t.Parallel()
+	exported, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	imported, err := address.NewIDAddress(1001)
+	require.NoError(t, err)
+	key, err := encryptKey(exported.String(), types.KeyInfo{Type: types.KTSecp256k1, PrivateKey: []byte("private key")}, "passphrase")
+	require.NoError(t, err)
+
+	var existing, deleted []address.Address
+	client := &apistruct.FullNodeStruct{}
+	client.Internal.WalletList = func(context.Context) ([]address.Address, error) {
+		return existing, nil
+	}
+	client.Internal.WalletImport = func(context.Context, *types.KeyInfo) (address.Address, error) {
+		return imported, nil
+	}
+	client.Internal.WalletDelete = func(_ context.Context, a address.Address) error {
+		deleted = append(deleted, a)
+		return nil
+	}
+	m := &Module{
+		clientBuilder: func(context.Context) (*apistruct.FullNodeStruct, func(), error) {
+			return client, func() {}, nil
+		},
+	}
+
+	// Mismatched keys are deleted from the wallet.
+	_, err = m.ImportKey(context.Background(), key, "passphrase")
+	require.Error(t, err)
+	require.Equal(t, []address.Address{imported}, deleted)
+
+	// Unless they were already in the wallet.
+	deleted = nil
+	existing = []address.Address{imported}
+	_, err = m.ImportKey(context.Background(), key, "passphrase")
+	require.Error(t, err)
+	require.Empty(t, deleted)
+}
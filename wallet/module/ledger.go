@@ -0,0 +1,273 @@
+package module
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/exitcode"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/market"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/wallet"
+)
+
+var (
+	// indexInterval is the interval in which inbound transfers and
+	// escrow movements of wallet addresses are indexed.
+	indexInterval = time.Minute * 10
+	// txConfidence is the number of epochs to wait for a message
+	// execution to be considered final.
+	txConfidence = uint64(5)
+	// txWaitTimeout is the maximum time to wait for a sent message to be
+	// executed on-chain, after which its transaction is considered dropped.
+	txWaitTimeout = time.Hour * 24
+	// backfillEpochs is the number of epochs before the current height
+	// indexed for addresses seen for the first time (~30 days).
+	backfillEpochs = int64(2880 * 30)
+)
+
+// recordSentTx records a pushed message as a pending transaction, and
+// watches its execution to update its status.
+func (m *Module) recordSentTx(c cid.Cid, msg *types.Message) error {
+	tx := wallet.Transaction{
+		MessageCid: c,
+		From:       msg.From.String(),
+		To:         msg.To.String(),
+		Amount:     msg.Value.Int,
+		Kind:       wallet.TxTransfer,
+		Status:     wallet.TxPending,
+		Time:       time.Now().Unix(),
+	}
+	if err := m.store.putTx(tx); err != nil {
+		return fmt.Errorf("saving transaction: %s", err)
+	}
+	m.watchTx(tx)
+	return nil
+}
+
+// resumePendingTxs watches the execution of pending transactions
+// from a previous run.
+func (m *Module) resumePendingTxs() error {
+	txs, err := m.store.getPendingTxs()
+	if err != nil {
+		return fmt.Errorf("getting pending transactions: %s", err)
+	}
+	for _, tx := range txs {
+		m.watchTx(tx)
+	}
+	return nil
+}
+
+// watchTx waits for a pending transaction message to be executed
+// on-chain, and updates its status. If the message isn't executed within
+// txWaitTimeout since the transaction was recorded, it's marked as dropped.
+func (m *Module) watchTx(tx wallet.Transaction) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ctx, cancel := context.WithDeadline(m.ctx, time.Unix(tx.Time, 0).Add(txWaitTimeout))
+		defer cancel()
+		client, cls, err := m.clientBuilder(ctx)
+		if err != nil {
+			log.Errorf("creating lotus client: %s", err)
+			return
+		}
+		defer cls()
+		lookup, err := client.StateWaitMsg(ctx, tx.MessageCid, txConfidence)
+		if err != nil {
+			if m.ctx.Err() != nil {
+				return
+			}
+			if ctx.Err() != context.DeadlineExceeded {
+				log.Errorf("waiting for message %s: %s", tx.MessageCid, err)
+				return
+			}
+			log.Warnf("message %s wasn't executed after %s, marking as dropped", tx.MessageCid, txWaitTimeout)
+			tx.Status = wallet.TxDropped
+			if err := m.store.putTx(tx); err != nil {
+				log.Errorf("saving transaction %s: %s", tx.MessageCid, err)
+			}
+			return
+		}
+		if err := applyLookup(m.ctx, client, &tx, lookup); err != nil {
+			log.Errorf("getting message %s execution: %s", tx.MessageCid, err)
+			return
+		}
+		if err := m.store.putTx(tx); err != nil {
+			log.Errorf("saving transaction %s: %s", tx.MessageCid, err)
+		}
+	}()
+}
+
+func (m *Module) startIndexer() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		for {
+			select {
+			case <-m.ctx.Done():
+				log.Info("graceful shutdown of transactions indexer")
+				return
+			case <-time.After(indexInterval):
+				if err := m.indexTransactions(m.ctx); err != nil {
+					log.Errorf("indexing transactions: %s", err)
+				}
+			}
+		}
+	}()
+}
+
+// indexTransactions records inbound transfers and storage market escrow
+// movements of wallet addresses up to the last final tipset. Addresses
+// seen for the first time are backfilled from backfillEpochs before the
+// current height.
+func (m *Module) indexTransactions(ctx context.Context) error {
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	head, err := client.ChainHead(ctx)
+	if err != nil {
+		return fmt.Errorf("getting chain head: %s", err)
+	}
+	if uint64(head.Height()) <= txConfidence {
+		return nil
+	}
+	ts, err := client.ChainGetTipSetByHeight(ctx, head.Height()-abi.ChainEpoch(txConfidence), head.Key())
+	if err != nil {
+		return fmt.Errorf("getting final tipset: %s", err)
+	}
+	addrs, err := client.WalletList(ctx)
+	if err != nil {
+		return fmt.Errorf("getting wallet addresses: %s", err)
+	}
+	for _, a := range addrs {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := m.indexAddress(ctx, client, a, ts); err != nil {
+			log.Errorf("indexing transactions of %s: %s", a, err)
+		}
+	}
+	return nil
+}
+
+func (m *Module) indexAddress(ctx context.Context, client *apistruct.FullNodeStruct, addr address.Address, ts *types.TipSet) error {
+	last, ok, err := m.store.getIndexedHeight(addr.String())
+	if err != nil {
+		return err
+	}
+	height := int64(ts.Height())
+	if !ok {
+		last = height - backfillEpochs - 1
+		if last < -1 {
+			last = -1
+		}
+	}
+	if last >= height {
+		return m.store.putIndexedHeight(addr.String(), height)
+	}
+	matches := []*api.MessageMatch{
+		{To: addr},
+		{From: addr, To: market.Address},
+	}
+	for _, match := range matches {
+		cids, err := client.StateListMessages(ctx, match, ts.Key(), abi.ChainEpoch(last+1))
+		if err != nil {
+			return fmt.Errorf("listing messages: %s", err)
+		}
+		for _, c := range cids {
+			if err := m.indexMessage(ctx, client, c); err != nil {
+				return fmt.Errorf("indexing message %s: %s", c, err)
+			}
+		}
+	}
+	return m.store.putIndexedHeight(addr.String(), height)
+}
+
+func (m *Module) indexMessage(ctx context.Context, client *apistruct.FullNodeStruct, c cid.Cid) error {
+	old, ok, err := m.store.getTx(c)
+	if err != nil {
+		return err
+	}
+	if ok && old.Status != wallet.TxPending && old.Status != wallet.TxDropped {
+		return nil
+	}
+	msg, err := client.ChainGetMessage(ctx, c)
+	if err != nil {
+		return fmt.Errorf("getting message: %s", err)
+	}
+	tx := wallet.Transaction{
+		MessageCid: c,
+		From:       msg.From.String(),
+		To:         msg.To.String(),
+		Amount:     msg.Value.Int,
+		Kind:       wallet.TxTransfer,
+		Status:     wallet.TxPending,
+		Time:       time.Now().Unix(),
+	}
+	if msg.To == market.Address {
+		switch msg.Method {
+		case market.Methods.AddBalance:
+			tx.Kind = wallet.TxEscrowDeposit
+		case market.Methods.WithdrawBalance:
+			var params market.WithdrawBalanceParams
+			if err := params.UnmarshalCBOR(bytes.NewReader(msg.Params)); err != nil {
+				return fmt.Errorf("unmarshaling withdraw balance params: %s", err)
+			}
+			tx.Kind = wallet.TxEscrowWithdrawal
+			tx.Amount = params.Amount.Int
+		default:
+			return nil
+		}
+	}
+	if tx.Amount == nil {
+		tx.Amount = big.NewInt(0)
+	}
+	if ok {
+		// Keep the time the transaction was sent, since pending
+		// transactions are dropped txWaitTimeout after it.
+		tx.Time = old.Time
+	}
+	lookup, err := client.StateSearchMsg(ctx, c)
+	if err != nil {
+		return fmt.Errorf("searching message execution: %s", err)
+	}
+	if lookup != nil {
+		if err := applyLookup(ctx, client, &tx, lookup); err != nil {
+			return err
+		}
+	}
+	if err := m.store.putTx(tx); err != nil {
+		return fmt.Errorf("saving transaction: %s", err)
+	}
+	if tx.Status == wallet.TxPending && !ok {
+		m.watchTx(tx)
+	}
+	return nil
+}
+
+// applyLookup updates tx with the result of its message execution.
+func applyLookup(ctx context.Context, client *apistruct.FullNodeStruct, tx *wallet.Transaction, lookup *api.MsgLookup) error {
+	ts, err := client.ChainGetTipSet(ctx, lookup.TipSet)
+	if err != nil {
+		return fmt.Errorf("getting execution tipset: %s", err)
+	}
+	tx.Height = int64(lookup.Height)
+	tx.ExitCode = int64(lookup.Receipt.ExitCode)
+	tx.Time = int64(ts.MinTimestamp())
+	tx.Status = wallet.TxConfirmed
+	if lookup.Receipt.ExitCode != exitcode.Ok {
+		tx.Status = wallet.TxFailed
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package module
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/apistruct"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
+)
+
+func TestWatchTxDropped(t *testing.T) {
+	client := &apistruct.FullNodeStruct{}
+	client.Internal.StateWaitMsg = func(ctx context.Context, _ cid.Cid, _ uint64) (*api.MsgLookup, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Module{
+		clientBuilder: func(context.Context) (*apistruct.FullNodeStruct, func(), error) {
+			return client, func() {}, nil
+		},
+		store:  newStore(tests.NewTxMapDatastore()),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	defer func() { require.NoError(t, m.Close()) }()
+
+	c, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	require.NoError(t, err)
+	tx := wallet.Transaction{
+		MessageCid: c,
+		From:       "a",
+		To:         "b",
+		Amount:     big.NewInt(10),
+		Status:     wallet.TxPending,
+		Time:       time.Now().Add(-txWaitTimeout).Unix(),
+	}
+	require.NoError(t, m.store.putTx(tx))
+	m.watchTx(tx)
+
+	require.Eventually(t, func() bool {
+		res, ok, err := m.store.getTx(c)
+		return err == nil && ok && res.Status == wallet.TxDropped
+	}, time.Second*5, time.Millisecond*50)
+	pending, err := m.store.getPendingTxs()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestIndexMessageKeepsSendTime(t *testing.T) {
+	from, err := address.NewIDAddress(100)
+	require.NoError(t, err)
+	to, err := address.NewIDAddress(101)
+	require.NoError(t, err)
+	client := &apistruct.FullNodeStruct{}
+	client.Internal.ChainGetMessage = func(context.Context, cid.Cid) (*types.Message, error) {
+		return &types.Message{From: from, To: to, Value: types.NewInt(10)}, nil
+	}
+	client.Internal.StateSearchMsg = func(context.Context, cid.Cid) (*api.MsgLookup, error) {
+		return nil, nil
+	}
+	m := &Module{store: newStore(tests.NewTxMapDatastore())}
+
+	c, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	require.NoError(t, err)
+	sent := time.Now().Add(-time.Hour).Unix()
+	require.NoError(t, m.store.putTx(wallet.Transaction{
+		MessageCid: c,
+		From:       from.String(),
+		To:         to.String(),
+		Amount:     big.NewInt(10),
+		Status:     wallet.TxPending,
+		Time:       sent,
+	}))
+
+	require.NoError(t, m.indexMessage(context.Background(), client, c))
+	res, ok, err := m.store.getTx(c)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, wallet.TxPending, res.Status)
+	require.Equal(t, sent, res.Time)
+}
@@ -0,0 +1,222 @@
+package module
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/exitcode"
+	"github.com/filecoin-project/lotus/api/apibstore"
+	"github.com/filecoin-project/lotus/chain/actors"
+	"github.com/filecoin-project/lotus/chain/actors/adt"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/market"
+	"github.com/filecoin-project/lotus/chain/actors/builtin/multisig"
+	"github.com/filecoin-project/lotus/chain/types"
+	init2 "github.com/filecoin-project/specs-actors/v2/actors/builtin/init"
+	"github.com/ipfs/go-cid"
+	cbor "github.com/ipfs/go-ipld-cbor"
+	"github.com/textileio/powergate/wallet"
+)
+
+// NewMultisig creates a multisig actor with the provided signers and approval threshold,
+// funded with amount from creator. The creator must be one of the signers, and a wallet address.
+// It waits for the creation message to be executed, and returns the multisig robust address.
+func (m *Module) NewMultisig(ctx context.Context, creator string, signers []string, threshold uint64, amount *big.Int) (string, error) {
+	if threshold == 0 || threshold > uint64(len(signers)) {
+		return "", fmt.Errorf("threshold should be between 1 and the number of signers")
+	}
+	c, err := address.NewFromString(creator)
+	if err != nil {
+		return "", fmt.Errorf("parsing creator address: %s", err)
+	}
+	var isSigner bool
+	addrs := make([]address.Address, len(signers))
+	for i, s := range signers {
+		addrs[i], err = address.NewFromString(s)
+		if err != nil {
+			return "", fmt.Errorf("parsing signer address %s: %s", s, err)
+		}
+		if addrs[i] == c {
+			isSigner = true
+		}
+	}
+	if !isSigner {
+		return "", fmt.Errorf("creator should be one of the signers")
+	}
+	if amount == nil {
+		amount = big.NewInt(0)
+	}
+
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return "", fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	mcid, err := client.MsigCreate(ctx, threshold, addrs, 0, types.BigInt{Int: amount}, c, types.NewInt(0))
+	if err != nil {
+		return "", fmt.Errorf("creating multisig: %s", err)
+	}
+	lookup, err := client.StateWaitMsg(ctx, mcid, 1)
+	if err != nil {
+		return "", fmt.Errorf("waiting for multisig creation message %s: %s", mcid, err)
+	}
+	if lookup.Receipt.ExitCode != exitcode.Ok {
+		return "", fmt.Errorf("multisig creation message %s failed with exit code %d", mcid, lookup.Receipt.ExitCode)
+	}
+	var ret init2.ExecReturn
+	if err := ret.UnmarshalCBOR(bytes.NewReader(lookup.Receipt.Return)); err != nil {
+		return "", fmt.Errorf("unmarshaling multisig creation return: %s", err)
+	}
+	maddr := ret.RobustAddress.String()
+
+	mi := wallet.MultisigInfo{
+		Addr:      maddr,
+		Creator:   creator,
+		Signers:   signers,
+		Threshold: threshold,
+	}
+	if err := m.store.putMultisig(mi); err != nil {
+		return "", err
+	}
+	tx := wallet.Transaction{
+		MessageCid: mcid,
+		From:       creator,
+		To:         maddr,
+		Amount:     amount,
+		Kind:       wallet.TxTransfer,
+	}
+	if err := applyLookup(ctx, client, &tx, lookup); err != nil {
+		return "", err
+	}
+	if err := m.store.putTx(tx); err != nil {
+		return "", fmt.Errorf("saving multisig funding transaction: %s", err)
+	}
+	log.Infof("created multisig %s with %d signers and threshold %d", maddr, len(signers), threshold)
+	return maddr, nil
+}
+
+// Multisig returns information about a multisig created by the wallet. If addr
+// isn't such a multisig, wallet.ErrMultisigNotFound is returned.
+func (m *Module) Multisig(ctx context.Context, addr string) (wallet.MultisigInfo, error) {
+	return m.store.getMultisig(addr)
+}
+
+// MultisigPending returns the transactions of a multisig waiting for approvals.
+func (m *Module) MultisigPending(ctx context.Context, addr string) ([]wallet.MultisigTx, error) {
+	maddr, err := address.NewFromString(addr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing multisig address: %s", err)
+	}
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	act, err := client.StateGetActor(ctx, maddr, types.EmptyTSK)
+	if err != nil {
+		return nil, fmt.Errorf("getting multisig actor: %s", err)
+	}
+	store := adt.WrapStore(ctx, cbor.NewCborStore(apibstore.NewAPIBlockstore(client)))
+	st, err := multisig.Load(store, act)
+	if err != nil {
+		return nil, fmt.Errorf("loading multisig state: %s", err)
+	}
+	var ret []wallet.MultisigTx
+	err = st.ForEachPendingTxn(func(id int64, txn multisig.Transaction) error {
+		tx := wallet.MultisigTx{
+			ID:     id,
+			To:     txn.To.String(),
+			Amount: txn.Value.Int,
+			Method: uint64(txn.Method),
+		}
+		for _, a := range txn.Approved {
+			tx.Approved = append(tx.Approved, a.String())
+		}
+		ret = append(ret, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterating pending transactions: %s", err)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
+	return ret, nil
+}
+
+// MultisigPropose proposes a transfer of amount from a multisig to another address.
+// The proposal counts as the proposer approval.
+func (m *Module) MultisigPropose(ctx context.Context, addr string, proposer string, to string, amount *big.Int) (cid.Cid, error) {
+	t, err := address.NewFromString(to)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing destination address: %s", err)
+	}
+	return m.multisigPropose(ctx, addr, proposer, t, amount, 0, nil)
+}
+
+// MultisigProposeEscrowTopUp proposes a deposit of amount from a multisig into the
+// storage market escrow of escrowAddr. The proposal counts as the proposer approval.
+func (m *Module) MultisigProposeEscrowTopUp(ctx context.Context, addr string, proposer string, escrowAddr string, amount *big.Int) (cid.Cid, error) {
+	ea, err := address.NewFromString(escrowAddr)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing escrow address: %s", err)
+	}
+	params, err := actors.SerializeParams(&ea)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("serializing add balance params: %s", err)
+	}
+	return m.multisigPropose(ctx, addr, proposer, market.Address, amount, market.Methods.AddBalance, params)
+}
+
+// MultisigApprove approves a pending multisig transaction. If the threshold of
+// approvals is reached, the transaction is executed.
+func (m *Module) MultisigApprove(ctx context.Context, addr string, approver string, txID int64) (cid.Cid, error) {
+	maddr, err := address.NewFromString(addr)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing multisig address: %s", err)
+	}
+	a, err := address.NewFromString(approver)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing approver address: %s", err)
+	}
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	c, err := client.MsigApprove(ctx, maddr, uint64(txID), a)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("approving multisig transaction: %s", err)
+	}
+	return c, nil
+}
+
+func (m *Module) multisigPropose(ctx context.Context, addr string, proposer string, to address.Address, amount *big.Int, method abi.MethodNum, params []byte) (cid.Cid, error) {
+	maddr, err := address.NewFromString(addr)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing multisig address: %s", err)
+	}
+	p, err := address.NewFromString(proposer)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing proposer address: %s", err)
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return cid.Undef, fmt.Errorf("amount should be positive")
+	}
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+
+	c, err := client.MsigPropose(ctx, maddr, to, types.BigInt{Int: amount}, p, uint64(method), params)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("proposing multisig transaction: %s", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,273 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
+)
+
+var (
+	// dsBaseTx is the namespace of Transactions keyed by message cid.
+	dsBaseTx = datastore.NewKey("tx")
+	// dsBaseAddrIndex is the namespace of the per-address ledger index,
+	// with keys of the form <addr>/<time>/<message cid>.
+	dsBaseAddrIndex = datastore.NewKey("addrindex")
+	// dsBasePending is the namespace of Transactions waiting to be executed on-chain.
+	dsBasePending = datastore.NewKey("pending")
+	// dsBaseIndexedHeight is the namespace of the last chain height
+	// indexed for each address.
+	dsBaseIndexedHeight = datastore.NewKey("indexedheight")
+	// dsBaseMultisig is the namespace of MultisigInfo keyed by address.
+	dsBaseMultisig = datastore.NewKey("multisig")
+)
+
+type store struct {
+	ds datastore.TxnDatastore
+}
+
+func newStore(ds datastore.TxnDatastore) *store {
+	return &store{ds: ds}
+}
+
+// putTx saves a Transaction, updating the address indexes and pending
+// status. If the Transaction already exists, its original Time is preserved
+// so its position in the ledger doesn't change.
+func (s *store) putTx(tx wallet.Transaction) error {
+	txn, err := s.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+
+	key := makeTxKey(tx.MessageCid)
+	buf, err := txn.Get(key)
+	if err != nil && err != datastore.ErrNotFound {
+		return fmt.Errorf("getting existing tx: %s", err)
+	}
+	if err == nil {
+		var old wallet.Transaction
+		if err := json.Unmarshal(buf, &old); err != nil {
+			return fmt.Errorf("unmarshaling existing tx: %s", err)
+		}
+		tx.Time = old.Time
+	}
+
+	buf, err = json.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("marshaling tx: %s", err)
+	}
+	if err := txn.Put(key, buf); err != nil {
+		return fmt.Errorf("putting tx: %s", err)
+	}
+	for _, addr := range []string{tx.From, tx.To} {
+		if err := txn.Put(makeAddrIndexKey(addr, tx.Time, tx.MessageCid), []byte{}); err != nil {
+			return fmt.Errorf("putting address index: %s", err)
+		}
+	}
+	pendingKey := makePendingKey(tx.MessageCid)
+	if tx.Status == wallet.TxPending {
+		if err := txn.Put(pendingKey, []byte{}); err != nil {
+			return fmt.Errorf("putting pending tx: %s", err)
+		}
+	} else if err := txn.Delete(pendingKey); err != nil {
+		return fmt.Errorf("deleting pending tx: %s", err)
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	return nil
+}
+
+// getTx returns the Transaction of a message cid, and false if it doesn't exist.
+func (s *store) getTx(c cid.Cid) (wallet.Transaction, bool, error) {
+	buf, err := s.ds.Get(makeTxKey(c))
+	if err == datastore.ErrNotFound {
+		return wallet.Transaction{}, false, nil
+	}
+	if err != nil {
+		return wallet.Transaction{}, false, fmt.Errorf("getting tx: %s", err)
+	}
+	var tx wallet.Transaction
+	if err := json.Unmarshal(buf, &tx); err != nil {
+		return wallet.Transaction{}, false, fmt.Errorf("unmarshaling tx: %s", err)
+	}
+	return tx, true, nil
+}
+
+// getPendingTxs returns all Transactions which weren't yet executed on-chain.
+func (s *store) getPendingTxs() ([]wallet.Transaction, error) {
+	q := query.Query{Prefix: dsBasePending.String(), KeysOnly: true}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying pending txs: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+
+	var ret []wallet.Transaction
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iterating pending txs: %s", r.Error)
+		}
+		c, err := util.CidFromString(datastore.RawKey(r.Key).Name())
+		if err != nil {
+			return nil, fmt.Errorf("decoding pending tx cid: %s", err)
+		}
+		tx, ok, err := s.getTx(c)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ret = append(ret, tx)
+		}
+	}
+	return ret, nil
+}
+
+// getTxs returns the Transactions in which any of addrs is the sender or
+// receiver, sorted by time and paginated as specified in cfg.
+func (s *store) getTxs(addrs []string, cfg wallet.TransactionsConfig) ([]wallet.Transaction, error) {
+	type indexEntry struct {
+		time int64
+		cid  string
+	}
+	seen := make(map[string]struct{})
+	var entries []indexEntry
+	for _, addr := range addrs {
+		q := query.Query{Prefix: dsBaseAddrIndex.ChildString(addr).String(), KeysOnly: true}
+		res, err := s.ds.Query(q)
+		if err != nil {
+			return nil, fmt.Errorf("querying address index: %s", err)
+		}
+		for r := range res.Next() {
+			if r.Error != nil {
+				_ = res.Close()
+				return nil, fmt.Errorf("iterating address index: %s", r.Error)
+			}
+			parts := datastore.RawKey(r.Key).Namespaces()
+			if len(parts) < 2 {
+				continue
+			}
+			strCid := parts[len(parts)-1]
+			if _, ok := seen[strCid]; ok {
+				continue
+			}
+			seen[strCid] = struct{}{}
+			t, err := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+			if err != nil {
+				_ = res.Close()
+				return nil, fmt.Errorf("parsing index time: %s", err)
+			}
+			entries = append(entries, indexEntry{time: t, cid: strCid})
+		}
+		_ = res.Close()
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].time == entries[j].time {
+			if cfg.Ascending {
+				return strings.Compare(entries[i].cid, entries[j].cid) < 0
+			}
+			return strings.Compare(entries[i].cid, entries[j].cid) > 0
+		}
+		if cfg.Ascending {
+			return entries[i].time < entries[j].time
+		}
+		return entries[i].time > entries[j].time
+	})
+	if cfg.Offset >= len(entries) {
+		return []wallet.Transaction{}, nil
+	}
+	entries = entries[cfg.Offset:]
+	if cfg.Limit > 0 && cfg.Limit < len(entries) {
+		entries = entries[:cfg.Limit]
+	}
+
+	ret := make([]wallet.Transaction, 0, len(entries))
+	for _, e := range entries {
+		c, err := util.CidFromString(e.cid)
+		if err != nil {
+			return nil, fmt.Errorf("decoding tx cid: %s", err)
+		}
+		tx, ok, err := s.getTx(c)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("indexed tx %s not found", e.cid)
+		}
+		ret = append(ret, tx)
+	}
+	return ret, nil
+}
+
+// getIndexedHeight returns the last chain height indexed for addr, and
+// false if addr was never indexed.
+func (s *store) getIndexedHeight(addr string) (int64, bool, error) {
+	buf, err := s.ds.Get(dsBaseIndexedHeight.ChildString(addr))
+	if err == datastore.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("getting indexed height: %s", err)
+	}
+	h, err := strconv.ParseInt(string(buf), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("parsing indexed height: %s", err)
+	}
+	return h, true, nil
+}
+
+func (s *store) putIndexedHeight(addr string, height int64) error {
+	if err := s.ds.Put(dsBaseIndexedHeight.ChildString(addr), []byte(strconv.FormatInt(height, 10))); err != nil {
+		return fmt.Errorf("putting indexed height: %s", err)
+	}
+	return nil
+}
+
+func (s *store) putMultisig(mi wallet.MultisigInfo) error {
+	buf, err := json.Marshal(mi)
+	if err != nil {
+		return fmt.Errorf("marshaling multisig: %s", err)
+	}
+	if err := s.ds.Put(dsBaseMultisig.ChildString(mi.Addr), buf); err != nil {
+		return fmt.Errorf("putting multisig: %s", err)
+	}
+	return nil
+}
+
+// getMultisig returns the MultisigInfo of addr, or wallet.ErrMultisigNotFound
+// if it wasn't created by the wallet.
+func (s *store) getMultisig(addr string) (wallet.MultisigInfo, error) {
+	buf, err := s.ds.Get(dsBaseMultisig.ChildString(addr))
+	if err == datastore.ErrNotFound {
+		return wallet.MultisigInfo{}, wallet.ErrMultisigNotFound
+	}
+	if err != nil {
+		return wallet.MultisigInfo{}, fmt.Errorf("getting multisig: %s", err)
+	}
+	var mi wallet.MultisigInfo
+	if err := json.Unmarshal(buf, &mi); err != nil {
+		return wallet.MultisigInfo{}, fmt.Errorf("unmarshaling multisig: %s", err)
+	}
+	return mi, nil
+}
+
+func makeTxKey(c cid.Cid) datastore.Key {
+	return dsBaseTx.ChildString(util.CidToString(c))
+}
+
+func makePendingKey(c cid.Cid) datastore.Key {
+	return dsBasePending.ChildString(util.CidToString(c))
+}
+
+func makeAddrIndexKey(addr string, t int64, c cid.Cid) datastore.Key {
+	return dsBaseAddrIndex.ChildString(addr).ChildString(fmt.Sprintf("%020d", t)).ChildString(util.CidToString(c))
+}
@@ -0,0 +1,119 @@
+package module
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/tests"
+	"github.com/textileio/powergate/util"
+	"github.com/textileio/powergate/wallet"
+)
+
+func TestPutTx(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+
+	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	require.NoError(t, err)
+	tx := wallet.Transaction{MessageCid: c1, From: "a", To: "b", Amount: big.NewInt(10), Status: wallet.TxPending, Time: 100}
+	err = s.putTx(tx)
+	require.NoError(t, err)
+
+	pending, err := s.getPendingTxs()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, tx, pending[0])
+
+	// Updating the tx keeps its original time, and
+	// removes it from pending.
+	tx.Status = wallet.TxConfirmed
+	tx.Height = 5
+	tx.Time = 200
+	err = s.putTx(tx)
+	require.NoError(t, err)
+	pending, err = s.getPendingTxs()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	res, ok, err := s.getTx(c1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, wallet.TxConfirmed, res.Status)
+	require.Equal(t, int64(5), res.Height)
+	require.Equal(t, int64(100), res.Time)
+}
+
+func TestGetTxs(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+
+	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
+	require.NoError(t, err)
+	c2, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2E")
+	require.NoError(t, err)
+	c3, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2F")
+	require.NoError(t, err)
+	require.NoError(t, s.putTx(wallet.Transaction{MessageCid: c1, From: "a", To: "b", Amount: big.NewInt(1), Time: 1}))
+	require.NoError(t, s.putTx(wallet.Transaction{MessageCid: c2, From: "b", To: "c", Amount: big.NewInt(2), Time: 2}))
+	require.NoError(t, s.putTx(wallet.Transaction{MessageCid: c3, From: "c", To: "a", Amount: big.NewInt(3), Time: 3}))
+
+	txs, err := s.getTxs([]string{"a"}, wallet.TransactionsConfig{})
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, c3, txs[0].MessageCid)
+	require.Equal(t, c1, txs[1].MessageCid)
+
+	// Transactions between queried addresses aren't duplicated.
+	txs, err = s.getTxs([]string{"a", "b", "c"}, wallet.TransactionsConfig{Ascending: true})
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+	require.Equal(t, c1, txs[0].MessageCid)
+	require.Equal(t, c2, txs[1].MessageCid)
+	require.Equal(t, c3, txs[2].MessageCid)
+
+	// Pagination.
+	txs, err = s.getTxs([]string{"a", "b", "c"}, wallet.TransactionsConfig{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, c2, txs[0].MessageCid)
+	txs, err = s.getTxs([]string{"a", "b", "c"}, wallet.TransactionsConfig{Offset: 3})
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	txs, err = s.getTxs([]string{"d"}, wallet.TransactionsConfig{})
+	require.NoError(t, err)
+	require.Empty(t, txs)
+}
+
+func TestIndexedHeight(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+
+	_, ok, err := s.getIndexedHeight("a")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, s.putIndexedHeight("a", 42))
+	h, ok, err := s.getIndexedHeight("a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(42), h)
+}
+
+func TestMultisig(t *testing.T) {
+	s := newStore(tests.NewTxMapDatastore())
+
+	_, err := s.getMultisig("t2abc")
+	require.Equal(t, wallet.ErrMultisigNotFound, err)
+
+	mi := wallet.MultisigInfo{
+		Addr:      "t2abc",
+		Creator:   "t1a",
+		Signers:   []string{"t1a", "t1b", "t1c"},
+		Threshold: 2,
+	}
+	err = s.putMultisig(mi)
+	require.NoError(t, err)
+
+	res, err := s.getMultisig("t2abc")
+	require.NoError(t, err)
+	require.Equal(t, mi, res)
+}
@@ -7,13 +7,17 @@ import (
 	"io/ioutil"
 	"math/big"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-state-types/crypto"
 	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
 	logger "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/lotus"
+	"github.com/textileio/powergate/wallet"
 )
 
 const (
@@ -25,45 +29,70 @@ var (
 	networkFaucet = map[string]string{}
 )
 
+var _ wallet.Module = (*Module)(nil)
+
 // Module exposes the filecoin wallet api.
 type Module struct {
 	clientBuilder lotus.ClientBuilder
+	store         *store
 	iAmount       *big.Int
 	masterAddr    address.Address
 	networkName   string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
-// New creates a new wallet module.
-func New(clientBuilder lotus.ClientBuilder, maddr address.Address, iam big.Int, autocreate bool, networkName string) (*Module, error) {
+// New creates a new wallet module. Transactions of wallet addresses
+// are recorded in ds.
+func New(ds datastore.TxnDatastore, clientBuilder lotus.ClientBuilder, maddr address.Address, iam big.Int, autocreate bool, networkName string) (*Module, error) {
+	ctx, cancel := context.WithCancel(context.Background())
 	m := &Module{
 		clientBuilder: clientBuilder,
+		store:         newStore(ds),
 		iAmount:       &iam,
 		masterAddr:    maddr,
 		networkName:   networkName,
+
+		ctx:    ctx,
+		cancel: cancel,
 	}
 	if maddr == address.Undef && autocreate {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-		defer cancel()
-		newMasterAddr, err := m.NewAddress(ctx, "bls")
+		actx, acancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer acancel()
+		newMasterAddr, err := m.NewAddress(actx, "bls")
 		if err != nil {
+			cancel()
 			return nil, fmt.Errorf("creating and funding master addr: %s", err)
 		}
 		log.Infof("Auto-created master wallet addr: %s", newMasterAddr)
-		if err := m.FundFromFaucet(ctx, newMasterAddr); err != nil {
+		if err := m.FundFromFaucet(actx, newMasterAddr); err != nil {
+			cancel()
 			return nil, fmt.Errorf("funding new master addr: %s", err)
 		}
 		log.Info("Autocreated master wallet addr funded successfully")
-		maddr, _ = address.NewFromString(newMasterAddr)
-		m = &Module{
-			clientBuilder: clientBuilder,
-			iAmount:       &iam,
-			masterAddr:    maddr,
-		}
+		m.masterAddr, _ = address.NewFromString(newMasterAddr)
 	}
 
+	if err := m.resumePendingTxs(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("resuming pending transactions: %s", err)
+	}
+	m.startIndexer()
+
 	return m, nil
 }
 
+// Close closes the module.
+func (m *Module) Close() error {
+	log.Info("closing...")
+	defer log.Info("closed")
+	m.cancel()
+	m.wg.Wait()
+	return nil
+}
+
 // MasterAddr returns the master address.
 // Will return address.Undef is Powergate was started with no master address.
 func (m *Module) MasterAddr() address.Address {
@@ -118,7 +147,10 @@ func (m *Module) NewAddress(ctx context.Context, typ string) (string, error) {
 				log.Errorf("transferring funds to new address: %s", err)
 				return
 			}
-			log.Infof("%s funding transaction message: %s", addr, smsg.Message.Cid())
+			log.Infof("%s funding transaction message: %s", addr, smsg.Cid())
+			if err := m.recordSentTx(smsg.Cid(), msg); err != nil {
+				log.Errorf("recording funding transaction: %s", err)
+			}
 		}()
 	}
 
@@ -202,15 +234,42 @@ func (m *Module) Balance(ctx context.Context, addr string) (*big.Int, error) {
 	return b.Int, nil
 }
 
-// SendFil sends fil from one address to another.
-func (m *Module) SendFil(ctx context.Context, from string, to string, amount *big.Int) error {
+// MarketBalance returns the storage market escrow balance of the specified address.
+func (m *Module) MarketBalance(ctx context.Context, addr string) (wallet.MarketBalance, error) {
+	client, cls, err := m.clientBuilder(ctx)
+	if err != nil {
+		return wallet.MarketBalance{}, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+	a, err := address.NewFromString(addr)
+	if err != nil {
+		return wallet.MarketBalance{}, err
+	}
+	mb, err := client.StateMarketBalance(ctx, a, types.EmptyTSK)
+	if err != nil {
+		return wallet.MarketBalance{}, fmt.Errorf("getting market balance from lotus: %s", err)
+	}
+	res := wallet.MarketBalance{Escrow: big.NewInt(0), Locked: big.NewInt(0)}
+	if mb.Escrow.Int != nil {
+		res.Escrow = mb.Escrow.Int
+	}
+	if mb.Locked.Int != nil {
+		res.Locked = mb.Locked.Int
+	}
+	return res, nil
+}
+
+// SendFil sends fil from one address to another. It returns the cid of
+// the pushed message, and the transaction is recorded as pending until
+// the message is executed on-chain.
+func (m *Module) SendFil(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, error) {
 	f, err := address.NewFromString(from)
 	if err != nil {
-		return err
+		return cid.Undef, err
 	}
 	t, err := address.NewFromString(to)
 	if err != nil {
-		return err
+		return cid.Undef, err
 	}
 	msg := &types.Message{
 		From:  f,
@@ -219,12 +278,32 @@ func (m *Module) SendFil(ctx context.Context, from string, to string, amount *bi
 	}
 	client, cls, err := m.clientBuilder(ctx)
 	if err != nil {
-		return fmt.Errorf("creating lotus client: %s", err)
+		return cid.Undef, fmt.Errorf("creating lotus client: %s", err)
 	}
 	defer cls()
 
-	_, err = client.MpoolPushMessage(ctx, msg, nil)
-	return err
+	smsg, err := client.MpoolPushMessage(ctx, msg, nil)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if err := m.recordSentTx(smsg.Cid(), msg); err != nil {
+		log.Errorf("recording sent transaction: %s", err)
+	}
+	return smsg.Cid(), nil
+}
+
+// Transactions returns the recorded transactions in which any of addrs
+// is the sender or receiver.
+func (m *Module) Transactions(ctx context.Context, addrs []string, opts ...wallet.TransactionsOption) ([]wallet.Transaction, error) {
+	var cfg wallet.TransactionsConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	txs, err := m.store.getTxs(addrs, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("getting transactions: %s", err)
+	}
+	return txs, nil
 }
 
 // FundFromFaucet make a faucet call to fund the provided wallet address.
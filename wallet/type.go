@@ -2,7 +2,12 @@ package wallet
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math/big"
+
+	"github.com/ipfs/go-cid"
 )
 
 // Module provides wallet management access to a Filecoin client.
@@ -10,6 +15,162 @@ type Module interface {
 	NewAddress(ctx context.Context, typ string) (string, error)
 	List(ctx context.Context) ([]string, error)
 	Balance(ctx context.Context, addr string) (*big.Int, error)
-	SendFil(ctx context.Context, from string, to string, amount *big.Int) error
+	MarketBalance(ctx context.Context, addr string) (MarketBalance, error)
+	SendFil(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, error)
 	FundFromFaucet(ctx context.Context, addr string) error
+	Transactions(ctx context.Context, addrs []string, opts ...TransactionsOption) ([]Transaction, error)
+	NewMultisig(ctx context.Context, creator string, signers []string, threshold uint64, amount *big.Int) (string, error)
+	Multisig(ctx context.Context, addr string) (MultisigInfo, error)
+	MultisigPending(ctx context.Context, addr string) ([]MultisigTx, error)
+	MultisigPropose(ctx context.Context, addr string, proposer string, to string, amount *big.Int) (cid.Cid, error)
+	MultisigProposeEscrowTopUp(ctx context.Context, addr string, proposer string, escrowAddr string, amount *big.Int) (cid.Cid, error)
+	MultisigApprove(ctx context.Context, addr string, approver string, txID int64) (cid.Cid, error)
+	ExportKey(ctx context.Context, addr string, passphrase string) ([]byte, error)
+	ImportKey(ctx context.Context, key []byte, passphrase string) (string, error)
+}
+
+var (
+	// ErrMultisigNotFound is returned when an address isn't a multisig
+	// created by the wallet.
+	ErrMultisigNotFound = errors.New("multisig not found")
+	// ErrInvalidPassphrase is returned when an exported key can't be
+	// decrypted with the provided passphrase.
+	ErrInvalidPassphrase = errors.New("invalid passphrase")
+)
+
+// ExportedKeyAddr returns the address of a key exported with ExportKey,
+// without decrypting it. The address is only verified when the key is
+// imported, so it should be used just for early checks.
+func ExportedKeyAddr(key []byte) (string, error) {
+	var header struct{ Address string }
+	if err := json.Unmarshal(key, &header); err != nil {
+		return "", fmt.Errorf("unmarshaling exported key: %s", err)
+	}
+	if header.Address == "" {
+		return "", fmt.Errorf("exported key has no address")
+	}
+	return header.Address, nil
+}
+
+// MultisigInfo describes a multisig actor created by the wallet.
+type MultisigInfo struct {
+	// Addr is the robust address of the multisig actor.
+	Addr string
+	// Creator is the wallet address which created the multisig. It's
+	// always one of the signers, and is used as the deal client
+	// address when the multisig is used to fund storage deals.
+	Creator string
+	// Signers are the addresses that can propose and approve transactions.
+	Signers []string
+	// Threshold is the number of approvals required to execute a transaction.
+	Threshold uint64
+}
+
+// MultisigTx is a multisig transaction waiting for approvals.
+type MultisigTx struct {
+	ID       int64
+	To       string
+	Amount   *big.Int
+	Method   uint64
+	Approved []string
+}
+
+// MarketBalance is the storage market escrow balance of an address.
+type MarketBalance struct {
+	// Escrow is the total amount deposited in the escrow.
+	Escrow *big.Int
+	// Locked is the amount of the escrow locked by active deals.
+	Locked *big.Int
+}
+
+// Available returns the amount of the escrow that isn't locked.
+func (mb MarketBalance) Available() *big.Int {
+	return new(big.Int).Sub(mb.Escrow, mb.Locked)
+}
+
+// TxKind is the kind of movement of funds of a Transaction.
+type TxKind int
+
+const (
+	// TxTransfer is a plain transfer of funds between addresses.
+	TxTransfer TxKind = iota
+	// TxEscrowDeposit is a deposit of funds in the storage market escrow.
+	TxEscrowDeposit
+	// TxEscrowWithdrawal is a withdrawal of funds from the storage market escrow.
+	TxEscrowWithdrawal
+)
+
+// TxKindStr maps TxKind to describing string.
+var TxKindStr = map[TxKind]string{
+	TxTransfer:         "Transfer",
+	TxEscrowDeposit:    "EscrowDeposit",
+	TxEscrowWithdrawal: "EscrowWithdrawal",
+}
+
+// TxStatus is the on-chain status of a Transaction.
+type TxStatus int
+
+const (
+	// TxPending indicates the message wasn't yet executed on-chain.
+	TxPending TxStatus = iota
+	// TxConfirmed indicates the message was successfully executed on-chain.
+	TxConfirmed
+	// TxFailed indicates the message was executed on-chain with a non-zero exit code.
+	TxFailed
+	// TxDropped indicates the message wasn't executed on-chain within the
+	// wait timeout, e.g: it was dropped from the message pool.
+	TxDropped
+)
+
+// TxStatusStr maps TxStatus to describing string.
+var TxStatusStr = map[TxStatus]string{
+	TxPending:   "Pending",
+	TxConfirmed: "Confirmed",
+	TxFailed:    "Failed",
+	TxDropped:   "Dropped",
+}
+
+// Transaction is a movement of funds from or to a wallet address.
+type Transaction struct {
+	MessageCid cid.Cid
+	From       string
+	To         string
+	Amount     *big.Int
+	Kind       TxKind
+	Status     TxStatus
+	ExitCode   int64
+	Height     int64
+	Time       int64
+}
+
+// TransactionsConfig specifies the options for Module.Transactions.
+type TransactionsConfig struct {
+	Ascending bool
+	Offset    int
+	Limit     int
+}
+
+// TransactionsOption updates a TransactionsConfig.
+type TransactionsOption func(*TransactionsConfig)
+
+// WithTxAscending specifies to sort the results in ascending order. Default is descending order.
+// Transactions are sorted by timestamp.
+func WithTxAscending(ascending bool) TransactionsOption {
+	return func(c *TransactionsConfig) {
+		c.Ascending = ascending
+	}
+}
+
+// WithTxOffset skips the first offset transactions of the results.
+func WithTxOffset(offset int) TransactionsOption {
+	return func(c *TransactionsConfig) {
+		c.Offset = offset
+	}
+}
+
+// WithTxLimit limits the results to at most limit transactions. Zero means no limit.
+func WithTxLimit(limit int) TransactionsOption {
+	return func(c *TransactionsConfig) {
+		c.Limit = limit
+	}
 }